@@ -23,6 +23,8 @@ var DefaultRoles = map[string]string{
 	atc.BuildResources:                ViewerRole,
 	atc.AbortBuild:                    OperatorRole,
 	atc.GetBuildPreparation:           ViewerRole,
+	atc.GetBuildSecretAccesses:        ViewerRole,
+	atc.ListSecretAccesses:            ViewerRole,
 	atc.GetJob:                        ViewerRole,
 	atc.CreateJobBuild:                OperatorRole,
 	atc.RerunJobBuild:                 OperatorRole,
@@ -43,6 +45,9 @@ var DefaultRoles = map[string]string{
 	atc.ListResourceTypes:             ViewerRole,
 	atc.GetResource:                   ViewerRole,
 	atc.UnpinResource:                 OperatorRole,
+	atc.PinResourceTypeVersion:        OperatorRole,
+	atc.UnpinResourceType:             OperatorRole,
+	atc.PromoteResourceTypeCandidate:  OperatorRole,
 	atc.SetPinCommentOnResource:       OperatorRole,
 	atc.CheckResource:                 OperatorRole,
 	atc.CheckResourceWebHook:          OperatorRole,
@@ -51,6 +56,8 @@ var DefaultRoles = map[string]string{
 	atc.GetResourceVersion:            ViewerRole,
 	atc.EnableResourceVersion:         OperatorRole,
 	atc.DisableResourceVersion:        OperatorRole,
+	atc.AddResourceVersionLabel:       OperatorRole,
+	atc.RemoveResourceVersionLabel:    OperatorRole,
 	atc.PinResourceVersion:            OperatorRole,
 	atc.ListBuildsWithVersionAsInput:  ViewerRole,
 	atc.ListBuildsWithVersionAsOutput: ViewerRole,
@@ -76,6 +83,17 @@ var DefaultRoles = map[string]string{
 	atc.HeartbeatWorker:               MemberRole,
 	atc.ListWorkers:                   ViewerRole,
 	atc.DeleteWorker:                  MemberRole,
+	atc.ListMaintenanceWindows:        ViewerRole,
+	atc.CreateMaintenanceWindow:       OwnerRole,
+	atc.DeleteMaintenanceWindow:       OwnerRole,
+	atc.ListWorkerKeys:                MemberRole,
+	atc.MarkWorkerKeysUsed:            MemberRole,
+	atc.ListWorkerRegistrationTokens:  ViewerRole,
+	atc.CreateWorkerRegistrationToken: OwnerRole,
+	atc.DeleteWorkerRegistrationToken: OwnerRole,
+	atc.RedeemWorkerRegistrationToken: MemberRole,
+	atc.ListWorkerSessions:            ViewerRole,
+	atc.ReportWorkerSessions:          MemberRole,
 	atc.SetLogLevel:                   MemberRole,
 	atc.GetLogLevel:                   ViewerRole,
 	atc.DownloadCLI:                   ViewerRole,
@@ -94,6 +112,15 @@ var DefaultRoles = map[string]string{
 	atc.SetTeam:                       OwnerRole,
 	atc.RenameTeam:                    OwnerRole,
 	atc.DestroyTeam:                   OwnerRole,
+	atc.GetTeamCheckLimits:            OwnerRole,
+	atc.SetTeamCheckLimits:            OwnerRole,
+	atc.ListTeamWorkerKeys:            OwnerRole,
+	atc.SetTeamWorkerKey:              OwnerRole,
+	atc.DeleteTeamWorkerKey:           OwnerRole,
+	atc.ListTeamSecrets:               MemberRole,
+	atc.SetTeamSecret:                 MemberRole,
+	atc.DeleteTeamSecret:              MemberRole,
+	atc.ClearTeamSecretCache:          OwnerRole,
 	atc.ListTeamBuilds:                ViewerRole,
 	atc.CreateArtifact:                MemberRole,
 	atc.GetArtifact:                   MemberRole,
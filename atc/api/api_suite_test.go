@@ -40,37 +40,44 @@ var (
 	externalURL = "https://example.com"
 	clusterName = "Test Cluster"
 
-	fakeWorkerPool          *workerfakes.FakePool
-	fakeVolumeRepository    *dbfakes.FakeVolumeRepository
-	fakeContainerRepository *dbfakes.FakeContainerRepository
-	fakeDestroyer           *gcfakes.FakeDestroyer
-	dbTeamFactory           *dbfakes.FakeTeamFactory
-	dbPipelineFactory       *dbfakes.FakePipelineFactory
-	dbJobFactory            *dbfakes.FakeJobFactory
-	dbResourceFactory       *dbfakes.FakeResourceFactory
-	dbResourceConfigFactory *dbfakes.FakeResourceConfigFactory
-	fakePipeline            *dbfakes.FakePipeline
-	fakeAccess              *accessorfakes.FakeAccess
-	fakeAccessor            *accessorfakes.FakeAccessFactory
-	dbWorkerFactory         *dbfakes.FakeWorkerFactory
-	dbWorkerTeamFactory     *dbfakes.FakeTeamFactory
-	dbWorkerLifecycle       *dbfakes.FakeWorkerLifecycle
-	build                   *dbfakes.FakeBuild
-	dbBuildFactory          *dbfakes.FakeBuildFactory
-	dbUserFactory           *dbfakes.FakeUserFactory
-	dbCheckFactory          *dbfakes.FakeCheckFactory
-	dbTeam                  *dbfakes.FakeTeam
-	dbWall                  *dbfakes.FakeWall
-	fakeSecretManager       *credsfakes.FakeSecrets
-	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
-	fakePolicyChecker       *policycheckerfakes.FakePolicyChecker
-	credsManagers           creds.Managers
-	interceptTimeoutFactory *containerserverfakes.FakeInterceptTimeoutFactory
-	interceptTimeout        *containerserverfakes.FakeInterceptTimeout
-	isTLSEnabled            bool
-	cliDownloadsDir         string
-	logger                  *lagertest.TestLogger
-	fakeClock               *fakeclock.FakeClock
+	fakeWorkerPool               *workerfakes.FakePool
+	fakeVolumeRepository         *dbfakes.FakeVolumeRepository
+	fakeContainerRepository      *dbfakes.FakeContainerRepository
+	fakeDestroyer                *gcfakes.FakeDestroyer
+	dbTeamFactory                *dbfakes.FakeTeamFactory
+	dbPipelineFactory            *dbfakes.FakePipelineFactory
+	dbJobFactory                 *dbfakes.FakeJobFactory
+	dbResourceFactory            *dbfakes.FakeResourceFactory
+	dbResourceConfigFactory      *dbfakes.FakeResourceConfigFactory
+	fakePipeline                 *dbfakes.FakePipeline
+	fakeAccess                   *accessorfakes.FakeAccess
+	fakeAccessor                 *accessorfakes.FakeAccessFactory
+	dbWorkerFactory              *dbfakes.FakeWorkerFactory
+	dbMaintenanceWindowFactory   *dbfakes.FakeMaintenanceWindowFactory
+	dbWorkerKeyFactory           *dbfakes.FakeWorkerKeyFactory
+	dbWorkerSessionFactory       *dbfakes.FakeWorkerSessionFactory
+	dbSecretFactory              *dbfakes.FakeSecretFactory
+	dbSecretAccessFactory        *dbfakes.FakeSecretAccessFactory
+	dbSecretCacheEvictionFactory *dbfakes.FakeSecretCacheEvictionFactory
+	dbWorkerTeamFactory          *dbfakes.FakeTeamFactory
+	dbWorkerLifecycle            *dbfakes.FakeWorkerLifecycle
+	build                        *dbfakes.FakeBuild
+	dbBuildFactory               *dbfakes.FakeBuildFactory
+	dbUserFactory                *dbfakes.FakeUserFactory
+	dbCheckFactory               *dbfakes.FakeCheckFactory
+	dbTeam                       *dbfakes.FakeTeam
+	dbWall                       *dbfakes.FakeWall
+	fakeSecretManager            *credsfakes.FakeSecrets
+	fakeVarSourcePool            *credsfakes.FakeVarSourcePool
+	fakePolicyChecker            *policycheckerfakes.FakePolicyChecker
+	credsManagers                creds.Managers
+	credsChain                   []string
+	interceptTimeoutFactory      *containerserverfakes.FakeInterceptTimeoutFactory
+	interceptTimeout             *containerserverfakes.FakeInterceptTimeout
+	isTLSEnabled                 bool
+	cliDownloadsDir              string
+	logger                       *lagertest.TestLogger
+	fakeClock                    *fakeclock.FakeClock
 
 	constructedEventHandler *fakeEventHandlerFactory
 
@@ -129,6 +136,12 @@ var _ = BeforeEach(func() {
 	dbTeam.PipelineReturns(fakePipeline, true, nil)
 
 	dbWorkerFactory = new(dbfakes.FakeWorkerFactory)
+	dbMaintenanceWindowFactory = new(dbfakes.FakeMaintenanceWindowFactory)
+	dbWorkerKeyFactory = new(dbfakes.FakeWorkerKeyFactory)
+	dbWorkerSessionFactory = new(dbfakes.FakeWorkerSessionFactory)
+	dbSecretFactory = new(dbfakes.FakeSecretFactory)
+	dbSecretAccessFactory = new(dbfakes.FakeSecretAccessFactory)
+	dbSecretCacheEvictionFactory = new(dbfakes.FakeSecretCacheEvictionFactory)
 	dbWorkerLifecycle = new(dbfakes.FakeWorkerLifecycle)
 
 	fakeWorkerPool = new(workerfakes.FakePool)
@@ -140,6 +153,7 @@ var _ = BeforeEach(func() {
 	fakeSecretManager = new(credsfakes.FakeSecrets)
 	fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
 	credsManagers = make(creds.Managers)
+	credsChain = nil
 
 	fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
 
@@ -191,6 +205,12 @@ var _ = BeforeEach(func() {
 		dbJobFactory,
 		dbResourceFactory,
 		dbWorkerFactory,
+		dbMaintenanceWindowFactory,
+		dbWorkerKeyFactory,
+		dbWorkerSessionFactory,
+		dbSecretFactory,
+		dbSecretAccessFactory,
+		dbSecretCacheEvictionFactory,
 		dbWorkerTeamFactory,
 		fakeVolumeRepository,
 		fakeContainerRepository,
@@ -214,6 +234,7 @@ var _ = BeforeEach(func() {
 		fakeSecretManager,
 		fakeVarSourcePool,
 		credsManagers,
+		credsChain,
 		interceptTimeoutFactory,
 		time.Second,
 		dbWall,
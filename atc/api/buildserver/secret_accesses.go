@@ -0,0 +1,82 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetBuildSecretAccesses(build db.Build) http.Handler {
+	hLog := s.logger.Session("get-build-secret-accesses")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accesses, err := build.SecretAccesses()
+		if err != nil {
+			hLog.Error("failed-to-get-secret-accesses", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented := make([]atc.SecretAccess, len(accesses))
+		for i, access := range accesses {
+			presented[i] = present.SecretAccess(access)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			hLog.Error("failed-to-encode-secret-accesses", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+// ListSecretAccesses lists, for every credential, the builds which accessed
+// it. They can be narrowed down to a path and to the accesses made since a
+// date.
+func (s *Server) ListSecretAccesses(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("list-secret-accesses")
+
+	filter := db.SecretAccessFilter{
+		Path: r.FormValue("path"),
+	}
+
+	if r.FormValue("since") != "" {
+		since, err := time.Parse("2006-01-02", r.FormValue("since"))
+		if err != nil {
+			hLog.Error("failed-to-parse-time", err)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			err = json.NewEncoder(w).Encode(map[string]string{"error": "wrong date format (yyyy-mm-dd)"})
+			if err != nil {
+				hLog.Error("failed-to-encode-date-parsing-error", err)
+			}
+			return
+		}
+
+		filter.Since = since
+	}
+
+	summaries, err := s.secretAccessFactory.SecretAccessSummaries(filter)
+	if err != nil {
+		hLog.Error("failed-to-get-secret-access-summaries", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presented := make([]atc.SecretAccessSummary, len(summaries))
+	for i, summary := range summaries {
+		presented[i] = present.SecretAccessSummary(summary)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(presented)
+	if err != nil {
+		hLog.Error("failed-to-encode-secret-access-summaries", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -47,6 +47,12 @@ func NewHandler(
 	dbJobFactory db.JobFactory,
 	dbResourceFactory db.ResourceFactory,
 	dbWorkerFactory db.WorkerFactory,
+	dbMaintenanceWindowFactory db.MaintenanceWindowFactory,
+	dbWorkerKeyFactory db.WorkerKeyFactory,
+	dbWorkerSessionFactory db.WorkerSessionFactory,
+	dbSecretFactory db.SecretFactory,
+	dbSecretAccessFactory db.SecretAccessFactory,
+	dbSecretCacheEvictionFactory db.SecretCacheEvictionFactory,
 	workerTeamFactory db.TeamFactory,
 	volumeRepository db.VolumeRepository,
 	containerRepository db.ContainerRepository,
@@ -70,6 +76,7 @@ func NewHandler(
 	secretManager creds.Secrets,
 	varSourcePool creds.VarSourcePool,
 	credsManagers creds.Managers,
+	credsChain []string,
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 	interceptUpdateInterval time.Duration,
 	dbWall db.Wall,
@@ -85,7 +92,7 @@ func NewHandler(
 	buildHandlerFactory := buildserver.NewScopedHandlerFactory(logger)
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
 
-	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory)
+	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, dbSecretAccessFactory, eventHandlerFactory)
 	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory)
 	resourceServer := resourceserver.NewServer(logger, secretManager, varSourcePool, dbCheckFactory, dbResourceFactory, dbResourceConfigFactory)
 
@@ -93,13 +100,13 @@ func NewHandler(
 	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL)
 	configServer := configserver.NewServer(logger, dbTeamFactory, secretManager)
 	ccServer := ccserver.NewServer(logger, dbTeamFactory, externalURL)
-	workerServer := workerserver.NewServer(logger, workerTeamFactory, dbWorkerFactory)
+	workerServer := workerserver.NewServer(logger, workerTeamFactory, dbWorkerFactory, dbMaintenanceWindowFactory, dbWorkerKeyFactory, dbWorkerSessionFactory)
 	logLevelServer := loglevelserver.NewServer(logger, sink)
 	cliServer := cliserver.NewServer(logger, absCLIDownloadsDir)
 	containerServer := containerserver.NewServer(logger, workerPool, secretManager, varSourcePool, interceptTimeoutFactory, interceptUpdateInterval, containerRepository, destroyer, clock)
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
-	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
-	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers)
+	teamServer := teamserver.NewServer(logger, dbTeamFactory, dbSecretFactory, dbSecretCacheEvictionFactory, externalURL)
+	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers, credsChain)
 	artifactServer := artifactserver.NewServer(logger, workerPool)
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
 	wallServer := wallserver.NewServer(dbWall, logger)
@@ -120,6 +127,9 @@ func NewHandler(
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
 		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
 
+		atc.GetBuildSecretAccesses: buildHandlerFactory.HandlerFor(buildServer.GetBuildSecretAccesses),
+		atc.ListSecretAccesses:     http.HandlerFunc(buildServer.ListSecretAccesses),
+
 		atc.ListAllJobs:    http.HandlerFunc(jobServer.ListAllJobs),
 		atc.ListJobs:       pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
 		atc.GetJob:         pipelineHandlerFactory.HandlerFor(jobServer.GetJob),
@@ -165,10 +175,16 @@ func NewHandler(
 		atc.CheckResourceWebHook:    pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceWebHook),
 		atc.CheckResourceType:       pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceType),
 
+		atc.PinResourceTypeVersion:       pipelineHandlerFactory.HandlerFor(resourceServer.PinResourceTypeVersion),
+		atc.UnpinResourceType:            pipelineHandlerFactory.HandlerFor(resourceServer.UnpinResourceType),
+		atc.PromoteResourceTypeCandidate: pipelineHandlerFactory.HandlerFor(resourceServer.PromoteResourceTypeCandidate),
+
 		atc.ListResourceVersions:          pipelineHandlerFactory.HandlerFor(versionServer.ListResourceVersions),
 		atc.GetResourceVersion:            pipelineHandlerFactory.HandlerFor(versionServer.GetResourceVersion),
 		atc.EnableResourceVersion:         pipelineHandlerFactory.HandlerFor(versionServer.EnableResourceVersion),
 		atc.DisableResourceVersion:        pipelineHandlerFactory.HandlerFor(versionServer.DisableResourceVersion),
+		atc.AddResourceVersionLabel:       pipelineHandlerFactory.HandlerFor(versionServer.AddResourceVersionLabel),
+		atc.RemoveResourceVersionLabel:    pipelineHandlerFactory.HandlerFor(versionServer.RemoveResourceVersionLabel),
 		atc.PinResourceVersion:            pipelineHandlerFactory.HandlerFor(versionServer.PinResourceVersion),
 		atc.ListBuildsWithVersionAsInput:  pipelineHandlerFactory.HandlerFor(versionServer.ListBuildsWithVersionAsInput),
 		atc.ListBuildsWithVersionAsOutput: pipelineHandlerFactory.HandlerFor(versionServer.ListBuildsWithVersionAsOutput),
@@ -182,6 +198,21 @@ func NewHandler(
 		atc.HeartbeatWorker: http.HandlerFunc(workerServer.HeartbeatWorker),
 		atc.DeleteWorker:    http.HandlerFunc(workerServer.DeleteWorker),
 
+		atc.ListMaintenanceWindows:  http.HandlerFunc(workerServer.ListMaintenanceWindows),
+		atc.CreateMaintenanceWindow: http.HandlerFunc(workerServer.CreateMaintenanceWindow),
+		atc.DeleteMaintenanceWindow: http.HandlerFunc(workerServer.DeleteMaintenanceWindow),
+
+		atc.ListWorkerKeys:     http.HandlerFunc(workerServer.ListWorkerKeys),
+		atc.MarkWorkerKeysUsed: http.HandlerFunc(workerServer.MarkWorkerKeysUsed),
+
+		atc.ListWorkerSessions:   http.HandlerFunc(workerServer.ListWorkerSessions),
+		atc.ReportWorkerSessions: http.HandlerFunc(workerServer.ReportWorkerSessions),
+
+		atc.ListWorkerRegistrationTokens:  http.HandlerFunc(workerServer.ListWorkerRegistrationTokens),
+		atc.CreateWorkerRegistrationToken: http.HandlerFunc(workerServer.CreateWorkerRegistrationToken),
+		atc.DeleteWorkerRegistrationToken: http.HandlerFunc(workerServer.DeleteWorkerRegistrationToken),
+		atc.RedeemWorkerRegistrationToken: http.HandlerFunc(workerServer.RedeemWorkerRegistrationToken),
+
 		atc.SetLogLevel: http.HandlerFunc(logLevelServer.SetMinLevel),
 		atc.GetLogLevel: http.HandlerFunc(logLevelServer.GetMinLevel),
 
@@ -209,6 +240,20 @@ func NewHandler(
 		atc.DestroyTeam:    teamHandlerFactory.HandlerFor(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: teamHandlerFactory.HandlerFor(teamServer.ListTeamBuilds),
 
+		atc.GetTeamCheckLimits: teamHandlerFactory.HandlerFor(teamServer.GetCheckLimits),
+		atc.SetTeamCheckLimits: teamHandlerFactory.HandlerFor(teamServer.SetCheckLimits),
+
+		atc.ListTeamWorkerKeys:  teamHandlerFactory.HandlerFor(teamServer.ListWorkerKeys),
+		atc.SetTeamWorkerKey:    teamHandlerFactory.HandlerFor(teamServer.SetWorkerKey),
+		atc.DeleteTeamWorkerKey: teamHandlerFactory.HandlerFor(teamServer.DeleteWorkerKey),
+
+		atc.ListTeamSecrets:  teamHandlerFactory.HandlerFor(teamServer.ListSecrets),
+		atc.SetTeamSecret:    teamHandlerFactory.HandlerFor(teamServer.SetSecret),
+		atc.DeleteTeamSecret: teamHandlerFactory.HandlerFor(teamServer.DeleteSecret),
+
+		atc.ClearSecretCache:     http.HandlerFunc(teamServer.ClearAllSecretCaches),
+		atc.ClearTeamSecretCache: teamHandlerFactory.HandlerFor(teamServer.ClearSecretCache),
+
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
 
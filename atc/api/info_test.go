@@ -5,14 +5,19 @@ import (
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 
 	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	awssecretsmanager "github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
 	awsssm "github.com/aws/aws-sdk-go/service/ssm"
 	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
+	"github.com/concourse/concourse/atc/api/infoserver"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/credhub"
+	"github.com/concourse/concourse/atc/creds/dummy"
 	"github.com/concourse/concourse/atc/creds/secretsmanager"
 	"github.com/concourse/concourse/atc/creds/ssm"
 	"github.com/concourse/concourse/atc/creds/vault"
@@ -272,6 +277,7 @@ var _ = Describe("Pipelines API", func() {
 						"auth_max_ttl": 20,
 						"auth_retry_max": 5,
 						"auth_retry_initial": 2,
+						"dynamic_paths": null,
 						"health": {
 							"response": {
                   "initialized": true,
@@ -470,5 +476,31 @@ var _ = Describe("Pipelines API", func() {
 			})
 
 		})
+
+		Context("when credential managers are chained", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("lists every member along with the order of the chain", func() {
+				managers := creds.Managers{
+					"dummy": &dummy.Manager{Vars: []dummy.VarFlag{{Name: "some-var", Value: "some-value"}}},
+					"other": &dummy.Manager{Vars: []dummy.VarFlag{{Name: "some-var", Value: "some-value"}}},
+				}
+
+				infoServer := infoserver.NewServer(lagertest.NewTestLogger("test"), "", "", "", "", managers, []string{"other", "dummy"})
+
+				recorder := httptest.NewRecorder()
+				infoServer.Creds(recorder, httptest.NewRequest("GET", "/api/v1/info/creds", nil))
+
+				Expect(recorder.Code).To(Equal(http.StatusOK))
+				Expect(recorder.Body.String()).To(MatchJSON(`{
+					"dummy": {"health": {"method": "noop"}},
+					"other": {"health": {"method": "noop"}},
+					"chain": ["other", "dummy"]
+				}`))
+			})
+		})
 	})
 })
@@ -3,19 +3,18 @@ package infoserver
 import (
 	"encoding/json"
 	"net/http"
-
-	"github.com/concourse/concourse/atc/creds"
 )
 
 // Creds returns information on the credential manager attached to this instance of concourse.
 // If no credential manager is configured the response will be empty.
+// If managers are chained, every member is listed, along with the order of the chain.
 // No actual credentials are shown in the response.
 func (s *Server) Creds(w http.ResponseWriter, r *http.Request) {
 	logger := s.logger.Session("creds")
 
 	w.Header().Set("Content-Type", "application/json")
 
-	configuredManagers := make(creds.Managers)
+	configuredManagers := make(map[string]interface{})
 
 	for name, manager := range s.credsManagers {
 		if manager.IsConfigured() {
@@ -23,6 +22,10 @@ func (s *Server) Creds(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	if len(s.credsChain) > 0 {
+		configuredManagers["chain"] = s.credsChain
+	}
+
 	err := json.NewEncoder(w).Encode(configuredManagers)
 	if err != nil {
 		logger.Error("failed-to-encode-info", err)
@@ -12,6 +12,7 @@ type Server struct {
 	externalURL   string
 	clusterName   string
 	credsManagers creds.Managers
+	credsChain    []string
 }
 
 func NewServer(
@@ -21,6 +22,7 @@ func NewServer(
 	externalURL string,
 	clusterName string,
 	credsManagers creds.Managers,
+	credsChain []string,
 ) *Server {
 	return &Server{
 		logger:        logger,
@@ -29,5 +31,6 @@ func NewServer(
 		externalURL:   externalURL,
 		clusterName:   clusterName,
 		credsManagers: credsManagers,
+		credsChain:    credsChain,
 	}
 }
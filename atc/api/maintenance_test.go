@@ -0,0 +1,281 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Maintenance Windows API", func() {
+	var (
+		response *http.Response
+
+		drainStartsAt time.Time
+		startsAt      time.Time
+		endsAt        time.Time
+	)
+
+	BeforeEach(func() {
+		drainStartsAt = time.Now().Add(time.Hour).Truncate(time.Second)
+		startsAt = drainStartsAt.Add(time.Hour)
+		endsAt = startsAt.Add(time.Hour)
+	})
+
+	Describe("GET /api/v1/maintenance-windows", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/maintenance-windows", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbMaintenanceWindowFactory.MaintenanceWindowsReturns([]db.MaintenanceWindow{
+					{
+						ID:            1,
+						WorkerName:    "some-worker",
+						DrainStartsAt: drainStartsAt,
+						StartsAt:      startsAt,
+						EndsAt:        endsAt,
+					},
+					{
+						ID:            2,
+						Tag:           "some-tag",
+						DrainStartsAt: time.Now().Add(-time.Minute),
+						StartsAt:      startsAt,
+						EndsAt:        endsAt,
+					},
+				}, nil)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("returns Content-Type 'application/json'", func() {
+				expectedHeaderEntries := map[string]string{
+					"Content-Type": "application/json",
+				}
+				Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+			})
+
+			It("returns the windows with their state", func() {
+				var windows []atc.MaintenanceWindow
+				err := json.NewDecoder(response.Body).Decode(&windows)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(windows).To(HaveLen(2))
+				Expect(windows[0]).To(Equal(atc.MaintenanceWindow{
+					ID:            1,
+					Worker:        "some-worker",
+					DrainStartsAt: drainStartsAt.Unix(),
+					StartsAt:      startsAt.Unix(),
+					EndsAt:        endsAt.Unix(),
+					State:         atc.WorkerMaintenanceScheduled,
+				}))
+				Expect(windows[1].Tag).To(Equal("some-tag"))
+				Expect(windows[1].State).To(Equal(atc.WorkerMaintenanceDraining))
+			})
+
+			Context("when listing the windows fails", func() {
+				BeforeEach(func() {
+					dbMaintenanceWindowFactory.MaintenanceWindowsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("POST /api/v1/maintenance-windows", func() {
+		var window atc.MaintenanceWindow
+
+		BeforeEach(func() {
+			window = atc.MaintenanceWindow{
+				Worker:        "some-worker",
+				DrainStartsAt: drainStartsAt.Unix(),
+				StartsAt:      startsAt.Unix(),
+				EndsAt:        endsAt.Unix(),
+			}
+
+			dbMaintenanceWindowFactory.CreateMaintenanceWindowReturns(db.MaintenanceWindow{
+				ID:            42,
+				WorkerName:    "some-worker",
+				DrainStartsAt: drainStartsAt,
+				StartsAt:      startsAt,
+				EndsAt:        endsAt,
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(window)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("POST", server.URL+"/api/v1/maintenance-windows",
+				ioutil.NopCloser(bytes.NewBuffer(payload)))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("returns 201", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			})
+
+			It("creates the window", func() {
+				Expect(dbMaintenanceWindowFactory.CreateMaintenanceWindowCallCount()).To(Equal(1))
+				Expect(dbMaintenanceWindowFactory.CreateMaintenanceWindowArgsForCall(0)).To(Equal(window))
+			})
+
+			It("returns the created window", func() {
+				Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(fmt.Sprintf(`{
+					"id": 42,
+					"worker": "some-worker",
+					"drain_starts_at": %d,
+					"starts_at": %d,
+					"ends_at": %d,
+					"state": "scheduled"
+				}`, drainStartsAt.Unix(), startsAt.Unix(), endsAt.Unix())))
+			})
+
+			Context("when the window is invalid", func() {
+				BeforeEach(func() {
+					window.Tag = "some-tag"
+				})
+
+				It("returns 400 with the reason", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte(atc.ErrMaintenanceWindowTarget.Error())))
+				})
+
+				It("does not create the window", func() {
+					Expect(dbMaintenanceWindowFactory.CreateMaintenanceWindowCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when creating the window fails", func() {
+				BeforeEach(func() {
+					dbMaintenanceWindowFactory.CreateMaintenanceWindowReturns(db.MaintenanceWindow{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/maintenance-windows/:window_id", func() {
+		var windowID string
+
+		BeforeEach(func() {
+			windowID = "42"
+			dbMaintenanceWindowFactory.DeleteMaintenanceWindowReturns(true, nil)
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/maintenance-windows/"+windowID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("returns 204", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			})
+
+			It("deletes the window", func() {
+				Expect(dbMaintenanceWindowFactory.DeleteMaintenanceWindowCallCount()).To(Equal(1))
+				Expect(dbMaintenanceWindowFactory.DeleteMaintenanceWindowArgsForCall(0)).To(Equal(42))
+			})
+
+			Context("when the window does not exist", func() {
+				BeforeEach(func() {
+					dbMaintenanceWindowFactory.DeleteMaintenanceWindowReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the id is not a number", func() {
+				BeforeEach(func() {
+					windowID = "nope"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+})
@@ -0,0 +1,20 @@
+package present
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func MaintenanceWindow(window db.MaintenanceWindow) atc.MaintenanceWindow {
+	return atc.MaintenanceWindow{
+		ID:            window.ID,
+		Worker:        window.WorkerName,
+		Tag:           window.Tag,
+		DrainStartsAt: window.DrainStartsAt.Unix(),
+		StartsAt:      window.StartsAt.Unix(),
+		EndsAt:        window.EndsAt.Unix(),
+		State:         window.State(time.Now()),
+	}
+}
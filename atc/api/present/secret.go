@@ -0,0 +1,38 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func Secret(secret db.Secret) atc.Secret {
+	return atc.Secret{
+		TeamName:     secret.TeamName,
+		PipelineName: secret.PipelineName,
+		Name:         secret.Name,
+		CreatedAt:    secret.CreatedAt.Unix(),
+		UpdatedAt:    secret.UpdatedAt.Unix(),
+		UpdatedBy:    secret.UpdatedBy,
+	}
+}
+
+func SecretAccess(access db.SecretAccess) atc.SecretAccess {
+	return atc.SecretAccess{
+		BuildID:    access.BuildID,
+		VarSource:  access.VarSource,
+		Path:       access.Path,
+		Manager:    access.Manager,
+		AccessedAt: access.AccessedAt.Unix(),
+	}
+}
+
+func SecretAccessSummary(summary db.SecretAccessSummary) atc.SecretAccessSummary {
+	return atc.SecretAccessSummary{
+		TeamName:       summary.TeamName,
+		VarSource:      summary.VarSource,
+		Path:           summary.Path,
+		Manager:        summary.Manager,
+		BuildIDs:       summary.BuildIDs,
+		LastAccessedAt: summary.LastAccessedAt.Unix(),
+	}
+}
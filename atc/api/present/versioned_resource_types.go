@@ -6,5 +6,12 @@ import (
 )
 
 func VersionedResourceTypes(savedResourceTypes db.ResourceTypes) atc.VersionedResourceTypes {
-	return savedResourceTypes.Deserialize()
+	versionedResourceTypes := savedResourceTypes.Deserialize()
+
+	for i, t := range savedResourceTypes {
+		versionedResourceTypes[i].Pinned = t.CurrentPinnedVersion() != nil
+		versionedResourceTypes[i].CandidateVersion = t.CandidateVersion()
+	}
+
+	return versionedResourceTypes
 }
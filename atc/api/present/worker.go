@@ -1,6 +1,8 @@
 package present
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -24,27 +26,41 @@ func Worker(workerInfo db.Worker) atc.Worker {
 	}
 
 	atcWorker := atc.Worker{
-		GardenAddr:       gardenAddr,
-		BaggageclaimURL:  baggageclaimURL,
-		HTTPProxyURL:     workerInfo.HTTPProxyURL(),
-		HTTPSProxyURL:    workerInfo.HTTPSProxyURL(),
-		NoProxy:          workerInfo.NoProxy(),
-		ActiveContainers: workerInfo.ActiveContainers(),
-		ActiveVolumes:    workerInfo.ActiveVolumes(),
-		ActiveTasks:      activeTasks,
-		ResourceTypes:    workerInfo.ResourceTypes(),
-		Platform:         workerInfo.Platform(),
-		Tags:             workerInfo.Tags(),
-		Name:             workerInfo.Name(),
-		Team:             workerInfo.TeamName(),
-		State:            string(workerInfo.State()),
-		Version:          version,
-		Ephemeral:        workerInfo.Ephemeral(),
+		GardenAddr:             gardenAddr,
+		BaggageclaimURL:        baggageclaimURL,
+		HTTPProxyURL:           workerInfo.HTTPProxyURL(),
+		HTTPSProxyURL:          workerInfo.HTTPSProxyURL(),
+		NoProxy:                workerInfo.NoProxy(),
+		ActiveContainers:       workerInfo.ActiveContainers(),
+		ActiveVolumes:          workerInfo.ActiveVolumes(),
+		ActiveTasks:            activeTasks,
+		Resources:              workerInfo.Resources(),
+		ResourceTypes:          workerInfo.ResourceTypes(),
+		Platform:               workerInfo.Platform(),
+		Tags:                   workerInfo.Tags(),
+		Labels:                 workerInfo.Labels(),
+		RuntimeClasses:         workerInfo.RuntimeClasses(),
+		EnforcesEgressPolicies: workerInfo.EnforcesEgressPolicies(),
+		Name:                   workerInfo.Name(),
+		Team:                   workerInfo.TeamName(),
+		State:                  string(workerInfo.State()),
+		Version:                version,
+		Ephemeral:              workerInfo.Ephemeral(),
 	}
 
 	if !workerInfo.StartTime().IsZero() {
 		atcWorker.StartTime = workerInfo.StartTime().Unix()
 	}
 
+	if maintenance := workerInfo.Maintenance(); maintenance != nil {
+		atcWorker.Maintenance = &atc.WorkerMaintenance{
+			WindowID:      maintenance.ID,
+			DrainStartsAt: maintenance.DrainStartsAt.Unix(),
+			StartsAt:      maintenance.StartsAt.Unix(),
+			EndsAt:        maintenance.EndsAt.Unix(),
+			State:         maintenance.State(time.Now()),
+		}
+	}
+
 	return atcWorker
 }
@@ -0,0 +1,31 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func WorkerKey(key db.WorkerKey) atc.WorkerKey {
+	presented := atc.WorkerKey{
+		TeamName:    key.TeamName,
+		Name:        key.Name,
+		PublicKey:   key.PublicKey,
+		Fingerprint: key.Fingerprint,
+		WorkerName:  key.WorkerName,
+		CreatedAt:   key.CreatedAt.Unix(),
+	}
+
+	if len(key.Tags) > 0 {
+		presented.Tags = key.Tags
+	}
+
+	if !key.ExpiresAt.IsZero() {
+		presented.ExpiresAt = key.ExpiresAt.Unix()
+	}
+
+	if !key.LastUsedAt.IsZero() {
+		presented.LastUsedAt = key.LastUsedAt.Unix()
+	}
+
+	return presented
+}
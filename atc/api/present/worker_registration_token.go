@@ -0,0 +1,23 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func WorkerRegistrationToken(token db.WorkerRegistrationToken) atc.WorkerRegistrationToken {
+	presented := atc.WorkerRegistrationToken{
+		ID:        token.ID,
+		TeamName:  token.TeamName,
+		CreatedAt: token.CreatedAt.Unix(),
+		ExpiresAt: token.ExpiresAt.Unix(),
+		MaxUses:   token.MaxUses,
+		Uses:      token.Uses,
+	}
+
+	if len(token.Tags) > 0 {
+		presented.Tags = token.Tags
+	}
+
+	return presented
+}
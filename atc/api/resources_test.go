@@ -791,6 +791,13 @@ var _ = Describe("Resources API", func() {
 					"version-key-1": "version-value-1",
 					"version-key-2": "version-value-2",
 				})
+				resourceType1.CurrentPinnedVersionReturns(map[string]string{
+					"version-key-1": "version-value-1",
+					"version-key-2": "version-value-2",
+				})
+				resourceType1.CandidateVersionReturns(map[string]string{
+					"version-key-1": "version-value-3",
+				})
 
 				resourceType2 := new(dbfakes.FakeResourceType)
 				resourceType2.IDReturns(2)
@@ -856,6 +863,10 @@ var _ = Describe("Resources API", func() {
 					"version": {
 						"version-key-1": "version-value-1",
 						"version-key-2": "version-value-2"
+					},
+					"pinned": true,
+					"candidate_version": {
+						"version-key-1": "version-value-3"
 					}
 				},
 				{
@@ -906,6 +917,10 @@ var _ = Describe("Resources API", func() {
 				"version": {
 					"version-key-1": "version-value-1",
 					"version-key-2": "version-value-2"
+				},
+				"pinned": true,
+				"candidate_version": {
+					"version-key-1": "version-value-3"
 				}
 			},
 			{
@@ -1443,6 +1458,282 @@ var _ = Describe("Resources API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/pin", func() {
+		var (
+			response         *http.Response
+			requestBody      string
+			fakeResourceType *dbfakes.FakeResourceType
+		)
+
+		BeforeEach(func() {
+			requestBody = `{"version":{"tag":"1.2.3"}}`
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resource-types/resource-type-name/pin", bytes.NewBufferString(requestBody))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the resource type exists", func() {
+				BeforeEach(func() {
+					fakeResourceType = new(dbfakes.FakeResourceType)
+					fakePipeline.ResourceTypeReturns(fakeResourceType, true, nil)
+				})
+
+				It("looks up the resource type", func() {
+					Expect(fakePipeline.ResourceTypeArgsForCall(0)).To(Equal("resource-type-name"))
+				})
+
+				Context("when pinning the version succeeds", func() {
+					BeforeEach(func() {
+						fakeResourceType.PinVersionReturns(true, nil)
+					})
+
+					It("pins the given version", func() {
+						Expect(fakeResourceType.PinVersionCallCount()).To(Equal(1))
+						Expect(fakeResourceType.PinVersionArgsForCall(0)).To(Equal(atc.Version{"tag": "1.2.3"}))
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+				})
+
+				Context("when the version has not been found", func() {
+					BeforeEach(func() {
+						fakeResourceType.PinVersionReturns(false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when pinning the version fails", func() {
+					BeforeEach(func() {
+						fakeResourceType.PinVersionReturns(false, errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when no version is given", func() {
+					BeforeEach(func() {
+						requestBody = `{}`
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					})
+
+					It("does not pin anything", func() {
+						Expect(fakeResourceType.PinVersionCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the resource type is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceTypeReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when finding the resource type fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceTypeReturns(nil, false, errors.New("welp"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/unpin", func() {
+		var (
+			response         *http.Response
+			fakeResourceType *dbfakes.FakeResourceType
+		)
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resource-types/resource-type-name/unpin", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the resource type exists", func() {
+				BeforeEach(func() {
+					fakeResourceType = new(dbfakes.FakeResourceType)
+					fakePipeline.ResourceTypeReturns(fakeResourceType, true, nil)
+				})
+
+				Context("when unpinning succeeds", func() {
+					It("unpins the resource type", func() {
+						Expect(fakeResourceType.UnpinVersionCallCount()).To(Equal(1))
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+				})
+
+				Context("when unpinning fails", func() {
+					BeforeEach(func() {
+						fakeResourceType.UnpinVersionReturns(errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the resource type is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceTypeReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/promote", func() {
+		var (
+			response         *http.Response
+			fakeResourceType *dbfakes.FakeResourceType
+		)
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resource-types/resource-type-name/promote", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the resource type exists", func() {
+				BeforeEach(func() {
+					fakeResourceType = new(dbfakes.FakeResourceType)
+					fakePipeline.ResourceTypeReturns(fakeResourceType, true, nil)
+				})
+
+				Context("when the candidate is promoted", func() {
+					BeforeEach(func() {
+						fakeResourceType.PromoteCandidateVersionReturns(true, nil)
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+				})
+
+				Context("when there is no candidate", func() {
+					BeforeEach(func() {
+						fakeResourceType.PromoteCandidateVersionReturns(false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when promoting fails", func() {
+					BeforeEach(func() {
+						fakeResourceType.PromoteCandidateVersionReturns(false, errors.New("welp"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the resource type is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.ResourceTypeReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
 	Describe("POST /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", func() {
 		var (
 			checkRequestBody atc.CheckRequestBody
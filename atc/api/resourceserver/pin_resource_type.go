@@ -0,0 +1,127 @@
+package resourceserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) PinResourceTypeVersion(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceTypeName := rata.Param(r, "resource_type_name")
+
+		logger := s.logger.Session("pin-resource-type-version", lager.Data{
+			"resource-type": resourceTypeName,
+		})
+
+		var reqBody atc.PinResourceTypeRequestBody
+		err := json.NewDecoder(r.Body).Decode(&reqBody)
+		if err != nil || reqBody.Version == nil {
+			logger.Info("malformed-request")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		resourceType, found, err := pipeline.ResourceType(resourceTypeName)
+		if err != nil {
+			logger.Error("failed-to-get-resource-type", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Info("resource-type-not-found")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		pinned, err := resourceType.PinVersion(reqBody.Version)
+		if err != nil {
+			logger.Error("failed-to-pin-resource-type-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !pinned {
+			logger.Info("resource-type-version-not-found")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func (s *Server) UnpinResourceType(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceTypeName := rata.Param(r, "resource_type_name")
+
+		logger := s.logger.Session("unpin-resource-type", lager.Data{
+			"resource-type": resourceTypeName,
+		})
+
+		resourceType, found, err := pipeline.ResourceType(resourceTypeName)
+		if err != nil {
+			logger.Error("failed-to-get-resource-type", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Info("resource-type-not-found")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		err = resourceType.UnpinVersion()
+		if err != nil {
+			logger.Error("failed-to-unpin-resource-type", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func (s *Server) PromoteResourceTypeCandidate(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceTypeName := rata.Param(r, "resource_type_name")
+
+		logger := s.logger.Session("promote-resource-type-candidate", lager.Data{
+			"resource-type": resourceTypeName,
+		})
+
+		resourceType, found, err := pipeline.ResourceType(resourceTypeName)
+		if err != nil {
+			logger.Error("failed-to-get-resource-type", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Info("resource-type-not-found")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		promoted, err := resourceType.PromoteCandidateVersion()
+		if err != nil {
+			logger.Error("failed-to-promote-resource-type-candidate", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !promoted {
+			logger.Info("no-candidate-version")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
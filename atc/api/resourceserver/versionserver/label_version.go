@@ -0,0 +1,68 @@
+package versionserver
+
+import (
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) AddResourceVersionLabel(pipeline db.Pipeline) http.Handler {
+	return s.labelResourceVersion(pipeline, "add-resource-version-label", db.Resource.AddVersionLabel)
+}
+
+func (s *Server) RemoveResourceVersionLabel(pipeline db.Pipeline) http.Handler {
+	return s.labelResourceVersion(pipeline, "remove-resource-version-label", db.Resource.RemoveVersionLabel)
+}
+
+func (s *Server) labelResourceVersion(
+	pipeline db.Pipeline,
+	session string,
+	label func(db.Resource, int, string) (bool, error),
+) http.Handler {
+	logger := s.logger.Session(session)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resourceName := r.FormValue(":resource_name")
+		resource, found, err := pipeline.Resource(resourceName)
+		if err != nil {
+			logger.Error("failed-to-get-resource", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if !found {
+			logger.Debug("resource-not-found", lager.Data{"resource": resourceName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		resourceConfigVersionID, err := strconv.Atoi(r.FormValue(":resource_config_version_id"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		versionLabel := r.FormValue(":label")
+		_, err = atc.ValidateIdentifier(versionLabel, "label")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		found, err = label(resource, resourceConfigVersionID, versionLabel)
+		if err != nil {
+			logger.Error("failed-to-label-resource-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("resource-version-not-found", lager.Data{"resource-config-version-id": resourceConfigVersionID})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
@@ -31,13 +33,12 @@ func (s *Server) ListResourceVersions(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		fields := r.Form["filter"]
-		versionFilter := make(atc.Version)
-		for _, field := range fields {
-			vs := strings.SplitN(field, ":", 2)
-			if len(vs) == 2 {
-				versionFilter[vs[0]] = vs[1]
-			}
+		filter, err := parseVersionFilter(r)
+		if err != nil {
+			logger.Info("invalid-version-filter", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "%s", err)
+			return
 		}
 
 		resourceName := r.FormValue(":resource_name")
@@ -76,7 +77,7 @@ func (s *Server) ListResourceVersions(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		versions, pagination, found, err := resource.Versions(page, versionFilter)
+		versions, pagination, found, err := resource.Versions(page, filter)
 		if err != nil {
 			logger.Error("failed-to-get-resource-config-versions", err)
 			w.WriteHeader(http.StatusInternalServerError)
@@ -94,11 +95,11 @@ func (s *Server) ListResourceVersions(pipeline db.Pipeline) http.Handler {
 			InstanceVars: pipeline.InstanceVars(),
 		}
 		if pagination.Older != nil {
-			s.addNextLink(w, teamName, pipelineRef, resourceName, *pagination.Older)
+			s.addNextLink(w, teamName, pipelineRef, resourceName, *pagination.Older, versionFilterQuery(r))
 		}
 
 		if pagination.Newer != nil {
-			s.addPreviousLink(w, teamName, pipelineRef, resourceName, *pagination.Newer)
+			s.addPreviousLink(w, teamName, pipelineRef, resourceName, *pagination.Newer, versionFilterQuery(r))
 		}
 
 		w.Header().Set("Content-Type", "application/json")
@@ -118,10 +119,60 @@ func (s *Server) ListResourceVersions(pipeline db.Pipeline) http.Handler {
 	})
 }
 
-func (s *Server) addNextLink(w http.ResponseWriter, teamName string, pipelineRef atc.PipelineRef, resourceName string, page db.Page) {
+func parseVersionFilter(r *http.Request) (atc.ResourceVersionFilter, error) {
+	filter := atc.ResourceVersionFilter{
+		Version: atc.Version{},
+	}
+
+	for _, field := range r.Form[atc.ResourceVersionQueryFilter] {
+		vs := strings.SplitN(field, ":", 2)
+		if len(vs) == 2 {
+			filter.Version[vs[0]] = vs[1]
+		}
+	}
+
+	for _, field := range r.Form[atc.ResourceVersionQueryMetadata] {
+		metadataFilter, err := atc.ParseMetadataFilter(field)
+		if err != nil {
+			return atc.ResourceVersionFilter{}, err
+		}
+
+		filter.Metadata = append(filter.Metadata, metadataFilter)
+	}
+
+	filter.Labels = r.Form[atc.ResourceVersionQueryLabel]
+
+	var err error
+	filter.Since, err = parseUnixTime(r.FormValue(atc.ResourceVersionQuerySince))
+	if err != nil {
+		return atc.ResourceVersionFilter{}, fmt.Errorf("invalid '%s': %w", atc.ResourceVersionQuerySince, err)
+	}
+
+	filter.Until, err = parseUnixTime(r.FormValue(atc.ResourceVersionQueryUntil))
+	if err != nil {
+		return atc.ResourceVersionFilter{}, fmt.Errorf("invalid '%s': %w", atc.ResourceVersionQueryUntil, err)
+	}
+
+	return filter, nil
+}
+
+func parseUnixTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(seconds, 0), nil
+}
+
+func (s *Server) addNextLink(w http.ResponseWriter, teamName string, pipelineRef atc.PipelineRef, resourceName string, page db.Page, filterQuery url.Values) {
 	if pipelineRef.InstanceVars != nil {
 		w.Header().Add("Link", fmt.Sprintf(
-			`<%s/api/v1/teams/%s/pipelines/%s/resources/%s/versions?%s=%d&%s=%d&%s>; rel="%s"`,
+			`<%s/api/v1/teams/%s/pipelines/%s/resources/%s/versions?%s=%d&%s=%d&%s%s>; rel="%s"`,
 			s.externalURL,
 			teamName,
 			pipelineRef.Name,
@@ -131,11 +182,12 @@ func (s *Server) addNextLink(w http.ResponseWriter, teamName string, pipelineRef
 			atc.PaginationQueryLimit,
 			page.Limit,
 			pipelineRef.QueryParams().Encode(),
+			encodeFilterQuery(filterQuery),
 			atc.LinkRelNext,
 		))
 	} else {
 		w.Header().Add("Link", fmt.Sprintf(
-			`<%s/api/v1/teams/%s/pipelines/%s/resources/%s/versions?%s=%d&%s=%d>; rel="%s"`,
+			`<%s/api/v1/teams/%s/pipelines/%s/resources/%s/versions?%s=%d&%s=%d%s>; rel="%s"`,
 			s.externalURL,
 			teamName,
 			pipelineRef.Name,
@@ -144,15 +196,16 @@ func (s *Server) addNextLink(w http.ResponseWriter, teamName string, pipelineRef
 			*page.To,
 			atc.PaginationQueryLimit,
 			page.Limit,
+			encodeFilterQuery(filterQuery),
 			atc.LinkRelNext,
 		))
 	}
 }
 
-func (s *Server) addPreviousLink(w http.ResponseWriter, teamName string, pipelineRef atc.PipelineRef, resourceName string, page db.Page) {
+func (s *Server) addPreviousLink(w http.ResponseWriter, teamName string, pipelineRef atc.PipelineRef, resourceName string, page db.Page, filterQuery url.Values) {
 	if pipelineRef.InstanceVars != nil {
 		w.Header().Add("Link", fmt.Sprintf(
-			`<%s/api/v1/teams/%s/pipelines/%s/resources/%s/versions?%s=%d&%s=%d&%s>; rel="%s"`,
+			`<%s/api/v1/teams/%s/pipelines/%s/resources/%s/versions?%s=%d&%s=%d&%s%s>; rel="%s"`,
 			s.externalURL,
 			teamName,
 			pipelineRef.Name,
@@ -162,11 +215,12 @@ func (s *Server) addPreviousLink(w http.ResponseWriter, teamName string, pipelin
 			atc.PaginationQueryLimit,
 			page.Limit,
 			pipelineRef.QueryParams().Encode(),
+			encodeFilterQuery(filterQuery),
 			atc.LinkRelPrevious,
 		))
 	} else {
 		w.Header().Add("Link", fmt.Sprintf(
-			`<%s/api/v1/teams/%s/pipelines/%s/resources/%s/versions?%s=%d&%s=%d>; rel="%s"`,
+			`<%s/api/v1/teams/%s/pipelines/%s/resources/%s/versions?%s=%d&%s=%d%s>; rel="%s"`,
 			s.externalURL,
 			teamName,
 			pipelineRef.Name,
@@ -175,7 +229,35 @@ func (s *Server) addPreviousLink(w http.ResponseWriter, teamName string, pipelin
 			*page.From,
 			atc.PaginationQueryLimit,
 			page.Limit,
+			encodeFilterQuery(filterQuery),
 			atc.LinkRelPrevious,
 		))
 	}
 }
+
+// versionFilterQuery returns the filters of the request, so that the
+// pagination links list the same versions.
+func versionFilterQuery(r *http.Request) url.Values {
+	query := url.Values{}
+	for _, key := range []string{
+		atc.ResourceVersionQueryFilter,
+		atc.ResourceVersionQueryMetadata,
+		atc.ResourceVersionQueryLabel,
+		atc.ResourceVersionQuerySince,
+		atc.ResourceVersionQueryUntil,
+	} {
+		if values, ok := r.URL.Query()[key]; ok {
+			query[key] = values
+		}
+	}
+
+	return query
+}
+
+func encodeFilterQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+
+	return "&" + query.Encode()
+}
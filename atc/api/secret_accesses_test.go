@@ -0,0 +1,233 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/concourse/concourse/atc/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secret Accesses API", func() {
+	Describe("GET /api/v1/builds/:build_id/secrets-accessed", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/builds/128/secrets-accessed")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+
+				build.IDReturns(128)
+				build.TeamNameReturns("some-team")
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(true)
+				})
+
+				Context("when the accesses are found", func() {
+					BeforeEach(func() {
+						build.SecretAccessesReturns([]db.SecretAccess{
+							{
+								BuildID:    128,
+								Path:       "deploy-key",
+								Manager:    "vault",
+								AccessedAt: time.Unix(100, 0),
+							},
+							{
+								BuildID:    128,
+								VarSource:  ".",
+								Path:       "loaded",
+								Manager:    "load_var",
+								AccessedAt: time.Unix(200, 0),
+							},
+						}, nil)
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns application/json", func() {
+						expectedHeaderEntries := map[string]string{
+							"Content-Type": "application/json",
+						}
+						Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+					})
+
+					It("returns the accesses", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`[
+							{
+								"build_id": 128,
+								"path": "deploy-key",
+								"manager": "vault",
+								"accessed_at": 100
+							},
+							{
+								"build_id": 128,
+								"var_source": ".",
+								"path": "loaded",
+								"manager": "load_var",
+								"accessed_at": 200
+							}
+						]`))
+					})
+				})
+
+				Context("when getting the accesses fails", func() {
+					BeforeEach(func() {
+						build.SecretAccessesReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/secrets-accessed", func() {
+		var (
+			query    string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/secrets-accessed" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbSecretAccessFactory.SecretAccessSummariesReturns([]db.SecretAccessSummary{
+					{
+						TeamName:       "some-team",
+						Path:           "deploy-key",
+						Manager:        "vault",
+						BuildIDs:       []int{2, 1},
+						LastAccessedAt: time.Unix(100, 0),
+					},
+				}, nil)
+			})
+
+			It("returns the summaries", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"team_name": "some-team",
+						"path": "deploy-key",
+						"manager": "vault",
+						"build_ids": [2, 1],
+						"last_accessed_at": 100
+					}
+				]`))
+			})
+
+			It("does not filter the summaries", func() {
+				Expect(dbSecretAccessFactory.SecretAccessSummariesArgsForCall(0)).To(Equal(db.SecretAccessFilter{}))
+			})
+
+			Context("when filtering by path and date", func() {
+				BeforeEach(func() {
+					query = "?path=deploy-key&since=2021-01-02"
+				})
+
+				It("filters the summaries", func() {
+					Expect(dbSecretAccessFactory.SecretAccessSummariesArgsForCall(0)).To(Equal(db.SecretAccessFilter{
+						Path:  "deploy-key",
+						Since: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC),
+					}))
+				})
+			})
+
+			Context("when the date is malformed", func() {
+				BeforeEach(func() {
+					query = "?since=yesterday"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbSecretAccessFactory.SecretAccessSummariesCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when getting the summaries fails", func() {
+				BeforeEach(func() {
+					dbSecretAccessFactory.SecretAccessSummariesReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
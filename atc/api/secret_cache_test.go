@@ -0,0 +1,165 @@
+package api_test
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secret Cache API", func() {
+	var (
+		response *http.Response
+		query    string
+	)
+
+	BeforeEach(func() {
+		query = ""
+	})
+
+	Describe("DELETE /api/v1/secrets-cache", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/secrets-cache"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("returns 204 No Content", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			})
+
+			It("evicts every cached secret", func() {
+				Expect(dbSecretCacheEvictionFactory.CreateEvictionCallCount()).To(Equal(1))
+				Expect(dbSecretCacheEvictionFactory.CreateEvictionArgsForCall(0)).To(Equal(creds.SecretCacheFilter{}))
+			})
+
+			Context("when given a path prefix", func() {
+				BeforeEach(func() {
+					query = "?path_prefix=/concourse/shared/"
+				})
+
+				It("evicts the cached secrets under it", func() {
+					Expect(dbSecretCacheEvictionFactory.CreateEvictionArgsForCall(0)).To(Equal(creds.SecretCacheFilter{
+						PathPrefix: "/concourse/shared/",
+					}))
+				})
+			})
+
+			Context("when requesting the eviction fails", func() {
+				BeforeEach(func() {
+					dbSecretCacheEvictionFactory.CreateEvictionReturns(errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated as a non-admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbSecretCacheEvictionFactory.CreateEvictionCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/secrets-cache", func() {
+		var fakeTeam *dbfakes.FakeTeam
+
+		BeforeEach(func() {
+			fakeTeam = new(dbfakes.FakeTeam)
+			fakeTeam.NameReturns("some-team")
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/secrets-cache"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns 204 No Content", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			})
+
+			It("evicts the team's cached secrets", func() {
+				Expect(dbSecretCacheEvictionFactory.CreateEvictionCallCount()).To(Equal(1))
+				Expect(dbSecretCacheEvictionFactory.CreateEvictionArgsForCall(0)).To(Equal(creds.SecretCacheFilter{
+					TeamName: "some-team",
+				}))
+			})
+
+			Context("when given a pipeline and a path prefix", func() {
+				BeforeEach(func() {
+					query = "?pipeline=some-pipeline&path_prefix=/concourse/some-team/"
+				})
+
+				It("evicts only the matching secrets", func() {
+					Expect(dbSecretCacheEvictionFactory.CreateEvictionArgsForCall(0)).To(Equal(creds.SecretCacheFilter{
+						TeamName:     "some-team",
+						PipelineName: "some-pipeline",
+						PathPrefix:   "/concourse/some-team/",
+					}))
+				})
+			})
+
+			Context("when requesting the eviction fails", func() {
+				BeforeEach(func() {
+					dbSecretCacheEvictionFactory.CreateEvictionReturns(errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbSecretCacheEvictionFactory.CreateEvictionCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
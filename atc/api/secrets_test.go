@@ -0,0 +1,328 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secrets API", func() {
+	var (
+		response *http.Response
+		fakeTeam *dbfakes.FakeTeam
+
+		updatedAt time.Time
+	)
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.IDReturns(42)
+		fakeTeam.NameReturns("some-team")
+
+		updatedAt = time.Now().Add(-time.Hour).Truncate(time.Second)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/secrets", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/some-team/secrets"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+				dbSecretFactory.SecretsReturns([]db.Secret{
+					{
+						TeamName:  "some-team",
+						Name:      "some-secret",
+						CreatedAt: updatedAt,
+						UpdatedAt: updatedAt,
+						UpdatedBy: "some-user",
+					},
+					{
+						TeamName:     "some-team",
+						PipelineName: "some-pipeline",
+						Name:         "some-other-secret",
+						CreatedAt:    updatedAt,
+						UpdatedAt:    updatedAt,
+					},
+				}, nil)
+			})
+
+			It("returns 200 OK with the team's secrets", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbSecretFactory.SecretsArgsForCall(0)).To(Equal(42))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var secrets []atc.Secret
+				err = json.Unmarshal(body, &secrets)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secrets).To(Equal([]atc.Secret{
+					{
+						TeamName:  "some-team",
+						Name:      "some-secret",
+						CreatedAt: updatedAt.Unix(),
+						UpdatedAt: updatedAt.Unix(),
+						UpdatedBy: "some-user",
+					},
+					{
+						TeamName:     "some-team",
+						PipelineName: "some-pipeline",
+						Name:         "some-other-secret",
+						CreatedAt:    updatedAt.Unix(),
+						UpdatedAt:    updatedAt.Unix(),
+					},
+				}))
+			})
+
+			It("does not return any values", func() {
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(body)).NotTo(ContainSubstring("value"))
+			})
+
+			Context("when filtering by pipeline", func() {
+				BeforeEach(func() {
+					query = "?pipeline=some-pipeline"
+				})
+
+				It("returns only the pipeline's secrets", func() {
+					var secrets []atc.Secret
+					err := json.NewDecoder(response.Body).Decode(&secrets)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(secrets).To(HaveLen(1))
+					Expect(secrets[0].Name).To(Equal("some-other-secret"))
+				})
+			})
+
+			Context("when getting the secrets fails", func() {
+				BeforeEach(func() {
+					dbSecretFactory.SecretsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/secrets/:secret_name", func() {
+		var (
+			requestBody string
+			query       string
+		)
+
+		BeforeEach(func() {
+			requestBody = `{"value":{"username":"some-user","password":"some-password"}}`
+			query = "?pipeline=some-pipeline"
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/secrets/some-secret"+query, bytes.NewBufferString(requestBody))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns 204 No Content", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			})
+
+			It("saves the secret along with who set it", func() {
+				Expect(dbSecretFactory.SetSecretCallCount()).To(Equal(1))
+
+				teamID, pipelineName, name, request, updatedBy := dbSecretFactory.SetSecretArgsForCall(0)
+				Expect(teamID).To(Equal(42))
+				Expect(pipelineName).To(Equal("some-pipeline"))
+				Expect(name).To(Equal("some-secret"))
+				Expect(request).To(Equal(atc.SetSecretRequest{
+					Value: map[string]interface{}{
+						"username": "some-user",
+						"password": "some-password",
+					},
+				}))
+				Expect(updatedBy).To(Equal("some-user"))
+			})
+
+			Context("when no pipeline is given", func() {
+				BeforeEach(func() {
+					query = ""
+				})
+
+				It("saves the secret for the whole team", func() {
+					_, pipelineName, _, _, _ := dbSecretFactory.SetSecretArgsForCall(0)
+					Expect(pipelineName).To(Equal(""))
+				})
+			})
+
+			Context("when the value is missing", func() {
+				BeforeEach(func() {
+					requestBody = `{}`
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("secret value must be specified"))
+				})
+
+				It("does not save the secret", func() {
+					Expect(dbSecretFactory.SetSecretCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the request body is malformed", func() {
+				BeforeEach(func() {
+					requestBody = `{`
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when saving the secret fails", func() {
+				BeforeEach(func() {
+					dbSecretFactory.SetSecretReturns(errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not save the secret", func() {
+				Expect(dbSecretFactory.SetSecretCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/secrets/:secret_name", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/secrets/some-secret?pipeline=some-pipeline", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				dbSecretFactory.DeleteSecretReturns(true, nil)
+			})
+
+			It("deletes the secret", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				teamID, pipelineName, name := dbSecretFactory.DeleteSecretArgsForCall(0)
+				Expect(teamID).To(Equal(42))
+				Expect(pipelineName).To(Equal("some-pipeline"))
+				Expect(name).To(Equal("some-secret"))
+			})
+
+			Context("when the secret does not exist", func() {
+				BeforeEach(func() {
+					dbSecretFactory.DeleteSecretReturns(false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when deleting the secret fails", func() {
+				BeforeEach(func() {
+					dbSecretFactory.DeleteSecretReturns(false, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(dbSecretFactory.DeleteSecretCallCount()).To(Equal(0))
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+})
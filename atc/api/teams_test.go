@@ -516,6 +516,152 @@ var _ = Describe("Teams API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/check-limits", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", server.URL+"/api/v1/teams/some-team/check-limits", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the requester is an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+				maxCheckContainers := 5
+				fakeTeam.CheckLimitsReturns(atc.TeamCheckLimits{MaxCheckContainers: &maxCheckContainers}, nil)
+			})
+
+			It("returns 200 OK with the team's check limits", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{"max_check_containers":5}`))
+			})
+
+			Context("when getting the check limits fails", func() {
+				BeforeEach(func() {
+					fakeTeam.CheckLimitsReturns(atc.TeamCheckLimits{}, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when the requester is not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/check-limits", func() {
+		var response *http.Response
+		var requestBody string
+
+		BeforeEach(func() {
+			requestBody = `{"checks_per_second":2.5,"max_check_containers":10}`
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest(
+				"PUT",
+				server.URL+"/api/v1/teams/some-team/check-limits",
+				bytes.NewBufferString(requestBody),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the requester is an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns 204 No Content", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			})
+
+			It("saves the check limits", func() {
+				Expect(fakeTeam.SetCheckLimitsCallCount()).To(Equal(1))
+
+				limits := fakeTeam.SetCheckLimitsArgsForCall(0)
+				Expect(*limits.ChecksPerSecond).To(Equal(2.5))
+				Expect(*limits.MaxCheckContainers).To(Equal(10))
+			})
+
+			Context("when the request body is malformed", func() {
+				BeforeEach(func() {
+					requestBody = `{`
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+
+				It("does not save the check limits", func() {
+					Expect(fakeTeam.SetCheckLimitsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when saving the check limits fails", func() {
+				BeforeEach(func() {
+					fakeTeam.SetCheckLimitsReturns(errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when the requester is not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not save the check limits", func() {
+				Expect(fakeTeam.SetCheckLimitsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/rename", func() {
 		var response *http.Response
 		var requestBody string
@@ -0,0 +1,53 @@
+package teamserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ClearAllSecretCaches evicts the cached secrets of every team, e.g. once a
+// secret shared by all of them has been rotated.
+func (s *Server) ClearAllSecretCaches(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("clear-all-secret-caches")
+
+	s.clearSecretCache(logger, w, r, creds.SecretCacheFilter{
+		PathPrefix: r.FormValue(atc.ClearSecretCacheQueryPathPrefix),
+	})
+}
+
+func (s *Server) ClearSecretCache(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("clear-secret-cache")
+
+		s.clearSecretCache(logger, w, r, creds.SecretCacheFilter{
+			TeamName:     team.Name(),
+			PipelineName: r.FormValue(atc.ClearSecretCacheQueryPipeline),
+			PathPrefix:   r.FormValue(atc.ClearSecretCacheQueryPathPrefix),
+		})
+	})
+}
+
+// clearSecretCache only requests the eviction, which every web node carries
+// out on its own shortly after.
+func (s *Server) clearSecretCache(logger lager.Logger, w http.ResponseWriter, r *http.Request, filter creds.SecretCacheFilter) {
+	err := s.secretCacheEvictionFactory.CreateEviction(filter)
+	if err != nil {
+		logger.Error("failed-to-create-eviction", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("requested", lager.Data{
+		"team":        filter.TeamName,
+		"pipeline":    filter.PipelineName,
+		"path-prefix": filter.PathPrefix,
+		"user":        accessor.GetAccessor(r).Claims().UserName,
+	})
+
+	w.WriteHeader(http.StatusNoContent)
+}
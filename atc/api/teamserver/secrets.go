@@ -0,0 +1,119 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListSecrets(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-secrets")
+
+		secrets, err := s.secretFactory.Secrets(team.ID())
+		if err != nil {
+			logger.Error("failed-to-get-secrets", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		pipelineName := r.FormValue("pipeline")
+
+		presented := []atc.Secret{}
+		for _, secret := range secrets {
+			if pipelineName != "" && secret.PipelineName != pipelineName {
+				continue
+			}
+
+			presented = append(presented, present.Secret(secret))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-secrets", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+// SetSecret writes are logged along with the user who made them, as the
+// value itself can never be read back.
+func (s *Server) SetSecret(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("set-secret")
+
+		name := r.FormValue(":secret_name")
+		pipelineName := r.FormValue("pipeline")
+
+		var request atc.SetSecretRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			logger.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = request.Validate(pipelineName, name)
+		if err != nil {
+			logger.Info("invalid-secret", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "%s", err)
+			return
+		}
+
+		userName := accessor.GetAccessor(r).Claims().UserName
+
+		err = s.secretFactory.SetSecret(team.ID(), pipelineName, name, request, userName)
+		if err != nil {
+			logger.Error("failed-to-set-secret", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		logger.Info("set", lager.Data{
+			"team":     team.Name(),
+			"pipeline": pipelineName,
+			"secret":   name,
+			"user":     userName,
+		})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) DeleteSecret(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("delete-secret")
+
+		name := r.FormValue(":secret_name")
+		pipelineName := r.FormValue("pipeline")
+
+		deleted, err := s.secretFactory.DeleteSecret(team.ID(), pipelineName, name)
+		if err != nil {
+			logger.Error("failed-to-delete-secret", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !deleted {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		logger.Info("deleted", lager.Data{
+			"team":     team.Name(),
+			"pipeline": pipelineName,
+			"secret":   name,
+			"user":     accessor.GetAccessor(r).Claims().UserName,
+		})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
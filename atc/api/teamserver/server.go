@@ -6,19 +6,25 @@ import (
 )
 
 type Server struct {
-	logger      lager.Logger
-	teamFactory db.TeamFactory
-	externalURL string
+	logger                     lager.Logger
+	teamFactory                db.TeamFactory
+	secretFactory              db.SecretFactory
+	secretCacheEvictionFactory db.SecretCacheEvictionFactory
+	externalURL                string
 }
 
 func NewServer(
 	logger lager.Logger,
 	teamFactory db.TeamFactory,
+	secretFactory db.SecretFactory,
+	secretCacheEvictionFactory db.SecretCacheEvictionFactory,
 	externalURL string,
 ) *Server {
 	return &Server{
-		logger:      logger,
-		teamFactory: teamFactory,
-		externalURL: externalURL,
+		logger:                     logger,
+		teamFactory:                teamFactory,
+		secretFactory:              secretFactory,
+		secretCacheEvictionFactory: secretCacheEvictionFactory,
+		externalURL:                externalURL,
 	}
 }
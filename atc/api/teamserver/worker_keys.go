@@ -0,0 +1,107 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListWorkerKeys(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-worker-keys")
+
+		keys, err := team.WorkerKeys()
+		if err != nil {
+			logger.Error("failed-to-get-worker-keys", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented := make([]atc.WorkerKey, len(keys))
+		for i, key := range keys {
+			presented[i] = present.WorkerKey(key)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-worker-keys", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) SetWorkerKey(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("set-worker-key")
+
+		name := r.FormValue(":worker_key_name")
+
+		var request atc.SetWorkerKeyRequest
+		err := json.NewDecoder(r.Body).Decode(&request)
+		if err != nil {
+			logger.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = request.Validate(name, time.Now())
+		if err != nil {
+			logger.Info("invalid-worker-key", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "%s", err)
+			return
+		}
+
+		err = team.SetWorkerKey(name, request)
+		switch err {
+		case nil:
+		case db.ErrWorkerKeyInUse:
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "%s", err)
+			return
+		case db.ErrWorkerKeyNotFound:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, "worker key '%s' not found", request.Replaces)
+			return
+		default:
+			logger.Error("failed-to-set-worker-key", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		logger.Info("set", lager.Data{
+			"team":     team.Name(),
+			"key":      name,
+			"replaces": request.Replaces,
+		})
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) DeleteWorkerKey(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("delete-worker-key")
+
+		deleted, err := team.DeleteWorkerKey(r.FormValue(":worker_key_name"))
+		if err != nil {
+			logger.Error("failed-to-delete-worker-key", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !deleted {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -231,7 +231,9 @@ var _ = Describe("Versions API", func() {
 						Expect(page).To(Equal(db.Page{
 							Limit: 100,
 						}))
-						Expect(versionFilter).To(Equal(atc.Version{}))
+						Expect(versionFilter).To(Equal(atc.ResourceVersionFilter{
+							Version: atc.Version{},
+						}))
 					})
 				})
 
@@ -249,7 +251,7 @@ var _ = Describe("Versions API", func() {
 							To:    db.NewIntPtr(7),
 							Limit: 8,
 						}))
-						Expect(versionFilter).To(Equal(atc.Version{
+						Expect(versionFilter.Version).To(Equal(atc.Version{
 							"ref":      "foo",
 							"some-ref": "blah",
 						}))
@@ -266,7 +268,7 @@ var _ = Describe("Versions API", func() {
 							Expect(fakeResource.VersionsCallCount()).To(Equal(1))
 
 							_, versionFilter := fakeResource.VersionsArgsForCall(0)
-							Expect(versionFilter).To(Equal(atc.Version{
+							Expect(versionFilter.Version).To(Equal(atc.Version{
 								"some ref": "some value",
 							}))
 						})
@@ -281,7 +283,7 @@ var _ = Describe("Versions API", func() {
 							Expect(fakeResource.VersionsCallCount()).To(Equal(1))
 
 							_, versionFilter := fakeResource.VersionsArgsForCall(0)
-							Expect(versionFilter).To(Equal(atc.Version{
+							Expect(versionFilter.Version).To(Equal(atc.Version{
 								"ref": "some%value",
 							}))
 						})
@@ -296,7 +298,7 @@ var _ = Describe("Versions API", func() {
 							Expect(fakeResource.VersionsCallCount()).To(Equal(1))
 
 							_, versionFilter := fakeResource.VersionsArgsForCall(0)
-							Expect(versionFilter).To(Equal(atc.Version{
+							Expect(versionFilter.Version).To(Equal(atc.Version{
 								"key": "with:colon:abcdef",
 							}))
 						})
@@ -311,11 +313,52 @@ var _ = Describe("Versions API", func() {
 							Expect(fakeResource.VersionsCallCount()).To(Equal(1))
 
 							_, versionFilter := fakeResource.VersionsArgsForCall(0)
-							Expect(versionFilter).To(BeEmpty())
+							Expect(versionFilter.Version).To(BeEmpty())
 						})
 					})
 				})
 
+				Context("when metadata and time range filters are passed", func() {
+					BeforeEach(func() {
+						queryParams = "?metadata=author:alice&metadata=message~fix%20bug&metadata=tag:v1.*&since=100&until=200"
+					})
+
+					It("passes them through", func() {
+						Expect(fakeResource.VersionsCallCount()).To(Equal(1))
+
+						_, versionFilter := fakeResource.VersionsArgsForCall(0)
+						Expect(versionFilter.Metadata).To(Equal([]atc.MetadataFilter{
+							{Name: "author", Value: "alice", Match: atc.MetadataMatchExact},
+							{Name: "message", Value: "fix bug", Match: atc.MetadataMatchContains},
+							{Name: "tag", Value: "v1.*", Match: atc.MetadataMatchPattern},
+						}))
+						Expect(versionFilter.Since).To(Equal(time.Unix(100, 0)))
+						Expect(versionFilter.Until).To(Equal(time.Unix(200, 0)))
+					})
+				})
+
+				Context("when the metadata filter is invalid", func() {
+					BeforeEach(func() {
+						queryParams = "?metadata=author"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeResource.VersionsCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the time range is invalid", func() {
+					BeforeEach(func() {
+						queryParams = "?until=yesterday"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeResource.VersionsCallCount()).To(BeZero())
+					})
+				})
+
 				Context("when getting the versions succeeds", func() {
 					var returnedVersions []atc.ResourceVersion
 
@@ -407,11 +450,25 @@ var _ = Describe("Versions API", func() {
 
 						It("returns Link headers per rfc5988", func() {
 							Expect(response.Header["Link"]).To(ConsistOf([]string{
-								fmt.Sprintf(`<%s/api/v1/teams/a-team/pipelines/some-pipeline/resources/some-resource/versions?from=4&limit=2>; rel="previous"`, externalURL),
-								fmt.Sprintf(`<%s/api/v1/teams/a-team/pipelines/some-pipeline/resources/some-resource/versions?to=2&limit=2>; rel="next"`, externalURL),
+								fmt.Sprintf(`<%s/api/v1/teams/a-team/pipelines/some-pipeline/resources/some-resource/versions?from=4&limit=2&since=5>; rel="previous"`, externalURL),
+								fmt.Sprintf(`<%s/api/v1/teams/a-team/pipelines/some-pipeline/resources/some-resource/versions?to=2&limit=2&since=5>; rel="next"`, externalURL),
 							}))
 						})
 
+						Context("when the versions are filtered", func() {
+							BeforeEach(func() {
+								queryParams = "?limit=2&metadata=author:alice&label=prod&since=100"
+							})
+
+							It("keeps the filters in the Link headers", func() {
+								link := fmt.Sprintf(`<%s/api/v1/teams/a-team/pipelines/some-pipeline/resources/some-resource/versions?`, externalURL)
+								Expect(response.Header["Link"]).To(ConsistOf([]string{
+									link + `to=2&limit=2&label=prod&metadata=author%3Aalice&since=100>; rel="next"`,
+									link + `from=4&limit=2&label=prod&metadata=author%3Aalice&since=100>; rel="previous"`,
+								}))
+							})
+						})
+
 						Context("and resource is on an instanced pipeline", func() {
 							BeforeEach(func() {
 								fakePipeline.InstanceVarsReturns(atc.InstanceVars{"branch": "master"})
@@ -420,8 +477,8 @@ var _ = Describe("Versions API", func() {
 							It("returns Link headers per rfc5988", func() {
 								link := fmt.Sprintf(`<%s/api/v1/teams/a-team/pipelines/some-pipeline/resources/some-resource/versions?`, externalURL)
 								Expect(response.Header["Link"]).To(ConsistOf([]string{
-									link + `to=2&limit=2&vars.branch=%22master%22>; rel="next"`,
-									link + `from=4&limit=2&vars.branch=%22master%22>; rel="previous"`,
+									link + `to=2&limit=2&vars.branch=%22master%22&since=5>; rel="next"`,
+									link + `from=4&limit=2&vars.branch=%22master%22&since=5>; rel="previous"`,
 								}))
 							})
 						})
@@ -678,6 +735,146 @@ var _ = Describe("Versions API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/labels/:label", func() {
+		var response *http.Response
+		var fakeResource *dbfakes.FakeResource
+		var label string
+
+		BeforeEach(func() {
+			label = "qa-approved"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/versions/42/labels/"+label, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated ", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(true)
+				})
+
+				Context("when finding the resource succeeds", func() {
+					BeforeEach(func() {
+						fakeResource = new(dbfakes.FakeResource)
+						fakeResource.IDReturns(1)
+						fakePipeline.ResourceReturns(fakeResource, true, nil)
+					})
+
+					It("labels the right resource config version", func() {
+						resourceConfigVersionID, versionLabel := fakeResource.AddVersionLabelArgsForCall(0)
+						Expect(resourceConfigVersionID).To(Equal(42))
+						Expect(versionLabel).To(Equal("qa-approved"))
+					})
+
+					Context("when labeling the resource version succeeds", func() {
+						BeforeEach(func() {
+							fakeResource.AddVersionLabelReturns(true, nil)
+						})
+
+						It("returns 200", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+						})
+					})
+
+					Context("when the resource version is not found", func() {
+						BeforeEach(func() {
+							fakeResource.AddVersionLabelReturns(false, nil)
+						})
+
+						It("returns 404", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+						})
+					})
+
+					Context("when labeling the resource version fails", func() {
+						BeforeEach(func() {
+							fakeResource.AddVersionLabelReturns(false, errors.New("welp"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+
+				Context("when the resource is not found", func() {
+					BeforeEach(func() {
+						fakePipeline.ResourceReturns(nil, false, nil)
+					})
+
+					It("returns not found", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/labels/:label", func() {
+		var response *http.Response
+		var fakeResource *dbfakes.FakeResource
+
+		JustBeforeEach(func() {
+			var err error
+
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/a-team/pipelines/a-pipeline/resources/resource-name/versions/42/labels/bad-release", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				fakeResource = new(dbfakes.FakeResource)
+				fakePipeline.ResourceReturns(fakeResource, true, nil)
+				fakeResource.RemoveVersionLabelReturns(true, nil)
+			})
+
+			It("removes the label from the right resource config version", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				resourceConfigVersionID, versionLabel := fakeResource.RemoveVersionLabelArgsForCall(0)
+				Expect(resourceConfigVersionID).To(Equal(42))
+				Expect(versionLabel).To(Equal("bad-release"))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/pin", func() {
 		var response *http.Response
 		var fakeResource *dbfakes.FakeResource
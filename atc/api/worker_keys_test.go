@@ -0,0 +1,426 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Worker Keys API", func() {
+	const somePublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFdKVCFpynKib6/W5IGS6qXrd/yxmYbgJKdH/zOv51fo"
+
+	var (
+		response *http.Response
+		fakeTeam *dbfakes.FakeTeam
+
+		createdAt time.Time
+		expiresAt time.Time
+	)
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+
+		createdAt = time.Now().Add(-time.Hour).Truncate(time.Second)
+		expiresAt = time.Now().Add(time.Hour).Truncate(time.Second)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/worker-keys", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/teams/some-team/worker-keys", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+				fakeTeam.WorkerKeysReturns([]db.WorkerKey{
+					{
+						TeamName:    "some-team",
+						Name:        "some-key",
+						PublicKey:   somePublicKey,
+						Fingerprint: "SHA256:some-fingerprint",
+						CreatedAt:   createdAt,
+						ExpiresAt:   expiresAt,
+					},
+				}, nil)
+			})
+
+			It("returns 200 OK with the team's keys", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var keys []atc.WorkerKey
+				err = json.Unmarshal(body, &keys)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keys).To(Equal([]atc.WorkerKey{
+					{
+						TeamName:    "some-team",
+						Name:        "some-key",
+						PublicKey:   somePublicKey,
+						Fingerprint: "SHA256:some-fingerprint",
+						CreatedAt:   createdAt.Unix(),
+						ExpiresAt:   expiresAt.Unix(),
+					},
+				}))
+			})
+
+			Context("when getting the keys fails", func() {
+				BeforeEach(func() {
+					fakeTeam.WorkerKeysReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401 Unauthorized", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/worker-keys/:worker_key_name", func() {
+		var requestBody string
+
+		BeforeEach(func() {
+			requestBody = `{"public_key":"` + somePublicKey + `"}`
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest(
+				"PUT",
+				server.URL+"/api/v1/teams/some-team/worker-keys/some-key",
+				bytes.NewBufferString(requestBody),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+			})
+
+			It("returns 204 No Content", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+			})
+
+			It("saves the key", func() {
+				Expect(fakeTeam.SetWorkerKeyCallCount()).To(Equal(1))
+
+				name, request := fakeTeam.SetWorkerKeyArgsForCall(0)
+				Expect(name).To(Equal("some-key"))
+				Expect(request).To(Equal(atc.SetWorkerKeyRequest{PublicKey: somePublicKey}))
+			})
+
+			Context("when replacing another key", func() {
+				BeforeEach(func() {
+					requestBody = fmt.Sprintf(`{"public_key":%q,"replaces":"some-old-key","replaced_key_expires_at":%d}`, somePublicKey, expiresAt.Unix())
+				})
+
+				It("saves the key along with the replacement", func() {
+					_, request := fakeTeam.SetWorkerKeyArgsForCall(0)
+					Expect(request.Replaces).To(Equal("some-old-key"))
+					Expect(request.ReplacedKeyExpiresAt).To(Equal(expiresAt.Unix()))
+				})
+
+				Context("when the other key does not exist", func() {
+					BeforeEach(func() {
+						fakeTeam.SetWorkerKeyReturns(db.ErrWorkerKeyNotFound)
+					})
+
+					It("returns 404 Not Found", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(string(body)).To(Equal("worker key 'some-old-key' not found"))
+					})
+				})
+			})
+
+			Context("when the public key is invalid", func() {
+				BeforeEach(func() {
+					requestBody = `{"public_key":"bogus"}`
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring("invalid public key"))
+				})
+
+				It("does not save the key", func() {
+					Expect(fakeTeam.SetWorkerKeyCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the request body is malformed", func() {
+				BeforeEach(func() {
+					requestBody = `{`
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the key is in use by another key", func() {
+				BeforeEach(func() {
+					fakeTeam.SetWorkerKeyReturns(db.ErrWorkerKeyInUse)
+				})
+
+				It("returns 409 Conflict", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+
+			Context("when saving the key fails", func() {
+				BeforeEach(func() {
+					fakeTeam.SetWorkerKeyReturns(errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+
+			It("does not save the key", func() {
+				Expect(fakeTeam.SetWorkerKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/worker-keys/:worker_key_name", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/worker-keys/some-key", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+				fakeTeam.DeleteWorkerKeyReturns(true, nil)
+			})
+
+			It("deletes the key", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(fakeTeam.DeleteWorkerKeyCallCount()).To(Equal(1))
+				Expect(fakeTeam.DeleteWorkerKeyArgsForCall(0)).To(Equal("some-key"))
+			})
+
+			Context("when the key does not exist", func() {
+				BeforeEach(func() {
+					fakeTeam.DeleteWorkerKeyReturns(false, nil)
+				})
+
+				It("returns 404 Not Found", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/worker-keys", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/worker-keys", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when requested by the system", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsSystemReturns(true)
+
+				dbWorkerKeyFactory.ActiveWorkerKeysReturns([]db.WorkerKey{
+					{
+						TeamName:    "some-team",
+						Name:        "some-key",
+						PublicKey:   somePublicKey,
+						Fingerprint: "SHA256:some-fingerprint",
+						CreatedAt:   createdAt,
+					},
+				}, nil)
+			})
+
+			It("returns the keys of every team", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var keys []atc.WorkerKey
+				err = json.Unmarshal(body, &keys)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(keys).To(Equal([]atc.WorkerKey{
+					{
+						TeamName:    "some-team",
+						Name:        "some-key",
+						PublicKey:   somePublicKey,
+						Fingerprint: "SHA256:some-fingerprint",
+						CreatedAt:   createdAt.Unix(),
+					},
+				}))
+			})
+
+			Context("when getting the keys fails", func() {
+				BeforeEach(func() {
+					dbWorkerKeyFactory.ActiveWorkerKeysReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not requested by the system", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+				fakeAccess.IsSystemReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbWorkerKeyFactory.ActiveWorkerKeysCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/worker-keys/used", func() {
+		var requestBody string
+
+		BeforeEach(func() {
+			requestBody = `[{"team_name":"some-team","name":"some-key","used_at":123}]`
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/worker-keys/used", bytes.NewBufferString(requestBody))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when requested by the system", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsSystemReturns(true)
+			})
+
+			It("records the uses", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(dbWorkerKeyFactory.MarkWorkerKeysUsedCallCount()).To(Equal(1))
+				Expect(dbWorkerKeyFactory.MarkWorkerKeysUsedArgsForCall(0)).To(Equal([]atc.WorkerKeyUse{
+					{TeamName: "some-team", Name: "some-key", UsedAt: 123},
+				}))
+			})
+
+			Context("when the request body is malformed", func() {
+				BeforeEach(func() {
+					requestBody = `{`
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when recording the uses fails", func() {
+				BeforeEach(func() {
+					dbWorkerKeyFactory.MarkWorkerKeysUsedReturns(errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not requested by the system", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsSystemReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbWorkerKeyFactory.MarkWorkerKeysUsedCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,365 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Worker Registration Tokens API", func() {
+	const somePublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFdKVCFpynKib6/W5IGS6qXrd/yxmYbgJKdH/zOv51fo"
+
+	var (
+		response *http.Response
+
+		createdAt time.Time
+		expiresAt time.Time
+	)
+
+	BeforeEach(func() {
+		createdAt = time.Now().Truncate(time.Second)
+		expiresAt = createdAt.Add(time.Hour)
+	})
+
+	Describe("GET /api/v1/worker-registration-tokens", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/worker-registration-tokens", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbWorkerKeyFactory.WorkerRegistrationTokensReturns([]db.WorkerRegistrationToken{
+					{
+						ID:        1,
+						TeamName:  "some-team",
+						Tags:      []string{"some-tag"},
+						CreatedAt: createdAt,
+						ExpiresAt: expiresAt,
+						MaxUses:   1,
+					},
+				}, nil)
+			})
+
+			It("returns the tokens without their secrets", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				var tokens []atc.WorkerRegistrationToken
+				err := json.NewDecoder(response.Body).Decode(&tokens)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(tokens).To(Equal([]atc.WorkerRegistrationToken{
+					{
+						ID:        1,
+						TeamName:  "some-team",
+						Tags:      []string{"some-tag"},
+						CreatedAt: createdAt.Unix(),
+						ExpiresAt: expiresAt.Unix(),
+						MaxUses:   1,
+					},
+				}))
+			})
+
+			Context("when listing the tokens fails", func() {
+				BeforeEach(func() {
+					dbWorkerKeyFactory.WorkerRegistrationTokensReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("POST /api/v1/worker-registration-tokens", func() {
+		var token atc.WorkerRegistrationToken
+
+		BeforeEach(func() {
+			token = atc.WorkerRegistrationToken{
+				TeamName:  "some-team",
+				ExpiresAt: expiresAt.Unix(),
+				MaxUses:   1,
+			}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(token)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("POST", server.URL+"/api/v1/worker-registration-tokens", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbWorkerKeyFactory.CreateWorkerRegistrationTokenReturns(db.WorkerRegistrationToken{
+					ID:        1,
+					TeamName:  "some-team",
+					CreatedAt: createdAt,
+					ExpiresAt: expiresAt,
+					MaxUses:   1,
+				}, "some-secret", nil)
+			})
+
+			It("returns 201 with the token and its secret", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+				var created atc.WorkerRegistrationToken
+				err := json.NewDecoder(response.Body).Decode(&created)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(created.ID).To(Equal(1))
+				Expect(created.Token).To(Equal("some-secret"))
+			})
+
+			It("creates the token", func() {
+				Expect(dbWorkerKeyFactory.CreateWorkerRegistrationTokenCallCount()).To(Equal(1))
+				Expect(dbWorkerKeyFactory.CreateWorkerRegistrationTokenArgsForCall(0)).To(Equal(token))
+			})
+
+			Context("when the token has already expired", func() {
+				BeforeEach(func() {
+					token.ExpiresAt = time.Now().Add(-time.Minute).Unix()
+				})
+
+				It("returns 400 with the reason", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal(atc.ErrWorkerRegistrationTokenExpiry.Error()))
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerKeyFactory.CreateWorkerRegistrationTokenReturns(db.WorkerRegistrationToken{}, "", db.ErrWorkerRegistrationTokenTeamNotFound{TeamName: "some-team"})
+				})
+
+				It("returns 400 with the reason", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("team 'some-team' not found"))
+				})
+			})
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/worker-registration-tokens/:worker_registration_token_id", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/worker-registration-tokens/1", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			Context("when the token exists", func() {
+				BeforeEach(func() {
+					dbWorkerKeyFactory.DeleteWorkerRegistrationTokenReturns(true, nil)
+				})
+
+				It("deletes it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					Expect(dbWorkerKeyFactory.DeleteWorkerRegistrationTokenArgsForCall(0)).To(Equal(1))
+				})
+			})
+
+			Context("when the token does not exist", func() {
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("POST /api/v1/worker-registration-tokens/redeem", func() {
+		var request atc.RedeemWorkerRegistrationTokenRequest
+
+		BeforeEach(func() {
+			request = atc.RedeemWorkerRegistrationTokenRequest{
+				Token:      "some-secret",
+				WorkerName: "some-worker",
+				TeamName:   "some-team",
+				PublicKey:  somePublicKey,
+			}
+
+			fakeAccess.IsAuthenticatedReturns(true)
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			req, err := http.NewRequest("POST", server.URL+"/api/v1/worker-registration-tokens/redeem", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the request comes from the system", func() {
+			BeforeEach(func() {
+				fakeAccess.IsSystemReturns(true)
+
+				dbWorkerKeyFactory.RedeemWorkerRegistrationTokenReturns(db.WorkerKey{
+					TeamName:    "some-team",
+					Name:        "some-worker",
+					PublicKey:   somePublicKey,
+					Fingerprint: "SHA256:abc",
+					WorkerName:  "some-worker",
+					CreatedAt:   createdAt,
+				}, nil)
+			})
+
+			It("returns 201 with the worker's key", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+				var key atc.WorkerKey
+				err := json.NewDecoder(response.Body).Decode(&key)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(key).To(Equal(atc.WorkerKey{
+					TeamName:    "some-team",
+					Name:        "some-worker",
+					PublicKey:   somePublicKey,
+					Fingerprint: "SHA256:abc",
+					WorkerName:  "some-worker",
+					CreatedAt:   createdAt.Unix(),
+				}))
+			})
+
+			It("redeems the token", func() {
+				Expect(dbWorkerKeyFactory.RedeemWorkerRegistrationTokenArgsForCall(0)).To(Equal(request))
+			})
+
+			Context("when the public key is invalid", func() {
+				BeforeEach(func() {
+					request.PublicKey = "bogus"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbWorkerKeyFactory.RedeemWorkerRegistrationTokenCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the token is rejected", func() {
+				BeforeEach(func() {
+					dbWorkerKeyFactory.RedeemWorkerRegistrationTokenReturns(db.WorkerKey{}, db.ErrWorkerRegistrationTokenMismatch)
+				})
+
+				It("returns 403 with the reason", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal(db.ErrWorkerRegistrationTokenMismatch.Error()))
+				})
+			})
+
+			Context("when the worker's name is taken by another team or a global worker", func() {
+				BeforeEach(func() {
+					dbWorkerKeyFactory.RedeemWorkerRegistrationTokenReturns(db.WorkerKey{}, db.ErrWorkerRegistrationTokenNameTaken)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when the worker's team does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerKeyFactory.RedeemWorkerRegistrationTokenReturns(db.WorkerKey{}, db.ErrWorkerRegistrationTokenTeamNotFound{TeamName: "some-team"})
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when the public key is in use", func() {
+				BeforeEach(func() {
+					dbWorkerKeyFactory.RedeemWorkerRegistrationTokenReturns(db.WorkerKey{}, db.ErrWorkerKeyInUse)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+		})
+
+		Context("when the request does not come from the system", func() {
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbWorkerKeyFactory.RedeemWorkerRegistrationTokenCallCount()).To(BeZero())
+			})
+		})
+	})
+})
@@ -0,0 +1,212 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Worker Sessions API", func() {
+	var response *http.Response
+
+	Describe("GET /api/v1/worker-sessions", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/worker-sessions", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when requested by an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbWorkerSessionFactory.WorkerSessionsReturns([]atc.WorkerSession{
+					{
+						ID:                  "some-session",
+						TSA:                 "some-tsa:2222",
+						WorkerName:          "some-worker",
+						RemoteAddr:          "1.2.3.4:5678",
+						Transport:           "ssh",
+						ConnectedAt:         100,
+						LastHeartbeatAt:     130,
+						LastHeartbeatStatus: "healthy",
+						BytesProxied:        1024,
+					},
+				}, nil)
+			})
+
+			It("returns the sessions of every TSA", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				var sessions []atc.WorkerSession
+				err = json.Unmarshal(body, &sessions)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(sessions).To(Equal([]atc.WorkerSession{
+					{
+						ID:                  "some-session",
+						TSA:                 "some-tsa:2222",
+						WorkerName:          "some-worker",
+						RemoteAddr:          "1.2.3.4:5678",
+						Transport:           "ssh",
+						ConnectedAt:         100,
+						LastHeartbeatAt:     130,
+						LastHeartbeatStatus: "healthy",
+						BytesProxied:        1024,
+					},
+				}))
+			})
+
+			Context("when getting the sessions fails", func() {
+				BeforeEach(func() {
+					dbWorkerSessionFactory.WorkerSessionsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not requested by an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbWorkerSessionFactory.WorkerSessionsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/worker-sessions", func() {
+		var (
+			requestBody string
+			query       string
+		)
+
+		BeforeEach(func() {
+			requestBody = `{"tsa":"some-tsa:2222","sessions":[{"id":"some-session","tsa":"some-tsa:2222","remote_addr":"1.2.3.4:5678","transport":"websocket","connected_at":100,"bytes_proxied":10,"missed_keepalives":2}]}`
+			query = "?ttl=20s"
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/worker-sessions"+query, bytes.NewBufferString(requestBody))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when requested by the system", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsSystemReturns(true)
+			})
+
+			It("saves the sessions with the ttl", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(dbWorkerSessionFactory.SaveWorkerSessionsCallCount()).To(Equal(1))
+
+				report, ttl := dbWorkerSessionFactory.SaveWorkerSessionsArgsForCall(0)
+				Expect(report).To(Equal(atc.WorkerSessionsReport{
+					TSA: "some-tsa:2222",
+					Sessions: []atc.WorkerSession{
+						{
+							ID:               "some-session",
+							TSA:              "some-tsa:2222",
+							RemoteAddr:       "1.2.3.4:5678",
+							Transport:        "websocket",
+							ConnectedAt:      100,
+							BytesProxied:     10,
+							MissedKeepalives: 2,
+						},
+					},
+				}))
+				Expect(ttl).To(Equal(20 * time.Second))
+			})
+
+			Context("when no ttl is given", func() {
+				BeforeEach(func() {
+					query = ""
+				})
+
+				It("saves the sessions for a minute", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					_, ttl := dbWorkerSessionFactory.SaveWorkerSessionsArgsForCall(0)
+					Expect(ttl).To(Equal(time.Minute))
+				})
+			})
+
+			Context("when the ttl is malformed", func() {
+				BeforeEach(func() {
+					query = "?ttl=soon"
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbWorkerSessionFactory.SaveWorkerSessionsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the tsa is missing", func() {
+				BeforeEach(func() {
+					requestBody = `{"sessions":[]}`
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbWorkerSessionFactory.SaveWorkerSessionsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the request body is malformed", func() {
+				BeforeEach(func() {
+					requestBody = `{`
+				})
+
+				It("returns 400 Bad Request", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when saving the sessions fails", func() {
+				BeforeEach(func() {
+					dbWorkerSessionFactory.SaveWorkerSessionsReturns(errors.New("nope"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not requested by the system", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsSystemReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbWorkerSessionFactory.SaveWorkerSessionsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
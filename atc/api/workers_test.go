@@ -136,6 +136,41 @@ var _ = Describe("Workers API", func() {
 				})
 			})
 
+			Context("when a worker has maintenance scheduled", func() {
+				var (
+					startsAt time.Time
+					endsAt   time.Time
+				)
+
+				BeforeEach(func() {
+					startsAt = time.Now().Add(time.Hour).Truncate(time.Second)
+					endsAt = startsAt.Add(time.Hour)
+
+					teamWorker1.MaintenanceReturns(&db.MaintenanceWindow{
+						ID:            42,
+						WorkerName:    "some-worker",
+						DrainStartsAt: time.Now().Add(-time.Minute).Truncate(time.Second),
+						StartsAt:      startsAt,
+						EndsAt:        endsAt,
+					})
+
+					dbWorkerFactory.VisibleWorkersReturns([]db.Worker{teamWorker1}, nil)
+				})
+
+				It("returns the state and schedule of the maintenance", func() {
+					var returnedWorkers []atc.Worker
+					err := json.NewDecoder(response.Body).Decode(&returnedWorkers)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(returnedWorkers).To(HaveLen(1))
+					Expect(returnedWorkers[0].Maintenance).ToNot(BeNil())
+					Expect(returnedWorkers[0].Maintenance.WindowID).To(Equal(42))
+					Expect(returnedWorkers[0].Maintenance.StartsAt).To(Equal(startsAt.Unix()))
+					Expect(returnedWorkers[0].Maintenance.EndsAt).To(Equal(endsAt.Unix()))
+					Expect(returnedWorkers[0].Maintenance.State).To(Equal(atc.WorkerMaintenanceDraining))
+				})
+			})
+
 			Context("when getting the workers fails", func() {
 				BeforeEach(func() {
 					dbWorkerFactory.VisibleWorkersReturns(nil, errors.New("error!"))
@@ -860,6 +895,79 @@ var _ = Describe("Workers API", func() {
 			Expect(t).To(Equal(ttl))
 		})
 
+		It("does not save any resources", func() {
+			Expect(fakeWorker.SetResourcesCallCount()).To(BeZero())
+			Expect(fakeWorker.RecoverCallCount()).To(BeZero())
+			Expect(fakeWorker.DegradeCallCount()).To(BeZero())
+		})
+
+		Context("when the worker reports its resources", func() {
+			BeforeEach(func() {
+				worker.Resources = &atc.WorkerResources{
+					MemoryFree:  1024,
+					MemoryTotal: 4096,
+					CPULoad:     0.25,
+					DiskFree:    2048,
+					DiskTotal:   8192,
+				}
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("saves the resources of the worker", func() {
+				Expect(fakeWorker.SetResourcesCallCount()).To(Equal(1))
+				Expect(fakeWorker.SetResourcesArgsForCall(0)).To(Equal(atc.WorkerResources{
+					MemoryFree:  1024,
+					MemoryTotal: 4096,
+					CPULoad:     0.25,
+					DiskFree:    2048,
+					DiskTotal:   8192,
+				}))
+			})
+
+			It("recovers the worker in case it was degraded", func() {
+				Expect(fakeWorker.RecoverCallCount()).To(Equal(1))
+				Expect(fakeWorker.DegradeCallCount()).To(BeZero())
+			})
+
+			Context("when the worker reports pressure", func() {
+				BeforeEach(func() {
+					worker.Resources = &atc.WorkerResources{
+						DiskFree:  10,
+						DiskTotal: 8192,
+						Pressure:  []string{"disk"},
+					}
+				})
+
+				It("degrades the worker", func() {
+					Expect(fakeWorker.DegradeCallCount()).To(Equal(1))
+					Expect(fakeWorker.RecoverCallCount()).To(BeZero())
+				})
+
+				Context("when degrading the worker fails", func() {
+					BeforeEach(func() {
+						fakeWorker.DegradeReturns(false, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when saving the resources fails", func() {
+				BeforeEach(func() {
+					fakeWorker.SetResourcesReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
 		Context("when the TTL is invalid", func() {
 			BeforeEach(func() {
 				ttlStr = "invalid-duration"
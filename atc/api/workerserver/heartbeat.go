@@ -70,6 +70,15 @@ func (s *Server) HeartbeatWorker(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if registration.Resources != nil {
+		err = s.saveResources(logger, savedWorker, *registration.Resources)
+		if err != nil {
+			logger.Error("failed-to-save-worker-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
 	activeTasks, err := savedWorker.ActiveTasks()
 
 	if err != nil {
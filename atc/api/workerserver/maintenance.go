@@ -0,0 +1,101 @@
+package workerserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+)
+
+func (s *Server) ListMaintenanceWindows(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-maintenance-windows")
+
+	windows, err := s.dbMaintenanceWindowFactory.MaintenanceWindows()
+	if err != nil {
+		logger.Error("failed-to-get-maintenance-windows", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presented := make([]atc.MaintenanceWindow, len(windows))
+	for i, window := range windows {
+		presented[i] = present.MaintenanceWindow(window)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(presented)
+	if err != nil {
+		logger.Error("failed-to-encode-maintenance-windows", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) CreateMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("create-maintenance-window")
+
+	var window atc.MaintenanceWindow
+	err := json.NewDecoder(r.Body).Decode(&window)
+	if err != nil {
+		logger.Error("failed-to-decode-maintenance-window", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = window.Validate(time.Now())
+	if err != nil {
+		logger.Info("invalid-maintenance-window", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "%s", err)
+		return
+	}
+
+	created, err := s.dbMaintenanceWindowFactory.CreateMaintenanceWindow(window)
+	if err != nil {
+		logger.Error("failed-to-create-maintenance-window", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("created", lager.Data{
+		"id":     created.ID,
+		"worker": created.WorkerName,
+		"tag":    created.Tag,
+	})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err = json.NewEncoder(w).Encode(present.MaintenanceWindow(created))
+	if err != nil {
+		logger.Error("failed-to-encode-maintenance-window", err)
+	}
+}
+
+func (s *Server) DeleteMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("delete-maintenance-window")
+
+	id, err := strconv.Atoi(r.FormValue(":window_id"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	deleted, err := s.dbMaintenanceWindowFactory.DeleteMaintenanceWindow(id)
+	if err != nil {
+		logger.Error("failed-to-delete-maintenance-window", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !deleted {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
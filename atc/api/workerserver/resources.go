@@ -0,0 +1,66 @@
+package workerserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// saveResources records the resources reported with a worker's heartbeat and
+// applies any pressure they report.
+func (s *Server) saveResources(logger lager.Logger, worker db.Worker, resources atc.WorkerResources) error {
+	err := worker.SetResources(resources)
+	if err != nil {
+		return err
+	}
+
+	if worker.EnforcesEgressPolicies() {
+		metric.WorkerEgressPacketsDenied{
+			WorkerName: worker.Name(),
+			Packets:    resources.EgressPacketsDenied,
+		}.Emit(logger)
+	}
+
+	return s.applyPressure(logger, worker, resources.Pressure)
+}
+
+// applyPressure degrades a running worker which reports resource pressure,
+// and recovers a degraded worker once the pressure has cleared. Workers in
+// any other state are left alone.
+func (s *Server) applyPressure(logger lager.Logger, worker db.Worker, pressure []string) error {
+	var (
+		state        db.WorkerState
+		transitioned bool
+		err          error
+	)
+
+	if len(pressure) > 0 {
+		state = db.WorkerStateDegraded
+		transitioned, err = worker.Degrade()
+	} else {
+		state = db.WorkerStateRunning
+		transitioned, err = worker.Recover()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !transitioned {
+		return nil
+	}
+
+	logger.Info("worker-state-transitioned", lager.Data{
+		"state":    state,
+		"pressure": pressure,
+	})
+
+	metric.WorkerStateTransition{
+		WorkerName: worker.Name(),
+		State:      state,
+		Pressure:   pressure,
+	}.Emit(logger)
+
+	return nil
+}
@@ -10,17 +10,26 @@ type Server struct {
 
 	teamFactory     db.TeamFactory
 	dbWorkerFactory db.WorkerFactory
+
+	dbMaintenanceWindowFactory db.MaintenanceWindowFactory
+	dbWorkerKeyFactory         db.WorkerKeyFactory
+	dbWorkerSessionFactory     db.WorkerSessionFactory
 }
 
 func NewServer(
 	logger lager.Logger,
 	teamFactory db.TeamFactory,
 	dbWorkerFactory db.WorkerFactory,
-
+	dbMaintenanceWindowFactory db.MaintenanceWindowFactory,
+	dbWorkerKeyFactory db.WorkerKeyFactory,
+	dbWorkerSessionFactory db.WorkerSessionFactory,
 ) *Server {
 	return &Server{
-		logger:          logger,
-		teamFactory:     teamFactory,
-		dbWorkerFactory: dbWorkerFactory,
+		logger:                     logger,
+		teamFactory:                teamFactory,
+		dbWorkerFactory:            dbWorkerFactory,
+		dbMaintenanceWindowFactory: dbMaintenanceWindowFactory,
+		dbWorkerKeyFactory:         dbWorkerKeyFactory,
+		dbWorkerSessionFactory:     dbWorkerSessionFactory,
 	}
 }
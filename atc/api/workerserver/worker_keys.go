@@ -0,0 +1,68 @@
+package workerserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+)
+
+// ListWorkerKeys returns the worker keys of every team which the TSA should
+// accept.
+func (s *Server) ListWorkerKeys(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-worker-keys")
+
+	acc := accessor.GetAccessor(r)
+	if !acc.IsSystem() {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	keys, err := s.dbWorkerKeyFactory.ActiveWorkerKeys()
+	if err != nil {
+		logger.Error("failed-to-get-worker-keys", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presented := make([]atc.WorkerKey, len(keys))
+	for i, key := range keys {
+		presented[i] = present.WorkerKey(key)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(presented)
+	if err != nil {
+		logger.Error("failed-to-encode-worker-keys", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// MarkWorkerKeysUsed records when the TSA last accepted each worker key.
+func (s *Server) MarkWorkerKeysUsed(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("mark-worker-keys-used")
+
+	acc := accessor.GetAccessor(r)
+	if !acc.IsSystem() {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	var uses []atc.WorkerKeyUse
+	err := json.NewDecoder(r.Body).Decode(&uses)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = s.dbWorkerKeyFactory.MarkWorkerKeysUsed(uses)
+	if err != nil {
+		logger.Error("failed-to-mark-worker-keys-used", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,178 @@
+package workerserver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListWorkerRegistrationTokens(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-worker-registration-tokens")
+
+	tokens, err := s.dbWorkerKeyFactory.WorkerRegistrationTokens()
+	if err != nil {
+		logger.Error("failed-to-get-worker-registration-tokens", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presented := make([]atc.WorkerRegistrationToken, len(tokens))
+	for i, token := range tokens {
+		presented[i] = present.WorkerRegistrationToken(token)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(presented)
+	if err != nil {
+		logger.Error("failed-to-encode-worker-registration-tokens", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) CreateWorkerRegistrationToken(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("create-worker-registration-token")
+
+	var token atc.WorkerRegistrationToken
+	err := json.NewDecoder(r.Body).Decode(&token)
+	if err != nil {
+		logger.Error("failed-to-decode-worker-registration-token", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = token.Validate(time.Now())
+	if err != nil {
+		logger.Info("invalid-worker-registration-token", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "%s", err)
+		return
+	}
+
+	created, secret, err := s.dbWorkerKeyFactory.CreateWorkerRegistrationToken(token)
+	if err != nil {
+		if _, ok := err.(db.ErrWorkerRegistrationTokenTeamNotFound); ok {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "%s", err)
+			return
+		}
+
+		logger.Error("failed-to-create-worker-registration-token", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	logger.Info("created", lager.Data{
+		"id":       created.ID,
+		"team":     created.TeamName,
+		"tags":     created.Tags,
+		"max-uses": created.MaxUses,
+	})
+
+	presented := present.WorkerRegistrationToken(created)
+	presented.Token = secret
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err = json.NewEncoder(w).Encode(presented)
+	if err != nil {
+		logger.Error("failed-to-encode-worker-registration-token", err)
+	}
+}
+
+func (s *Server) DeleteWorkerRegistrationToken(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("delete-worker-registration-token")
+
+	id, err := strconv.Atoi(r.FormValue(":worker_registration_token_id"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	deleted, err := s.dbWorkerKeyFactory.DeleteWorkerRegistrationToken(id)
+	if err != nil {
+		logger.Error("failed-to-delete-worker-registration-token", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !deleted {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RedeemWorkerRegistrationToken is called by the TSA to exchange a worker's
+// registration token for a key which only that worker can register with.
+func (s *Server) RedeemWorkerRegistrationToken(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("redeem-worker-registration-token")
+
+	acc := accessor.GetAccessor(r)
+	if !acc.IsSystem() {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	var request atc.RedeemWorkerRegistrationTokenRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = request.Validate()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "%s", err)
+		return
+	}
+
+	logger = logger.WithData(lager.Data{
+		"worker": request.WorkerName,
+		"team":   request.TeamName,
+	})
+
+	key, err := s.dbWorkerKeyFactory.RedeemWorkerRegistrationToken(request)
+	if err != nil {
+		var teamNotFound db.ErrWorkerRegistrationTokenTeamNotFound
+
+		switch {
+		case err == db.ErrWorkerRegistrationTokenInvalid,
+			err == db.ErrWorkerRegistrationTokenMismatch,
+			err == db.ErrWorkerRegistrationTokenNameTaken,
+			errors.As(err, &teamNotFound):
+			logger.Info("rejected", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, "%s", err)
+		case err == db.ErrWorkerKeyInUse:
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "%s", err)
+		default:
+			logger.Error("failed-to-redeem-worker-registration-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	logger.Info("redeemed", lager.Data{"fingerprint": key.Fingerprint})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err = json.NewEncoder(w).Encode(present.WorkerKey(key))
+	if err != nil {
+		logger.Error("failed-to-encode-worker-key", err)
+	}
+}
@@ -0,0 +1,93 @@
+package workerserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// defaultWorkerSessionsTTL is used when the TSA does not say how soon it
+// will report again.
+const defaultWorkerSessionsTTL = time.Minute
+
+// ListWorkerSessions returns the sessions reported by every TSA.
+func (s *Server) ListWorkerSessions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-worker-sessions")
+
+	sessions, err := s.dbWorkerSessionFactory.WorkerSessions()
+	if err != nil {
+		logger.Error("failed-to-get-worker-sessions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	err = json.NewEncoder(w).Encode(sessions)
+	if err != nil {
+		logger.Error("failed-to-encode-worker-sessions", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// ReportWorkerSessions saves the sessions currently served by a TSA.
+func (s *Server) ReportWorkerSessions(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("report-worker-sessions")
+
+	acc := accessor.GetAccessor(r)
+	if !acc.IsSystem() {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	var report atc.WorkerSessionsReport
+	err := json.NewDecoder(r.Body).Decode(&report)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if report.TSA == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "missing tsa")
+		return
+	}
+
+	ttl := defaultWorkerSessionsTTL
+
+	ttlStr := r.URL.Query().Get("ttl")
+	if len(ttlStr) > 0 {
+		ttl, err = time.ParseDuration(ttlStr)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "malformed ttl")
+			return
+		}
+	}
+
+	err = s.dbWorkerSessionFactory.SaveWorkerSessions(report, ttl)
+	if err != nil {
+		logger.Error("failed-to-save-worker-sessions", err, lager.Data{"tsa": report.TSA})
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	event := metric.TSAWorkerSessions{
+		TSA:      report.TSA,
+		Sessions: len(report.Sessions),
+	}
+
+	for _, session := range report.Sessions {
+		event.BytesProxied += session.BytesProxied
+		event.MissedKeepalives += session.MissedKeepalives
+	}
+
+	event.Emit(logger)
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -83,9 +83,11 @@ import (
 	_ "github.com/concourse/concourse/atc/policy/opa"
 
 	// dynamically registered credential managers
+	"github.com/concourse/concourse/atc/creds/builtin"
 	_ "github.com/concourse/concourse/atc/creds/conjur"
 	_ "github.com/concourse/concourse/atc/creds/credhub"
 	_ "github.com/concourse/concourse/atc/creds/dummy"
+	_ "github.com/concourse/concourse/atc/creds/gcpsm"
 	_ "github.com/concourse/concourse/atc/creds/kubernetes"
 	_ "github.com/concourse/concourse/atc/creds/secretsmanager"
 	_ "github.com/concourse/concourse/atc/creds/ssm"
@@ -151,14 +153,21 @@ type RunCommand struct {
 	ResourceCheckingInterval            time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 	ResourceWithWebhookCheckingInterval time.Duration `long:"resource-with-webhook-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources that has webhook defined."`
 	MaxChecksPerSecond                  int           `long:"max-checks-per-second" description:"Maximum number of checks that can be started per second. If not specified, this will be calculated as (# of resources)/(resource checking interval). -1 value will remove this maximum limit of checks per second."`
+	MaxChecksPerSecondPerTeam           float64       `long:"max-checks-per-second-per-team" description:"Maximum number of checks that can be started per second for resources belonging to any one team. A value of 0 removes the per-team limit. Can be overridden for a team by an admin."`
+	MaxCheckContainersPerTeam           int           `long:"max-check-containers-per-team" description:"Maximum number of checks that can run at the same time, across all web nodes, for resources belonging to any one team. A value of 0 removes the per-team limit. Can be overridden for a team by an admin."`
 
 	ContainerPlacementStrategyOptions worker.ContainerPlacementStrategyOptions `group:"Container Placement Strategy"`
 
 	BaggageclaimResponseHeaderTimeout time.Duration `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
-	StreamingArtifactsCompression     string        `long:"streaming-artifacts-compression" default:"gzip" choice:"gzip" choice:"zstd" description:"Compression algorithm for internal streaming."`
+	StreamingArtifactsCompression     string        `long:"streaming-artifacts-compression" default:"gzip" choice:"gzip" choice:"zstd" choice:"auto" description:"Compression algorithm for internal streaming. auto streams large volumes with zstd and the rest with gzip."`
+	StreamingLargeVolumeSizeMB        int64         `long:"streaming-large-volume-size-mb" default:"1024" description:"With auto compression, volumes which were this many megabytes or more the last time they were streamed are streamed with zstd rather than gzip."`
+	StreamingChunkSizeMB              int64         `long:"streaming-chunk-size-mb" default:"0" description:"Relay volumes streamed through the ATC in chunks of roughly this many megabytes, resending chunks the destination did not fully consume and resuming failed streams from the last delivered chunk. Each chunk in flight is buffered on disk. 0 streams volumes in one piece."`
+	StreamingMaxRetries               int           `long:"streaming-max-retries" default:"3" description:"Number of times a chunked volume stream is resent or resumed before failing."`
 
 	GardenRequestTimeout time.Duration `long:"garden-request-timeout" default:"5m" description:"How long to wait for requests to Garden to complete. 0 means no timeout."`
 
+	EgressPolicies flag.File `long:"egress-policies" description:"Path to a YAML file of the egress policies enforced on the containers of each team and pipeline. Containers to which a policy applies are only placed on workers using the containerd runtime, which enforce them."`
+
 	CLIArtifactsDir flag.Dir `long:"cli-artifacts-dir" description:"Directory containing downloadable CLI binaries."`
 
 	Metrics struct {
@@ -195,6 +204,14 @@ type RunCommand struct {
 		VarSourceRecyclePeriod time.Duration `long:"var-source-recycle-period" default:"5m" description:"Period after which to reap var_sources that are not used."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
+	CacheWarming struct {
+		Enable              bool          `long:"enable" description:"Stream the most used resource caches to newly registered workers ahead of their first builds."`
+		Interval            time.Duration `long:"interval" default:"30s" description:"Interval on which to stream a resource cache to each new worker."`
+		Window              time.Duration `long:"window" default:"15m" description:"Period after registering during which a worker is considered new."`
+		MaxCachesPerWorker  int           `long:"max-caches-per-worker" default:"10" description:"Number of the most used resource caches to stream to each new worker."`
+		MaxActiveContainers int           `long:"max-active-containers" default:"5" description:"Pause streaming to a worker while it is running this many containers. 0 means no limit."`
+	} `group:"Cache Warming" namespace:"cache-warming"`
+
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
 
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
@@ -247,6 +264,7 @@ type RunCommand struct {
 		EnableAcrossStep                     bool `long:"enable-across-step" description:"Enable the experimental across step to be used in jobs. The API is subject to change."`
 		EnablePipelineInstances              bool `long:"enable-pipeline-instances" description:"Enable pipeline instances"`
 		EnableP2PVolumeStreaming             bool `long:"enable-p2p-volume-streaming" description:"Enable P2P volume streaming"`
+		EnableVolumeStreamLogs               bool `long:"enable-volume-stream-logs" description:"Print the size, duration and retries of every volume streamed through the ATC for a step to its build log."`
 	} `group:"Feature Flags"`
 
 	BaseResourceTypeDefaults flag.File `long:"base-resource-type-defaults" description:"Base resource type defaults"`
@@ -506,6 +524,7 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 	atc.EnableBuildRerunWhenWorkerDisappears = cmd.FeatureFlags.EnableBuildRerunWhenWorkerDisappears
 	atc.EnableAcrossStep = cmd.FeatureFlags.EnableAcrossStep
 	atc.EnablePipelineInstances = cmd.FeatureFlags.EnablePipelineInstances
+	atc.EnableVolumeStreamLogs = cmd.FeatureFlags.EnableVolumeStreamLogs
 
 	if cmd.BaseResourceTypeDefaults.Path() != "" {
 		content, err := ioutil.ReadFile(cmd.BaseResourceTypeDefaults.Path())
@@ -586,11 +605,13 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
-	secretManager, err := cmd.secretManager(logger)
+	secretManager, err := cmd.secretManager(logger, backendConn)
 	if err != nil {
 		return nil, err
 	}
 
+	secretLeaser := cmd.secretLeaser()
+
 	cmd.varSourcePool = creds.NewVarSourcePool(
 		logger.Session("var-source-pool"),
 		cmd.CredentialManagement,
@@ -599,7 +620,7 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		clock.NewClock(),
 	)
 
-	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, workerConn, backendConn, gcConn, storage, lockFactory, secretManager)
+	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, workerConn, backendConn, gcConn, storage, lockFactory, secretManager, secretLeaser)
 	if err != nil {
 		return nil, err
 	}
@@ -647,6 +668,7 @@ func (cmd *RunCommand) constructMembers(
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
+	secretLeaser creds.Leaser,
 ) ([]grouper.Member, error) {
 	if cmd.TelemetryOptIn {
 		url := fmt.Sprintf("http://telemetry.concourse-ci.org/?version=%s", concourse.Version)
@@ -668,12 +690,12 @@ func (cmd *RunCommand) constructMembers(
 		return nil, err
 	}
 
-	backendComponents, err := cmd.backendComponents(logger, backendConn, lockFactory, secretManager, policyChecker)
+	backendComponents, err := cmd.backendComponents(logger, backendConn, lockFactory, secretManager, secretLeaser, policyChecker)
 	if err != nil {
 		return nil, err
 	}
 
-	gcComponents, err := cmd.gcComponents(logger, gcConn, lockFactory)
+	gcComponents, err := cmd.gcComponents(logger, gcConn, lockFactory, secretLeaser)
 	if err != nil {
 		return nil, err
 	}
@@ -719,6 +741,19 @@ func (cmd *RunCommand) constructMembers(
 		}
 	}
 
+	// every web node caches its own secrets, so every one of them has to evict
+	// them, unlike the components which run on one node at a time
+	members = append(members, grouper.Member{
+		Name: "secret-cache-evictor",
+		Runner: &creds.SecretCacheEvictor{
+			Logger:        logger.Session("secret-cache-evictor"),
+			Notifications: bus,
+			Evictions:     db.NewSecretCacheEvictionFactory(backendConn),
+			Secrets:       secretManager,
+			VarSourcePool: cmd.varSourcePool,
+		},
+	})
+
 	return members, nil
 }
 
@@ -768,6 +803,11 @@ func (cmd *RunCommand) constructAPIMembers(
 		return nil, err
 	}
 
+	egressPolicies, err := cmd.parseEgressPolicies()
+	if err != nil {
+		return nil, err
+	}
+
 	workerProvider := worker.NewDBWorkerProvider(
 		lockFactory,
 		retryhttp.NewExponentialBackOffFactory(5*time.Minute),
@@ -784,6 +824,7 @@ func (cmd *RunCommand) constructAPIMembers(
 		workerVersion,
 		cmd.BaggageclaimResponseHeaderTimeout,
 		cmd.GardenRequestTimeout,
+		egressPolicies,
 	)
 
 	pool := worker.NewPool(workerProvider)
@@ -803,6 +844,12 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbAccessTokenFactory := db.NewAccessTokenFactory(dbConn)
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
+	dbMaintenanceWindowFactory := db.NewMaintenanceWindowFactory(dbConn)
+	dbWorkerKeyFactory := db.NewWorkerKeyFactory(dbConn)
+	dbWorkerSessionFactory := db.NewWorkerSessionFactory(dbConn)
+	dbSecretFactory := db.NewSecretFactory(dbConn)
+	dbSecretAccessFactory := db.NewSecretAccessFactory(dbConn)
+	dbSecretCacheEvictionFactory := db.NewSecretCacheEvictionFactory(dbConn)
 
 	tokenVerifier := cmd.constructTokenVerifier(dbAccessTokenFactory)
 
@@ -832,6 +879,12 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbJobFactory,
 		dbResourceFactory,
 		dbWorkerFactory,
+		dbMaintenanceWindowFactory,
+		dbWorkerKeyFactory,
+		dbWorkerSessionFactory,
+		dbSecretFactory,
+		dbSecretAccessFactory,
+		dbSecretCacheEvictionFactory,
 		dbVolumeRepository,
 		dbContainerRepository,
 		gcContainerDestroyer,
@@ -971,6 +1024,7 @@ func (cmd *RunCommand) backendComponents(
 	dbConn db.Conn,
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
+	secretLeaser creds.Leaser,
 	policyChecker policy.Checker,
 ) ([]RunnableComponent, error) {
 
@@ -1013,10 +1067,20 @@ func (cmd *RunCommand) backendComponents(
 		return nil, err
 	}
 
+	egressPolicies, err := cmd.parseEgressPolicies()
+	if err != nil {
+		return nil, err
+	}
+
 	var compressionLib compression.Compression
-	if cmd.StreamingArtifactsCompression == "zstd" {
+	switch cmd.StreamingArtifactsCompression {
+	case "zstd":
 		compressionLib = compression.NewZstdCompression()
-	} else {
+	case "auto":
+		compressionLib = worker.NewAutoCompression(compression.NewGzipCompression(), worker.AutoCompressionConfig{
+			LargeVolumeSize: cmd.StreamingLargeVolumeSizeMB * 1024 * 1024,
+		})
+	default:
 		compressionLib = compression.NewGzipCompression()
 	}
 	workerProvider := worker.NewDBWorkerProvider(
@@ -1035,11 +1099,12 @@ func (cmd *RunCommand) backendComponents(
 		workerVersion,
 		cmd.BaggageclaimResponseHeaderTimeout,
 		cmd.GardenRequestTimeout,
+		egressPolicies,
 	)
 
 	pool := worker.NewPool(workerProvider)
 	artifactStreamer := worker.NewArtifactStreamer(pool, compressionLib)
-	artifactSourcer := worker.NewArtifactSourcer(compressionLib, pool, cmd.FeatureFlags.EnableP2PVolumeStreaming, cmd.P2pVolumeStreamingTimeout)
+	artifactSourcer := worker.NewArtifactSourcer(compressionLib, pool, cmd.FeatureFlags.EnableP2PVolumeStreaming, cmd.P2pVolumeStreamingTimeout, cmd.streamChunking())
 
 	defaultLimits, err := cmd.parseDefaultLimits()
 	if err != nil {
@@ -1054,8 +1119,11 @@ func (cmd *RunCommand) backendComponents(
 	rateLimiter := db.NewResourceCheckRateLimiter(
 		rate.Limit(cmd.MaxChecksPerSecond),
 		cmd.ResourceCheckingInterval,
+		rate.Limit(cmd.MaxChecksPerSecondPerTeam),
+		cmd.MaxCheckContainersPerTeam,
 		dbConn,
 		time.Minute,
+		lockFactory,
 		clock.NewClock(),
 	)
 
@@ -1070,6 +1138,9 @@ func (cmd *RunCommand) backendComponents(
 		dbResourceCacheFactory,
 		dbResourceConfigFactory,
 		secretManager,
+		cmd.secretManagerName(),
+		secretLeaser,
+		db.NewSecretLeaseFactory(dbConn),
 		defaultLimits,
 		buildContainerStrategy,
 		lockFactory,
@@ -1145,6 +1216,29 @@ func (cmd *RunCommand) backendComponents(
 		},
 	}
 
+	if cmd.CacheWarming.Enable {
+		components = append(components, RunnableComponent{
+			Component: atc.Component{
+				Name:     atc.ComponentCacheWarmer,
+				Interval: cmd.CacheWarming.Interval,
+			},
+			Runnable: worker.NewCacheWarmer(
+				workerProvider,
+				pool,
+				dbResourceCacheFactory,
+				compressionLib,
+				cmd.FeatureFlags.EnableP2PVolumeStreaming,
+				cmd.P2pVolumeStreamingTimeout,
+				cmd.streamChunking(),
+				worker.CacheWarmingBudget{
+					Window:              cmd.CacheWarming.Window,
+					MaxCaches:           cmd.CacheWarming.MaxCachesPerWorker,
+					MaxActiveContainers: cmd.CacheWarming.MaxActiveContainers,
+				},
+			),
+		})
+	}
+
 	if syslogDrainConfigured {
 		components = append(components, RunnableComponent{
 			Component: atc.Component{
@@ -1168,6 +1262,7 @@ func (cmd *RunCommand) gcComponents(
 	logger lager.Logger,
 	gcConn db.Conn,
 	lockFactory lock.LockFactory,
+	secretLeaser creds.Leaser,
 ) ([]RunnableComponent, error) {
 	dbWorkerLifecycle := db.NewWorkerLifecycle(gcConn)
 	dbResourceCacheLifecycle := db.NewResourceCacheLifecycle(gcConn)
@@ -1178,6 +1273,7 @@ func (cmd *RunCommand) gcComponents(
 	dbBuildFactory := db.NewBuildFactory(gcConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod)
 	dbResourceConfigFactory := db.NewResourceConfigFactory(gcConn, lockFactory)
 	dbPipelineLifecycle := db.NewPipelineLifecycle(gcConn, lockFactory)
+	dbWorkerKeyLifecycle := db.NewWorkerKeyLifecycle(gcConn)
 
 	dbVolumeRepository := db.NewVolumeRepository(gcConn)
 
@@ -1190,6 +1286,10 @@ func (cmd *RunCommand) gcComponents(
 	// want to set it too low.
 	unreferencedConfigGracePeriod := cmd.GlobalResourceCheckTimeout + 5*time.Minute
 
+	// keep the keys of workers which went away for long enough that a worker
+	// being recreated under the same name can reconnect with its saved key
+	orphanedWorkerKeyGracePeriod := 24 * time.Hour
+
 	collectors := map[string]component.Runnable{
 		atc.ComponentCollectorBuilds:            gc.NewBuildCollector(dbBuildFactory),
 		atc.ComponentCollectorWorkers:           gc.NewWorkerCollector(dbWorkerLifecycle),
@@ -1202,6 +1302,11 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorCheckSessions:     gc.NewResourceConfigCheckSessionCollector(resourceConfigCheckSessionLifecycle),
 		atc.ComponentCollectorPipelines:         gc.NewPipelineCollector(dbPipelineLifecycle),
 		atc.ComponentCollectorAccessTokens:      gc.NewAccessTokensCollector(dbAccessTokenLifecycle, jwt.DefaultLeeway),
+		atc.ComponentCollectorWorkerKeys:        gc.NewWorkerKeysCollector(dbWorkerKeyLifecycle, orphanedWorkerKeyGracePeriod),
+	}
+
+	if secretLeaser != nil {
+		collectors[atc.ComponentCollectorSecretLeases] = gc.NewSecretLeaseCollector(db.NewSecretLeaseFactory(gcConn), secretLeaser)
 	}
 
 	var components []RunnableComponent
@@ -1285,38 +1390,123 @@ func workerVersion() (version.Version, error) {
 	return version.NewVersionFromString(concourse.WorkerVersion)
 }
 
-func (cmd *RunCommand) secretManager(logger lager.Logger) (creds.Secrets, error) {
+func (cmd *RunCommand) secretManager(logger lager.Logger, dbConn db.Conn) (creds.Secrets, error) {
+	if len(cmd.CredentialManagement.Chain) > 0 {
+		return cmd.chainedSecretManager(logger, dbConn)
+	}
+
 	var secretsFactory creds.SecretsFactory = noop.NewNoopFactory()
 	for name, manager := range cmd.CredentialManagers {
 		if !manager.IsConfigured() {
 			continue
 		}
 
-		credsLogger := logger.Session("credential-manager", lager.Data{
-			"name": name,
-		})
-
-		credsLogger.Info("configured credentials manager")
-
-		err := manager.Init(credsLogger)
+		var err error
+		secretsFactory, err = cmd.secretsFactory(logger, dbConn, name, manager)
 		if err != nil {
 			return nil, err
 		}
 
-		err = manager.Validate()
-		if err != nil {
-			return nil, fmt.Errorf("credential manager '%s' misconfigured: %s", name, err)
+		break
+	}
+
+	return cmd.CredentialManagement.NewSecrets(secretsFactory), nil
+}
+
+// secretManagerName names the manager of the cluster-wide credentials, as
+// recorded against the builds which access them.
+func (cmd *RunCommand) secretManagerName() string {
+	if len(cmd.CredentialManagement.Chain) > 0 {
+		return strings.Join(cmd.CredentialManagement.Chain, ",")
+	}
+
+	for name, manager := range cmd.CredentialManagers {
+		if manager.IsConfigured() {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// secretLeaser returns the leaser of the dynamic secrets of the credential
+// managers in use, if any of them issues dynamic secrets. It must be called
+// once their secrets factories have been created.
+func (cmd *RunCommand) secretLeaser() creds.Leaser {
+	for _, manager := range cmd.CredentialManagers {
+		leasingManager, ok := manager.(creds.LeasingManager)
+		if !ok || !manager.IsConfigured() {
+			continue
 		}
 
-		secretsFactory, err = manager.NewSecretsFactory(credsLogger)
+		leaser, enabled := leasingManager.Leaser()
+		if enabled {
+			return leaser
+		}
+	}
+
+	return nil
+}
+
+// chainedSecretManager looks up credentials in each manager of the chain in
+// turn, counting which of them found each credential.
+func (cmd *RunCommand) chainedSecretManager(logger lager.Logger, dbConn db.Conn) (creds.Secrets, error) {
+	secretsFactories := map[string]creds.SecretsFactory{}
+	for _, name := range cmd.CredentialManagement.Chain {
+		if _, found := secretsFactories[name]; found {
+			return nil, fmt.Errorf("credential manager '%s' is in the chain more than once", name)
+		}
+
+		manager, found := cmd.CredentialManagers[name]
+		if !found {
+			return nil, fmt.Errorf("unknown credential manager '%s' in the chain", name)
+		}
+
+		if !manager.IsConfigured() {
+			return nil, fmt.Errorf("credential manager '%s' in the chain is not configured", name)
+		}
+
+		secretsFactory, err := cmd.secretsFactory(logger, dbConn, name, manager)
 		if err != nil {
 			return nil, err
 		}
 
-		break
+		secretsFactories[name] = secretsFactory
 	}
 
-	return cmd.CredentialManagement.NewSecrets(secretsFactory), nil
+	for _, flag := range cmd.CredentialManagement.ChainLookupTemplates {
+		if _, found := secretsFactories[flag.Manager]; !found {
+			return nil, fmt.Errorf("lookup template given for credential manager '%s', which is not in the chain", flag.Manager)
+		}
+	}
+
+	return cmd.CredentialManagement.NewChainedSecrets(secretsFactories, func(name string) {
+		metric.Metrics.CredentialLookups(name).Inc()
+	}), nil
+}
+
+func (cmd *RunCommand) secretsFactory(logger lager.Logger, dbConn db.Conn, name string, manager creds.Manager) (creds.SecretsFactory, error) {
+	if builtinManager, ok := manager.(*builtin.Manager); ok {
+		builtinManager.SetSecretFactory(db.NewSecretFactory(dbConn))
+	}
+
+	credsLogger := logger.Session("credential-manager", lager.Data{
+		"name": name,
+	})
+
+	credsLogger.Info("configured credentials manager")
+
+	err := manager.Init(credsLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	err = manager.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("credential manager '%s' misconfigured: %s", name, err)
+	}
+
+	return manager.NewSecretsFactory(credsLogger)
 }
 
 func (cmd *RunCommand) newKey() *encryption.Key {
@@ -1450,6 +1640,13 @@ func (cmd *RunCommand) parseDefaultLimits() (atc.ContainerLimits, error) {
 	return limits, nil
 }
 
+func (cmd *RunCommand) streamChunking() worker.StreamChunking {
+	return worker.StreamChunking{
+		ChunkSize:  cmd.StreamingChunkSizeMB * 1024 * 1024,
+		MaxRetries: cmd.StreamingMaxRetries,
+	}
+}
+
 func (cmd *RunCommand) defaultBindIP() net.IP {
 	URL := cmd.BindIP.String()
 	if URL == "0.0.0.0" {
@@ -1527,9 +1724,32 @@ func (cmd *RunCommand) validate() error {
 		errs = multierror.Append(errs, err)
 	}
 
+	if _, err := cmd.parseEgressPolicies(); err != nil {
+		errs = multierror.Append(errs, err)
+	}
+
 	return errs.ErrorOrNil()
 }
 
+func (cmd *RunCommand) parseEgressPolicies() (atc.EgressPolicies, error) {
+	path := cmd.EgressPolicies.Path()
+	if path == "" {
+		return atc.EgressPolicies{}, nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return atc.EgressPolicies{}, fmt.Errorf("failed to open egress policies file (%s): %w", cmd.EgressPolicies, err)
+	}
+
+	policies, err := atc.NewEgressPolicies(content)
+	if err != nil {
+		return atc.EgressPolicies{}, fmt.Errorf("failed to parse egress policies file (%s): %w", cmd.EgressPolicies, err)
+	}
+
+	return policies, nil
+}
+
 func (cmd *RunCommand) nonTLSBindAddr() string {
 	return fmt.Sprintf("%s:%d", cmd.BindIP, cmd.BindPort)
 }
@@ -1635,6 +1855,9 @@ func (cmd *RunCommand) constructEngine(
 	resourceCacheFactory db.ResourceCacheFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	secretManager creds.Secrets,
+	secretManagerName string,
+	secretLeaser creds.Leaser,
+	secretLeaseFactory db.SecretLeaseFactory,
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
@@ -1664,6 +1887,9 @@ func (cmd *RunCommand) constructEngine(
 			lockFactory,
 		),
 		secretManager,
+		secretManagerName,
+		secretLeaser,
+		secretLeaseFactory,
 		cmd.varSourcePool,
 	)
 }
@@ -1823,6 +2049,12 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbJobFactory db.JobFactory,
 	dbResourceFactory db.ResourceFactory,
 	dbWorkerFactory db.WorkerFactory,
+	dbMaintenanceWindowFactory db.MaintenanceWindowFactory,
+	dbWorkerKeyFactory db.WorkerKeyFactory,
+	dbWorkerSessionFactory db.WorkerSessionFactory,
+	dbSecretFactory db.SecretFactory,
+	dbSecretAccessFactory db.SecretAccessFactory,
+	dbSecretCacheEvictionFactory db.SecretCacheEvictionFactory,
 	dbVolumeRepository db.VolumeRepository,
 	dbContainerRepository db.ContainerRepository,
 	gcContainerDestroyer gc.Destroyer,
@@ -1898,6 +2130,12 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbJobFactory,
 		dbResourceFactory,
 		dbWorkerFactory,
+		dbMaintenanceWindowFactory,
+		dbWorkerKeyFactory,
+		dbWorkerSessionFactory,
+		dbSecretFactory,
+		dbSecretAccessFactory,
+		dbSecretCacheEvictionFactory,
 		workerTeamFactory,
 		dbVolumeRepository,
 		dbContainerRepository,
@@ -1921,6 +2159,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		secretManager,
 		cmd.varSourcePool,
 		credsManagers,
+		cmd.CredentialManagement.Chain,
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 		time.Minute,
 		dbWall,
@@ -64,6 +64,8 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.BuildResources,
 		atc.AbortBuild,
 		atc.GetBuildPreparation,
+		atc.GetBuildSecretAccesses,
+		atc.ListSecretAccesses,
 		atc.ListBuildsWithVersionAsInput,
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
@@ -117,7 +119,12 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.GetResourceVersion,
 		atc.EnableResourceVersion,
 		atc.DisableResourceVersion,
+		atc.AddResourceVersionLabel,
+		atc.RemoveResourceVersionLabel,
 		atc.PinResourceVersion,
+		atc.PinResourceTypeVersion,
+		atc.UnpinResourceType,
+		atc.PromoteResourceTypeCandidate,
 		atc.GetResourceCausality:
 		return a.EnableResourceAuditLog
 	case
@@ -135,13 +142,20 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.GetUser,
 		atc.GetWall,
 		atc.SetWall,
-		atc.ClearWall:
+		atc.ClearWall,
+		atc.ClearSecretCache:
 		return a.EnableSystemAuditLog
 	case atc.ListTeams,
 		atc.SetTeam,
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
+		atc.GetTeamCheckLimits,
+		atc.SetTeamCheckLimits,
+		atc.ListTeamSecrets,
+		atc.SetTeamSecret,
+		atc.DeleteTeamSecret,
+		atc.ClearTeamSecretCache,
 		atc.GetTeam:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
@@ -150,7 +164,21 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.PruneWorker,
 		atc.HeartbeatWorker,
 		atc.ListWorkers,
-		atc.DeleteWorker:
+		atc.DeleteWorker,
+		atc.ListMaintenanceWindows,
+		atc.CreateMaintenanceWindow,
+		atc.DeleteMaintenanceWindow,
+		atc.ListWorkerKeys,
+		atc.MarkWorkerKeysUsed,
+		atc.ListWorkerRegistrationTokens,
+		atc.CreateWorkerRegistrationToken,
+		atc.DeleteWorkerRegistrationToken,
+		atc.RedeemWorkerRegistrationToken,
+		atc.ListWorkerSessions,
+		atc.ReportWorkerSessions,
+		atc.ListTeamWorkerKeys,
+		atc.SetTeamWorkerKey,
+		atc.DeleteTeamWorkerKey:
 		return a.EnableWorkerAuditLog
 	case atc.ListVolumes,
 		atc.ListDestroyingVolumes,
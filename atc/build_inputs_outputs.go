@@ -22,4 +22,5 @@ type ResourceVersion struct {
 	Metadata []MetadataField `json:"metadata,omitempty"`
 	Version  Version         `json:"version"`
 	Enabled  bool            `json:"enabled"`
+	Labels   []string        `json:"labels,omitempty"`
 }
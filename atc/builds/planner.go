@@ -55,6 +55,8 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 		ConfigPath:        step.ConfigPath,
 		Vars:              step.Vars,
 		Tags:              step.Tags,
+		WorkerSelector:    step.WorkerSelector,
+		RuntimeClass:      step.RuntimeClass,
 		Params:            step.Params,
 		InputMapping:      step.InputMapping,
 		OutputMapping:     step.OutputMapping,
@@ -103,6 +105,8 @@ func (visitor *planVisitor) VisitGet(step *atc.GetStep) error {
 		Tags:     step.Tags,
 		Timeout:  step.Timeout,
 
+		WorkerSelector: step.WorkerSelector,
+
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
 
@@ -136,6 +140,8 @@ func (visitor *planVisitor) VisitPut(step *atc.PutStep) error {
 		Tags:    step.Tags,
 		Timeout: step.Timeout,
 
+		WorkerSelector: step.WorkerSelector,
+
 		VersionedResourceTypes: visitor.resourceTypes,
 	}
 
@@ -152,6 +158,8 @@ func (visitor *planVisitor) VisitPut(step *atc.PutStep) error {
 		Tags:    step.Tags,
 		Timeout: step.Timeout,
 
+		WorkerSelector: step.WorkerSelector,
+
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
 
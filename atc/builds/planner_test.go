@@ -243,6 +243,12 @@ var factoryTests = []PlannerTest{
 			OutputMapping:     map[string]string{"specific": "generic"},
 			ImageArtifactName: "some-image",
 			Timeout:           "1h",
+			WorkerSelector: &atc.WorkerSelector{
+				Required: []atc.WorkerSelectorExpression{
+					{Key: "arch", Operator: atc.WorkerSelectorOperatorIn, Values: []string{"arm64"}},
+				},
+			},
+			RuntimeClass: "gvisor",
 		},
 
 		PlanJSON: `{
@@ -258,6 +264,10 @@ var factoryTests = []PlannerTest{
 				"vars": {"some": "vars"},
 				"params": {"SOME": "PARAMS"},
 				"tags": ["tag-1", "tag-2"],
+				"worker_selector": {
+					"required": [{"key": "arch", "operator": "In", "values": ["arm64"]}]
+				},
+				"runtime_class": "gvisor",
 				"input_mapping": {"generic": "specific"},
 				"output_mapping": {"specific": "generic"},
 				"image": "some-image",
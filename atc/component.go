@@ -8,6 +8,7 @@ const (
 	ComponentLidarScanner               = "scanner"
 	ComponentBuildReaper                = "reaper"
 	ComponentSyslogDrainer              = "drainer"
+	ComponentCacheWarmer                = "cache_warmer"
 	ComponentCollectorAccessTokens      = "collector_access_tokens"
 	ComponentCollectorArtifacts         = "collector_artifacts"
 	ComponentCollectorBuilds            = "collector_builds"
@@ -19,6 +20,8 @@ const (
 	ComponentCollectorResourceConfigs   = "collector_resource_configs"
 	ComponentCollectorVolumes           = "collector_volumes"
 	ComponentCollectorWorkers           = "collector_workers"
+	ComponentCollectorWorkerKeys        = "collector_worker_keys"
+	ComponentCollectorSecretLeases      = "collector_secret_leases"
 	ComponentCollectorPipelines         = "collector_pipelines"
 )
 
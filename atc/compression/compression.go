@@ -1,14 +1,21 @@
 package compression
 
 import (
+	"errors"
 	"io"
 
 	"github.com/concourse/baggageclaim"
 )
 
+// ErrChecksumMismatch is returned when reading a compressed stream whose
+// content does not match the checksum written along with it, i.e. the stream
+// was corrupted after being compressed.
+var ErrChecksumMismatch = errors.New("compressed stream does not match its checksum")
+
 //go:generate counterfeiter . Compression
 
 type Compression interface {
 	NewReader(io.ReadCloser) (io.ReadCloser, error)
+	NewWriter(io.Writer) (io.WriteCloser, error)
 	Encoding() baggageclaim.Encoding
 }
@@ -1,6 +1,9 @@
 package compression_test
 
 import (
+	"bytes"
+	"io/ioutil"
+
 	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc/compression"
 
@@ -13,6 +16,50 @@ var _ = Describe("Compression", func() {
 		comp compression.Compression
 	)
 
+	// itDetectsCorruption flips a byte of the checksum at the given offset from
+	// the end of the compressed stream
+	itDetectsCorruption := func(offset int) {
+		It("rejects a stream which does not match its checksum", func() {
+			buf := new(bytes.Buffer)
+
+			w, err := comp.NewWriter(buf)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = w.Write([]byte("some-content"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(w.Close()).To(Succeed())
+
+			compressed := buf.Bytes()
+			compressed[len(compressed)-offset] ^= 0xff
+
+			r, err := comp.NewReader(ioutil.NopCloser(bytes.NewReader(compressed)))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = ioutil.ReadAll(r)
+			Expect(err).To(Equal(compression.ErrChecksumMismatch))
+		})
+	}
+
+	itRoundTrips := func() {
+		It("decompresses what it compressed", func() {
+			buf := new(bytes.Buffer)
+
+			w, err := comp.NewWriter(buf)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = w.Write([]byte("some-content"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(w.Close()).To(Succeed())
+
+			r, err := comp.NewReader(ioutil.NopCloser(buf))
+			Expect(err).ToNot(HaveOccurred())
+
+			content, err := ioutil.ReadAll(r)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(Equal("some-content"))
+		})
+	}
+
 	Describe("Gzip", func() {
 		BeforeEach(func() {
 			comp = compression.NewGzipCompression()
@@ -21,6 +68,9 @@ var _ = Describe("Compression", func() {
 		It("returns gzip", func() {
 			Expect(comp.Encoding()).To(Equal(baggageclaim.GzipEncoding))
 		})
+
+		itRoundTrips()
+		itDetectsCorruption(8)
 	})
 
 	Describe("Zstd", func() {
@@ -31,5 +81,8 @@ var _ = Describe("Compression", func() {
 		It("returns zstd", func() {
 			Expect(comp.Encoding()).To(Equal(baggageclaim.ZstdEncoding))
 		})
+
+		itRoundTrips()
+		itDetectsCorruption(1)
 	})
 })
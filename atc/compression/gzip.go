@@ -21,6 +21,10 @@ func (c *gzipCompression) NewReader(reader io.ReadCloser) (io.ReadCloser, error)
 	return &gzipReader{reader: r}, nil
 }
 
+func (c *gzipCompression) NewWriter(writer io.Writer) (io.WriteCloser, error) {
+	return gzip.NewWriter(writer), nil
+}
+
 func (c *gzipCompression) Encoding() baggageclaim.Encoding {
 	return baggageclaim.GzipEncoding
 }
@@ -30,7 +34,12 @@ type gzipReader struct {
 }
 
 func (gr *gzipReader) Read(p []byte) (int, error) {
-	return gr.reader.Read(p)
+	n, err := gr.reader.Read(p)
+	if err == gzip.ErrChecksum {
+		err = ErrChecksumMismatch
+	}
+
+	return n, err
 }
 
 func (gr *gzipReader) Close() error {
@@ -21,6 +21,12 @@ func (c *zstdCompression) NewReader(reader io.ReadCloser) (io.ReadCloser, error)
 	return &zstdReader{decoder: d}, nil
 }
 
+func (c *zstdCompression) NewWriter(writer io.Writer) (io.WriteCloser, error) {
+	// the checksum lets whoever decompresses the stream detect corruption,
+	// as with gzip
+	return zstd.NewWriter(writer, zstd.WithEncoderCRC(true))
+}
+
 func (c *zstdCompression) Encoding() baggageclaim.Encoding {
 	return baggageclaim.ZstdEncoding
 }
@@ -30,7 +36,12 @@ type zstdReader struct {
 }
 
 func (zr *zstdReader) Read(p []byte) (int, error) {
-	return zr.decoder.Read(p)
+	n, err := zr.decoder.Read(p)
+	if err == zstd.ErrCRCMismatch {
+		err = ErrChecksumMismatch
+	}
+
+	return n, err
 }
 
 func (zr *zstdReader) Close() error {
@@ -198,6 +198,8 @@ type ResourceType struct {
 	CheckEvery *CheckEvery `json:"check_every,omitempty"`
 	Tags       Tags        `json:"tags,omitempty"`
 	Params     Params      `json:"params,omitempty"`
+
+	VersionSchema *VersionSchema `json:"version_schema,omitempty"`
 }
 
 type DisplayConfig struct {
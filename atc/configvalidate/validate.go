@@ -230,6 +230,12 @@ func validateResourceTypes(c Config) ([]ConfigWarning, error) {
 		if resourceType.Type == "" {
 			errorMessages = append(errorMessages, identifier+" has no type")
 		}
+
+		if resourceType.VersionSchema != nil {
+			if err := resourceType.VersionSchema.Validate(); err != nil {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has an invalid version_schema: %s", identifier, err))
+			}
+		}
 	}
 
 	return warnings, compositeErr(errorMessages)
@@ -804,6 +804,24 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a resource type has an invalid version schema", func() {
+			BeforeEach(func() {
+				config.ResourceTypes = append(config.ResourceTypes, atc.ResourceType{
+					Name: "strict-resource-type",
+					Type: "some-type",
+					VersionSchema: &atc.VersionSchema{
+						MaxVersionSize: -1,
+					},
+				})
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("invalid resource types:"))
+				Expect(errorMessages[0]).To(ContainSubstring("resource_types.strict-resource-type has an invalid version_schema: max_version_size must not be negative"))
+			})
+		})
+
 		Context("when two resource types have the same name", func() {
 			BeforeEach(func() {
 				config.ResourceTypes = append(config.ResourceTypes, config.ResourceTypes...)
@@ -979,6 +997,33 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a step has an invalid worker selector", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.TaskStep{
+							Name:       "lol",
+							ConfigPath: "task.yml",
+							WorkerSelector: &atc.WorkerSelector{
+								Required: []atc.WorkerSelectorExpression{
+									{Key: "arch", Operator: "In"},
+								},
+								Preferred: []atc.WorkerSelectorExpression{
+									{Key: "zone", Operator: "Near", Values: []string{"b"}},
+								},
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(lol).worker_selector: required[0]: operator 'In' requires at least one value"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(lol).worker_selector: preferred[0]: unknown operator 'Near' (must be one of In, NotIn, Exists)"))
+				})
+			})
+
 			Context("when a task plan is invalid", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
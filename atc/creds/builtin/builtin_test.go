@@ -0,0 +1,89 @@
+package builtin_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/builtin"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Built-in", func() {
+	var (
+		fakeSecretFactory *dbfakes.FakeSecretFactory
+		variables         vars.Variables
+	)
+
+	BeforeEach(func() {
+		fakeSecretFactory = new(dbfakes.FakeSecretFactory)
+
+		manager := &builtin.Manager{Enabled: true}
+		manager.SetSecretFactory(fakeSecretFactory)
+		Expect(manager.Validate()).To(Succeed())
+
+		factory, err := manager.NewSecretsFactory(lagertest.NewTestLogger("test"))
+		Expect(err).ToNot(HaveOccurred())
+
+		variables = creds.NewVariables(factory.NewSecrets(), "some-team", "some-pipeline", false)
+	})
+
+	It("finds pipeline secrets before team secrets", func() {
+		fakeSecretFactory.FindSecretValueStub = func(teamName string, pipelineName string, name string) (interface{}, bool, error) {
+			if teamName == "some-team" && pipelineName == "some-pipeline" && name == "some-secret" {
+				return "pipeline-value", true, nil
+			}
+
+			return nil, false, nil
+		}
+
+		value, found, err := variables.Get(vars.Reference{Path: "some-secret"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("pipeline-value"))
+	})
+
+	It("falls back to team secrets", func() {
+		fakeSecretFactory.FindSecretValueStub = func(teamName string, pipelineName string, name string) (interface{}, bool, error) {
+			if teamName == "some-team" && pipelineName == "" && name == "some-secret" {
+				return map[string]interface{}{"username": "some-user"}, true, nil
+			}
+
+			return nil, false, nil
+		}
+
+		value, found, err := variables.Get(vars.Reference{Path: "some-secret", Fields: []string{"username"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("some-user"))
+	})
+
+	It("does not find secrets of other teams", func() {
+		_, found, err := variables.Get(vars.Reference{Path: "some-secret"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+
+		Expect(fakeSecretFactory.FindSecretValueCallCount()).To(Equal(2))
+		for i := 0; i < 2; i++ {
+			teamName, _, _ := fakeSecretFactory.FindSecretValueArgsForCall(i)
+			Expect(teamName).To(Equal("some-team"))
+		}
+	})
+
+	It("returns errors from the database", func() {
+		fakeSecretFactory.FindSecretValueReturns(nil, false, errors.New("nope"))
+
+		_, _, err := variables.Get(vars.Reference{Path: "some-secret"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("NewInstance", func() {
+		It("cannot be used as a var source", func() {
+			_, err := builtin.NewManagerFactory().NewInstance(map[string]interface{}{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
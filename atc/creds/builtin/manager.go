@@ -0,0 +1,78 @@
+package builtin
+
+import (
+	"encoding/json"
+	"errors"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Manager struct {
+	Enabled bool `long:"enable" description:"Enable the built-in credential manager, which stores secrets set with 'fly set-secret' in the database, encrypted with the configured encryption key."`
+
+	secretFactory db.SecretFactory
+}
+
+// SetSecretFactory gives the manager access to the database. It must be
+// called before the manager is initialized.
+func (manager *Manager) SetSecretFactory(secretFactory db.SecretFactory) {
+	manager.secretFactory = secretFactory
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	return nil
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"health": health,
+	})
+}
+
+func (manager *Manager) IsConfigured() bool {
+	return manager.Enabled
+}
+
+func (manager *Manager) Validate() error {
+	if manager.secretFactory == nil {
+		return errors.New("no database configured for the built-in credential manager")
+	}
+
+	return nil
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "SELECT",
+	}
+
+	if manager.secretFactory == nil {
+		health.Error = "not initialized"
+		return health, nil
+	}
+
+	_, _, err := manager.secretFactory.FindSecretValue("", "", "health")
+	if err != nil {
+		health.Error = err.Error()
+		return health, nil
+	}
+
+	health.Response = "ok"
+
+	return health, nil
+}
+
+func (manager *Manager) Close(logger lager.Logger) {
+}
+
+func (manager *Manager) NewSecretsFactory(logger lager.Logger) (creds.SecretsFactory, error) {
+	return NewSecretsFactory(logger, manager.secretFactory), nil
+}
@@ -0,0 +1,62 @@
+package builtin
+
+import (
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Secrets struct {
+	logger        lager.Logger
+	secretFactory db.SecretFactory
+}
+
+// NewSecretLookupPaths looks up pipeline secrets before team secrets. There
+// is no root path, as every secret belongs to a team.
+func (secrets *Secrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+	lookupPaths := []creds.SecretLookupPath{}
+
+	if len(pipelineName) > 0 {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(teamName+"/"+pipelineName+"/"))
+	}
+
+	lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix(teamName+"/"))
+
+	return lookupPaths
+}
+
+// Get finds the secret at [team]/[pipeline]/[secret] or [team]/[secret].
+// Secrets set through the API never expire.
+func (secrets *Secrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	var teamName, pipelineName, secretName string
+
+	parts := strings.Split(secretPath, "/")
+	switch len(parts) {
+	case 2:
+		teamName, secretName = parts[0], parts[1]
+	case 3:
+		teamName, pipelineName, secretName = parts[0], parts[1], parts[2]
+	default:
+		return nil, nil, false, nil
+	}
+
+	value, found, err := secrets.secretFactory.FindSecretValue(teamName, pipelineName, secretName)
+	if err != nil {
+		secrets.logger.Error("failed-to-find-secret", err, lager.Data{
+			"team":     teamName,
+			"pipeline": pipelineName,
+			"secret":   secretName,
+		})
+		return nil, nil, false, err
+	}
+
+	if !found {
+		return nil, nil, false, nil
+	}
+
+	return value, nil, true, nil
+}
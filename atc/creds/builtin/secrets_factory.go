@@ -0,0 +1,27 @@
+package builtin
+
+import (
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type SecretsFactory struct {
+	logger        lager.Logger
+	secretFactory db.SecretFactory
+}
+
+func NewSecretsFactory(logger lager.Logger, secretFactory db.SecretFactory) *SecretsFactory {
+	return &SecretsFactory{
+		logger:        logger,
+		secretFactory: secretFactory,
+	}
+}
+
+func (factory *SecretsFactory) NewSecrets() creds.Secrets {
+	return &Secrets{
+		logger:        factory.logger,
+		secretFactory: factory.secretFactory,
+	}
+}
@@ -1,6 +1,7 @@
 package creds
 
 import (
+	"strings"
 	"time"
 
 	"github.com/patrickmn/go-cache"
@@ -9,7 +10,7 @@ import (
 type SecretCacheConfig struct {
 	Enabled          bool          `long:"secret-cache-enabled" description:"Enable in-memory cache for secrets"`
 	Duration         time.Duration `long:"secret-cache-duration" default:"1m" description:"If the cache is enabled, secret values will be cached for not longer than this duration (it can be less, if underlying secret lease time is smaller)"`
-	DurationNotFound time.Duration `long:"secret-cache-duration-notfound" default:"10s" description:"If the cache is enabled, secret not found responses will be cached for this duration"`
+	DurationNotFound time.Duration `long:"secret-cache-duration-notfound" default:"10s" description:"If the cache is enabled, secret not found responses will be cached for this duration. Set to 0 to not cache them."`
 	PurgeInterval    time.Duration `long:"secret-cache-purge-interval" default:"10m" description:"If the cache is enabled, expired items will be removed on this interval"`
 }
 
@@ -67,7 +68,8 @@ func (cs *CachedSecrets) Get(secretPath string) (interface{}, *time.Time, bool,
 			}
 		}
 		cs.cache.Set(secretPath, entry, duration)
-	} else {
+	} else if cs.cacheConfig.DurationNotFound > 0 {
+		// a zero duration would mean the default duration to the cache
 		cs.cache.Set(secretPath, entry, cs.cacheConfig.DurationNotFound)
 	}
 
@@ -77,3 +79,24 @@ func (cs *CachedSecrets) Get(secretPath string) (interface{}, *time.Time, bool,
 func (cs *CachedSecrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []SecretLookupPath {
 	return cs.secrets.NewSecretLookupPaths(teamName, pipelineName, allowRootPath)
 }
+
+// EvictCachedSecrets evicts the cached secrets matching the filter, including
+// the cached "secret not found" responses.
+func (cs *CachedSecrets) EvictCachedSecrets(filter SecretCacheFilter) int {
+	return cs.evict(filter.pathPrefixes(cs.secrets.NewSecretLookupPaths))
+}
+
+func (cs *CachedSecrets) evict(pathPrefixes []string) int {
+	evicted := 0
+	for secretPath := range cs.cache.Items() {
+		for _, prefix := range pathPrefixes {
+			if strings.HasPrefix(secretPath, prefix) {
+				cs.cache.Delete(secretPath)
+				evicted++
+				break
+			}
+		}
+	}
+
+	return evicted
+}
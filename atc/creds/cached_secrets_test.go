@@ -162,4 +162,95 @@ var _ = Describe("Caching of secrets", func() {
 		Expect(underlyingMisses).To(BeIdenticalTo(4))
 	})
 
+	It("should not cache negative responses if their duration is zero", func() {
+		cacheConfig.DurationNotFound = 0
+		cachedSecretManager = creds.NewCachedSecrets(secretManager, cacheConfig)
+		secretManager.GetStub = makeGetStub("foo", "value", nil, true, nil, &underlyingReads, &underlyingMisses)
+
+		_, _, _, _ = cachedSecretManager.Get("foo")
+		_, _, _, _ = cachedSecretManager.Get("bar")
+		_, _, _, _ = cachedSecretManager.Get("foo")
+		_, _, _, _ = cachedSecretManager.Get("bar")
+		Expect(underlyingReads).To(BeIdenticalTo(1))
+		Expect(underlyingMisses).To(BeIdenticalTo(2))
+	})
+
+	Describe("EvictCachedSecrets", func() {
+		var paths []string
+
+		BeforeEach(func() {
+			secretManager.NewSecretLookupPathsStub = func(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+				return []creds.SecretLookupPath{
+					creds.NewSecretLookupWithPrefix("/concourse/" + teamName + "/" + pipelineName + "/"),
+					creds.NewSecretLookupWithPrefix("/concourse/" + teamName + "/"),
+				}
+			}
+
+			secretManager.GetStub = makeGetStub("/concourse/main/foo", "value", nil, true, nil, &underlyingReads, &underlyingMisses)
+
+			paths = []string{
+				"/concourse/main/foo",
+				"/concourse/main/some-pipeline/foo",
+				"/concourse/main/other-pipeline/foo",
+				"/concourse/other-team/foo",
+			}
+
+			for _, path := range paths {
+				_, _, _, _ = cachedSecretManager.Get(path)
+			}
+
+			Expect(underlyingReads + underlyingMisses).To(Equal(4))
+		})
+
+		refetched := func() []string {
+			before := underlyingReads + underlyingMisses
+
+			fetched := []string{}
+			for _, path := range paths {
+				_, _, _, _ = cachedSecretManager.Get(path)
+				if underlyingReads+underlyingMisses > before {
+					fetched = append(fetched, path)
+					before = underlyingReads + underlyingMisses
+				}
+			}
+
+			return fetched
+		}
+
+		It("evicts every secret with an empty filter", func() {
+			Expect(cachedSecretManager.EvictCachedSecrets(creds.SecretCacheFilter{})).To(Equal(4))
+			Expect(refetched()).To(Equal(paths))
+		})
+
+		It("evicts the secrets of every pipeline of a team", func() {
+			Expect(cachedSecretManager.EvictCachedSecrets(creds.SecretCacheFilter{TeamName: "main"})).To(Equal(3))
+			Expect(refetched()).To(Equal([]string{
+				"/concourse/main/foo",
+				"/concourse/main/some-pipeline/foo",
+				"/concourse/main/other-pipeline/foo",
+			}))
+		})
+
+		It("evicts the secrets of a pipeline, leaving out those of its team", func() {
+			Expect(cachedSecretManager.EvictCachedSecrets(creds.SecretCacheFilter{TeamName: "main", PipelineName: "some-pipeline"})).To(Equal(1))
+			Expect(refetched()).To(Equal([]string{
+				"/concourse/main/some-pipeline/foo",
+			}))
+		})
+
+		It("evicts the secrets under a path prefix", func() {
+			Expect(cachedSecretManager.EvictCachedSecrets(creds.SecretCacheFilter{PathPrefix: "/concourse/main/some"})).To(Equal(1))
+			Expect(refetched()).To(Equal([]string{
+				"/concourse/main/some-pipeline/foo",
+			}))
+		})
+
+		It("only evicts the team's secrets under the path prefix", func() {
+			Expect(cachedSecretManager.EvictCachedSecrets(creds.SecretCacheFilter{TeamName: "other-team", PathPrefix: "/concourse/main/"})).To(Equal(0))
+			Expect(cachedSecretManager.EvictCachedSecrets(creds.SecretCacheFilter{TeamName: "main", PathPrefix: "/concourse/main/other"})).To(Equal(1))
+			Expect(refetched()).To(Equal([]string{
+				"/concourse/main/other-pipeline/foo",
+			}))
+		})
+	})
 })
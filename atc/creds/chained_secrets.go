@@ -0,0 +1,172 @@
+package creds
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ChainedSecretsMember is a credential manager taking part in a chain. If
+// LookupTemplates are given, they are used in place of the manager's own
+// lookup paths.
+type ChainedSecretsMember struct {
+	Name            string
+	Secrets         Secrets
+	LookupTemplates []*SecretTemplate
+}
+
+// ChainedSecrets looks up each variable in every member in turn, until one of
+// them has it. Its secret paths are prefixed with the name of the member they
+// belong to, so that the member can be found again by Get.
+type ChainedSecrets struct {
+	members []ChainedSecretsMember
+	served  func(string)
+}
+
+// NewChainedSecrets creates a chain of the members, in order. served, if
+// given, is called with the name of the member which found each secret.
+func NewChainedSecrets(members []ChainedSecretsMember, served func(string)) *ChainedSecrets {
+	return &ChainedSecrets{
+		members: members,
+		served:  served,
+	}
+}
+
+func (cs *ChainedSecrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	separator := strings.Index(secretPath, ":")
+	if separator == -1 {
+		return nil, nil, false, nil
+	}
+
+	name, memberPath := secretPath[:separator], secretPath[separator+1:]
+
+	for _, member := range cs.members {
+		if member.Name != name {
+			continue
+		}
+
+		value, expiration, found, err := member.Secrets.Get(memberPath)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("%s: %s", name, err)
+		}
+
+		if found && cs.served != nil {
+			cs.served(name)
+		}
+
+		return value, expiration, found, nil
+	}
+
+	return nil, nil, false, nil
+}
+
+// Member returns the name of the member which a secret path of the chain
+// belongs to.
+func (cs *ChainedSecrets) Member(secretPath string) (string, bool) {
+	separator := strings.Index(secretPath, ":")
+	if separator == -1 {
+		return "", false
+	}
+
+	name := secretPath[:separator]
+	for _, member := range cs.members {
+		if member.Name == name {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+func (cs *ChainedSecrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []SecretLookupPath {
+	lookupPaths := []SecretLookupPath{}
+
+	for _, member := range cs.members {
+		memberPaths := member.lookupPaths(teamName, pipelineName, allowRootPath)
+		if len(memberPaths) == 0 && len(member.LookupTemplates) == 0 {
+			// the member maps vars 1-to-1 to secrets
+			lookupPaths = append(lookupPaths, chainedLookupPath{member: member.Name})
+			continue
+		}
+
+		for _, lookupPath := range memberPaths {
+			lookupPaths = append(lookupPaths, chainedLookupPath{member: member.Name, lookupPath: lookupPath})
+		}
+	}
+
+	return lookupPaths
+}
+
+// EvictCachedSecrets evicts the secrets cached by each member, which are
+// matched against the member's own lookup paths.
+func (cs *ChainedSecrets) EvictCachedSecrets(filter SecretCacheFilter) int {
+	evicted := 0
+	for _, member := range cs.members {
+		cached, ok := member.Secrets.(*CachedSecrets)
+		if !ok {
+			continue
+		}
+
+		evicted += cached.evict(filter.pathPrefixes(member.lookupPaths))
+	}
+
+	return evicted
+}
+
+func (member ChainedSecretsMember) lookupPaths(teamName string, pipelineName string, allowRootPath bool) []SecretLookupPath {
+	if len(member.LookupTemplates) == 0 {
+		return member.Secrets.NewSecretLookupPaths(teamName, pipelineName, allowRootPath)
+	}
+
+	lookupPaths := []SecretLookupPath{}
+	for _, tmpl := range member.LookupTemplates {
+		lookupPath := NewSecretLookupWithTemplate(tmpl, teamName, pipelineName)
+		if lookupPath != nil {
+			lookupPaths = append(lookupPaths, lookupPath)
+		}
+	}
+
+	return lookupPaths
+}
+
+type chainedLookupPath struct {
+	member     string
+	lookupPath SecretLookupPath
+}
+
+func (path chainedLookupPath) VariableToSecretPath(varName string) (string, error) {
+	secretPath := varName
+	if path.lookupPath != nil {
+		var err error
+		secretPath, err = path.lookupPath.VariableToSecretPath(varName)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return path.member + ":" + secretPath, nil
+}
+
+// ChainLookupTemplateFlag overrides the lookup paths of a member of the
+// chain, as MANAGER=TEMPLATE.
+type ChainLookupTemplateFlag struct {
+	Manager  string
+	Template *SecretTemplate
+}
+
+func (flag *ChainLookupTemplateFlag) UnmarshalFlag(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("invalid chain lookup template '%s' (must be MANAGER=TEMPLATE)", value)
+	}
+
+	tmpl, err := BuildSecretTemplate(parts[0], parts[1])
+	if err != nil {
+		return fmt.Errorf("invalid chain lookup template for '%s': %s", parts[0], err)
+	}
+
+	flag.Manager = parts[0]
+	flag.Template = tmpl
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package creds_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/concourse/concourse/vars"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ChainedSecrets", func() {
+	var (
+		oldSecrets *credsfakes.FakeSecrets
+		newSecrets *credsfakes.FakeSecrets
+
+		config    creds.CredentialManagementConfig
+		served    []string
+		chain     creds.Secrets
+		variables vars.Variables
+	)
+
+	BeforeEach(func() {
+		oldSecrets = new(credsfakes.FakeSecrets)
+		oldSecrets.NewSecretLookupPathsStub = func(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+			return []creds.SecretLookupPath{
+				creds.NewSecretLookupWithPrefix("/old/" + teamName + "/" + pipelineName + "/"),
+				creds.NewSecretLookupWithPrefix("/old/" + teamName + "/"),
+			}
+		}
+
+		newSecrets = new(credsfakes.FakeSecrets)
+
+		config = creds.CredentialManagementConfig{
+			RetryConfig: creds.SecretRetryConfig{Attempts: 1},
+			CacheConfig: creds.SecretCacheConfig{
+				Enabled:          true,
+				Duration:         time.Minute,
+				DurationNotFound: time.Minute,
+				PurgeInterval:    time.Minute,
+			},
+			Chain: []string{"old", "new"},
+		}
+
+		served = nil
+	})
+
+	JustBeforeEach(func() {
+		oldFactory := new(credsfakes.FakeSecretsFactory)
+		oldFactory.NewSecretsReturns(oldSecrets)
+
+		newFactory := new(credsfakes.FakeSecretsFactory)
+		newFactory.NewSecretsReturns(newSecrets)
+
+		chain = config.NewChainedSecrets(map[string]creds.SecretsFactory{
+			"old": oldFactory,
+			"new": newFactory,
+		}, func(name string) {
+			served = append(served, name)
+		})
+
+		variables = creds.NewVariables(chain, "some-team", "some-pipeline", false)
+	})
+
+	It("finds secrets in the first manager which has them", func() {
+		oldSecrets.GetStub = func(path string) (interface{}, *time.Time, bool, error) {
+			return "old-value", nil, path == "/old/some-team/some-var", nil
+		}
+		newSecrets.GetReturns("new-value", nil, true, nil)
+
+		value, found, err := variables.Get(vars.Reference{Path: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("old-value"))
+
+		Expect(oldSecrets.GetCallCount()).To(Equal(2))
+		Expect(oldSecrets.GetArgsForCall(0)).To(Equal("/old/some-team/some-pipeline/some-var"))
+		Expect(newSecrets.GetCallCount()).To(Equal(0))
+		Expect(served).To(Equal([]string{"old"}))
+	})
+
+	It("falls back to the next manager", func() {
+		newSecrets.GetReturns("new-value", nil, true, nil)
+
+		value, found, err := variables.Get(vars.Reference{Path: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("new-value"))
+
+		Expect(oldSecrets.GetCallCount()).To(Equal(2))
+		Expect(newSecrets.GetArgsForCall(0)).To(Equal("some-var"))
+		Expect(served).To(Equal([]string{"new"}))
+	})
+
+	It("counts secrets served from a member's cache", func() {
+		newSecrets.GetReturns("new-value", nil, true, nil)
+
+		_, _, err := variables.Get(vars.Reference{Path: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = variables.Get(vars.Reference{Path: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(newSecrets.GetCallCount()).To(Equal(1))
+		Expect(served).To(Equal([]string{"new", "new"}))
+	})
+
+	It("names the member which each of its secret paths belongs to", func() {
+		chained := chain.(*creds.ChainedSecrets)
+
+		member, found := chained.Member("old:/old/some-team/some-var")
+		Expect(found).To(BeTrue())
+		Expect(member).To(Equal("old"))
+
+		_, found = chained.Member("bogus:some-var")
+		Expect(found).To(BeFalse())
+
+		_, found = chained.Member("some-var")
+		Expect(found).To(BeFalse())
+	})
+
+	It("does not find secrets which no manager has", func() {
+		_, found, err := variables.Get(vars.Reference{Path: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+		Expect(served).To(BeEmpty())
+	})
+
+	It("stops at the first error, naming the manager", func() {
+		oldSecrets.GetReturns(nil, nil, false, errors.New("sealed"))
+		newSecrets.GetReturns("new-value", nil, true, nil)
+
+		_, _, err := variables.Get(vars.Reference{Path: "some-var"})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix("old: sealed"))
+		Expect(newSecrets.GetCallCount()).To(Equal(0))
+	})
+
+	Context("when a member has its own lookup templates", func() {
+		BeforeEach(func() {
+			var templates []creds.ChainLookupTemplateFlag
+			for _, value := range []string{
+				"new=/concourse/{{.Team}}/{{.Pipeline}}/{{.Secret}}",
+				"new=/concourse/{{.Team}}/{{.Secret}}",
+			} {
+				var flag creds.ChainLookupTemplateFlag
+				Expect(flag.UnmarshalFlag(value)).To(Succeed())
+				templates = append(templates, flag)
+			}
+
+			config.ChainLookupTemplates = templates
+		})
+
+		It("uses them in place of the member's lookup paths", func() {
+			_, _, err := variables.Get(vars.Reference{Path: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(newSecrets.GetCallCount()).To(Equal(2))
+			Expect(newSecrets.GetArgsForCall(0)).To(Equal("/concourse/some-team/some-pipeline/some-var"))
+			Expect(newSecrets.GetArgsForCall(1)).To(Equal("/concourse/some-team/some-var"))
+		})
+
+		It("evicts the member's cached secrets against them", func() {
+			_, _, err := variables.Get(vars.Reference{Path: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(oldSecrets.GetCallCount()).To(Equal(2))
+			Expect(newSecrets.GetCallCount()).To(Equal(2))
+
+			evicted := creds.EvictCachedSecrets(chain, creds.SecretCacheFilter{TeamName: "some-team", PipelineName: "some-pipeline"})
+			Expect(evicted).To(Equal(2))
+
+			_, _, err = variables.Get(vars.Reference{Path: "some-var"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(oldSecrets.GetCallCount()).To(Equal(3))
+			Expect(oldSecrets.GetArgsForCall(2)).To(Equal("/old/some-team/some-pipeline/some-var"))
+			Expect(newSecrets.GetCallCount()).To(Equal(3))
+			Expect(newSecrets.GetArgsForCall(2)).To(Equal("/concourse/some-team/some-pipeline/some-var"))
+		})
+	})
+
+	It("evicts the secrets cached by every member", func() {
+		_, _, err := variables.Get(vars.Reference{Path: "some-var"})
+		Expect(err).ToNot(HaveOccurred())
+
+		evicted := creds.EvictCachedSecrets(chain, creds.SecretCacheFilter{TeamName: "some-team"})
+		Expect(evicted).To(Equal(3))
+
+		evicted = creds.EvictCachedSecrets(chain, creds.SecretCacheFilter{})
+		Expect(evicted).To(Equal(0))
+	})
+
+	Describe("ChainLookupTemplateFlag", func() {
+		It("requires a manager", func() {
+			var flag creds.ChainLookupTemplateFlag
+			Expect(flag.UnmarshalFlag("/{{.Team}}/{{.Secret}}")).ToNot(Succeed())
+		})
+
+		It("requires a valid template", func() {
+			var flag creds.ChainLookupTemplateFlag
+			Expect(flag.UnmarshalFlag("vault=/{{.Bogus}}")).ToNot(Succeed())
+		})
+	})
+})
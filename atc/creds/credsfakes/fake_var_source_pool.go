@@ -13,6 +13,17 @@ type FakeVarSourcePool struct {
 	closeMutex       sync.RWMutex
 	closeArgsForCall []struct {
 	}
+	EvictCachedSecretsStub        func(creds.SecretCacheFilter) int
+	evictCachedSecretsMutex       sync.RWMutex
+	evictCachedSecretsArgsForCall []struct {
+		arg1 creds.SecretCacheFilter
+	}
+	evictCachedSecretsReturns struct {
+		result1 int
+	}
+	evictCachedSecretsReturnsOnCall map[int]struct {
+		result1 int
+	}
 	FindOrCreateStub        func(lager.Logger, map[string]interface{}, creds.ManagerFactory) (creds.Secrets, error)
 	findOrCreateMutex       sync.RWMutex
 	findOrCreateArgsForCall []struct {
@@ -65,6 +76,66 @@ func (fake *FakeVarSourcePool) CloseCalls(stub func()) {
 	fake.CloseStub = stub
 }
 
+func (fake *FakeVarSourcePool) EvictCachedSecrets(arg1 creds.SecretCacheFilter) int {
+	fake.evictCachedSecretsMutex.Lock()
+	ret, specificReturn := fake.evictCachedSecretsReturnsOnCall[len(fake.evictCachedSecretsArgsForCall)]
+	fake.evictCachedSecretsArgsForCall = append(fake.evictCachedSecretsArgsForCall, struct {
+		arg1 creds.SecretCacheFilter
+	}{arg1})
+	fake.recordInvocation("EvictCachedSecrets", []interface{}{arg1})
+	fake.evictCachedSecretsMutex.Unlock()
+	if fake.EvictCachedSecretsStub != nil {
+		return fake.EvictCachedSecretsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.evictCachedSecretsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeVarSourcePool) EvictCachedSecretsCallCount() int {
+	fake.evictCachedSecretsMutex.RLock()
+	defer fake.evictCachedSecretsMutex.RUnlock()
+	return len(fake.evictCachedSecretsArgsForCall)
+}
+
+func (fake *FakeVarSourcePool) EvictCachedSecretsCalls(stub func(creds.SecretCacheFilter) int) {
+	fake.evictCachedSecretsMutex.Lock()
+	defer fake.evictCachedSecretsMutex.Unlock()
+	fake.EvictCachedSecretsStub = stub
+}
+
+func (fake *FakeVarSourcePool) EvictCachedSecretsArgsForCall(i int) creds.SecretCacheFilter {
+	fake.evictCachedSecretsMutex.RLock()
+	defer fake.evictCachedSecretsMutex.RUnlock()
+	argsForCall := fake.evictCachedSecretsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeVarSourcePool) EvictCachedSecretsReturns(result1 int) {
+	fake.evictCachedSecretsMutex.Lock()
+	defer fake.evictCachedSecretsMutex.Unlock()
+	fake.EvictCachedSecretsStub = nil
+	fake.evictCachedSecretsReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeVarSourcePool) EvictCachedSecretsReturnsOnCall(i int, result1 int) {
+	fake.evictCachedSecretsMutex.Lock()
+	defer fake.evictCachedSecretsMutex.Unlock()
+	fake.EvictCachedSecretsStub = nil
+	if fake.evictCachedSecretsReturnsOnCall == nil {
+		fake.evictCachedSecretsReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.evictCachedSecretsReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeVarSourcePool) FindOrCreate(arg1 lager.Logger, arg2 map[string]interface{}, arg3 creds.ManagerFactory) (creds.Secrets, error) {
 	fake.findOrCreateMutex.Lock()
 	ret, specificReturn := fake.findOrCreateReturnsOnCall[len(fake.findOrCreateArgsForCall)]
@@ -187,6 +258,8 @@ func (fake *FakeVarSourcePool) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.closeMutex.RLock()
 	defer fake.closeMutex.RUnlock()
+	fake.evictCachedSecretsMutex.RLock()
+	defer fake.evictCachedSecretsMutex.RUnlock()
 	fake.findOrCreateMutex.RLock()
 	defer fake.findOrCreateMutex.RUnlock()
 	fake.sizeMutex.RLock()
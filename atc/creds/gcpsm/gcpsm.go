@@ -0,0 +1,154 @@
+package gcpsm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/googleapis/gax-go/v2"
+	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LatestVersion is accessed unless the secret is pinned to a version, e.g.
+// ((db-password@3)).
+const LatestVersion = "latest"
+
+// secret IDs may only contain letters, digits, dashes and underscores, so the
+// version can't be mistaken for part of the ID
+var versionRegex = regexp.MustCompile(`@(latest|[0-9]+)`)
+
+// a var may be pinned to a version by ending with it
+var versionSuffixRegex = regexp.MustCompile(`@(latest|[0-9]+)$`)
+
+// the client retries requests while the API is unavailable, so they are given
+// up on after a while rather than blocking the build
+const requestTimeout = time.Minute
+
+// SecretManagerAPI is implemented by the GCP Secret Manager client.
+type SecretManagerAPI interface {
+	AccessSecretVersion(ctx context.Context, req *secretmanagerpb.AccessSecretVersionRequest, opts ...gax.CallOption) (*secretmanagerpb.AccessSecretVersionResponse, error)
+}
+
+type SecretManager struct {
+	log             lager.Logger
+	api             SecretManagerAPI
+	projectID       string
+	secretTemplates []*creds.SecretTemplate
+}
+
+func NewSecretManager(log lager.Logger, api SecretManagerAPI, projectID string, secretTemplates []*creds.SecretTemplate) *SecretManager {
+	return &SecretManager{
+		log:             log,
+		api:             api,
+		projectID:       projectID,
+		secretTemplates: secretTemplates,
+	}
+}
+
+// NewSecretLookupPaths defines how variables will be searched in the underlying secret manager
+func (s *SecretManager) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+	lookupPaths := []creds.SecretLookupPath{}
+	for _, tmpl := range s.secretTemplates {
+		if lPath := creds.NewSecretLookupWithTemplate(tmpl, escapeName(teamName), escapeName(pipelineName)); lPath != nil {
+			lookupPaths = append(lookupPaths, secretLookupPath{lPath})
+		}
+	}
+	return lookupPaths
+}
+
+// escapeName escapes a name for use in a secret ID. Letters, digits and
+// dashes are kept, and every other byte becomes '_' followed by its two
+// lowercase hex digits, e.g. "my_pipeline" becomes "my_5fpipeline". An
+// escaped name never contains "__", so names separated by it can't collide.
+func escapeName(name string) string {
+	var escaped strings.Builder
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', '0' <= c && c <= '9', c == '-':
+			escaped.WriteByte(c)
+		default:
+			fmt.Fprintf(&escaped, "_%02x", c)
+		}
+	}
+
+	return escaped.String()
+}
+
+// secretLookupPath escapes the var before it is put into the secret ID,
+// leaving the version it may be pinned to as is.
+type secretLookupPath struct {
+	creds.SecretLookupPath
+}
+
+func (path secretLookupPath) VariableToSecretPath(varName string) (string, error) {
+	version := ""
+	if match := versionSuffixRegex.FindStringIndex(varName); match != nil {
+		varName, version = varName[:match[0]], varName[match[0]:]
+	}
+
+	secretPath, err := path.SecretLookupPath.VariableToSecretPath(escapeName(varName))
+	if err != nil {
+		return "", err
+	}
+
+	return secretPath + version, nil
+}
+
+// Get retrieves the value and expiration of an individual secret
+func (s *SecretManager) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	value, expiration, found, err := s.getSecretVersion(secretPath)
+	if err != nil {
+		s.log.Error("failed-to-fetch-gcp-secret", err, lager.Data{
+			"secret-path": secretPath,
+		})
+		return nil, nil, false, err
+	}
+	if found {
+		return value, expiration, true, nil
+	}
+	return nil, nil, false, nil
+}
+
+// getSecretVersion accesses a version of the secret, the latest one unless the
+// path pins it with a @version suffix.
+//
+// The payload is returned as a map[string]interface{} if it is a JSON object,
+// so that its fields can be used, and as a string otherwise.
+func (s *SecretManager) getSecretVersion(path string) (interface{}, *time.Time, bool, error) {
+	secretID, version := path, LatestVersion
+	if match := versionRegex.FindStringSubmatchIndex(path); match != nil {
+		secretID = path[:match[0]] + path[match[1]:]
+		version = path[match[2]:match[3]]
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	response, err := s.api.AccessSecretVersion(ctx, &secretmanagerpb.AccessSecretVersionRequest{
+		Name: fmt.Sprintf("projects/%s/secrets/%s/versions/%s", s.projectID, secretID, version),
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, nil, false, nil
+		}
+
+		return nil, nil, false, err
+	}
+
+	data := response.GetPayload().GetData()
+
+	var values map[string]interface{}
+	if err := json.Unmarshal(data, &values); err == nil {
+		return values, nil, true, nil
+	}
+
+	return string(data), nil, true, nil
+}
@@ -0,0 +1,26 @@
+package gcpsm
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type secretManagerFactory struct {
+	log             lager.Logger
+	api             SecretManagerAPI
+	projectID       string
+	secretTemplates []*creds.SecretTemplate
+}
+
+func NewSecretManagerFactory(log lager.Logger, api SecretManagerAPI, projectID string, secretTemplates []*creds.SecretTemplate) *secretManagerFactory {
+	return &secretManagerFactory{
+		log:             log,
+		api:             api,
+		projectID:       projectID,
+		secretTemplates: secretTemplates,
+	}
+}
+
+func (factory *secretManagerFactory) NewSecrets() creds.Secrets {
+	return NewSecretManager(factory.log, factory.api, factory.projectID, factory.secretTemplates)
+}
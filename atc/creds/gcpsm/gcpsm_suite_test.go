@@ -0,0 +1,104 @@
+package gcpsm_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGcpsm(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "GCP Secret Manager Creds Suite")
+}
+
+// fakeSecretManagerServer serves secret versions over gRPC, like the GCP
+// Secret Manager API.
+type fakeSecretManagerServer struct {
+	secretmanagerpb.UnimplementedSecretManagerServiceServer
+
+	server   *grpc.Server
+	listener net.Listener
+
+	lock     sync.Mutex
+	versions map[string][]byte
+	err      error
+	accessed []string
+}
+
+func startFakeSecretManagerServer() *fakeSecretManagerServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+
+	fake := &fakeSecretManagerServer{
+		server:   grpc.NewServer(),
+		listener: listener,
+		versions: map[string][]byte{},
+	}
+
+	secretmanagerpb.RegisterSecretManagerServiceServer(fake.server, fake)
+
+	go fake.server.Serve(listener)
+
+	return fake
+}
+
+func (fake *fakeSecretManagerServer) Addr() string {
+	return fake.listener.Addr().String()
+}
+
+func (fake *fakeSecretManagerServer) Stop() {
+	fake.server.Stop()
+}
+
+func (fake *fakeSecretManagerServer) SetVersion(name string, data string) {
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+
+	fake.versions[name] = []byte(data)
+}
+
+func (fake *fakeSecretManagerServer) SetError(err error) {
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+
+	fake.err = err
+}
+
+func (fake *fakeSecretManagerServer) Accessed() []string {
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+
+	return append([]string{}, fake.accessed...)
+}
+
+func (fake *fakeSecretManagerServer) AccessSecretVersion(ctx context.Context, req *secretmanagerpb.AccessSecretVersionRequest) (*secretmanagerpb.AccessSecretVersionResponse, error) {
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+
+	fake.accessed = append(fake.accessed, req.GetName())
+
+	if fake.err != nil {
+		return nil, fake.err
+	}
+
+	data, found := fake.versions[req.GetName()]
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "Secret [%s] not found or has no versions.", req.GetName())
+	}
+
+	return &secretmanagerpb.AccessSecretVersionResponse{
+		Name: req.GetName(),
+		Payload: &secretmanagerpb.SecretPayload{
+			Data: data,
+		},
+	}, nil
+}
@@ -0,0 +1,202 @@
+package gcpsm_test
+
+import (
+	"context"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/vars"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	. "github.com/concourse/concourse/atc/creds/gcpsm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SecretManager", func() {
+	var fakeServer *fakeSecretManagerServer
+	var client *secretmanager.Client
+	var secretAccess *SecretManager
+	var variables vars.Variables
+	var varRef vars.Reference
+
+	BeforeEach(func() {
+		fakeServer = startFakeSecretManagerServer()
+
+		var err error
+		client, err = secretmanager.NewClient(context.Background(),
+			option.WithEndpoint(fakeServer.Addr()),
+			option.WithoutAuthentication(),
+			option.WithGRPCDialOption(grpc.WithInsecure()),
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		varRef = vars.Reference{Path: "cheery"}
+	})
+
+	JustBeforeEach(func() {
+		t1, err := creds.BuildSecretTemplate("t1", DefaultPipelineSecretTemplate)
+		Expect(err).ToNot(HaveOccurred())
+		t2, err := creds.BuildSecretTemplate("t2", DefaultTeamSecretTemplate)
+		Expect(err).ToNot(HaveOccurred())
+
+		secretAccess = NewSecretManager(lagertest.NewTestLogger("gcpsm_test"), client, "some-project", []*creds.SecretTemplate{t1, t2})
+		variables = creds.NewVariables(secretAccess, "alpha", "bogus", false)
+	})
+
+	AfterEach(func() {
+		Expect(client.Close()).To(Succeed())
+		fakeServer.Stop()
+	})
+
+	Describe("Get()", func() {
+		It("gets the latest version of the pipeline secret", func() {
+			fakeServer.SetVersion("projects/some-project/secrets/concourse__alpha__bogus__cheery/versions/latest", "secret value")
+
+			value, found, err := variables.Get(varRef)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("secret value"))
+		})
+
+		It("falls back to the team secret", func() {
+			fakeServer.SetVersion("projects/some-project/secrets/concourse__alpha__cheery/versions/latest", "team value")
+
+			value, found, err := variables.Get(varRef)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("team value"))
+
+			Expect(fakeServer.Accessed()).To(Equal([]string{
+				"projects/some-project/secrets/concourse__alpha__bogus__cheery/versions/latest",
+				"projects/some-project/secrets/concourse__alpha__cheery/versions/latest",
+			}))
+		})
+
+		It("gets the fields of a JSON secret", func() {
+			fakeServer.SetVersion("projects/some-project/secrets/concourse__alpha__bogus__user/versions/latest", `{"name": "yours", "pass": "truely"}`)
+
+			value, found, err := variables.Get(vars.Reference{Path: "user", Fields: []string{"pass"}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("truely"))
+		})
+
+		It("gets a pinned version of the secret", func() {
+			fakeServer.SetVersion("projects/some-project/secrets/concourse__alpha__bogus__cheery/versions/latest", "new value")
+			fakeServer.SetVersion("projects/some-project/secrets/concourse__alpha__bogus__cheery/versions/3", "old value")
+
+			value, found, err := variables.Get(vars.Reference{Path: "cheery@3"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("old value"))
+		})
+
+		It("does not find a missing secret", func() {
+			_, found, err := variables.Get(varRef)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns other errors", func() {
+			fakeServer.SetError(status.Error(codes.Internal, "oh no"))
+
+			_, found, err := variables.Get(varRef)
+			Expect(err).To(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("escapes the names so that they can't collide", func() {
+			fakeServer.SetVersion("projects/some-project/secrets/concourse__a-b__c__d/versions/latest", "a-b's value")
+			fakeServer.SetVersion("projects/some-project/secrets/concourse__a_5fb__c__d/versions/latest", "a_b's value")
+
+			ref := vars.Reference{Path: "d"}
+
+			value, found, err := creds.NewVariables(secretAccess, "a-b", "c", false).Get(ref)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("a-b's value"))
+
+			value, found, err = creds.NewVariables(secretAccess, "a_b", "c", false).Get(ref)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("a_b's value"))
+
+			_, found, err = creds.NewVariables(secretAccess, "a", "b-c", false).Get(ref)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			_, found, err = creds.NewVariables(secretAccess, "a", "b__c", false).Get(ref)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			Expect(fakeServer.Accessed()).To(ContainElements(
+				"projects/some-project/secrets/concourse__a__b-c__d/versions/latest",
+				"projects/some-project/secrets/concourse__a__b_5f_5fc__d/versions/latest",
+			))
+		})
+
+		It("escapes the secret name but not its version", func() {
+			fakeServer.SetVersion("projects/some-project/secrets/concourse__alpha__bogus__db_5fpassword/versions/3", "old value")
+
+			value, found, err := variables.Get(vars.Reference{Path: "db_password@3"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("old value"))
+		})
+
+		It("allows an empty pipeline name", func() {
+			fakeServer.SetVersion("projects/some-project/secrets/concourse__alpha__cheery/versions/latest", "team power")
+
+			variables := creds.NewVariables(secretAccess, "alpha", "", false)
+			value, found, err := variables.Get(varRef)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("team power"))
+
+			Expect(fakeServer.Accessed()).To(Equal([]string{
+				"projects/some-project/secrets/concourse__alpha__cheery/versions/latest",
+			}))
+		})
+	})
+
+	Describe("Manager.Health()", func() {
+		var manager *Manager
+
+		JustBeforeEach(func() {
+			manager = &Manager{
+				ProjectID:     "some-project",
+				SecretManager: secretAccess,
+			}
+		})
+
+		It("is up when the API responds", func() {
+			health, err := manager.Health()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health.Method).To(Equal("AccessSecretVersion"))
+			Expect(health.Response).To(Equal(map[string]string{"status": "UP"}))
+			Expect(health.Error).To(BeEmpty())
+		})
+
+		It("is up when the health check secret may not be read", func() {
+			fakeServer.SetError(status.Error(codes.PermissionDenied, "denied"))
+
+			health, err := manager.Health()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health.Response).To(Equal(map[string]string{"status": "UP"}))
+		})
+
+		It("reports the error when the API fails", func() {
+			fakeServer.SetError(status.Error(codes.Internal, "oh no"))
+
+			health, err := manager.Health()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health.Response).To(BeNil())
+			Expect(health.Error).To(ContainSubstring("oh no"))
+		})
+	})
+})
@@ -0,0 +1,147 @@
+package gcpsm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	secretmanager "cloud.google.com/go/secretmanager/apiv1"
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// The team, pipeline and secret names are escaped so that they never contain
+// "__", which the default templates separate them with.
+const DefaultPipelineSecretTemplate = "concourse__{{.Team}}__{{.Pipeline}}__{{.Secret}}"
+const DefaultTeamSecretTemplate = "concourse__{{.Team}}__{{.Secret}}"
+
+type Manager struct {
+	ProjectID              string `mapstructure:"project_id" long:"project-id" description:"GCP project containing the secrets"`
+	CredentialsFile        string `mapstructure:"credentials_file" long:"credentials-file" description:"Path to a service account key file. If not set, the application default credentials are used, e.g. those of workload identity."`
+	PipelineSecretTemplate string `mapstructure:"pipeline_secret_template" long:"pipeline-secret-template" description:"GCP Secret Manager secret ID template used for pipeline specific secrets. Names are escaped so that they never contain '__'." default:"concourse__{{.Team}}__{{.Pipeline}}__{{.Secret}}"`
+	TeamSecretTemplate     string `mapstructure:"team_secret_template" long:"team-secret-template" description:"GCP Secret Manager secret ID template used for team specific secrets. Names are escaped so that they never contain '__'." default:"concourse__{{.Team}}__{{.Secret}}"`
+
+	SecretManager *SecretManager
+	client        *secretmanager.Client
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	client, err := manager.newClient()
+	if err != nil {
+		log.Error("failed-to-create-gcp-secret-manager-client", err)
+		return err
+	}
+
+	manager.client = client
+	manager.SecretManager = NewSecretManager(log, client, manager.ProjectID, nil)
+
+	return nil
+}
+
+func (manager *Manager) newClient() (*secretmanager.Client, error) {
+	opts := []option.ClientOption{}
+	if manager.CredentialsFile != "" {
+		opts = append(opts, option.WithCredentialsFile(manager.CredentialsFile))
+	}
+
+	return secretmanager.NewClient(context.Background(), opts...)
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "AccessSecretVersion",
+	}
+
+	_, _, _, err := manager.SecretManager.getSecretVersion("__concourse-health-check")
+	if err != nil {
+		// the health check secret need not be readable for the API to be up
+		if status.Code(err) == codes.PermissionDenied {
+			health.Response = map[string]string{
+				"status": "UP",
+			}
+
+			return health, nil
+		}
+
+		health.Error = err.Error()
+		return health, nil
+	}
+
+	health.Response = map[string]string{
+		"status": "UP",
+	}
+
+	return health, nil
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"project_id":               manager.ProjectID,
+		"pipeline_secret_template": manager.PipelineSecretTemplate,
+		"team_secret_template":     manager.TeamSecretTemplate,
+		"health":                   health,
+	})
+}
+
+func (manager *Manager) IsConfigured() bool {
+	return manager.ProjectID != ""
+}
+
+func (manager *Manager) Validate() error {
+	if manager.ProjectID == "" {
+		return errors.New("must provide gcp project id")
+	}
+
+	if _, err := creds.BuildSecretTemplate("pipeline-secret-template", manager.PipelineSecretTemplate); err != nil {
+		return err
+	}
+
+	if _, err := creds.BuildSecretTemplate("team-secret-template", manager.TeamSecretTemplate); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (manager *Manager) NewSecretsFactory(log lager.Logger) (creds.SecretsFactory, error) {
+	if manager.client == nil {
+		client, err := manager.newClient()
+		if err != nil {
+			log.Error("failed-to-create-gcp-secret-manager-client", err)
+			return nil, err
+		}
+
+		manager.client = client
+	}
+
+	pipelineSecretTemplate, err := creds.BuildSecretTemplate("pipeline-secret-template", manager.PipelineSecretTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	teamSecretTemplate, err := creds.BuildSecretTemplate("team-secret-template", manager.TeamSecretTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSecretManagerFactory(log, manager.client, manager.ProjectID, []*creds.SecretTemplate{pipelineSecretTemplate, teamSecretTemplate}), nil
+}
+
+func (manager *Manager) Close(logger lager.Logger) {
+	if manager.client == nil {
+		return
+	}
+
+	err := manager.client.Close()
+	if err != nil {
+		logger.Error("failed-to-close-gcp-secret-manager-client", err)
+	}
+}
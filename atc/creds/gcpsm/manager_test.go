@@ -0,0 +1,98 @@
+package gcpsm_test
+
+import (
+	"github.com/concourse/concourse/atc/creds/gcpsm"
+	flags "github.com/jessevdk/go-flags"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manager", func() {
+	var manager gcpsm.Manager
+
+	Describe("IsConfigured()", func() {
+		JustBeforeEach(func() {
+			_, err := flags.ParseArgs(&manager, []string{})
+			Expect(err).To(BeNil())
+		})
+
+		It("fails on empty Manager", func() {
+			Expect(manager.IsConfigured()).To(BeFalse())
+		})
+
+		It("passes if ProjectID is set", func() {
+			manager.ProjectID = "some-project"
+			Expect(manager.IsConfigured()).To(BeTrue())
+		})
+	})
+
+	Describe("Validate()", func() {
+		JustBeforeEach(func() {
+			manager = gcpsm.Manager{ProjectID: "some-project"}
+			_, err := flags.ParseArgs(&manager, []string{})
+			Expect(err).To(BeNil())
+			Expect(manager.PipelineSecretTemplate).To(Equal(gcpsm.DefaultPipelineSecretTemplate))
+			Expect(manager.TeamSecretTemplate).To(Equal(gcpsm.DefaultTeamSecretTemplate))
+		})
+
+		It("passes on default parameters", func() {
+			Expect(manager.Validate()).To(BeNil())
+		})
+
+		It("passes with a credentials file", func() {
+			manager.CredentialsFile = "/path/to/key.json"
+			Expect(manager.Validate()).To(BeNil())
+		})
+
+		It("fails without a project", func() {
+			manager.ProjectID = ""
+			Expect(manager.Validate()).ToNot(BeNil())
+		})
+
+		It("fails on empty pipeline secret template", func() {
+			manager.PipelineSecretTemplate = ""
+			Expect(manager.Validate()).ToNot(BeNil())
+		})
+
+		It("fails on pipeline secret template containing invalid parameters", func() {
+			manager.PipelineSecretTemplate = "{{.Teams}}"
+			Expect(manager.Validate()).ToNot(BeNil())
+		})
+
+		It("fails on empty team secret template", func() {
+			manager.TeamSecretTemplate = ""
+			Expect(manager.Validate()).ToNot(BeNil())
+		})
+
+		It("fails on team secret template containing invalid parameters", func() {
+			manager.TeamSecretTemplate = "{{.Teams}}"
+			Expect(manager.Validate()).ToNot(BeNil())
+		})
+	})
+
+	Describe("NewInstance()", func() {
+		It("decodes a var_source config", func() {
+			instance, err := gcpsm.NewManagerFactory().NewInstance(map[string]interface{}{
+				"project_id":       "some-project",
+				"credentials_file": "/path/to/key.json",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			manager := instance.(*gcpsm.Manager)
+			Expect(manager.ProjectID).To(Equal("some-project"))
+			Expect(manager.CredentialsFile).To(Equal("/path/to/key.json"))
+			Expect(manager.PipelineSecretTemplate).To(Equal(gcpsm.DefaultPipelineSecretTemplate))
+			Expect(manager.TeamSecretTemplate).To(Equal(gcpsm.DefaultTeamSecretTemplate))
+			Expect(manager.Validate()).To(Succeed())
+		})
+
+		It("rejects unknown config", func() {
+			_, err := gcpsm.NewManagerFactory().NewInstance(map[string]interface{}{
+				"project_id": "some-project",
+				"bogus":      "value",
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
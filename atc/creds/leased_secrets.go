@@ -0,0 +1,122 @@
+package creds
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// A Lease is held on a dynamic secret for as long as the build which
+// requested it is running.
+type Lease struct {
+	ID        string
+	Duration  time.Duration
+	Renewable bool
+}
+
+//go:generate counterfeiter . Leaser
+
+// A Leaser issues dynamic secrets. Unlike other secrets, they are requested
+// afresh by each build, and their leases are renewed while the build runs and
+// revoked once it is done.
+type Leaser interface {
+	// IsDynamic tells whether the var names a dynamic secret which the team
+	// may request.
+	IsDynamic(teamName string, path string) bool
+
+	Lease(path string) (interface{}, Lease, bool, error)
+	Renew(leaseID string) (Lease, error)
+	Revoke(leaseID string) error
+}
+
+// A LeasingManager is a Manager which can issue dynamic secrets.
+type LeasingManager interface {
+	// Leaser returns false if the manager is not configured to issue any
+	// dynamic secrets.
+	Leaser() (Leaser, bool)
+}
+
+// dynamicSecretPrefix marks the secret paths which name dynamic secrets, as
+// opposed to the paths given by the lookup paths of the underlying secrets.
+const dynamicSecretPrefix = "dynamic:"
+
+// LeasedSecrets requests the dynamic secrets of a single build, falling back
+// to the underlying secrets for every other var. Each dynamic secret is only
+// requested once, so that the fields of its value match.
+type LeasedSecrets struct {
+	secrets  Secrets
+	leaser   Leaser
+	teamName string
+	leased   func(Lease) error
+
+	lock   sync.Mutex
+	values map[string]interface{}
+}
+
+// NewLeasedSecrets returns the secrets of a build of the team. leased is
+// called with the lease of each dynamic secret the build requests, before its
+// value is used.
+func NewLeasedSecrets(secrets Secrets, leaser Leaser, teamName string, leased func(Lease) error) *LeasedSecrets {
+	return &LeasedSecrets{
+		secrets:  secrets,
+		leaser:   leaser,
+		teamName: teamName,
+		leased:   leased,
+		values:   map[string]interface{}{},
+	}
+}
+
+func (ls *LeasedSecrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	if !strings.HasPrefix(secretPath, dynamicSecretPrefix) {
+		return ls.secrets.Get(secretPath)
+	}
+
+	path := strings.TrimPrefix(secretPath, dynamicSecretPrefix)
+	if !ls.leaser.IsDynamic(ls.teamName, path) {
+		return nil, nil, false, nil
+	}
+
+	ls.lock.Lock()
+	defer ls.lock.Unlock()
+
+	value, found := ls.values[path]
+	if found {
+		return value, nil, true, nil
+	}
+
+	value, lease, found, err := ls.leaser.Lease(path)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	if !found {
+		return nil, nil, false, nil
+	}
+
+	if lease.ID != "" {
+		err = ls.leased(lease)
+		if err != nil {
+			// the lease could not be recorded, so nothing would revoke it
+			_ = ls.leaser.Revoke(lease.ID)
+			return nil, nil, false, err
+		}
+	}
+
+	ls.values[path] = value
+
+	return value, nil, true, nil
+}
+
+// NewSecretLookupPaths looks up dynamic secrets by their own path, before
+// the lookup paths of the underlying secrets.
+func (ls *LeasedSecrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []SecretLookupPath {
+	lookupPaths := []SecretLookupPath{NewSecretLookupWithPrefix(dynamicSecretPrefix)}
+
+	underlying := ls.secrets.NewSecretLookupPaths(teamName, pipelineName, allowRootPath)
+	if len(underlying) == 0 {
+		// keep the 1-to-1 var->secret mapping of managers without lookup paths
+		underlying = []SecretLookupPath{NewSecretLookupWithPrefix("")}
+	}
+
+	return append(lookupPaths, underlying...)
+}
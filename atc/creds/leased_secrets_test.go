@@ -0,0 +1,127 @@
+package creds_test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/concourse/concourse/vars"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LeasedSecrets", func() {
+	var (
+		fakeSecrets *credsfakes.FakeSecrets
+		fakeLeaser  *credsfakes.FakeLeaser
+
+		leased    []creds.Lease
+		leasedErr error
+		variables vars.Variables
+	)
+
+	BeforeEach(func() {
+		fakeSecrets = new(credsfakes.FakeSecrets)
+		fakeSecrets.NewSecretLookupPathsReturns([]creds.SecretLookupPath{
+			creds.NewSecretLookupWithPrefix("/concourse/some-team/"),
+		})
+		fakeSecrets.GetStub = func(path string) (interface{}, *time.Time, bool, error) {
+			if path == "/concourse/some-team/static" {
+				return "some-static-value", nil, true, nil
+			}
+			return nil, nil, false, nil
+		}
+
+		fakeLeaser = new(credsfakes.FakeLeaser)
+		fakeLeaser.IsDynamicStub = func(teamName string, path string) bool {
+			return teamName == "some-team" && path == "database/creds/some-role"
+		}
+		fakeLeaser.LeaseReturns(
+			map[string]interface{}{"username": "some-user", "password": "some-password"},
+			creds.Lease{ID: "some-lease", Duration: time.Hour, Renewable: true},
+			true,
+			nil,
+		)
+
+		leased = nil
+		leasedErr = nil
+	})
+
+	JustBeforeEach(func() {
+		secrets := creds.NewLeasedSecrets(fakeSecrets, fakeLeaser, "some-team", func(lease creds.Lease) error {
+			leased = append(leased, lease)
+			return leasedErr
+		})
+
+		variables = creds.NewVariables(secrets, "some-team", "some-pipeline", false)
+	})
+
+	It("requests dynamic secrets by their own path", func() {
+		val, found, err := variables.Get(vars.Reference{Path: "database/creds/some-role", Fields: []string{"username"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal("some-user"))
+
+		Expect(fakeLeaser.LeaseCallCount()).To(Equal(1))
+		Expect(fakeLeaser.LeaseArgsForCall(0)).To(Equal("database/creds/some-role"))
+		Expect(leased).To(Equal([]creds.Lease{{ID: "some-lease", Duration: time.Hour, Renewable: true}}))
+	})
+
+	It("only requests each dynamic secret once", func() {
+		_, _, err := variables.Get(vars.Reference{Path: "database/creds/some-role", Fields: []string{"username"}})
+		Expect(err).ToNot(HaveOccurred())
+
+		val, _, err := variables.Get(vars.Reference{Path: "database/creds/some-role", Fields: []string{"password"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(val).To(Equal("some-password"))
+
+		Expect(fakeLeaser.LeaseCallCount()).To(Equal(1))
+		Expect(leased).To(HaveLen(1))
+	})
+
+	It("asks whether the secret is dynamic for the build's team", func() {
+		_, _, err := variables.Get(vars.Reference{Path: "database/creds/some-role"})
+		Expect(err).ToNot(HaveOccurred())
+
+		teamName, path := fakeLeaser.IsDynamicArgsForCall(0)
+		Expect(teamName).To(Equal("some-team"))
+		Expect(path).To(Equal("database/creds/some-role"))
+	})
+
+	It("looks up other vars in the underlying secrets", func() {
+		val, found, err := variables.Get(vars.Reference{Path: "static"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(val).To(Equal("some-static-value"))
+
+		Expect(fakeLeaser.LeaseCallCount()).To(Equal(0))
+	})
+
+	Context("when the lease cannot be recorded", func() {
+		BeforeEach(func() {
+			leasedErr = errors.New("nope")
+		})
+
+		It("revokes the lease and returns the error", func() {
+			_, _, err := variables.Get(vars.Reference{Path: "database/creds/some-role"})
+			Expect(err).To(MatchError("nope"))
+
+			Expect(fakeLeaser.RevokeCallCount()).To(Equal(1))
+			Expect(fakeLeaser.RevokeArgsForCall(0)).To(Equal("some-lease"))
+		})
+	})
+
+	Context("when requesting the secret fails", func() {
+		BeforeEach(func() {
+			fakeLeaser.LeaseReturns(nil, creds.Lease{}, false, errors.New("sealed"))
+		})
+
+		It("returns the error", func() {
+			_, _, err := variables.Get(vars.Reference{Path: "database/creds/some-role"})
+			Expect(err).To(MatchError("sealed"))
+			Expect(leased).To(BeEmpty())
+		})
+	})
+})
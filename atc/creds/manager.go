@@ -25,6 +25,9 @@ type Managers map[string]Manager
 type CredentialManagementConfig struct {
 	RetryConfig SecretRetryConfig
 	CacheConfig SecretCacheConfig
+
+	Chain                []string                  `long:"credential-manager-chain" description:"Name of a credential manager to look up credentials in. Can be specified multiple times; each manager is tried in order until one has the credential."`
+	ChainLookupTemplates []ChainLookupTemplateFlag `long:"credential-manager-chain-lookup-template" value-name:"MANAGER=TEMPLATE" description:"Path template used for a manager in the chain, in place of its own. Can be specified multiple times."`
 }
 
 // NewSecrets creates a Secrets object from secretsFactory based on configs.
@@ -37,6 +40,29 @@ func (c CredentialManagementConfig) NewSecrets(secretsFactory SecretsFactory) Se
 	return result
 }
 
+// NewChainedSecrets creates a chain of the Secrets of each manager in Chain,
+// in order. Each is retried and cached on its own, so that served is called
+// even when the secret was cached.
+func (c CredentialManagementConfig) NewChainedSecrets(secretsFactories map[string]SecretsFactory, served func(string)) Secrets {
+	members := []ChainedSecretsMember{}
+	for _, name := range c.Chain {
+		member := ChainedSecretsMember{
+			Name:    name,
+			Secrets: c.NewSecrets(secretsFactories[name]),
+		}
+
+		for _, flag := range c.ChainLookupTemplates {
+			if flag.Manager == name {
+				member.LookupTemplates = append(member.LookupTemplates, flag.Template)
+			}
+		}
+
+		members = append(members, member)
+	}
+
+	return NewChainedSecrets(members, served)
+}
+
 type HealthResponse struct {
 	Response interface{} `json:"response,omitempty"`
 	Error    string      `json:"error,omitempty"`
@@ -14,6 +14,7 @@ import (
 
 type VarSourcePool interface {
 	FindOrCreate(lager.Logger, map[string]interface{}, ManagerFactory) (Secrets, error)
+	EvictCachedSecrets(SecretCacheFilter) int
 	Size() int
 	Close()
 }
@@ -115,6 +116,19 @@ func (pool *varSourcePool) FindOrCreate(logger lager.Logger, config map[string]i
 	return pool.pool[key].getSecrets(), nil
 }
 
+// EvictCachedSecrets evicts the secrets cached by every var source.
+func (pool *varSourcePool) EvictCachedSecrets(filter SecretCacheFilter) int {
+	pool.lock.Lock()
+	defer pool.lock.Unlock()
+
+	evicted := 0
+	for _, m := range pool.pool {
+		evicted += EvictCachedSecrets(m.secrets, filter)
+	}
+
+	return evicted
+}
+
 func (pool *varSourcePool) Close() {
 	pool.closeOnce.Do(func() {
 		close(pool.closed)
@@ -182,6 +182,33 @@ var _ = Context("pool", func() {
 		})
 	})
 
+	Describe("EvictCachedSecrets", func() {
+		BeforeEach(func() {
+			varSourcePool = creds.NewVarSourcePool(logger, credentialManagement, 5*time.Minute, time.Minute, fakeClock)
+		})
+
+		AfterEach(func() {
+			varSourcePool.Close()
+		})
+
+		It("evicts the secrets cached by every var source", func() {
+			secrets1, err := varSourcePool.FindOrCreate(logger, config1, factory)
+			Expect(err).ToNot(HaveOccurred())
+			secrets2, err := varSourcePool.FindOrCreate(logger, config2, factory)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, _, err = secrets1.Get("k1")
+			Expect(err).ToNot(HaveOccurred())
+			_, _, _, err = secrets2.Get("k2")
+			Expect(err).ToNot(HaveOccurred())
+			_, _, _, err = secrets2.Get("foo")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(varSourcePool.EvictCachedSecrets(creds.SecretCacheFilter{PathPrefix: "k"})).To(Equal(2))
+			Expect(varSourcePool.EvictCachedSecrets(creds.SecretCacheFilter{})).To(Equal(1))
+		})
+	})
+
 	Describe("Close", func() {
 		var err error
 
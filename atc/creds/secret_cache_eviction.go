@@ -0,0 +1,198 @@
+package creds
+
+import (
+	"os"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+)
+
+// SecretCacheFilter selects the cached secrets to evict, e.g. once they have
+// been rotated. An empty filter selects every cached secret.
+type SecretCacheFilter struct {
+	// TeamName selects the secrets looked up for the team and its pipelines.
+	TeamName string
+
+	// PipelineName narrows the team's secrets down to those only looked up for
+	// the pipeline, leaving out the team-wide ones.
+	PipelineName string
+
+	// PathPrefix selects the secrets whose path in the credential manager
+	// starts with it.
+	PathPrefix string
+}
+
+// EvictableSecrets cache secrets, which can be evicted before they expire.
+type EvictableSecrets interface {
+	// EvictCachedSecrets returns the number of secrets it evicted.
+	EvictCachedSecrets(SecretCacheFilter) int
+}
+
+// EvictCachedSecrets evicts the secrets matching the filter, if they are
+// cached.
+func EvictCachedSecrets(secrets Secrets, filter SecretCacheFilter) int {
+	evictable, ok := secrets.(EvictableSecrets)
+	if !ok {
+		return 0
+	}
+
+	return evictable.EvictCachedSecrets(filter)
+}
+
+// lookupPlaceholder stands in for the names of the secrets and pipelines when
+// turning the lookup paths into path prefixes. It can't be part of a name.
+const lookupPlaceholder = "\x00"
+
+// pathPrefixes returns the prefixes of the secret paths matching the filter,
+// given the lookup paths of a credential manager.
+//
+// The secrets of a team are those found by its lookup paths, which are turned
+// into prefixes by looking up a placeholder. Without a pipeline, a placeholder
+// pipeline is used, so that the prefixes cover every pipeline of the team.
+func (filter SecretCacheFilter) pathPrefixes(newLookupPaths func(string, string, bool) []SecretLookupPath) []string {
+	if filter.TeamName == "" {
+		return []string{filter.PathPrefix}
+	}
+
+	var lookupPaths []SecretLookupPath
+	if filter.PipelineName == "" {
+		lookupPaths = newLookupPaths(filter.TeamName, lookupPlaceholder, false)
+	} else if pipelinePaths := newLookupPaths(filter.TeamName, filter.PipelineName, false); len(pipelinePaths) > 0 {
+		teamPrefixes := map[string]bool{}
+		for _, lookupPath := range newLookupPaths(filter.TeamName, "", false) {
+			if prefix, ok := lookupPrefix(lookupPath); ok {
+				teamPrefixes[prefix] = true
+			}
+		}
+
+		for _, lookupPath := range pipelinePaths {
+			if prefix, ok := lookupPrefix(lookupPath); ok && !teamPrefixes[prefix] {
+				lookupPaths = append(lookupPaths, lookupPath)
+			}
+		}
+
+		if len(lookupPaths) == 0 {
+			// the manager looks up nothing for the pipeline alone
+			return nil
+		}
+	}
+
+	if len(lookupPaths) == 0 {
+		// vars map 1-to-1 to secrets, which belong to no team in particular
+		return []string{filter.PathPrefix}
+	}
+
+	prefixes := []string{}
+	for _, lookupPath := range lookupPaths {
+		prefix, ok := lookupPrefix(lookupPath)
+		if !ok {
+			continue
+		}
+
+		// both have to match, so the longer one is used
+		switch {
+		case strings.HasPrefix(filter.PathPrefix, prefix):
+			prefixes = append(prefixes, filter.PathPrefix)
+		case strings.HasPrefix(prefix, filter.PathPrefix):
+			prefixes = append(prefixes, prefix)
+		}
+	}
+
+	return prefixes
+}
+
+// lookupPrefix returns the prefix shared by the secret paths of the lookup
+// path, which is everything up to the first placeholder.
+func lookupPrefix(lookupPath SecretLookupPath) (string, bool) {
+	secretPath, err := lookupPath.VariableToSecretPath(lookupPlaceholder)
+	if err != nil {
+		return "", false
+	}
+
+	if i := strings.Index(secretPath, lookupPlaceholder); i != -1 {
+		return secretPath[:i], true
+	}
+
+	return secretPath, true
+}
+
+//go:generate counterfeiter . SecretCacheEvictions
+
+// SecretCacheEvictions are requested on any web node, and carried out by all
+// of them.
+type SecretCacheEvictions interface {
+	// EvictionsSince returns the filters of the evictions requested after the
+	// given one, along with the ID of the last of them.
+	EvictionsSince(id int) ([]SecretCacheFilter, int, error)
+}
+
+//go:generate counterfeiter . Notifications
+
+type Notifications interface {
+	Listen(string) (chan bool, error)
+	Unlisten(string, chan bool) error
+}
+
+// SecretCacheEvictor evicts the secrets cached by this web node whenever an
+// eviction is requested, on this node or any other.
+type SecretCacheEvictor struct {
+	Logger        lager.Logger
+	Notifications Notifications
+	Evictions     SecretCacheEvictions
+
+	Secrets       Secrets
+	VarSourcePool VarSourcePool
+}
+
+func (evictor *SecretCacheEvictor) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	notifier, err := evictor.Notifications.Listen(atc.SecretCacheEvictionChannel)
+	if err != nil {
+		return err
+	}
+
+	defer evictor.Notifications.Unlisten(atc.SecretCacheEvictionChannel, notifier)
+
+	// nothing was cached before this node started
+	_, lastID, err := evictor.Evictions.EvictionsSince(0)
+	if err != nil {
+		return err
+	}
+
+	close(ready)
+
+	for {
+		select {
+		case <-notifier:
+			lastID = evictor.evict(lastID)
+		case <-signals:
+			return nil
+		}
+	}
+}
+
+func (evictor *SecretCacheEvictor) evict(lastID int) int {
+	logger := evictor.Logger.Session("evict")
+
+	filters, id, err := evictor.Evictions.EvictionsSince(lastID)
+	if err != nil {
+		logger.Error("failed-to-get-evictions", err)
+		return lastID
+	}
+
+	for _, filter := range filters {
+		evicted := EvictCachedSecrets(evictor.Secrets, filter)
+		if evictor.VarSourcePool != nil {
+			evicted += evictor.VarSourcePool.EvictCachedSecrets(filter)
+		}
+
+		logger.Info("evicted", lager.Data{
+			"team":        filter.TeamName,
+			"pipeline":    filter.PipelineName,
+			"path-prefix": filter.PathPrefix,
+			"evicted":     evicted,
+		})
+	}
+
+	return id
+}
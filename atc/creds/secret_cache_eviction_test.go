@@ -0,0 +1,129 @@
+package creds_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/tedsuo/ifrit"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SecretCacheEvictor", func() {
+	var (
+		fakeNotifications *credsfakes.FakeNotifications
+		fakeEvictions     *credsfakes.FakeSecretCacheEvictions
+		fakeSecrets       *credsfakes.FakeSecrets
+		fakeVarSourcePool *credsfakes.FakeVarSourcePool
+
+		notifier      chan bool
+		cachedSecrets *creds.CachedSecrets
+
+		process ifrit.Process
+	)
+
+	BeforeEach(func() {
+		notifier = make(chan bool, 1)
+
+		fakeNotifications = new(credsfakes.FakeNotifications)
+		fakeNotifications.ListenReturns(notifier, nil)
+
+		fakeEvictions = new(credsfakes.FakeSecretCacheEvictions)
+		fakeEvictions.EvictionsSinceReturnsOnCall(0, []creds.SecretCacheFilter{{TeamName: "stale"}}, 41, nil)
+
+		fakeSecrets = new(credsfakes.FakeSecrets)
+		fakeSecrets.GetReturns("value", nil, true, nil)
+		fakeSecrets.NewSecretLookupPathsStub = func(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+			return []creds.SecretLookupPath{creds.NewSecretLookupWithPrefix("/concourse/" + teamName + "/")}
+		}
+
+		cachedSecrets = creds.NewCachedSecrets(fakeSecrets, creds.SecretCacheConfig{
+			Duration:         time.Minute,
+			DurationNotFound: time.Minute,
+			PurgeInterval:    time.Minute,
+		})
+
+		fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
+	})
+
+	JustBeforeEach(func() {
+		process = ifrit.Invoke(&creds.SecretCacheEvictor{
+			Logger:        lagertest.NewTestLogger("test"),
+			Notifications: fakeNotifications,
+			Evictions:     fakeEvictions,
+			Secrets:       cachedSecrets,
+			VarSourcePool: fakeVarSourcePool,
+		})
+	})
+
+	AfterEach(func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive())
+	})
+
+	It("listens for evictions, skipping those requested before it started", func() {
+		Expect(fakeNotifications.ListenArgsForCall(0)).To(Equal(atc.SecretCacheEvictionChannel))
+		Expect(fakeEvictions.EvictionsSinceCallCount()).To(Equal(1))
+		Expect(fakeEvictions.EvictionsSinceArgsForCall(0)).To(Equal(0))
+		Expect(fakeVarSourcePool.EvictCachedSecretsCallCount()).To(Equal(0))
+	})
+
+	Context("when notified of an eviction", func() {
+		BeforeEach(func() {
+			fakeEvictions.EvictionsSinceReturnsOnCall(1, []creds.SecretCacheFilter{{TeamName: "main"}}, 42, nil)
+			fakeEvictions.EvictionsSinceReturnsOnCall(2, []creds.SecretCacheFilter{{PathPrefix: "/concourse/other/"}}, 43, nil)
+		})
+
+		JustBeforeEach(func() {
+			_, _, _, err := cachedSecrets.Get("/concourse/main/foo")
+			Expect(err).ToNot(HaveOccurred())
+			_, _, _, err = cachedSecrets.Get("/concourse/other/foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeSecrets.GetCallCount()).To(Equal(2))
+
+			notifier <- true
+		})
+
+		It("evicts the secrets cached by the credential manager and var sources", func() {
+			Eventually(fakeVarSourcePool.EvictCachedSecretsCallCount).Should(Equal(1))
+			Expect(fakeEvictions.EvictionsSinceArgsForCall(1)).To(Equal(41))
+			Expect(fakeVarSourcePool.EvictCachedSecretsArgsForCall(0)).To(Equal(creds.SecretCacheFilter{TeamName: "main"}))
+
+			_, _, _, err := cachedSecrets.Get("/concourse/main/foo")
+			Expect(err).ToNot(HaveOccurred())
+			_, _, _, err = cachedSecrets.Get("/concourse/other/foo")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeSecrets.GetCallCount()).To(Equal(3))
+		})
+
+		It("carries on from the last eviction", func() {
+			Eventually(fakeVarSourcePool.EvictCachedSecretsCallCount).Should(Equal(1))
+
+			notifier <- true
+
+			Eventually(fakeVarSourcePool.EvictCachedSecretsCallCount).Should(Equal(2))
+			Expect(fakeEvictions.EvictionsSinceArgsForCall(2)).To(Equal(42))
+		})
+
+		Context("when the evictions can't be fetched", func() {
+			BeforeEach(func() {
+				fakeEvictions.EvictionsSinceReturnsOnCall(1, nil, 0, errors.New("nope"))
+			})
+
+			It("retries from the same eviction when notified again", func() {
+				Eventually(fakeEvictions.EvictionsSinceCallCount).Should(Equal(2))
+
+				notifier <- true
+
+				Eventually(fakeEvictions.EvictionsSinceCallCount).Should(Equal(3))
+				Expect(fakeEvictions.EvictionsSinceArgsForCall(2)).To(Equal(41))
+			})
+		})
+	})
+})
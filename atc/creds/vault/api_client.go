@@ -196,6 +196,18 @@ func (ac *APIClient) Renew() (time.Duration, error) {
 	return time.Duration(secret.Auth.LeaseDuration) * time.Second, nil
 }
 
+// RenewLease extends the lease of a dynamic secret by the given increment,
+// which Vault may cap.
+func (ac *APIClient) RenewLease(leaseID string, increment time.Duration) (*vaultapi.Secret, error) {
+	return ac.client().Sys().Renew(leaseID, int(increment.Seconds()))
+}
+
+// RevokeLease revokes the lease of a dynamic secret, so that the secret can
+// no longer be used.
+func (ac *APIClient) RevokeLease(leaseID string) error {
+	return ac.client().Sys().Revoke(leaseID)
+}
+
 func (ac *APIClient) client() *vaultapi.Client {
 	return ac.clientValue.Load().(*vaultapi.Client)
 }
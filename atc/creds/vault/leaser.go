@@ -0,0 +1,158 @@
+package vault
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+
+	vaultapi "github.com/hashicorp/vault/api"
+)
+
+// A LeaseClient requests dynamic secrets and manages their leases. It should
+// be thread safe!
+type LeaseClient interface {
+	SecretReader
+
+	RenewLease(leaseID string, increment time.Duration) (*vaultapi.Secret, error)
+	RevokeLease(leaseID string) error
+}
+
+// Leaser requests the dynamic secrets found at or under DynamicPaths, e.g.
+// the credentials of a database secrets engine role.
+type Leaser struct {
+	Client       LeaseClient
+	DynamicPaths []*template.Template
+	LoggedIn     <-chan struct{}
+	LoginTimeout time.Duration
+}
+
+type dynamicPathData struct {
+	Team string
+}
+
+// BuildDynamicPathTemplate parses the template of a dynamic path. It must name
+// the team with {{.Team}}, e.g. database/creds/concourse-{{.Team}}, so that
+// each team can only request its own dynamic secrets.
+func BuildDynamicPathTemplate(name, tmpl string) (*template.Template, error) {
+	t, err := template.New(name).Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+
+	some, err := renderDynamicPath(t, "some-team")
+	if err != nil {
+		return nil, err
+	}
+
+	other, err := renderDynamicPath(t, "other-team")
+	if err != nil {
+		return nil, err
+	}
+
+	if some == other {
+		return nil, fmt.Errorf("dynamic path '%s' must name the team with {{.Team}}", tmpl)
+	}
+
+	return t, nil
+}
+
+func renderDynamicPath(t *template.Template, teamName string) (string, error) {
+	var buf bytes.Buffer
+	err := t.Execute(&buf, dynamicPathData{Team: teamName})
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Trim(buf.String(), "/"), nil
+}
+
+func (l Leaser) IsDynamic(teamName string, secretPath string) bool {
+	secretPath = strings.Trim(secretPath, "/")
+
+	for _, tmpl := range l.DynamicPaths {
+		dynamicPath, err := renderDynamicPath(tmpl, teamName)
+		if err != nil || dynamicPath == "" {
+			continue
+		}
+
+		if within(secretPath, dynamicPath) {
+			// don't let the var escape the dynamic path, e.g. with '..'
+			return within(path.Clean(secretPath), dynamicPath)
+		}
+	}
+
+	return false
+}
+
+func within(secretPath string, dynamicPath string) bool {
+	return secretPath == dynamicPath || strings.HasPrefix(secretPath, dynamicPath+"/")
+}
+
+// Lease requests a new dynamic secret. Its data is the value of the secret.
+func (l Leaser) Lease(secretPath string) (interface{}, creds.Lease, bool, error) {
+	err := l.waitForLogin()
+	if err != nil {
+		return nil, creds.Lease{}, false, err
+	}
+
+	secret, err := l.Client.Read(path.Clean(secretPath))
+	if err != nil {
+		return nil, creds.Lease{}, false, err
+	}
+
+	if secret == nil {
+		return nil, creds.Lease{}, false, nil
+	}
+
+	return secret.Data, lease(secret), true, nil
+}
+
+func (l Leaser) Renew(leaseID string) (creds.Lease, error) {
+	err := l.waitForLogin()
+	if err != nil {
+		return creds.Lease{}, err
+	}
+
+	// leave it to Vault to extend the lease by its TTL
+	secret, err := l.Client.RenewLease(leaseID, 0)
+	if err != nil {
+		return creds.Lease{}, err
+	}
+
+	return lease(secret), nil
+}
+
+func (l Leaser) Revoke(leaseID string) error {
+	err := l.waitForLogin()
+	if err != nil {
+		return err
+	}
+
+	return l.Client.RevokeLease(leaseID)
+}
+
+func (l Leaser) waitForLogin() error {
+	if l.LoggedIn == nil {
+		return nil
+	}
+
+	select {
+	case <-l.LoggedIn:
+		return nil
+	case <-time.After(l.LoginTimeout):
+		return VaultLoginTimeout{}
+	}
+}
+
+func lease(secret *vaultapi.Secret) creds.Lease {
+	return creds.Lease{
+		ID:        secret.LeaseID,
+		Duration:  time.Duration(secret.LeaseDuration) * time.Second,
+		Renewable: secret.Renewable,
+	}
+}
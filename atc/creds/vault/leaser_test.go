@@ -0,0 +1,168 @@
+package vault_test
+
+import (
+	"errors"
+	"text/template"
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/vault"
+	vaultapi "github.com/hashicorp/vault/api"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type MockLeaseClient struct {
+	MockSecretReader
+
+	renewed []string
+	revoked []string
+}
+
+func (mlc *MockLeaseClient) RenewLease(leaseID string, increment time.Duration) (*vaultapi.Secret, error) {
+	mlc.renewed = append(mlc.renewed, leaseID)
+	return &vaultapi.Secret{LeaseID: leaseID, LeaseDuration: 7200, Renewable: true}, nil
+}
+
+func (mlc *MockLeaseClient) RevokeLease(leaseID string) error {
+	if leaseID == "unknown-lease" {
+		return errors.New("lease not found")
+	}
+
+	mlc.revoked = append(mlc.revoked, leaseID)
+	return nil
+}
+
+var _ = Describe("Leaser", func() {
+	var (
+		client *MockLeaseClient
+		leaser vault.Leaser
+	)
+
+	BeforeEach(func() {
+		client = &MockLeaseClient{
+			MockSecretReader: MockSecretReader{&[]MockSecret{
+				{
+					path: "database/creds/some-role",
+					secret: &vaultapi.Secret{
+						LeaseID:       "database/creds/some-role/some-lease",
+						LeaseDuration: 3600,
+						Renewable:     true,
+						Data: map[string]interface{}{
+							"username": "some-user",
+							"password": "some-password",
+						},
+					},
+				},
+			}},
+		}
+
+		roleTemplate, err := vault.BuildDynamicPathTemplate("role", "/database/creds/concourse-{{.Team}}")
+		Expect(err).ToNot(HaveOccurred())
+
+		engineTemplate, err := vault.BuildDynamicPathTemplate("engine", "aws/{{.Team}}/")
+		Expect(err).ToNot(HaveOccurred())
+
+		leaser = vault.Leaser{
+			Client:       client,
+			DynamicPaths: []*template.Template{roleTemplate, engineTemplate},
+		}
+	})
+
+	Describe("BuildDynamicPathTemplate", func() {
+		It("requires the team to be named", func() {
+			_, err := vault.BuildDynamicPathTemplate("shared", "database/creds")
+			Expect(err).To(MatchError("dynamic path 'database/creds' must name the team with {{.Team}}"))
+		})
+
+		It("rejects keys other than the team", func() {
+			_, err := vault.BuildDynamicPathTemplate("pipeline", "database/creds/{{.Team}}-{{.Pipeline}}")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("IsDynamic", func() {
+		It("is true for the team's dynamic paths and the secrets under them", func() {
+			Expect(leaser.IsDynamic("some-team", "database/creds/concourse-some-team")).To(BeTrue())
+			Expect(leaser.IsDynamic("some-team", "/database/creds/concourse-some-team")).To(BeTrue())
+			Expect(leaser.IsDynamic("some-team", "aws/some-team/creds/deploy")).To(BeTrue())
+		})
+
+		It("is false for the dynamic paths of another team", func() {
+			Expect(leaser.IsDynamic("other-team", "database/creds/concourse-some-team")).To(BeFalse())
+			Expect(leaser.IsDynamic("other-team", "aws/some-team/creds/deploy")).To(BeFalse())
+
+			// a team named 'some' must not lease the roles of 'some-team'
+			Expect(leaser.IsDynamic("some", "database/creds/concourse-some-team")).To(BeFalse())
+			Expect(leaser.IsDynamic("some", "aws/some-team/creds/deploy")).To(BeFalse())
+		})
+
+		It("is false for other secrets", func() {
+			Expect(leaser.IsDynamic("some-team", "database/creds")).To(BeFalse())
+			Expect(leaser.IsDynamic("some-team", "database/creds/some-role")).To(BeFalse())
+			Expect(leaser.IsDynamic("some-team", "some-secret")).To(BeFalse())
+		})
+
+		It("is false for paths escaping the dynamic path", func() {
+			Expect(leaser.IsDynamic("some-team", "aws/some-team/../other-team/creds/deploy")).To(BeFalse())
+			Expect(leaser.IsDynamic("some-team", "database/creds/concourse-some-team/../concourse-other-team")).To(BeFalse())
+		})
+	})
+
+	Describe("Lease", func() {
+		It("requests the secret and returns its lease", func() {
+			value, lease, found, err := leaser.Lease("database/creds/some-role")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(map[string]interface{}{
+				"username": "some-user",
+				"password": "some-password",
+			}))
+			Expect(lease).To(Equal(creds.Lease{
+				ID:        "database/creds/some-role/some-lease",
+				Duration:  time.Hour,
+				Renewable: true,
+			}))
+		})
+
+		It("is not found when there is no such role", func() {
+			_, _, found, err := leaser.Lease("database/creds/other-role")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when vault has not been logged in to in time", func() {
+			BeforeEach(func() {
+				leaser.LoggedIn = make(chan struct{})
+				leaser.LoginTimeout = time.Millisecond
+			})
+
+			It("times out", func() {
+				_, _, _, err := leaser.Lease("database/creds/some-role")
+				Expect(err).To(Equal(vault.VaultLoginTimeout{}))
+			})
+		})
+	})
+
+	Describe("Renew", func() {
+		It("renews the lease", func() {
+			lease, err := leaser.Renew("some-lease")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(lease.Duration).To(Equal(2 * time.Hour))
+			Expect(client.renewed).To(Equal([]string{"some-lease"}))
+		})
+	})
+
+	Describe("Revoke", func() {
+		It("revokes the lease", func() {
+			err := leaser.Revoke("some-lease")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(client.revoked).To(Equal([]string{"some-lease"}))
+		})
+
+		It("returns the error", func() {
+			err := leaser.Revoke("unknown-lease")
+			Expect(err).To(MatchError("lease not found"))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"path"
+	"text/template"
 	"time"
 
 	"code.cloudfoundry.org/lager"
@@ -23,6 +24,7 @@ type VaultManager struct {
 	Namespace       string        `mapstructure:"namespace" long:"namespace"   description:"Vault namespace to use for authentication and secret lookup."`
 	LoginTimeout    time.Duration `mapstructure:"login_timeout" long:"login-timeout" default:"60s" description:"Timeout value for Vault login."`
 	QueryTimeout    time.Duration `mapstructure:"query_timeout" long:"query-timeout" default:"60s" description:"Timeout value for Vault query."`
+	DynamicPaths    []string      `mapstructure:"dynamic_paths" long:"dynamic-path" description:"Path template at or under which Vault issues the dynamic secrets of a team, e.g. database/creds/concourse-{{.Team}}. It must contain {{.Team}}. Each build requests its own, which is revoked once the build is done. Can be specified multiple times."`
 
 	TLS  TLSConfig  `mapstructure:",squash"`
 	Auth AuthConfig `mapstructure:",squash"`
@@ -30,6 +32,8 @@ type VaultManager struct {
 	Client        *APIClient
 	ReAuther      *ReAuther
 	SecretFactory *vaultFactory
+
+	leaser *Leaser
 }
 
 type TLSConfig struct {
@@ -80,6 +84,7 @@ func (manager *VaultManager) MarshalJSON() ([]byte, error) {
 		"path_prefix":        manager.PathPrefix,
 		"lookup_templates":   manager.LookupTemplates,
 		"shared_path":        manager.SharedPath,
+		"dynamic_paths":      manager.DynamicPaths,
 		"namespace":          manager.Namespace,
 		"ca_cert":            manager.TLS.CACert,
 		"server_name":        manager.TLS.ServerName,
@@ -147,6 +152,13 @@ func (manager VaultManager) Validate() error {
 		}
 	}
 
+	for i, tmpl := range manager.DynamicPaths {
+		name := fmt.Sprintf("dynamic-path-%d", i)
+		if _, err := BuildDynamicPathTemplate(name, tmpl); err != nil {
+			return err
+		}
+	}
+
 	if manager.Auth.ClientToken != "" {
 		return nil
 	}
@@ -203,11 +215,41 @@ func (manager *VaultManager) NewSecretsFactory(logger lager.Logger) (creds.Secre
 			templates,
 			manager.SharedPath,
 		)
+
+		if len(manager.DynamicPaths) > 0 {
+			dynamicPaths := []*template.Template{}
+			for i, tmpl := range manager.DynamicPaths {
+				name := fmt.Sprintf("dynamic-path-%d", i)
+				dynamicPath, err := BuildDynamicPathTemplate(name, tmpl)
+				if err != nil {
+					return nil, err
+				}
+
+				dynamicPaths = append(dynamicPaths, dynamicPath)
+			}
+
+			manager.leaser = &Leaser{
+				Client:       manager.Client,
+				DynamicPaths: dynamicPaths,
+				LoggedIn:     manager.ReAuther.LoggedIn(),
+				LoginTimeout: manager.LoginTimeout,
+			}
+		}
 	}
 
 	return manager.SecretFactory, nil
 }
 
+// Leaser returns the leaser of the dynamic secrets, once the secrets factory
+// has been created.
+func (manager *VaultManager) Leaser() (creds.Leaser, bool) {
+	if manager.leaser == nil {
+		return nil, false
+	}
+
+	return manager.leaser, true
+}
+
 func (manager VaultManager) Close(logger lager.Logger) {
 	manager.ReAuther.Close()
 }
@@ -70,6 +70,11 @@ var _ = Describe("VaultManager", func() {
 			manager.Auth = vault.AuthConfig{}
 			Expect(manager.Validate()).ToNot(BeNil())
 		})
+
+		It("fails on dynamic paths which are not scoped to the team", func() {
+			manager.DynamicPaths = []string{"database/creds/concourse-{{.Team}}", "database/creds"}
+			Expect(manager.Validate()).To(MatchError("dynamic path 'database/creds' must name the team with {{.Team}}"))
+		})
 	})
 
 	Describe("Config", func() {
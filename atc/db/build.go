@@ -177,6 +177,9 @@ type Build interface {
 	Artifacts() ([]WorkerArtifact, error)
 	Artifact(artifactID int) (WorkerArtifact, error)
 
+	SaveSecretAccesses([]SecretAccess) error
+	SecretAccesses() ([]SecretAccess, error)
+
 	SaveOutput(string, atc.Source, atc.VersionedResourceTypes, atc.Version, ResourceConfigMetadataFields, string, string) error
 	AdoptInputsAndPipes() ([]BuildInput, bool, error)
 	AdoptRerunInputsAndPipes() ([]BuildInput, bool, error)
@@ -1149,6 +1152,59 @@ func (b *build) Artifacts() ([]WorkerArtifact, error) {
 	return artifacts, nil
 }
 
+// SaveSecretAccesses records the credentials resolved by the build. An
+// access which has already been recorded is left as is.
+func (b *build) SaveSecretAccesses(accesses []SecretAccess) error {
+	if len(accesses) == 0 {
+		return nil
+	}
+
+	insert := psql.Insert("build_secret_accesses").
+		Columns("build_id", "var_source", "path", "manager")
+
+	for _, access := range accesses {
+		insert = insert.Values(b.id, access.VarSource, access.Path, access.Manager)
+	}
+
+	_, err := insert.
+		Suffix("ON CONFLICT (build_id, var_source, path) DO NOTHING").
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
+func (b *build) SecretAccesses() ([]SecretAccess, error) {
+	rows, err := psql.Select("var_source", "path", "manager", "accessed_at").
+		From("build_secret_accesses").
+		Where(sq.Eq{
+			"build_id": b.id,
+		}).
+		OrderBy("var_source", "path").
+		RunWith(b.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	accesses := []SecretAccess{}
+	for rows.Next() {
+		access := SecretAccess{
+			BuildID: b.id,
+		}
+
+		err = rows.Scan(&access.VarSource, &access.Path, &access.Manager, &access.AccessedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		accesses = append(accesses, access)
+	}
+
+	return accesses, nil
+}
+
 func (b *build) SaveOutput(
 	resourceType string,
 	source atc.Source,
@@ -41,6 +41,13 @@ var (
 	teamFactory                         db.TeamFactory
 	workerFactory                       db.WorkerFactory
 	workerLifecycle                     db.WorkerLifecycle
+	maintenanceWindowFactory            db.MaintenanceWindowFactory
+	workerKeyFactory                    db.WorkerKeyFactory
+	workerSessionFactory                db.WorkerSessionFactory
+	secretFactory                       db.SecretFactory
+	secretAccessFactory                 db.SecretAccessFactory
+	secretLeaseFactory                  db.SecretLeaseFactory
+	secretCacheEvictionFactory          db.SecretCacheEvictionFactory
 	resourceConfigCheckSessionLifecycle db.ResourceConfigCheckSessionLifecycle
 	resourceConfigFactory               db.ResourceConfigFactory
 	resourceCacheFactory                db.ResourceCacheFactory
@@ -121,6 +128,13 @@ var _ = BeforeEach(func() {
 	teamFactory = db.NewTeamFactory(dbConn, lockFactory)
 	workerFactory = db.NewWorkerFactory(dbConn)
 	workerLifecycle = db.NewWorkerLifecycle(dbConn)
+	maintenanceWindowFactory = db.NewMaintenanceWindowFactory(dbConn)
+	workerKeyFactory = db.NewWorkerKeyFactory(dbConn)
+	workerSessionFactory = db.NewWorkerSessionFactory(dbConn)
+	secretFactory = db.NewSecretFactory(dbConn)
+	secretAccessFactory = db.NewSecretAccessFactory(dbConn)
+	secretLeaseFactory = db.NewSecretLeaseFactory(dbConn)
+	secretCacheEvictionFactory = db.NewSecretCacheEvictionFactory(dbConn)
 	resourceConfigCheckSessionLifecycle = db.NewResourceConfigCheckSessionLifecycle(dbConn)
 	resourceConfigFactory = db.NewResourceConfigFactory(dbConn, lockFactory)
 	resourceCacheFactory = db.NewResourceCacheFactory(dbConn, lockFactory)
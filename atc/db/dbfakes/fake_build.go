@@ -572,6 +572,17 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	SaveSecretAccessesStub        func([]db.SecretAccess) error
+	saveSecretAccessesMutex       sync.RWMutex
+	saveSecretAccessesArgsForCall []struct {
+		arg1 []db.SecretAccess
+	}
+	saveSecretAccessesReturns struct {
+		result1 error
+	}
+	saveSecretAccessesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SchemaStub        func() string
 	schemaMutex       sync.RWMutex
 	schemaArgsForCall []struct {
@@ -582,6 +593,18 @@ type FakeBuild struct {
 	schemaReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SecretAccessesStub        func() ([]db.SecretAccess, error)
+	secretAccessesMutex       sync.RWMutex
+	secretAccessesArgsForCall []struct {
+	}
+	secretAccessesReturns struct {
+		result1 []db.SecretAccess
+		result2 error
+	}
+	secretAccessesReturnsOnCall map[int]struct {
+		result1 []db.SecretAccess
+		result2 error
+	}
 	SetDrainedStub        func(bool) error
 	setDrainedMutex       sync.RWMutex
 	setDrainedArgsForCall []struct {
@@ -3404,6 +3427,71 @@ func (fake *FakeBuild) SavePipelineReturnsOnCall(i int, result1 db.Pipeline, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) SaveSecretAccesses(arg1 []db.SecretAccess) error {
+	var arg1Copy []db.SecretAccess
+	if arg1 != nil {
+		arg1Copy = make([]db.SecretAccess, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.saveSecretAccessesMutex.Lock()
+	ret, specificReturn := fake.saveSecretAccessesReturnsOnCall[len(fake.saveSecretAccessesArgsForCall)]
+	fake.saveSecretAccessesArgsForCall = append(fake.saveSecretAccessesArgsForCall, struct {
+		arg1 []db.SecretAccess
+	}{arg1Copy})
+	fake.recordInvocation("SaveSecretAccesses", []interface{}{arg1Copy})
+	fake.saveSecretAccessesMutex.Unlock()
+	if fake.SaveSecretAccessesStub != nil {
+		return fake.SaveSecretAccessesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveSecretAccessesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveSecretAccessesCallCount() int {
+	fake.saveSecretAccessesMutex.RLock()
+	defer fake.saveSecretAccessesMutex.RUnlock()
+	return len(fake.saveSecretAccessesArgsForCall)
+}
+
+func (fake *FakeBuild) SaveSecretAccessesCalls(stub func([]db.SecretAccess) error) {
+	fake.saveSecretAccessesMutex.Lock()
+	defer fake.saveSecretAccessesMutex.Unlock()
+	fake.SaveSecretAccessesStub = stub
+}
+
+func (fake *FakeBuild) SaveSecretAccessesArgsForCall(i int) []db.SecretAccess {
+	fake.saveSecretAccessesMutex.RLock()
+	defer fake.saveSecretAccessesMutex.RUnlock()
+	argsForCall := fake.saveSecretAccessesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SaveSecretAccessesReturns(result1 error) {
+	fake.saveSecretAccessesMutex.Lock()
+	defer fake.saveSecretAccessesMutex.Unlock()
+	fake.SaveSecretAccessesStub = nil
+	fake.saveSecretAccessesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveSecretAccessesReturnsOnCall(i int, result1 error) {
+	fake.saveSecretAccessesMutex.Lock()
+	defer fake.saveSecretAccessesMutex.Unlock()
+	fake.SaveSecretAccessesStub = nil
+	if fake.saveSecretAccessesReturnsOnCall == nil {
+		fake.saveSecretAccessesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveSecretAccessesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Schema() string {
 	fake.schemaMutex.Lock()
 	ret, specificReturn := fake.schemaReturnsOnCall[len(fake.schemaArgsForCall)]
@@ -3456,6 +3544,61 @@ func (fake *FakeBuild) SchemaReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SecretAccesses() ([]db.SecretAccess, error) {
+	fake.secretAccessesMutex.Lock()
+	ret, specificReturn := fake.secretAccessesReturnsOnCall[len(fake.secretAccessesArgsForCall)]
+	fake.secretAccessesArgsForCall = append(fake.secretAccessesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("SecretAccesses", []interface{}{})
+	fake.secretAccessesMutex.Unlock()
+	if fake.SecretAccessesStub != nil {
+		return fake.SecretAccessesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.secretAccessesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) SecretAccessesCallCount() int {
+	fake.secretAccessesMutex.RLock()
+	defer fake.secretAccessesMutex.RUnlock()
+	return len(fake.secretAccessesArgsForCall)
+}
+
+func (fake *FakeBuild) SecretAccessesCalls(stub func() ([]db.SecretAccess, error)) {
+	fake.secretAccessesMutex.Lock()
+	defer fake.secretAccessesMutex.Unlock()
+	fake.SecretAccessesStub = stub
+}
+
+func (fake *FakeBuild) SecretAccessesReturns(result1 []db.SecretAccess, result2 error) {
+	fake.secretAccessesMutex.Lock()
+	defer fake.secretAccessesMutex.Unlock()
+	fake.SecretAccessesStub = nil
+	fake.secretAccessesReturns = struct {
+		result1 []db.SecretAccess
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) SecretAccessesReturnsOnCall(i int, result1 []db.SecretAccess, result2 error) {
+	fake.secretAccessesMutex.Lock()
+	defer fake.secretAccessesMutex.Unlock()
+	fake.SecretAccessesStub = nil
+	if fake.secretAccessesReturnsOnCall == nil {
+		fake.secretAccessesReturnsOnCall = make(map[int]struct {
+			result1 []db.SecretAccess
+			result2 error
+		})
+	}
+	fake.secretAccessesReturnsOnCall[i] = struct {
+		result1 []db.SecretAccess
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) SetDrained(arg1 bool) error {
 	fake.setDrainedMutex.Lock()
 	ret, specificReturn := fake.setDrainedReturnsOnCall[len(fake.setDrainedArgsForCall)]
@@ -4177,8 +4320,12 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.saveOutputMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
+	fake.saveSecretAccessesMutex.RLock()
+	defer fake.saveSecretAccessesMutex.RUnlock()
 	fake.schemaMutex.RLock()
 	defer fake.schemaMutex.RUnlock()
+	fake.secretAccessesMutex.RLock()
+	defer fake.secretAccessesMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
 	defer fake.setDrainedMutex.RUnlock()
 	fake.setInterceptibleMutex.RLock()
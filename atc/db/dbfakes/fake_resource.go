@@ -21,6 +21,20 @@ type FakeResource struct {
 	aPIPinnedVersionReturnsOnCall map[int]struct {
 		result1 atc.Version
 	}
+	AddVersionLabelStub        func(int, string) (bool, error)
+	addVersionLabelMutex       sync.RWMutex
+	addVersionLabelArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	addVersionLabelReturns struct {
+		result1 bool
+		result2 error
+	}
+	addVersionLabelReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	BuildSummaryStub        func() *atc.BuildSummary
 	buildSummaryMutex       sync.RWMutex
 	buildSummaryArgsForCall []struct {
@@ -318,6 +332,20 @@ type FakeResource struct {
 		result1 bool
 		result2 error
 	}
+	RemoveVersionLabelStub        func(int, string) (bool, error)
+	removeVersionLabelMutex       sync.RWMutex
+	removeVersionLabelArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	removeVersionLabelReturns struct {
+		result1 bool
+		result2 error
+	}
+	removeVersionLabelReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ResourceConfigIDStub        func() int
 	resourceConfigIDMutex       sync.RWMutex
 	resourceConfigIDArgsForCall []struct {
@@ -434,11 +462,11 @@ type FakeResource struct {
 		result1 bool
 		result2 error
 	}
-	VersionsStub        func(db.Page, atc.Version) ([]atc.ResourceVersion, db.Pagination, bool, error)
+	VersionsStub        func(db.Page, atc.ResourceVersionFilter) ([]atc.ResourceVersion, db.Pagination, bool, error)
 	versionsMutex       sync.RWMutex
 	versionsArgsForCall []struct {
 		arg1 db.Page
-		arg2 atc.Version
+		arg2 atc.ResourceVersionFilter
 	}
 	versionsReturns struct {
 		result1 []atc.ResourceVersion
@@ -518,6 +546,70 @@ func (fake *FakeResource) APIPinnedVersionReturnsOnCall(i int, result1 atc.Versi
 	}{result1}
 }
 
+func (fake *FakeResource) AddVersionLabel(arg1 int, arg2 string) (bool, error) {
+	fake.addVersionLabelMutex.Lock()
+	ret, specificReturn := fake.addVersionLabelReturnsOnCall[len(fake.addVersionLabelArgsForCall)]
+	fake.addVersionLabelArgsForCall = append(fake.addVersionLabelArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("AddVersionLabel", []interface{}{arg1, arg2})
+	fake.addVersionLabelMutex.Unlock()
+	if fake.AddVersionLabelStub != nil {
+		return fake.AddVersionLabelStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.addVersionLabelReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) AddVersionLabelCallCount() int {
+	fake.addVersionLabelMutex.RLock()
+	defer fake.addVersionLabelMutex.RUnlock()
+	return len(fake.addVersionLabelArgsForCall)
+}
+
+func (fake *FakeResource) AddVersionLabelCalls(stub func(int, string) (bool, error)) {
+	fake.addVersionLabelMutex.Lock()
+	defer fake.addVersionLabelMutex.Unlock()
+	fake.AddVersionLabelStub = stub
+}
+
+func (fake *FakeResource) AddVersionLabelArgsForCall(i int) (int, string) {
+	fake.addVersionLabelMutex.RLock()
+	defer fake.addVersionLabelMutex.RUnlock()
+	argsForCall := fake.addVersionLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) AddVersionLabelReturns(result1 bool, result2 error) {
+	fake.addVersionLabelMutex.Lock()
+	defer fake.addVersionLabelMutex.Unlock()
+	fake.AddVersionLabelStub = nil
+	fake.addVersionLabelReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) AddVersionLabelReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.addVersionLabelMutex.Lock()
+	defer fake.addVersionLabelMutex.Unlock()
+	fake.AddVersionLabelStub = nil
+	if fake.addVersionLabelReturnsOnCall == nil {
+		fake.addVersionLabelReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.addVersionLabelReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) BuildSummary() *atc.BuildSummary {
 	fake.buildSummaryMutex.Lock()
 	ret, specificReturn := fake.buildSummaryReturnsOnCall[len(fake.buildSummaryArgsForCall)]
@@ -1999,6 +2091,70 @@ func (fake *FakeResource) ReloadReturnsOnCall(i int, result1 bool, result2 error
 	}{result1, result2}
 }
 
+func (fake *FakeResource) RemoveVersionLabel(arg1 int, arg2 string) (bool, error) {
+	fake.removeVersionLabelMutex.Lock()
+	ret, specificReturn := fake.removeVersionLabelReturnsOnCall[len(fake.removeVersionLabelArgsForCall)]
+	fake.removeVersionLabelArgsForCall = append(fake.removeVersionLabelArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RemoveVersionLabel", []interface{}{arg1, arg2})
+	fake.removeVersionLabelMutex.Unlock()
+	if fake.RemoveVersionLabelStub != nil {
+		return fake.RemoveVersionLabelStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeVersionLabelReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) RemoveVersionLabelCallCount() int {
+	fake.removeVersionLabelMutex.RLock()
+	defer fake.removeVersionLabelMutex.RUnlock()
+	return len(fake.removeVersionLabelArgsForCall)
+}
+
+func (fake *FakeResource) RemoveVersionLabelCalls(stub func(int, string) (bool, error)) {
+	fake.removeVersionLabelMutex.Lock()
+	defer fake.removeVersionLabelMutex.Unlock()
+	fake.RemoveVersionLabelStub = stub
+}
+
+func (fake *FakeResource) RemoveVersionLabelArgsForCall(i int) (int, string) {
+	fake.removeVersionLabelMutex.RLock()
+	defer fake.removeVersionLabelMutex.RUnlock()
+	argsForCall := fake.removeVersionLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResource) RemoveVersionLabelReturns(result1 bool, result2 error) {
+	fake.removeVersionLabelMutex.Lock()
+	defer fake.removeVersionLabelMutex.Unlock()
+	fake.RemoveVersionLabelStub = nil
+	fake.removeVersionLabelReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) RemoveVersionLabelReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.removeVersionLabelMutex.Lock()
+	defer fake.removeVersionLabelMutex.Unlock()
+	fake.RemoveVersionLabelStub = nil
+	if fake.removeVersionLabelReturnsOnCall == nil {
+		fake.removeVersionLabelReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.removeVersionLabelReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) ResourceConfigID() int {
 	fake.resourceConfigIDMutex.Lock()
 	ret, specificReturn := fake.resourceConfigIDReturnsOnCall[len(fake.resourceConfigIDArgsForCall)]
@@ -2599,12 +2755,12 @@ func (fake *FakeResource) UpdateMetadataReturnsOnCall(i int, result1 bool, resul
 	}{result1, result2}
 }
 
-func (fake *FakeResource) Versions(arg1 db.Page, arg2 atc.Version) ([]atc.ResourceVersion, db.Pagination, bool, error) {
+func (fake *FakeResource) Versions(arg1 db.Page, arg2 atc.ResourceVersionFilter) ([]atc.ResourceVersion, db.Pagination, bool, error) {
 	fake.versionsMutex.Lock()
 	ret, specificReturn := fake.versionsReturnsOnCall[len(fake.versionsArgsForCall)]
 	fake.versionsArgsForCall = append(fake.versionsArgsForCall, struct {
 		arg1 db.Page
-		arg2 atc.Version
+		arg2 atc.ResourceVersionFilter
 	}{arg1, arg2})
 	fake.recordInvocation("Versions", []interface{}{arg1, arg2})
 	fake.versionsMutex.Unlock()
@@ -2624,13 +2780,13 @@ func (fake *FakeResource) VersionsCallCount() int {
 	return len(fake.versionsArgsForCall)
 }
 
-func (fake *FakeResource) VersionsCalls(stub func(db.Page, atc.Version) ([]atc.ResourceVersion, db.Pagination, bool, error)) {
+func (fake *FakeResource) VersionsCalls(stub func(db.Page, atc.ResourceVersionFilter) ([]atc.ResourceVersion, db.Pagination, bool, error)) {
 	fake.versionsMutex.Lock()
 	defer fake.versionsMutex.Unlock()
 	fake.VersionsStub = stub
 }
 
-func (fake *FakeResource) VersionsArgsForCall(i int) (db.Page, atc.Version) {
+func (fake *FakeResource) VersionsArgsForCall(i int) (db.Page, atc.ResourceVersionFilter) {
 	fake.versionsMutex.RLock()
 	defer fake.versionsMutex.RUnlock()
 	argsForCall := fake.versionsArgsForCall[i]
@@ -2726,6 +2882,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.aPIPinnedVersionMutex.RLock()
 	defer fake.aPIPinnedVersionMutex.RUnlock()
+	fake.addVersionLabelMutex.RLock()
+	defer fake.addVersionLabelMutex.RUnlock()
 	fake.buildSummaryMutex.RLock()
 	defer fake.buildSummaryMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
@@ -2780,6 +2938,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.publicMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
+	fake.removeVersionLabelMutex.RLock()
+	defer fake.removeVersionLabelMutex.RUnlock()
 	fake.resourceConfigIDMutex.RLock()
 	defer fake.resourceConfigIDMutex.RUnlock()
 	fake.resourceConfigScopeIDMutex.RLock()
@@ -42,6 +42,20 @@ type FakeResourceCacheFactory struct {
 		result2 bool
 		result3 error
 	}
+	FindResourceCachesToWarmStub        func(string, int) ([]db.WarmableResourceCache, error)
+	findResourceCachesToWarmMutex       sync.RWMutex
+	findResourceCachesToWarmArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	findResourceCachesToWarmReturns struct {
+		result1 []db.WarmableResourceCache
+		result2 error
+	}
+	findResourceCachesToWarmReturnsOnCall map[int]struct {
+		result1 []db.WarmableResourceCache
+		result2 error
+	}
 	ResourceCacheMetadataStub        func(db.UsedResourceCache) (db.ResourceConfigMetadataFields, error)
 	resourceCacheMetadataMutex       sync.RWMutex
 	resourceCacheMetadataArgsForCall []struct {
@@ -205,6 +219,70 @@ func (fake *FakeResourceCacheFactory) FindResourceCacheByIDReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeResourceCacheFactory) FindResourceCachesToWarm(arg1 string, arg2 int) ([]db.WarmableResourceCache, error) {
+	fake.findResourceCachesToWarmMutex.Lock()
+	ret, specificReturn := fake.findResourceCachesToWarmReturnsOnCall[len(fake.findResourceCachesToWarmArgsForCall)]
+	fake.findResourceCachesToWarmArgsForCall = append(fake.findResourceCachesToWarmArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("FindResourceCachesToWarm", []interface{}{arg1, arg2})
+	fake.findResourceCachesToWarmMutex.Unlock()
+	if fake.FindResourceCachesToWarmStub != nil {
+		return fake.FindResourceCachesToWarmStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.findResourceCachesToWarmReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceCacheFactory) FindResourceCachesToWarmCallCount() int {
+	fake.findResourceCachesToWarmMutex.RLock()
+	defer fake.findResourceCachesToWarmMutex.RUnlock()
+	return len(fake.findResourceCachesToWarmArgsForCall)
+}
+
+func (fake *FakeResourceCacheFactory) FindResourceCachesToWarmCalls(stub func(string, int) ([]db.WarmableResourceCache, error)) {
+	fake.findResourceCachesToWarmMutex.Lock()
+	defer fake.findResourceCachesToWarmMutex.Unlock()
+	fake.FindResourceCachesToWarmStub = stub
+}
+
+func (fake *FakeResourceCacheFactory) FindResourceCachesToWarmArgsForCall(i int) (string, int) {
+	fake.findResourceCachesToWarmMutex.RLock()
+	defer fake.findResourceCachesToWarmMutex.RUnlock()
+	argsForCall := fake.findResourceCachesToWarmArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResourceCacheFactory) FindResourceCachesToWarmReturns(result1 []db.WarmableResourceCache, result2 error) {
+	fake.findResourceCachesToWarmMutex.Lock()
+	defer fake.findResourceCachesToWarmMutex.Unlock()
+	fake.FindResourceCachesToWarmStub = nil
+	fake.findResourceCachesToWarmReturns = struct {
+		result1 []db.WarmableResourceCache
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceCacheFactory) FindResourceCachesToWarmReturnsOnCall(i int, result1 []db.WarmableResourceCache, result2 error) {
+	fake.findResourceCachesToWarmMutex.Lock()
+	defer fake.findResourceCachesToWarmMutex.Unlock()
+	fake.FindResourceCachesToWarmStub = nil
+	if fake.findResourceCachesToWarmReturnsOnCall == nil {
+		fake.findResourceCachesToWarmReturnsOnCall = make(map[int]struct {
+			result1 []db.WarmableResourceCache
+			result2 error
+		})
+	}
+	fake.findResourceCachesToWarmReturnsOnCall[i] = struct {
+		result1 []db.WarmableResourceCache
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceCacheFactory) ResourceCacheMetadata(arg1 db.UsedResourceCache) (db.ResourceConfigMetadataFields, error) {
 	fake.resourceCacheMetadataMutex.Lock()
 	ret, specificReturn := fake.resourceCacheMetadataReturnsOnCall[len(fake.resourceCacheMetadataArgsForCall)]
@@ -341,6 +419,8 @@ func (fake *FakeResourceCacheFactory) Invocations() map[string][][]interface{} {
 	defer fake.findOrCreateResourceCacheMutex.RUnlock()
 	fake.findResourceCacheByIDMutex.RLock()
 	defer fake.findResourceCacheByIDMutex.RUnlock()
+	fake.findResourceCachesToWarmMutex.RLock()
+	defer fake.findResourceCachesToWarmMutex.RUnlock()
 	fake.resourceCacheMetadataMutex.RLock()
 	defer fake.resourceCacheMetadataMutex.RUnlock()
 	fake.updateResourceCacheMetadataMutex.RLock()
@@ -98,11 +98,12 @@ type FakeResourceConfigScope struct {
 	resourceConfigReturnsOnCall map[int]struct {
 		result1 db.ResourceConfig
 	}
-	SaveVersionsStub        func(db.SpanContext, []atc.Version) error
+	SaveVersionsStub        func(db.SpanContext, []atc.Version, atc.VersionSchema) error
 	saveVersionsMutex       sync.RWMutex
 	saveVersionsArgsForCall []struct {
 		arg1 db.SpanContext
 		arg2 []atc.Version
+		arg3 atc.VersionSchema
 	}
 	saveVersionsReturns struct {
 		result1 error
@@ -539,7 +540,7 @@ func (fake *FakeResourceConfigScope) ResourceConfigReturnsOnCall(i int, result1
 	}{result1}
 }
 
-func (fake *FakeResourceConfigScope) SaveVersions(arg1 db.SpanContext, arg2 []atc.Version) error {
+func (fake *FakeResourceConfigScope) SaveVersions(arg1 db.SpanContext, arg2 []atc.Version, arg3 atc.VersionSchema) error {
 	var arg2Copy []atc.Version
 	if arg2 != nil {
 		arg2Copy = make([]atc.Version, len(arg2))
@@ -550,11 +551,12 @@ func (fake *FakeResourceConfigScope) SaveVersions(arg1 db.SpanContext, arg2 []at
 	fake.saveVersionsArgsForCall = append(fake.saveVersionsArgsForCall, struct {
 		arg1 db.SpanContext
 		arg2 []atc.Version
-	}{arg1, arg2Copy})
-	fake.recordInvocation("SaveVersions", []interface{}{arg1, arg2Copy})
+		arg3 atc.VersionSchema
+	}{arg1, arg2Copy, arg3})
+	fake.recordInvocation("SaveVersions", []interface{}{arg1, arg2Copy, arg3})
 	fake.saveVersionsMutex.Unlock()
 	if fake.SaveVersionsStub != nil {
-		return fake.SaveVersionsStub(arg1, arg2)
+		return fake.SaveVersionsStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -569,17 +571,17 @@ func (fake *FakeResourceConfigScope) SaveVersionsCallCount() int {
 	return len(fake.saveVersionsArgsForCall)
 }
 
-func (fake *FakeResourceConfigScope) SaveVersionsCalls(stub func(db.SpanContext, []atc.Version) error) {
+func (fake *FakeResourceConfigScope) SaveVersionsCalls(stub func(db.SpanContext, []atc.Version, atc.VersionSchema) error) {
 	fake.saveVersionsMutex.Lock()
 	defer fake.saveVersionsMutex.Unlock()
 	fake.SaveVersionsStub = stub
 }
 
-func (fake *FakeResourceConfigScope) SaveVersionsArgsForCall(i int) (db.SpanContext, []atc.Version) {
+func (fake *FakeResourceConfigScope) SaveVersionsArgsForCall(i int) (db.SpanContext, []atc.Version, atc.VersionSchema) {
 	fake.saveVersionsMutex.RLock()
 	defer fake.saveVersionsMutex.RUnlock()
 	argsForCall := fake.saveVersionsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeResourceConfigScope) SaveVersionsReturns(result1 error) {
@@ -11,6 +11,16 @@ import (
 )
 
 type FakeResourceType struct {
+	CandidateVersionStub        func() atc.Version
+	candidateVersionMutex       sync.RWMutex
+	candidateVersionArgsForCall []struct {
+	}
+	candidateVersionReturns struct {
+		result1 atc.Version
+	}
+	candidateVersionReturnsOnCall map[int]struct {
+		result1 atc.Version
+	}
 	CheckEveryStub        func() *atc.CheckEvery
 	checkEveryMutex       sync.RWMutex
 	checkEveryArgsForCall []struct {
@@ -142,6 +152,19 @@ type FakeResourceType struct {
 	paramsReturnsOnCall map[int]struct {
 		result1 atc.Params
 	}
+	PinVersionStub        func(atc.Version) (bool, error)
+	pinVersionMutex       sync.RWMutex
+	pinVersionArgsForCall []struct {
+		arg1 atc.Version
+	}
+	pinVersionReturns struct {
+		result1 bool
+		result2 error
+	}
+	pinVersionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	PipelineStub        func() (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -206,6 +229,18 @@ type FakeResourceType struct {
 	privilegedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	PromoteCandidateVersionStub        func() (bool, error)
+	promoteCandidateVersionMutex       sync.RWMutex
+	promoteCandidateVersionArgsForCall []struct {
+	}
+	promoteCandidateVersionReturns struct {
+		result1 bool
+		result2 error
+	}
+	promoteCandidateVersionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -289,6 +324,16 @@ type FakeResourceType struct {
 	typeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UnpinVersionStub        func() error
+	unpinVersionMutex       sync.RWMutex
+	unpinVersionArgsForCall []struct {
+	}
+	unpinVersionReturns struct {
+		result1 error
+	}
+	unpinVersionReturnsOnCall map[int]struct {
+		result1 error
+	}
 	VersionStub        func() atc.Version
 	versionMutex       sync.RWMutex
 	versionArgsForCall []struct {
@@ -299,10 +344,72 @@ type FakeResourceType struct {
 	versionReturnsOnCall map[int]struct {
 		result1 atc.Version
 	}
+	VersionSchemaStub        func() *atc.VersionSchema
+	versionSchemaMutex       sync.RWMutex
+	versionSchemaArgsForCall []struct {
+	}
+	versionSchemaReturns struct {
+		result1 *atc.VersionSchema
+	}
+	versionSchemaReturnsOnCall map[int]struct {
+		result1 *atc.VersionSchema
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeResourceType) CandidateVersion() atc.Version {
+	fake.candidateVersionMutex.Lock()
+	ret, specificReturn := fake.candidateVersionReturnsOnCall[len(fake.candidateVersionArgsForCall)]
+	fake.candidateVersionArgsForCall = append(fake.candidateVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CandidateVersion", []interface{}{})
+	fake.candidateVersionMutex.Unlock()
+	if fake.CandidateVersionStub != nil {
+		return fake.CandidateVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.candidateVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) CandidateVersionCallCount() int {
+	fake.candidateVersionMutex.RLock()
+	defer fake.candidateVersionMutex.RUnlock()
+	return len(fake.candidateVersionArgsForCall)
+}
+
+func (fake *FakeResourceType) CandidateVersionCalls(stub func() atc.Version) {
+	fake.candidateVersionMutex.Lock()
+	defer fake.candidateVersionMutex.Unlock()
+	fake.CandidateVersionStub = stub
+}
+
+func (fake *FakeResourceType) CandidateVersionReturns(result1 atc.Version) {
+	fake.candidateVersionMutex.Lock()
+	defer fake.candidateVersionMutex.Unlock()
+	fake.CandidateVersionStub = nil
+	fake.candidateVersionReturns = struct {
+		result1 atc.Version
+	}{result1}
+}
+
+func (fake *FakeResourceType) CandidateVersionReturnsOnCall(i int, result1 atc.Version) {
+	fake.candidateVersionMutex.Lock()
+	defer fake.candidateVersionMutex.Unlock()
+	fake.CandidateVersionStub = nil
+	if fake.candidateVersionReturnsOnCall == nil {
+		fake.candidateVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.Version
+		})
+	}
+	fake.candidateVersionReturnsOnCall[i] = struct {
+		result1 atc.Version
+	}{result1}
+}
+
 func (fake *FakeResourceType) CheckEvery() *atc.CheckEvery {
 	fake.checkEveryMutex.Lock()
 	ret, specificReturn := fake.checkEveryReturnsOnCall[len(fake.checkEveryArgsForCall)]
@@ -954,6 +1061,69 @@ func (fake *FakeResourceType) ParamsReturnsOnCall(i int, result1 atc.Params) {
 	}{result1}
 }
 
+func (fake *FakeResourceType) PinVersion(arg1 atc.Version) (bool, error) {
+	fake.pinVersionMutex.Lock()
+	ret, specificReturn := fake.pinVersionReturnsOnCall[len(fake.pinVersionArgsForCall)]
+	fake.pinVersionArgsForCall = append(fake.pinVersionArgsForCall, struct {
+		arg1 atc.Version
+	}{arg1})
+	fake.recordInvocation("PinVersion", []interface{}{arg1})
+	fake.pinVersionMutex.Unlock()
+	if fake.PinVersionStub != nil {
+		return fake.PinVersionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pinVersionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceType) PinVersionCallCount() int {
+	fake.pinVersionMutex.RLock()
+	defer fake.pinVersionMutex.RUnlock()
+	return len(fake.pinVersionArgsForCall)
+}
+
+func (fake *FakeResourceType) PinVersionCalls(stub func(atc.Version) (bool, error)) {
+	fake.pinVersionMutex.Lock()
+	defer fake.pinVersionMutex.Unlock()
+	fake.PinVersionStub = stub
+}
+
+func (fake *FakeResourceType) PinVersionArgsForCall(i int) atc.Version {
+	fake.pinVersionMutex.RLock()
+	defer fake.pinVersionMutex.RUnlock()
+	argsForCall := fake.pinVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeResourceType) PinVersionReturns(result1 bool, result2 error) {
+	fake.pinVersionMutex.Lock()
+	defer fake.pinVersionMutex.Unlock()
+	fake.PinVersionStub = nil
+	fake.pinVersionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceType) PinVersionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.pinVersionMutex.Lock()
+	defer fake.pinVersionMutex.Unlock()
+	fake.PinVersionStub = nil
+	if fake.pinVersionReturnsOnCall == nil {
+		fake.pinVersionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.pinVersionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceType) Pipeline() (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -1272,6 +1442,61 @@ func (fake *FakeResourceType) PrivilegedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeResourceType) PromoteCandidateVersion() (bool, error) {
+	fake.promoteCandidateVersionMutex.Lock()
+	ret, specificReturn := fake.promoteCandidateVersionReturnsOnCall[len(fake.promoteCandidateVersionArgsForCall)]
+	fake.promoteCandidateVersionArgsForCall = append(fake.promoteCandidateVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PromoteCandidateVersion", []interface{}{})
+	fake.promoteCandidateVersionMutex.Unlock()
+	if fake.PromoteCandidateVersionStub != nil {
+		return fake.PromoteCandidateVersionStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.promoteCandidateVersionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceType) PromoteCandidateVersionCallCount() int {
+	fake.promoteCandidateVersionMutex.RLock()
+	defer fake.promoteCandidateVersionMutex.RUnlock()
+	return len(fake.promoteCandidateVersionArgsForCall)
+}
+
+func (fake *FakeResourceType) PromoteCandidateVersionCalls(stub func() (bool, error)) {
+	fake.promoteCandidateVersionMutex.Lock()
+	defer fake.promoteCandidateVersionMutex.Unlock()
+	fake.PromoteCandidateVersionStub = stub
+}
+
+func (fake *FakeResourceType) PromoteCandidateVersionReturns(result1 bool, result2 error) {
+	fake.promoteCandidateVersionMutex.Lock()
+	defer fake.promoteCandidateVersionMutex.Unlock()
+	fake.PromoteCandidateVersionStub = nil
+	fake.promoteCandidateVersionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceType) PromoteCandidateVersionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.promoteCandidateVersionMutex.Lock()
+	defer fake.promoteCandidateVersionMutex.Unlock()
+	fake.PromoteCandidateVersionStub = nil
+	if fake.promoteCandidateVersionReturnsOnCall == nil {
+		fake.promoteCandidateVersionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.promoteCandidateVersionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResourceType) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -1699,6 +1924,58 @@ func (fake *FakeResourceType) TypeReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeResourceType) UnpinVersion() error {
+	fake.unpinVersionMutex.Lock()
+	ret, specificReturn := fake.unpinVersionReturnsOnCall[len(fake.unpinVersionArgsForCall)]
+	fake.unpinVersionArgsForCall = append(fake.unpinVersionArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UnpinVersion", []interface{}{})
+	fake.unpinVersionMutex.Unlock()
+	if fake.UnpinVersionStub != nil {
+		return fake.UnpinVersionStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.unpinVersionReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) UnpinVersionCallCount() int {
+	fake.unpinVersionMutex.RLock()
+	defer fake.unpinVersionMutex.RUnlock()
+	return len(fake.unpinVersionArgsForCall)
+}
+
+func (fake *FakeResourceType) UnpinVersionCalls(stub func() error) {
+	fake.unpinVersionMutex.Lock()
+	defer fake.unpinVersionMutex.Unlock()
+	fake.UnpinVersionStub = stub
+}
+
+func (fake *FakeResourceType) UnpinVersionReturns(result1 error) {
+	fake.unpinVersionMutex.Lock()
+	defer fake.unpinVersionMutex.Unlock()
+	fake.UnpinVersionStub = nil
+	fake.unpinVersionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResourceType) UnpinVersionReturnsOnCall(i int, result1 error) {
+	fake.unpinVersionMutex.Lock()
+	defer fake.unpinVersionMutex.Unlock()
+	fake.UnpinVersionStub = nil
+	if fake.unpinVersionReturnsOnCall == nil {
+		fake.unpinVersionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unpinVersionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeResourceType) Version() atc.Version {
 	fake.versionMutex.Lock()
 	ret, specificReturn := fake.versionReturnsOnCall[len(fake.versionArgsForCall)]
@@ -1751,9 +2028,63 @@ func (fake *FakeResourceType) VersionReturnsOnCall(i int, result1 atc.Version) {
 	}{result1}
 }
 
+func (fake *FakeResourceType) VersionSchema() *atc.VersionSchema {
+	fake.versionSchemaMutex.Lock()
+	ret, specificReturn := fake.versionSchemaReturnsOnCall[len(fake.versionSchemaArgsForCall)]
+	fake.versionSchemaArgsForCall = append(fake.versionSchemaArgsForCall, struct {
+	}{})
+	fake.recordInvocation("VersionSchema", []interface{}{})
+	fake.versionSchemaMutex.Unlock()
+	if fake.VersionSchemaStub != nil {
+		return fake.VersionSchemaStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.versionSchemaReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceType) VersionSchemaCallCount() int {
+	fake.versionSchemaMutex.RLock()
+	defer fake.versionSchemaMutex.RUnlock()
+	return len(fake.versionSchemaArgsForCall)
+}
+
+func (fake *FakeResourceType) VersionSchemaCalls(stub func() *atc.VersionSchema) {
+	fake.versionSchemaMutex.Lock()
+	defer fake.versionSchemaMutex.Unlock()
+	fake.VersionSchemaStub = stub
+}
+
+func (fake *FakeResourceType) VersionSchemaReturns(result1 *atc.VersionSchema) {
+	fake.versionSchemaMutex.Lock()
+	defer fake.versionSchemaMutex.Unlock()
+	fake.VersionSchemaStub = nil
+	fake.versionSchemaReturns = struct {
+		result1 *atc.VersionSchema
+	}{result1}
+}
+
+func (fake *FakeResourceType) VersionSchemaReturnsOnCall(i int, result1 *atc.VersionSchema) {
+	fake.versionSchemaMutex.Lock()
+	defer fake.versionSchemaMutex.Unlock()
+	fake.VersionSchemaStub = nil
+	if fake.versionSchemaReturnsOnCall == nil {
+		fake.versionSchemaReturnsOnCall = make(map[int]struct {
+			result1 *atc.VersionSchema
+		})
+	}
+	fake.versionSchemaReturnsOnCall[i] = struct {
+		result1 *atc.VersionSchema
+	}{result1}
+}
+
 func (fake *FakeResourceType) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.candidateVersionMutex.RLock()
+	defer fake.candidateVersionMutex.RUnlock()
 	fake.checkEveryMutex.RLock()
 	defer fake.checkEveryMutex.RUnlock()
 	fake.checkPlanMutex.RLock()
@@ -1778,6 +2109,8 @@ func (fake *FakeResourceType) Invocations() map[string][][]interface{} {
 	defer fake.nameMutex.RUnlock()
 	fake.paramsMutex.RLock()
 	defer fake.paramsMutex.RUnlock()
+	fake.pinVersionMutex.RLock()
+	defer fake.pinVersionMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
@@ -1790,6 +2123,8 @@ func (fake *FakeResourceType) Invocations() map[string][][]interface{} {
 	defer fake.pipelineRefMutex.RUnlock()
 	fake.privilegedMutex.RLock()
 	defer fake.privilegedMutex.RUnlock()
+	fake.promoteCandidateVersionMutex.RLock()
+	defer fake.promoteCandidateVersionMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
 	fake.resourceConfigScopeIDMutex.RLock()
@@ -1806,8 +2141,12 @@ func (fake *FakeResourceType) Invocations() map[string][][]interface{} {
 	defer fake.teamNameMutex.RUnlock()
 	fake.typeMutex.RLock()
 	defer fake.typeMutex.RUnlock()
+	fake.unpinVersionMutex.RLock()
+	defer fake.unpinVersionMutex.RUnlock()
 	fake.versionMutex.RLock()
 	defer fake.versionMutex.RUnlock()
+	fake.versionSchemaMutex.RLock()
+	defer fake.versionSchemaMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -62,6 +62,18 @@ type FakeTeam struct {
 		result2 db.Pagination
 		result3 error
 	}
+	CheckLimitsStub        func() (atc.TeamCheckLimits, error)
+	checkLimitsMutex       sync.RWMutex
+	checkLimitsArgsForCall []struct {
+	}
+	checkLimitsReturns struct {
+		result1 atc.TeamCheckLimits
+		result2 error
+	}
+	checkLimitsReturnsOnCall map[int]struct {
+		result1 atc.TeamCheckLimits
+		result2 error
+	}
 	ContainersStub        func() ([]db.Container, error)
 	containersMutex       sync.RWMutex
 	containersArgsForCall []struct {
@@ -109,6 +121,19 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteWorkerKeyStub        func(string) (bool, error)
+	deleteWorkerKeyMutex       sync.RWMutex
+	deleteWorkerKeyArgsForCall []struct {
+		arg1 string
+	}
+	deleteWorkerKeyReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteWorkerKeyReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FindCheckContainersStub        func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
@@ -385,6 +410,29 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	SetCheckLimitsStub        func(atc.TeamCheckLimits) error
+	setCheckLimitsMutex       sync.RWMutex
+	setCheckLimitsArgsForCall []struct {
+		arg1 atc.TeamCheckLimits
+	}
+	setCheckLimitsReturns struct {
+		result1 error
+	}
+	setCheckLimitsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetWorkerKeyStub        func(string, atc.SetWorkerKeyRequest) error
+	setWorkerKeyMutex       sync.RWMutex
+	setWorkerKeyArgsForCall []struct {
+		arg1 string
+		arg2 atc.SetWorkerKeyRequest
+	}
+	setWorkerKeyReturns struct {
+		result1 error
+	}
+	setWorkerKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -396,6 +444,18 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WorkerKeysStub        func() ([]db.WorkerKey, error)
+	workerKeysMutex       sync.RWMutex
+	workerKeysArgsForCall []struct {
+	}
+	workerKeysReturns struct {
+		result1 []db.WorkerKey
+		result2 error
+	}
+	workerKeysReturnsOnCall map[int]struct {
+		result1 []db.WorkerKey
+		result2 error
+	}
 	WorkersStub        func() ([]db.Worker, error)
 	workersMutex       sync.RWMutex
 	workersArgsForCall []struct {
@@ -648,6 +708,61 @@ func (fake *FakeTeam) BuildsWithTimeReturnsOnCall(i int, result1 []db.Build, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) CheckLimits() (atc.TeamCheckLimits, error) {
+	fake.checkLimitsMutex.Lock()
+	ret, specificReturn := fake.checkLimitsReturnsOnCall[len(fake.checkLimitsArgsForCall)]
+	fake.checkLimitsArgsForCall = append(fake.checkLimitsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CheckLimits", []interface{}{})
+	fake.checkLimitsMutex.Unlock()
+	if fake.CheckLimitsStub != nil {
+		return fake.CheckLimitsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checkLimitsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CheckLimitsCallCount() int {
+	fake.checkLimitsMutex.RLock()
+	defer fake.checkLimitsMutex.RUnlock()
+	return len(fake.checkLimitsArgsForCall)
+}
+
+func (fake *FakeTeam) CheckLimitsCalls(stub func() (atc.TeamCheckLimits, error)) {
+	fake.checkLimitsMutex.Lock()
+	defer fake.checkLimitsMutex.Unlock()
+	fake.CheckLimitsStub = stub
+}
+
+func (fake *FakeTeam) CheckLimitsReturns(result1 atc.TeamCheckLimits, result2 error) {
+	fake.checkLimitsMutex.Lock()
+	defer fake.checkLimitsMutex.Unlock()
+	fake.CheckLimitsStub = nil
+	fake.checkLimitsReturns = struct {
+		result1 atc.TeamCheckLimits
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CheckLimitsReturnsOnCall(i int, result1 atc.TeamCheckLimits, result2 error) {
+	fake.checkLimitsMutex.Lock()
+	defer fake.checkLimitsMutex.Unlock()
+	fake.CheckLimitsStub = nil
+	if fake.checkLimitsReturnsOnCall == nil {
+		fake.checkLimitsReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamCheckLimits
+			result2 error
+		})
+	}
+	fake.checkLimitsReturnsOnCall[i] = struct {
+		result1 atc.TeamCheckLimits
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Containers() ([]db.Container, error) {
 	fake.containersMutex.Lock()
 	ret, specificReturn := fake.containersReturnsOnCall[len(fake.containersArgsForCall)]
@@ -873,6 +988,69 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) DeleteWorkerKey(arg1 string) (bool, error) {
+	fake.deleteWorkerKeyMutex.Lock()
+	ret, specificReturn := fake.deleteWorkerKeyReturnsOnCall[len(fake.deleteWorkerKeyArgsForCall)]
+	fake.deleteWorkerKeyArgsForCall = append(fake.deleteWorkerKeyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteWorkerKey", []interface{}{arg1})
+	fake.deleteWorkerKeyMutex.Unlock()
+	if fake.DeleteWorkerKeyStub != nil {
+		return fake.DeleteWorkerKeyStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteWorkerKeyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DeleteWorkerKeyCallCount() int {
+	fake.deleteWorkerKeyMutex.RLock()
+	defer fake.deleteWorkerKeyMutex.RUnlock()
+	return len(fake.deleteWorkerKeyArgsForCall)
+}
+
+func (fake *FakeTeam) DeleteWorkerKeyCalls(stub func(string) (bool, error)) {
+	fake.deleteWorkerKeyMutex.Lock()
+	defer fake.deleteWorkerKeyMutex.Unlock()
+	fake.DeleteWorkerKeyStub = stub
+}
+
+func (fake *FakeTeam) DeleteWorkerKeyArgsForCall(i int) string {
+	fake.deleteWorkerKeyMutex.RLock()
+	defer fake.deleteWorkerKeyMutex.RUnlock()
+	argsForCall := fake.deleteWorkerKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DeleteWorkerKeyReturns(result1 bool, result2 error) {
+	fake.deleteWorkerKeyMutex.Lock()
+	defer fake.deleteWorkerKeyMutex.Unlock()
+	fake.DeleteWorkerKeyStub = nil
+	fake.deleteWorkerKeyReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeleteWorkerKeyReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteWorkerKeyMutex.Lock()
+	defer fake.deleteWorkerKeyMutex.Unlock()
+	fake.DeleteWorkerKeyStub = nil
+	if fake.deleteWorkerKeyReturnsOnCall == nil {
+		fake.deleteWorkerKeyReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteWorkerKeyReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 atc.PipelineRef, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
@@ -2131,6 +2309,127 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetCheckLimits(arg1 atc.TeamCheckLimits) error {
+	fake.setCheckLimitsMutex.Lock()
+	ret, specificReturn := fake.setCheckLimitsReturnsOnCall[len(fake.setCheckLimitsArgsForCall)]
+	fake.setCheckLimitsArgsForCall = append(fake.setCheckLimitsArgsForCall, struct {
+		arg1 atc.TeamCheckLimits
+	}{arg1})
+	fake.recordInvocation("SetCheckLimits", []interface{}{arg1})
+	fake.setCheckLimitsMutex.Unlock()
+	if fake.SetCheckLimitsStub != nil {
+		return fake.SetCheckLimitsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setCheckLimitsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetCheckLimitsCallCount() int {
+	fake.setCheckLimitsMutex.RLock()
+	defer fake.setCheckLimitsMutex.RUnlock()
+	return len(fake.setCheckLimitsArgsForCall)
+}
+
+func (fake *FakeTeam) SetCheckLimitsCalls(stub func(atc.TeamCheckLimits) error) {
+	fake.setCheckLimitsMutex.Lock()
+	defer fake.setCheckLimitsMutex.Unlock()
+	fake.SetCheckLimitsStub = stub
+}
+
+func (fake *FakeTeam) SetCheckLimitsArgsForCall(i int) atc.TeamCheckLimits {
+	fake.setCheckLimitsMutex.RLock()
+	defer fake.setCheckLimitsMutex.RUnlock()
+	argsForCall := fake.setCheckLimitsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetCheckLimitsReturns(result1 error) {
+	fake.setCheckLimitsMutex.Lock()
+	defer fake.setCheckLimitsMutex.Unlock()
+	fake.SetCheckLimitsStub = nil
+	fake.setCheckLimitsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetCheckLimitsReturnsOnCall(i int, result1 error) {
+	fake.setCheckLimitsMutex.Lock()
+	defer fake.setCheckLimitsMutex.Unlock()
+	fake.SetCheckLimitsStub = nil
+	if fake.setCheckLimitsReturnsOnCall == nil {
+		fake.setCheckLimitsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setCheckLimitsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetWorkerKey(arg1 string, arg2 atc.SetWorkerKeyRequest) error {
+	fake.setWorkerKeyMutex.Lock()
+	ret, specificReturn := fake.setWorkerKeyReturnsOnCall[len(fake.setWorkerKeyArgsForCall)]
+	fake.setWorkerKeyArgsForCall = append(fake.setWorkerKeyArgsForCall, struct {
+		arg1 string
+		arg2 atc.SetWorkerKeyRequest
+	}{arg1, arg2})
+	fake.recordInvocation("SetWorkerKey", []interface{}{arg1, arg2})
+	fake.setWorkerKeyMutex.Unlock()
+	if fake.SetWorkerKeyStub != nil {
+		return fake.SetWorkerKeyStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setWorkerKeyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetWorkerKeyCallCount() int {
+	fake.setWorkerKeyMutex.RLock()
+	defer fake.setWorkerKeyMutex.RUnlock()
+	return len(fake.setWorkerKeyArgsForCall)
+}
+
+func (fake *FakeTeam) SetWorkerKeyCalls(stub func(string, atc.SetWorkerKeyRequest) error) {
+	fake.setWorkerKeyMutex.Lock()
+	defer fake.setWorkerKeyMutex.Unlock()
+	fake.SetWorkerKeyStub = stub
+}
+
+func (fake *FakeTeam) SetWorkerKeyArgsForCall(i int) (string, atc.SetWorkerKeyRequest) {
+	fake.setWorkerKeyMutex.RLock()
+	defer fake.setWorkerKeyMutex.RUnlock()
+	argsForCall := fake.setWorkerKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) SetWorkerKeyReturns(result1 error) {
+	fake.setWorkerKeyMutex.Lock()
+	defer fake.setWorkerKeyMutex.Unlock()
+	fake.SetWorkerKeyStub = nil
+	fake.setWorkerKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetWorkerKeyReturnsOnCall(i int, result1 error) {
+	fake.setWorkerKeyMutex.Lock()
+	defer fake.setWorkerKeyMutex.Unlock()
+	fake.SetWorkerKeyStub = nil
+	if fake.setWorkerKeyReturnsOnCall == nil {
+		fake.setWorkerKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setWorkerKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2191,6 +2490,61 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) WorkerKeys() ([]db.WorkerKey, error) {
+	fake.workerKeysMutex.Lock()
+	ret, specificReturn := fake.workerKeysReturnsOnCall[len(fake.workerKeysArgsForCall)]
+	fake.workerKeysArgsForCall = append(fake.workerKeysArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WorkerKeys", []interface{}{})
+	fake.workerKeysMutex.Unlock()
+	if fake.WorkerKeysStub != nil {
+		return fake.WorkerKeysStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.workerKeysReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) WorkerKeysCallCount() int {
+	fake.workerKeysMutex.RLock()
+	defer fake.workerKeysMutex.RUnlock()
+	return len(fake.workerKeysArgsForCall)
+}
+
+func (fake *FakeTeam) WorkerKeysCalls(stub func() ([]db.WorkerKey, error)) {
+	fake.workerKeysMutex.Lock()
+	defer fake.workerKeysMutex.Unlock()
+	fake.WorkerKeysStub = stub
+}
+
+func (fake *FakeTeam) WorkerKeysReturns(result1 []db.WorkerKey, result2 error) {
+	fake.workerKeysMutex.Lock()
+	defer fake.workerKeysMutex.Unlock()
+	fake.WorkerKeysStub = nil
+	fake.workerKeysReturns = struct {
+		result1 []db.WorkerKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) WorkerKeysReturnsOnCall(i int, result1 []db.WorkerKey, result2 error) {
+	fake.workerKeysMutex.Lock()
+	defer fake.workerKeysMutex.Unlock()
+	fake.WorkerKeysStub = nil
+	if fake.workerKeysReturnsOnCall == nil {
+		fake.workerKeysReturnsOnCall = make(map[int]struct {
+			result1 []db.WorkerKey
+			result2 error
+		})
+	}
+	fake.workerKeysReturnsOnCall[i] = struct {
+		result1 []db.WorkerKey
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Workers() ([]db.Worker, error) {
 	fake.workersMutex.Lock()
 	ret, specificReturn := fake.workersReturnsOnCall[len(fake.workersArgsForCall)]
@@ -2257,6 +2611,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.buildsMutex.RUnlock()
 	fake.buildsWithTimeMutex.RLock()
 	defer fake.buildsWithTimeMutex.RUnlock()
+	fake.checkLimitsMutex.RLock()
+	defer fake.checkLimitsMutex.RUnlock()
 	fake.containersMutex.RLock()
 	defer fake.containersMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
@@ -2265,6 +2621,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createStartedBuildMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.deleteWorkerKeyMutex.RLock()
+	defer fake.deleteWorkerKeyMutex.RUnlock()
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	fake.findContainerByHandleMutex.RLock()
@@ -2305,8 +2663,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.setCheckLimitsMutex.RLock()
+	defer fake.setCheckLimitsMutex.RUnlock()
+	fake.setWorkerKeyMutex.RLock()
+	defer fake.setWorkerKeyMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.workerKeysMutex.RLock()
+	defer fake.workerKeysMutex.RUnlock()
 	fake.workersMutex.RLock()
 	defer fake.workersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -86,6 +86,18 @@ type FakeWorker struct {
 	decreaseActiveTasksReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DegradeStub        func() (bool, error)
+	degradeMutex       sync.RWMutex
+	degradeArgsForCall []struct {
+	}
+	degradeReturns struct {
+		result1 bool
+		result2 error
+	}
+	degradeReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DeleteStub        func() error
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -96,6 +108,16 @@ type FakeWorker struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	EnforcesEgressPoliciesStub        func() bool
+	enforcesEgressPoliciesMutex       sync.RWMutex
+	enforcesEgressPoliciesArgsForCall []struct {
+	}
+	enforcesEgressPoliciesReturns struct {
+		result1 bool
+	}
+	enforcesEgressPoliciesReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	EphemeralStub        func() bool
 	ephemeralMutex       sync.RWMutex
 	ephemeralArgsForCall []struct {
@@ -171,6 +193,16 @@ type FakeWorker struct {
 	increaseActiveTasksReturnsOnCall map[int]struct {
 		result1 error
 	}
+	LabelsStub        func() map[string]string
+	labelsMutex       sync.RWMutex
+	labelsArgsForCall []struct {
+	}
+	labelsReturns struct {
+		result1 map[string]string
+	}
+	labelsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
 	LandStub        func() error
 	landMutex       sync.RWMutex
 	landArgsForCall []struct {
@@ -181,6 +213,16 @@ type FakeWorker struct {
 	landReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MaintenanceStub        func() *db.MaintenanceWindow
+	maintenanceMutex       sync.RWMutex
+	maintenanceArgsForCall []struct {
+	}
+	maintenanceReturns struct {
+		result1 *db.MaintenanceWindow
+	}
+	maintenanceReturnsOnCall map[int]struct {
+		result1 *db.MaintenanceWindow
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -221,6 +263,18 @@ type FakeWorker struct {
 	pruneReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RecoverStub        func() (bool, error)
+	recoverMutex       sync.RWMutex
+	recoverArgsForCall []struct {
+	}
+	recoverReturns struct {
+		result1 bool
+		result2 error
+	}
+	recoverReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -257,6 +311,16 @@ type FakeWorker struct {
 	resourceTypesReturnsOnCall map[int]struct {
 		result1 []atc.WorkerResourceType
 	}
+	ResourcesStub        func() *atc.WorkerResources
+	resourcesMutex       sync.RWMutex
+	resourcesArgsForCall []struct {
+	}
+	resourcesReturns struct {
+		result1 *atc.WorkerResources
+	}
+	resourcesReturnsOnCall map[int]struct {
+		result1 *atc.WorkerResources
+	}
 	RetireStub        func() error
 	retireMutex       sync.RWMutex
 	retireArgsForCall []struct {
@@ -267,6 +331,27 @@ type FakeWorker struct {
 	retireReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RuntimeClassesStub        func() []string
+	runtimeClassesMutex       sync.RWMutex
+	runtimeClassesArgsForCall []struct {
+	}
+	runtimeClassesReturns struct {
+		result1 []string
+	}
+	runtimeClassesReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	SetResourcesStub        func(atc.WorkerResources) error
+	setResourcesMutex       sync.RWMutex
+	setResourcesArgsForCall []struct {
+		arg1 atc.WorkerResources
+	}
+	setResourcesReturns struct {
+		result1 error
+	}
+	setResourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartTimeStub        func() time.Time
 	startTimeMutex       sync.RWMutex
 	startTimeArgsForCall []struct {
@@ -336,15 +421,16 @@ func (fake *FakeWorker) ActiveContainers() int {
 	ret, specificReturn := fake.activeContainersReturnsOnCall[len(fake.activeContainersArgsForCall)]
 	fake.activeContainersArgsForCall = append(fake.activeContainersArgsForCall, struct {
 	}{})
+	stub := fake.ActiveContainersStub
+	fakeReturns := fake.activeContainersReturns
 	fake.recordInvocation("ActiveContainers", []interface{}{})
 	fake.activeContainersMutex.Unlock()
-	if fake.ActiveContainersStub != nil {
-		return fake.ActiveContainersStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.activeContainersReturns
 	return fakeReturns.result1
 }
 
@@ -388,15 +474,16 @@ func (fake *FakeWorker) ActiveTasks() (int, error) {
 	ret, specificReturn := fake.activeTasksReturnsOnCall[len(fake.activeTasksArgsForCall)]
 	fake.activeTasksArgsForCall = append(fake.activeTasksArgsForCall, struct {
 	}{})
+	stub := fake.ActiveTasksStub
+	fakeReturns := fake.activeTasksReturns
 	fake.recordInvocation("ActiveTasks", []interface{}{})
 	fake.activeTasksMutex.Unlock()
-	if fake.ActiveTasksStub != nil {
-		return fake.ActiveTasksStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.activeTasksReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -443,15 +530,16 @@ func (fake *FakeWorker) ActiveVolumes() int {
 	ret, specificReturn := fake.activeVolumesReturnsOnCall[len(fake.activeVolumesArgsForCall)]
 	fake.activeVolumesArgsForCall = append(fake.activeVolumesArgsForCall, struct {
 	}{})
+	stub := fake.ActiveVolumesStub
+	fakeReturns := fake.activeVolumesReturns
 	fake.recordInvocation("ActiveVolumes", []interface{}{})
 	fake.activeVolumesMutex.Unlock()
-	if fake.ActiveVolumesStub != nil {
-		return fake.ActiveVolumesStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.activeVolumesReturns
 	return fakeReturns.result1
 }
 
@@ -495,15 +583,16 @@ func (fake *FakeWorker) BaggageclaimURL() *string {
 	ret, specificReturn := fake.baggageclaimURLReturnsOnCall[len(fake.baggageclaimURLArgsForCall)]
 	fake.baggageclaimURLArgsForCall = append(fake.baggageclaimURLArgsForCall, struct {
 	}{})
+	stub := fake.BaggageclaimURLStub
+	fakeReturns := fake.baggageclaimURLReturns
 	fake.recordInvocation("BaggageclaimURL", []interface{}{})
 	fake.baggageclaimURLMutex.Unlock()
-	if fake.BaggageclaimURLStub != nil {
-		return fake.BaggageclaimURLStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.baggageclaimURLReturns
 	return fakeReturns.result1
 }
 
@@ -547,15 +636,16 @@ func (fake *FakeWorker) CertsPath() *string {
 	ret, specificReturn := fake.certsPathReturnsOnCall[len(fake.certsPathArgsForCall)]
 	fake.certsPathArgsForCall = append(fake.certsPathArgsForCall, struct {
 	}{})
+	stub := fake.CertsPathStub
+	fakeReturns := fake.certsPathReturns
 	fake.recordInvocation("CertsPath", []interface{}{})
 	fake.certsPathMutex.Unlock()
-	if fake.CertsPathStub != nil {
-		return fake.CertsPathStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.certsPathReturns
 	return fakeReturns.result1
 }
 
@@ -601,15 +691,16 @@ func (fake *FakeWorker) CreateContainer(arg1 db.ContainerOwner, arg2 db.Containe
 		arg1 db.ContainerOwner
 		arg2 db.ContainerMetadata
 	}{arg1, arg2})
+	stub := fake.CreateContainerStub
+	fakeReturns := fake.createContainerReturns
 	fake.recordInvocation("CreateContainer", []interface{}{arg1, arg2})
 	fake.createContainerMutex.Unlock()
-	if fake.CreateContainerStub != nil {
-		return fake.CreateContainerStub(arg1, arg2)
+	if stub != nil {
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.createContainerReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -663,15 +754,16 @@ func (fake *FakeWorker) DecreaseActiveTasks() error {
 	ret, specificReturn := fake.decreaseActiveTasksReturnsOnCall[len(fake.decreaseActiveTasksArgsForCall)]
 	fake.decreaseActiveTasksArgsForCall = append(fake.decreaseActiveTasksArgsForCall, struct {
 	}{})
+	stub := fake.DecreaseActiveTasksStub
+	fakeReturns := fake.decreaseActiveTasksReturns
 	fake.recordInvocation("DecreaseActiveTasks", []interface{}{})
 	fake.decreaseActiveTasksMutex.Unlock()
-	if fake.DecreaseActiveTasksStub != nil {
-		return fake.DecreaseActiveTasksStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.decreaseActiveTasksReturns
 	return fakeReturns.result1
 }
 
@@ -710,20 +802,77 @@ func (fake *FakeWorker) DecreaseActiveTasksReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Degrade() (bool, error) {
+	fake.degradeMutex.Lock()
+	ret, specificReturn := fake.degradeReturnsOnCall[len(fake.degradeArgsForCall)]
+	fake.degradeArgsForCall = append(fake.degradeArgsForCall, struct {
+	}{})
+	stub := fake.DegradeStub
+	fakeReturns := fake.degradeReturns
+	fake.recordInvocation("Degrade", []interface{}{})
+	fake.degradeMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) DegradeCallCount() int {
+	fake.degradeMutex.RLock()
+	defer fake.degradeMutex.RUnlock()
+	return len(fake.degradeArgsForCall)
+}
+
+func (fake *FakeWorker) DegradeCalls(stub func() (bool, error)) {
+	fake.degradeMutex.Lock()
+	defer fake.degradeMutex.Unlock()
+	fake.DegradeStub = stub
+}
+
+func (fake *FakeWorker) DegradeReturns(result1 bool, result2 error) {
+	fake.degradeMutex.Lock()
+	defer fake.degradeMutex.Unlock()
+	fake.DegradeStub = nil
+	fake.degradeReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) DegradeReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.degradeMutex.Lock()
+	defer fake.degradeMutex.Unlock()
+	fake.DegradeStub = nil
+	if fake.degradeReturnsOnCall == nil {
+		fake.degradeReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.degradeReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) Delete() error {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
 	fake.deleteArgsForCall = append(fake.deleteArgsForCall, struct {
 	}{})
+	stub := fake.DeleteStub
+	fakeReturns := fake.deleteReturns
 	fake.recordInvocation("Delete", []interface{}{})
 	fake.deleteMutex.Unlock()
-	if fake.DeleteStub != nil {
-		return fake.DeleteStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.deleteReturns
 	return fakeReturns.result1
 }
 
@@ -762,20 +911,74 @@ func (fake *FakeWorker) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) EnforcesEgressPolicies() bool {
+	fake.enforcesEgressPoliciesMutex.Lock()
+	ret, specificReturn := fake.enforcesEgressPoliciesReturnsOnCall[len(fake.enforcesEgressPoliciesArgsForCall)]
+	fake.enforcesEgressPoliciesArgsForCall = append(fake.enforcesEgressPoliciesArgsForCall, struct {
+	}{})
+	stub := fake.EnforcesEgressPoliciesStub
+	fakeReturns := fake.enforcesEgressPoliciesReturns
+	fake.recordInvocation("EnforcesEgressPolicies", []interface{}{})
+	fake.enforcesEgressPoliciesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) EnforcesEgressPoliciesCallCount() int {
+	fake.enforcesEgressPoliciesMutex.RLock()
+	defer fake.enforcesEgressPoliciesMutex.RUnlock()
+	return len(fake.enforcesEgressPoliciesArgsForCall)
+}
+
+func (fake *FakeWorker) EnforcesEgressPoliciesCalls(stub func() bool) {
+	fake.enforcesEgressPoliciesMutex.Lock()
+	defer fake.enforcesEgressPoliciesMutex.Unlock()
+	fake.EnforcesEgressPoliciesStub = stub
+}
+
+func (fake *FakeWorker) EnforcesEgressPoliciesReturns(result1 bool) {
+	fake.enforcesEgressPoliciesMutex.Lock()
+	defer fake.enforcesEgressPoliciesMutex.Unlock()
+	fake.EnforcesEgressPoliciesStub = nil
+	fake.enforcesEgressPoliciesReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorker) EnforcesEgressPoliciesReturnsOnCall(i int, result1 bool) {
+	fake.enforcesEgressPoliciesMutex.Lock()
+	defer fake.enforcesEgressPoliciesMutex.Unlock()
+	fake.EnforcesEgressPoliciesStub = nil
+	if fake.enforcesEgressPoliciesReturnsOnCall == nil {
+		fake.enforcesEgressPoliciesReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.enforcesEgressPoliciesReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeWorker) Ephemeral() bool {
 	fake.ephemeralMutex.Lock()
 	ret, specificReturn := fake.ephemeralReturnsOnCall[len(fake.ephemeralArgsForCall)]
 	fake.ephemeralArgsForCall = append(fake.ephemeralArgsForCall, struct {
 	}{})
+	stub := fake.EphemeralStub
+	fakeReturns := fake.ephemeralReturns
 	fake.recordInvocation("Ephemeral", []interface{}{})
 	fake.ephemeralMutex.Unlock()
-	if fake.EphemeralStub != nil {
-		return fake.EphemeralStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.ephemeralReturns
 	return fakeReturns.result1
 }
 
@@ -819,15 +1022,16 @@ func (fake *FakeWorker) ExpiresAt() time.Time {
 	ret, specificReturn := fake.expiresAtReturnsOnCall[len(fake.expiresAtArgsForCall)]
 	fake.expiresAtArgsForCall = append(fake.expiresAtArgsForCall, struct {
 	}{})
+	stub := fake.ExpiresAtStub
+	fakeReturns := fake.expiresAtReturns
 	fake.recordInvocation("ExpiresAt", []interface{}{})
 	fake.expiresAtMutex.Unlock()
-	if fake.ExpiresAtStub != nil {
-		return fake.ExpiresAtStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.expiresAtReturns
 	return fakeReturns.result1
 }
 
@@ -872,15 +1076,16 @@ func (fake *FakeWorker) FindContainer(arg1 db.ContainerOwner) (db.CreatingContai
 	fake.findContainerArgsForCall = append(fake.findContainerArgsForCall, struct {
 		arg1 db.ContainerOwner
 	}{arg1})
+	stub := fake.FindContainerStub
+	fakeReturns := fake.findContainerReturns
 	fake.recordInvocation("FindContainer", []interface{}{arg1})
 	fake.findContainerMutex.Unlock()
-	if fake.FindContainerStub != nil {
-		return fake.FindContainerStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.findContainerReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -937,15 +1142,16 @@ func (fake *FakeWorker) GardenAddr() *string {
 	ret, specificReturn := fake.gardenAddrReturnsOnCall[len(fake.gardenAddrArgsForCall)]
 	fake.gardenAddrArgsForCall = append(fake.gardenAddrArgsForCall, struct {
 	}{})
+	stub := fake.GardenAddrStub
+	fakeReturns := fake.gardenAddrReturns
 	fake.recordInvocation("GardenAddr", []interface{}{})
 	fake.gardenAddrMutex.Unlock()
-	if fake.GardenAddrStub != nil {
-		return fake.GardenAddrStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.gardenAddrReturns
 	return fakeReturns.result1
 }
 
@@ -989,15 +1195,16 @@ func (fake *FakeWorker) HTTPProxyURL() string {
 	ret, specificReturn := fake.hTTPProxyURLReturnsOnCall[len(fake.hTTPProxyURLArgsForCall)]
 	fake.hTTPProxyURLArgsForCall = append(fake.hTTPProxyURLArgsForCall, struct {
 	}{})
+	stub := fake.HTTPProxyURLStub
+	fakeReturns := fake.hTTPProxyURLReturns
 	fake.recordInvocation("HTTPProxyURL", []interface{}{})
 	fake.hTTPProxyURLMutex.Unlock()
-	if fake.HTTPProxyURLStub != nil {
-		return fake.HTTPProxyURLStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.hTTPProxyURLReturns
 	return fakeReturns.result1
 }
 
@@ -1041,15 +1248,16 @@ func (fake *FakeWorker) HTTPSProxyURL() string {
 	ret, specificReturn := fake.hTTPSProxyURLReturnsOnCall[len(fake.hTTPSProxyURLArgsForCall)]
 	fake.hTTPSProxyURLArgsForCall = append(fake.hTTPSProxyURLArgsForCall, struct {
 	}{})
+	stub := fake.HTTPSProxyURLStub
+	fakeReturns := fake.hTTPSProxyURLReturns
 	fake.recordInvocation("HTTPSProxyURL", []interface{}{})
 	fake.hTTPSProxyURLMutex.Unlock()
-	if fake.HTTPSProxyURLStub != nil {
-		return fake.HTTPSProxyURLStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.hTTPSProxyURLReturns
 	return fakeReturns.result1
 }
 
@@ -1093,15 +1301,16 @@ func (fake *FakeWorker) IncreaseActiveTasks() error {
 	ret, specificReturn := fake.increaseActiveTasksReturnsOnCall[len(fake.increaseActiveTasksArgsForCall)]
 	fake.increaseActiveTasksArgsForCall = append(fake.increaseActiveTasksArgsForCall, struct {
 	}{})
+	stub := fake.IncreaseActiveTasksStub
+	fakeReturns := fake.increaseActiveTasksReturns
 	fake.recordInvocation("IncreaseActiveTasks", []interface{}{})
 	fake.increaseActiveTasksMutex.Unlock()
-	if fake.IncreaseActiveTasksStub != nil {
-		return fake.IncreaseActiveTasksStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.increaseActiveTasksReturns
 	return fakeReturns.result1
 }
 
@@ -1140,20 +1349,74 @@ func (fake *FakeWorker) IncreaseActiveTasksReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Labels() map[string]string {
+	fake.labelsMutex.Lock()
+	ret, specificReturn := fake.labelsReturnsOnCall[len(fake.labelsArgsForCall)]
+	fake.labelsArgsForCall = append(fake.labelsArgsForCall, struct {
+	}{})
+	stub := fake.LabelsStub
+	fakeReturns := fake.labelsReturns
+	fake.recordInvocation("Labels", []interface{}{})
+	fake.labelsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) LabelsCallCount() int {
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
+	return len(fake.labelsArgsForCall)
+}
+
+func (fake *FakeWorker) LabelsCalls(stub func() map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = stub
+}
+
+func (fake *FakeWorker) LabelsReturns(result1 map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	fake.labelsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeWorker) LabelsReturnsOnCall(i int, result1 map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	if fake.labelsReturnsOnCall == nil {
+		fake.labelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.labelsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
 func (fake *FakeWorker) Land() error {
 	fake.landMutex.Lock()
 	ret, specificReturn := fake.landReturnsOnCall[len(fake.landArgsForCall)]
 	fake.landArgsForCall = append(fake.landArgsForCall, struct {
 	}{})
+	stub := fake.LandStub
+	fakeReturns := fake.landReturns
 	fake.recordInvocation("Land", []interface{}{})
 	fake.landMutex.Unlock()
-	if fake.LandStub != nil {
-		return fake.LandStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.landReturns
 	return fakeReturns.result1
 }
 
@@ -1192,20 +1455,74 @@ func (fake *FakeWorker) LandReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Maintenance() *db.MaintenanceWindow {
+	fake.maintenanceMutex.Lock()
+	ret, specificReturn := fake.maintenanceReturnsOnCall[len(fake.maintenanceArgsForCall)]
+	fake.maintenanceArgsForCall = append(fake.maintenanceArgsForCall, struct {
+	}{})
+	stub := fake.MaintenanceStub
+	fakeReturns := fake.maintenanceReturns
+	fake.recordInvocation("Maintenance", []interface{}{})
+	fake.maintenanceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) MaintenanceCallCount() int {
+	fake.maintenanceMutex.RLock()
+	defer fake.maintenanceMutex.RUnlock()
+	return len(fake.maintenanceArgsForCall)
+}
+
+func (fake *FakeWorker) MaintenanceCalls(stub func() *db.MaintenanceWindow) {
+	fake.maintenanceMutex.Lock()
+	defer fake.maintenanceMutex.Unlock()
+	fake.MaintenanceStub = stub
+}
+
+func (fake *FakeWorker) MaintenanceReturns(result1 *db.MaintenanceWindow) {
+	fake.maintenanceMutex.Lock()
+	defer fake.maintenanceMutex.Unlock()
+	fake.MaintenanceStub = nil
+	fake.maintenanceReturns = struct {
+		result1 *db.MaintenanceWindow
+	}{result1}
+}
+
+func (fake *FakeWorker) MaintenanceReturnsOnCall(i int, result1 *db.MaintenanceWindow) {
+	fake.maintenanceMutex.Lock()
+	defer fake.maintenanceMutex.Unlock()
+	fake.MaintenanceStub = nil
+	if fake.maintenanceReturnsOnCall == nil {
+		fake.maintenanceReturnsOnCall = make(map[int]struct {
+			result1 *db.MaintenanceWindow
+		})
+	}
+	fake.maintenanceReturnsOnCall[i] = struct {
+		result1 *db.MaintenanceWindow
+	}{result1}
+}
+
 func (fake *FakeWorker) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
 	fake.nameArgsForCall = append(fake.nameArgsForCall, struct {
 	}{})
+	stub := fake.NameStub
+	fakeReturns := fake.nameReturns
 	fake.recordInvocation("Name", []interface{}{})
 	fake.nameMutex.Unlock()
-	if fake.NameStub != nil {
-		return fake.NameStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.nameReturns
 	return fakeReturns.result1
 }
 
@@ -1249,15 +1566,16 @@ func (fake *FakeWorker) NoProxy() string {
 	ret, specificReturn := fake.noProxyReturnsOnCall[len(fake.noProxyArgsForCall)]
 	fake.noProxyArgsForCall = append(fake.noProxyArgsForCall, struct {
 	}{})
+	stub := fake.NoProxyStub
+	fakeReturns := fake.noProxyReturns
 	fake.recordInvocation("NoProxy", []interface{}{})
 	fake.noProxyMutex.Unlock()
-	if fake.NoProxyStub != nil {
-		return fake.NoProxyStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.noProxyReturns
 	return fakeReturns.result1
 }
 
@@ -1301,15 +1619,16 @@ func (fake *FakeWorker) Platform() string {
 	ret, specificReturn := fake.platformReturnsOnCall[len(fake.platformArgsForCall)]
 	fake.platformArgsForCall = append(fake.platformArgsForCall, struct {
 	}{})
+	stub := fake.PlatformStub
+	fakeReturns := fake.platformReturns
 	fake.recordInvocation("Platform", []interface{}{})
 	fake.platformMutex.Unlock()
-	if fake.PlatformStub != nil {
-		return fake.PlatformStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.platformReturns
 	return fakeReturns.result1
 }
 
@@ -1353,15 +1672,16 @@ func (fake *FakeWorker) Prune() error {
 	ret, specificReturn := fake.pruneReturnsOnCall[len(fake.pruneArgsForCall)]
 	fake.pruneArgsForCall = append(fake.pruneArgsForCall, struct {
 	}{})
+	stub := fake.PruneStub
+	fakeReturns := fake.pruneReturns
 	fake.recordInvocation("Prune", []interface{}{})
 	fake.pruneMutex.Unlock()
-	if fake.PruneStub != nil {
-		return fake.PruneStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.pruneReturns
 	return fakeReturns.result1
 }
 
@@ -1400,20 +1720,77 @@ func (fake *FakeWorker) PruneReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Recover() (bool, error) {
+	fake.recoverMutex.Lock()
+	ret, specificReturn := fake.recoverReturnsOnCall[len(fake.recoverArgsForCall)]
+	fake.recoverArgsForCall = append(fake.recoverArgsForCall, struct {
+	}{})
+	stub := fake.RecoverStub
+	fakeReturns := fake.recoverReturns
+	fake.recordInvocation("Recover", []interface{}{})
+	fake.recoverMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) RecoverCallCount() int {
+	fake.recoverMutex.RLock()
+	defer fake.recoverMutex.RUnlock()
+	return len(fake.recoverArgsForCall)
+}
+
+func (fake *FakeWorker) RecoverCalls(stub func() (bool, error)) {
+	fake.recoverMutex.Lock()
+	defer fake.recoverMutex.Unlock()
+	fake.RecoverStub = stub
+}
+
+func (fake *FakeWorker) RecoverReturns(result1 bool, result2 error) {
+	fake.recoverMutex.Lock()
+	defer fake.recoverMutex.Unlock()
+	fake.RecoverStub = nil
+	fake.recoverReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) RecoverReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.recoverMutex.Lock()
+	defer fake.recoverMutex.Unlock()
+	fake.RecoverStub = nil
+	if fake.recoverReturnsOnCall == nil {
+		fake.recoverReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.recoverReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
 	fake.reloadArgsForCall = append(fake.reloadArgsForCall, struct {
 	}{})
+	stub := fake.ReloadStub
+	fakeReturns := fake.reloadReturns
 	fake.recordInvocation("Reload", []interface{}{})
 	fake.reloadMutex.Unlock()
-	if fake.ReloadStub != nil {
-		return fake.ReloadStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.reloadReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -1460,15 +1837,16 @@ func (fake *FakeWorker) ResourceCerts() (*db.UsedWorkerResourceCerts, bool, erro
 	ret, specificReturn := fake.resourceCertsReturnsOnCall[len(fake.resourceCertsArgsForCall)]
 	fake.resourceCertsArgsForCall = append(fake.resourceCertsArgsForCall, struct {
 	}{})
+	stub := fake.ResourceCertsStub
+	fakeReturns := fake.resourceCertsReturns
 	fake.recordInvocation("ResourceCerts", []interface{}{})
 	fake.resourceCertsMutex.Unlock()
-	if fake.ResourceCertsStub != nil {
-		return fake.ResourceCertsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.resourceCertsReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -1518,15 +1896,16 @@ func (fake *FakeWorker) ResourceTypes() []atc.WorkerResourceType {
 	ret, specificReturn := fake.resourceTypesReturnsOnCall[len(fake.resourceTypesArgsForCall)]
 	fake.resourceTypesArgsForCall = append(fake.resourceTypesArgsForCall, struct {
 	}{})
+	stub := fake.ResourceTypesStub
+	fakeReturns := fake.resourceTypesReturns
 	fake.recordInvocation("ResourceTypes", []interface{}{})
 	fake.resourceTypesMutex.Unlock()
-	if fake.ResourceTypesStub != nil {
-		return fake.ResourceTypesStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.resourceTypesReturns
 	return fakeReturns.result1
 }
 
@@ -1565,20 +1944,74 @@ func (fake *FakeWorker) ResourceTypesReturnsOnCall(i int, result1 []atc.WorkerRe
 	}{result1}
 }
 
+func (fake *FakeWorker) Resources() *atc.WorkerResources {
+	fake.resourcesMutex.Lock()
+	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
+	fake.resourcesArgsForCall = append(fake.resourcesArgsForCall, struct {
+	}{})
+	stub := fake.ResourcesStub
+	fakeReturns := fake.resourcesReturns
+	fake.recordInvocation("Resources", []interface{}{})
+	fake.resourcesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ResourcesCallCount() int {
+	fake.resourcesMutex.RLock()
+	defer fake.resourcesMutex.RUnlock()
+	return len(fake.resourcesArgsForCall)
+}
+
+func (fake *FakeWorker) ResourcesCalls(stub func() *atc.WorkerResources) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = stub
+}
+
+func (fake *FakeWorker) ResourcesReturns(result1 *atc.WorkerResources) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = nil
+	fake.resourcesReturns = struct {
+		result1 *atc.WorkerResources
+	}{result1}
+}
+
+func (fake *FakeWorker) ResourcesReturnsOnCall(i int, result1 *atc.WorkerResources) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = nil
+	if fake.resourcesReturnsOnCall == nil {
+		fake.resourcesReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerResources
+		})
+	}
+	fake.resourcesReturnsOnCall[i] = struct {
+		result1 *atc.WorkerResources
+	}{result1}
+}
+
 func (fake *FakeWorker) Retire() error {
 	fake.retireMutex.Lock()
 	ret, specificReturn := fake.retireReturnsOnCall[len(fake.retireArgsForCall)]
 	fake.retireArgsForCall = append(fake.retireArgsForCall, struct {
 	}{})
+	stub := fake.RetireStub
+	fakeReturns := fake.retireReturns
 	fake.recordInvocation("Retire", []interface{}{})
 	fake.retireMutex.Unlock()
-	if fake.RetireStub != nil {
-		return fake.RetireStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.retireReturns
 	return fakeReturns.result1
 }
 
@@ -1617,20 +2050,135 @@ func (fake *FakeWorker) RetireReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) RuntimeClasses() []string {
+	fake.runtimeClassesMutex.Lock()
+	ret, specificReturn := fake.runtimeClassesReturnsOnCall[len(fake.runtimeClassesArgsForCall)]
+	fake.runtimeClassesArgsForCall = append(fake.runtimeClassesArgsForCall, struct {
+	}{})
+	stub := fake.RuntimeClassesStub
+	fakeReturns := fake.runtimeClassesReturns
+	fake.recordInvocation("RuntimeClasses", []interface{}{})
+	fake.runtimeClassesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) RuntimeClassesCallCount() int {
+	fake.runtimeClassesMutex.RLock()
+	defer fake.runtimeClassesMutex.RUnlock()
+	return len(fake.runtimeClassesArgsForCall)
+}
+
+func (fake *FakeWorker) RuntimeClassesCalls(stub func() []string) {
+	fake.runtimeClassesMutex.Lock()
+	defer fake.runtimeClassesMutex.Unlock()
+	fake.RuntimeClassesStub = stub
+}
+
+func (fake *FakeWorker) RuntimeClassesReturns(result1 []string) {
+	fake.runtimeClassesMutex.Lock()
+	defer fake.runtimeClassesMutex.Unlock()
+	fake.RuntimeClassesStub = nil
+	fake.runtimeClassesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeWorker) RuntimeClassesReturnsOnCall(i int, result1 []string) {
+	fake.runtimeClassesMutex.Lock()
+	defer fake.runtimeClassesMutex.Unlock()
+	fake.RuntimeClassesStub = nil
+	if fake.runtimeClassesReturnsOnCall == nil {
+		fake.runtimeClassesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.runtimeClassesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeWorker) SetResources(arg1 atc.WorkerResources) error {
+	fake.setResourcesMutex.Lock()
+	ret, specificReturn := fake.setResourcesReturnsOnCall[len(fake.setResourcesArgsForCall)]
+	fake.setResourcesArgsForCall = append(fake.setResourcesArgsForCall, struct {
+		arg1 atc.WorkerResources
+	}{arg1})
+	stub := fake.SetResourcesStub
+	fakeReturns := fake.setResourcesReturns
+	fake.recordInvocation("SetResources", []interface{}{arg1})
+	fake.setResourcesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) SetResourcesCallCount() int {
+	fake.setResourcesMutex.RLock()
+	defer fake.setResourcesMutex.RUnlock()
+	return len(fake.setResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) SetResourcesCalls(stub func(atc.WorkerResources) error) {
+	fake.setResourcesMutex.Lock()
+	defer fake.setResourcesMutex.Unlock()
+	fake.SetResourcesStub = stub
+}
+
+func (fake *FakeWorker) SetResourcesArgsForCall(i int) atc.WorkerResources {
+	fake.setResourcesMutex.RLock()
+	defer fake.setResourcesMutex.RUnlock()
+	argsForCall := fake.setResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) SetResourcesReturns(result1 error) {
+	fake.setResourcesMutex.Lock()
+	defer fake.setResourcesMutex.Unlock()
+	fake.SetResourcesStub = nil
+	fake.setResourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) SetResourcesReturnsOnCall(i int, result1 error) {
+	fake.setResourcesMutex.Lock()
+	defer fake.setResourcesMutex.Unlock()
+	fake.SetResourcesStub = nil
+	if fake.setResourcesReturnsOnCall == nil {
+		fake.setResourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setResourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) StartTime() time.Time {
 	fake.startTimeMutex.Lock()
 	ret, specificReturn := fake.startTimeReturnsOnCall[len(fake.startTimeArgsForCall)]
 	fake.startTimeArgsForCall = append(fake.startTimeArgsForCall, struct {
 	}{})
+	stub := fake.StartTimeStub
+	fakeReturns := fake.startTimeReturns
 	fake.recordInvocation("StartTime", []interface{}{})
 	fake.startTimeMutex.Unlock()
-	if fake.StartTimeStub != nil {
-		return fake.StartTimeStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.startTimeReturns
 	return fakeReturns.result1
 }
 
@@ -1674,15 +2222,16 @@ func (fake *FakeWorker) State() db.WorkerState {
 	ret, specificReturn := fake.stateReturnsOnCall[len(fake.stateArgsForCall)]
 	fake.stateArgsForCall = append(fake.stateArgsForCall, struct {
 	}{})
+	stub := fake.StateStub
+	fakeReturns := fake.stateReturns
 	fake.recordInvocation("State", []interface{}{})
 	fake.stateMutex.Unlock()
-	if fake.StateStub != nil {
-		return fake.StateStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.stateReturns
 	return fakeReturns.result1
 }
 
@@ -1726,15 +2275,16 @@ func (fake *FakeWorker) Tags() []string {
 	ret, specificReturn := fake.tagsReturnsOnCall[len(fake.tagsArgsForCall)]
 	fake.tagsArgsForCall = append(fake.tagsArgsForCall, struct {
 	}{})
+	stub := fake.TagsStub
+	fakeReturns := fake.tagsReturns
 	fake.recordInvocation("Tags", []interface{}{})
 	fake.tagsMutex.Unlock()
-	if fake.TagsStub != nil {
-		return fake.TagsStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.tagsReturns
 	return fakeReturns.result1
 }
 
@@ -1778,15 +2328,16 @@ func (fake *FakeWorker) TeamID() int {
 	ret, specificReturn := fake.teamIDReturnsOnCall[len(fake.teamIDArgsForCall)]
 	fake.teamIDArgsForCall = append(fake.teamIDArgsForCall, struct {
 	}{})
+	stub := fake.TeamIDStub
+	fakeReturns := fake.teamIDReturns
 	fake.recordInvocation("TeamID", []interface{}{})
 	fake.teamIDMutex.Unlock()
-	if fake.TeamIDStub != nil {
-		return fake.TeamIDStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.teamIDReturns
 	return fakeReturns.result1
 }
 
@@ -1830,15 +2381,16 @@ func (fake *FakeWorker) TeamName() string {
 	ret, specificReturn := fake.teamNameReturnsOnCall[len(fake.teamNameArgsForCall)]
 	fake.teamNameArgsForCall = append(fake.teamNameArgsForCall, struct {
 	}{})
+	stub := fake.TeamNameStub
+	fakeReturns := fake.teamNameReturns
 	fake.recordInvocation("TeamName", []interface{}{})
 	fake.teamNameMutex.Unlock()
-	if fake.TeamNameStub != nil {
-		return fake.TeamNameStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.teamNameReturns
 	return fakeReturns.result1
 }
 
@@ -1882,15 +2434,16 @@ func (fake *FakeWorker) Version() *string {
 	ret, specificReturn := fake.versionReturnsOnCall[len(fake.versionArgsForCall)]
 	fake.versionArgsForCall = append(fake.versionArgsForCall, struct {
 	}{})
+	stub := fake.VersionStub
+	fakeReturns := fake.versionReturns
 	fake.recordInvocation("Version", []interface{}{})
 	fake.versionMutex.Unlock()
-	if fake.VersionStub != nil {
-		return fake.VersionStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.versionReturns
 	return fakeReturns.result1
 }
 
@@ -1946,8 +2499,12 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.createContainerMutex.RUnlock()
 	fake.decreaseActiveTasksMutex.RLock()
 	defer fake.decreaseActiveTasksMutex.RUnlock()
+	fake.degradeMutex.RLock()
+	defer fake.degradeMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.enforcesEgressPoliciesMutex.RLock()
+	defer fake.enforcesEgressPoliciesMutex.RUnlock()
 	fake.ephemeralMutex.RLock()
 	defer fake.ephemeralMutex.RUnlock()
 	fake.expiresAtMutex.RLock()
@@ -1962,8 +2519,12 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.hTTPSProxyURLMutex.RUnlock()
 	fake.increaseActiveTasksMutex.RLock()
 	defer fake.increaseActiveTasksMutex.RUnlock()
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
 	fake.landMutex.RLock()
 	defer fake.landMutex.RUnlock()
+	fake.maintenanceMutex.RLock()
+	defer fake.maintenanceMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.noProxyMutex.RLock()
@@ -1972,14 +2533,22 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.platformMutex.RUnlock()
 	fake.pruneMutex.RLock()
 	defer fake.pruneMutex.RUnlock()
+	fake.recoverMutex.RLock()
+	defer fake.recoverMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
 	fake.resourceCertsMutex.RLock()
 	defer fake.resourceCertsMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
 	defer fake.resourceTypesMutex.RUnlock()
+	fake.resourcesMutex.RLock()
+	defer fake.resourcesMutex.RUnlock()
 	fake.retireMutex.RLock()
 	defer fake.retireMutex.RUnlock()
+	fake.runtimeClassesMutex.RLock()
+	defer fake.runtimeClassesMutex.RUnlock()
+	fake.setResourcesMutex.RLock()
+	defer fake.setResourcesMutex.RUnlock()
 	fake.startTimeMutex.RLock()
 	defer fake.startTimeMutex.RUnlock()
 	fake.stateMutex.RLock()
@@ -29,6 +29,16 @@ type FakeWorkerArtifact struct {
 	createdAtReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	DestroyStub        func() error
+	destroyMutex       sync.RWMutex
+	destroyArgsForCall []struct {
+	}
+	destroyReturns struct {
+		result1 error
+	}
+	destroyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	IDStub        func() int
 	iDMutex       sync.RWMutex
 	iDArgsForCall []struct {
@@ -73,15 +83,16 @@ func (fake *FakeWorkerArtifact) BuildID() int {
 	ret, specificReturn := fake.buildIDReturnsOnCall[len(fake.buildIDArgsForCall)]
 	fake.buildIDArgsForCall = append(fake.buildIDArgsForCall, struct {
 	}{})
+	stub := fake.BuildIDStub
+	fakeReturns := fake.buildIDReturns
 	fake.recordInvocation("BuildID", []interface{}{})
 	fake.buildIDMutex.Unlock()
-	if fake.BuildIDStub != nil {
-		return fake.BuildIDStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.buildIDReturns
 	return fakeReturns.result1
 }
 
@@ -125,15 +136,16 @@ func (fake *FakeWorkerArtifact) CreatedAt() time.Time {
 	ret, specificReturn := fake.createdAtReturnsOnCall[len(fake.createdAtArgsForCall)]
 	fake.createdAtArgsForCall = append(fake.createdAtArgsForCall, struct {
 	}{})
+	stub := fake.CreatedAtStub
+	fakeReturns := fake.createdAtReturns
 	fake.recordInvocation("CreatedAt", []interface{}{})
 	fake.createdAtMutex.Unlock()
-	if fake.CreatedAtStub != nil {
-		return fake.CreatedAtStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.createdAtReturns
 	return fakeReturns.result1
 }
 
@@ -172,20 +184,74 @@ func (fake *FakeWorkerArtifact) CreatedAtReturnsOnCall(i int, result1 time.Time)
 	}{result1}
 }
 
+func (fake *FakeWorkerArtifact) Destroy() error {
+	fake.destroyMutex.Lock()
+	ret, specificReturn := fake.destroyReturnsOnCall[len(fake.destroyArgsForCall)]
+	fake.destroyArgsForCall = append(fake.destroyArgsForCall, struct {
+	}{})
+	stub := fake.DestroyStub
+	fakeReturns := fake.destroyReturns
+	fake.recordInvocation("Destroy", []interface{}{})
+	fake.destroyMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkerArtifact) DestroyCallCount() int {
+	fake.destroyMutex.RLock()
+	defer fake.destroyMutex.RUnlock()
+	return len(fake.destroyArgsForCall)
+}
+
+func (fake *FakeWorkerArtifact) DestroyCalls(stub func() error) {
+	fake.destroyMutex.Lock()
+	defer fake.destroyMutex.Unlock()
+	fake.DestroyStub = stub
+}
+
+func (fake *FakeWorkerArtifact) DestroyReturns(result1 error) {
+	fake.destroyMutex.Lock()
+	defer fake.destroyMutex.Unlock()
+	fake.DestroyStub = nil
+	fake.destroyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorkerArtifact) DestroyReturnsOnCall(i int, result1 error) {
+	fake.destroyMutex.Lock()
+	defer fake.destroyMutex.Unlock()
+	fake.DestroyStub = nil
+	if fake.destroyReturnsOnCall == nil {
+		fake.destroyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.destroyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorkerArtifact) ID() int {
 	fake.iDMutex.Lock()
 	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
 	fake.iDArgsForCall = append(fake.iDArgsForCall, struct {
 	}{})
+	stub := fake.IDStub
+	fakeReturns := fake.iDReturns
 	fake.recordInvocation("ID", []interface{}{})
 	fake.iDMutex.Unlock()
-	if fake.IDStub != nil {
-		return fake.IDStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.iDReturns
 	return fakeReturns.result1
 }
 
@@ -229,15 +295,16 @@ func (fake *FakeWorkerArtifact) Name() string {
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
 	fake.nameArgsForCall = append(fake.nameArgsForCall, struct {
 	}{})
+	stub := fake.NameStub
+	fakeReturns := fake.nameReturns
 	fake.recordInvocation("Name", []interface{}{})
 	fake.nameMutex.Unlock()
-	if fake.NameStub != nil {
-		return fake.NameStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.nameReturns
 	return fakeReturns.result1
 }
 
@@ -282,15 +349,16 @@ func (fake *FakeWorkerArtifact) Volume(arg1 int) (db.CreatedVolume, bool, error)
 	fake.volumeArgsForCall = append(fake.volumeArgsForCall, struct {
 		arg1 int
 	}{arg1})
+	stub := fake.VolumeStub
+	fakeReturns := fake.volumeReturns
 	fake.recordInvocation("Volume", []interface{}{arg1})
 	fake.volumeMutex.Unlock()
-	if fake.VolumeStub != nil {
-		return fake.VolumeStub(arg1)
+	if stub != nil {
+		return stub(arg1)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	fakeReturns := fake.volumeReturns
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
@@ -349,6 +417,8 @@ func (fake *FakeWorkerArtifact) Invocations() map[string][][]interface{} {
 	defer fake.buildIDMutex.RUnlock()
 	fake.createdAtMutex.RLock()
 	defer fake.createdAtMutex.RUnlock()
+	fake.destroyMutex.RLock()
+	defer fake.destroyMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
 	fake.nameMutex.RLock()
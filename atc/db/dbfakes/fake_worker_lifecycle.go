@@ -8,6 +8,18 @@ import (
 )
 
 type FakeWorkerLifecycle struct {
+	AbortBuildsInMaintenanceStub        func() ([]int, error)
+	abortBuildsInMaintenanceMutex       sync.RWMutex
+	abortBuildsInMaintenanceArgsForCall []struct {
+	}
+	abortBuildsInMaintenanceReturns struct {
+		result1 []int
+		result2 error
+	}
+	abortBuildsInMaintenanceReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
 	DeleteFinishedRetiringWorkersStub        func() ([]string, error)
 	deleteFinishedRetiringWorkersMutex       sync.RWMutex
 	deleteFinishedRetiringWorkersArgsForCall []struct {
@@ -72,20 +84,77 @@ type FakeWorkerLifecycle struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeWorkerLifecycle) AbortBuildsInMaintenance() ([]int, error) {
+	fake.abortBuildsInMaintenanceMutex.Lock()
+	ret, specificReturn := fake.abortBuildsInMaintenanceReturnsOnCall[len(fake.abortBuildsInMaintenanceArgsForCall)]
+	fake.abortBuildsInMaintenanceArgsForCall = append(fake.abortBuildsInMaintenanceArgsForCall, struct {
+	}{})
+	stub := fake.AbortBuildsInMaintenanceStub
+	fakeReturns := fake.abortBuildsInMaintenanceReturns
+	fake.recordInvocation("AbortBuildsInMaintenance", []interface{}{})
+	fake.abortBuildsInMaintenanceMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerLifecycle) AbortBuildsInMaintenanceCallCount() int {
+	fake.abortBuildsInMaintenanceMutex.RLock()
+	defer fake.abortBuildsInMaintenanceMutex.RUnlock()
+	return len(fake.abortBuildsInMaintenanceArgsForCall)
+}
+
+func (fake *FakeWorkerLifecycle) AbortBuildsInMaintenanceCalls(stub func() ([]int, error)) {
+	fake.abortBuildsInMaintenanceMutex.Lock()
+	defer fake.abortBuildsInMaintenanceMutex.Unlock()
+	fake.AbortBuildsInMaintenanceStub = stub
+}
+
+func (fake *FakeWorkerLifecycle) AbortBuildsInMaintenanceReturns(result1 []int, result2 error) {
+	fake.abortBuildsInMaintenanceMutex.Lock()
+	defer fake.abortBuildsInMaintenanceMutex.Unlock()
+	fake.AbortBuildsInMaintenanceStub = nil
+	fake.abortBuildsInMaintenanceReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerLifecycle) AbortBuildsInMaintenanceReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.abortBuildsInMaintenanceMutex.Lock()
+	defer fake.abortBuildsInMaintenanceMutex.Unlock()
+	fake.AbortBuildsInMaintenanceStub = nil
+	if fake.abortBuildsInMaintenanceReturnsOnCall == nil {
+		fake.abortBuildsInMaintenanceReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.abortBuildsInMaintenanceReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorkerLifecycle) DeleteFinishedRetiringWorkers() ([]string, error) {
 	fake.deleteFinishedRetiringWorkersMutex.Lock()
 	ret, specificReturn := fake.deleteFinishedRetiringWorkersReturnsOnCall[len(fake.deleteFinishedRetiringWorkersArgsForCall)]
 	fake.deleteFinishedRetiringWorkersArgsForCall = append(fake.deleteFinishedRetiringWorkersArgsForCall, struct {
 	}{})
+	stub := fake.DeleteFinishedRetiringWorkersStub
+	fakeReturns := fake.deleteFinishedRetiringWorkersReturns
 	fake.recordInvocation("DeleteFinishedRetiringWorkers", []interface{}{})
 	fake.deleteFinishedRetiringWorkersMutex.Unlock()
-	if fake.DeleteFinishedRetiringWorkersStub != nil {
-		return fake.DeleteFinishedRetiringWorkersStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteFinishedRetiringWorkersReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -132,15 +201,16 @@ func (fake *FakeWorkerLifecycle) DeleteUnresponsiveEphemeralWorkers() ([]string,
 	ret, specificReturn := fake.deleteUnresponsiveEphemeralWorkersReturnsOnCall[len(fake.deleteUnresponsiveEphemeralWorkersArgsForCall)]
 	fake.deleteUnresponsiveEphemeralWorkersArgsForCall = append(fake.deleteUnresponsiveEphemeralWorkersArgsForCall, struct {
 	}{})
+	stub := fake.DeleteUnresponsiveEphemeralWorkersStub
+	fakeReturns := fake.deleteUnresponsiveEphemeralWorkersReturns
 	fake.recordInvocation("DeleteUnresponsiveEphemeralWorkers", []interface{}{})
 	fake.deleteUnresponsiveEphemeralWorkersMutex.Unlock()
-	if fake.DeleteUnresponsiveEphemeralWorkersStub != nil {
-		return fake.DeleteUnresponsiveEphemeralWorkersStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.deleteUnresponsiveEphemeralWorkersReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -187,15 +257,16 @@ func (fake *FakeWorkerLifecycle) GetWorkerStateByName() (map[string]db.WorkerSta
 	ret, specificReturn := fake.getWorkerStateByNameReturnsOnCall[len(fake.getWorkerStateByNameArgsForCall)]
 	fake.getWorkerStateByNameArgsForCall = append(fake.getWorkerStateByNameArgsForCall, struct {
 	}{})
+	stub := fake.GetWorkerStateByNameStub
+	fakeReturns := fake.getWorkerStateByNameReturns
 	fake.recordInvocation("GetWorkerStateByName", []interface{}{})
 	fake.getWorkerStateByNameMutex.Unlock()
-	if fake.GetWorkerStateByNameStub != nil {
-		return fake.GetWorkerStateByNameStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.getWorkerStateByNameReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -242,15 +313,16 @@ func (fake *FakeWorkerLifecycle) LandFinishedLandingWorkers() ([]string, error)
 	ret, specificReturn := fake.landFinishedLandingWorkersReturnsOnCall[len(fake.landFinishedLandingWorkersArgsForCall)]
 	fake.landFinishedLandingWorkersArgsForCall = append(fake.landFinishedLandingWorkersArgsForCall, struct {
 	}{})
+	stub := fake.LandFinishedLandingWorkersStub
+	fakeReturns := fake.landFinishedLandingWorkersReturns
 	fake.recordInvocation("LandFinishedLandingWorkers", []interface{}{})
 	fake.landFinishedLandingWorkersMutex.Unlock()
-	if fake.LandFinishedLandingWorkersStub != nil {
-		return fake.LandFinishedLandingWorkersStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.landFinishedLandingWorkersReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -297,15 +369,16 @@ func (fake *FakeWorkerLifecycle) StallUnresponsiveWorkers() ([]string, error) {
 	ret, specificReturn := fake.stallUnresponsiveWorkersReturnsOnCall[len(fake.stallUnresponsiveWorkersArgsForCall)]
 	fake.stallUnresponsiveWorkersArgsForCall = append(fake.stallUnresponsiveWorkersArgsForCall, struct {
 	}{})
+	stub := fake.StallUnresponsiveWorkersStub
+	fakeReturns := fake.stallUnresponsiveWorkersReturns
 	fake.recordInvocation("StallUnresponsiveWorkers", []interface{}{})
 	fake.stallUnresponsiveWorkersMutex.Unlock()
-	if fake.StallUnresponsiveWorkersStub != nil {
-		return fake.StallUnresponsiveWorkersStub()
+	if stub != nil {
+		return stub()
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	fakeReturns := fake.stallUnresponsiveWorkersReturns
 	return fakeReturns.result1, fakeReturns.result2
 }
 
@@ -350,6 +423,8 @@ func (fake *FakeWorkerLifecycle) StallUnresponsiveWorkersReturnsOnCall(i int, re
 func (fake *FakeWorkerLifecycle) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.abortBuildsInMaintenanceMutex.RLock()
+	defer fake.abortBuildsInMaintenanceMutex.RUnlock()
 	fake.deleteFinishedRetiringWorkersMutex.RLock()
 	defer fake.deleteFinishedRetiringWorkersMutex.RUnlock()
 	fake.deleteUnresponsiveEphemeralWorkersMutex.RLock()
@@ -191,7 +191,7 @@ func (builder Builder) WithResourceVersions(resourceName string, versions ...atc
 			return fmt.Errorf("find or create scope: %w", err)
 		}
 
-		err = scope.SaveVersions(scenario.SpanContext, versions)
+		err = scope.SaveVersions(scenario.SpanContext, versions, atc.VersionSchema{})
 		if err != nil {
 			return fmt.Errorf("save versions: %w", err)
 		}
@@ -262,7 +262,7 @@ func (builder Builder) WithResourceTypeVersions(resourceTypeName string, version
 			return fmt.Errorf("find or create scope: %w", err)
 		}
 
-		err = scope.SaveVersions(db.SpanContext{}, versions)
+		err = scope.SaveVersions(db.SpanContext{}, versions, atc.VersionSchema{})
 		if err != nil {
 			return fmt.Errorf("save versions: %w", err)
 		}
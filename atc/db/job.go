@@ -26,6 +26,7 @@ type InputConfig struct {
 	Passed          JobSet
 	UseEveryVersion bool
 	PinnedVersion   atc.Version
+	Labels          []string
 	ResourceID      int
 	JobID           int
 }
@@ -224,13 +225,13 @@ func (j *job) Config() (atc.JobConfig, error) {
 }
 
 func (j *job) AlgorithmInputs() (InputConfigs, error) {
-	rows, err := psql.Select("ji.name", "ji.resource_id", "array_agg(ji.passed_job_id)", "ji.version", "rp.version", "ji.trigger").
+	rows, err := psql.Select("ji.name", "ji.resource_id", "array_agg(ji.passed_job_id)", "ji.version", "rp.version", "ji.trigger", "ji.labels").
 		From("job_inputs ji").
 		LeftJoin("resource_pins rp ON rp.resource_id = ji.resource_id").
 		Where(sq.Eq{
 			"ji.job_id": j.id,
 		}).
-		GroupBy("ji.name, ji.job_id, ji.resource_id, ji.version, rp.version, ji.trigger").
+		GroupBy("ji.name, ji.job_id, ji.resource_id, ji.version, rp.version, ji.trigger, ji.labels").
 		RunWith(j.conn).
 		Query()
 	if err != nil {
@@ -244,8 +245,9 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 		var inputName string
 		var resourceID int
 		var trigger bool
+		var labels []string
 
-		err = rows.Scan(&inputName, &resourceID, pq.Array(&passedJobs), &configVersionString, &pinnedVersionString, &trigger)
+		err = rows.Scan(&inputName, &resourceID, pq.Array(&passedJobs), &configVersionString, &pinnedVersionString, &trigger, pq.Array(&labels))
 		if err != nil {
 			return nil, err
 		}
@@ -255,6 +257,7 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 			ResourceID: resourceID,
 			JobID:      j.id,
 			Trigger:    trigger,
+			Labels:     labels,
 		}
 
 		if pinnedVersionString.Valid {
@@ -297,14 +300,14 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 }
 
 func (j *job) Inputs() ([]atc.JobInput, error) {
-	rows, err := psql.Select("ji.name", "r.name", "array_agg(p.name ORDER BY p.id)", "ji.trigger", "ji.version").
+	rows, err := psql.Select("ji.name", "r.name", "array_agg(p.name ORDER BY p.id)", "ji.trigger", "ji.version", "ji.labels").
 		From("job_inputs ji").
 		Join("resources r ON r.id = ji.resource_id").
 		LeftJoin("jobs p ON p.id = ji.passed_job_id").
 		Where(sq.Eq{
 			"ji.job_id": j.id,
 		}).
-		GroupBy("ji.name, ji.job_id, r.name, ji.trigger, ji.version").
+		GroupBy("ji.name, ji.job_id, r.name, ji.trigger, ji.version, ji.labels").
 		RunWith(j.conn).
 		Query()
 	if err != nil {
@@ -317,8 +320,9 @@ func (j *job) Inputs() ([]atc.JobInput, error) {
 		var versionString sql.NullString
 		var inputName, resourceName string
 		var trigger bool
+		var labels []string
 
-		err = rows.Scan(&inputName, &resourceName, pq.Array(&passedString), &trigger, &versionString)
+		err = rows.Scan(&inputName, &resourceName, pq.Array(&passedString), &trigger, &versionString, pq.Array(&labels))
 		if err != nil {
 			return nil, err
 		}
@@ -345,6 +349,7 @@ func (j *job) Inputs() ([]atc.JobInput, error) {
 			Trigger:  trigger,
 			Version:  version,
 			Passed:   passed,
+			Labels:   labels,
 		})
 	}
 
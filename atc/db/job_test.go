@@ -1343,7 +1343,7 @@ var _ = Describe("Job", func() {
 				),
 			)
 
-			reversions, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 3}, nil)
+			reversions, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 3}, atc.ResourceVersionFilter{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
@@ -1487,7 +1487,7 @@ var _ = Describe("Job", func() {
 				}),
 			)
 
-			reversions, _, found, err := scenarioPipeline1.Resource("some-resource").Versions(db.Page{Limit: 3}, nil)
+			reversions, _, found, err := scenarioPipeline1.Resource("some-resource").Versions(db.Page{Limit: 3}, atc.ResourceVersionFilter{})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
@@ -22,6 +22,7 @@ const (
 	LockTypeActiveTasks
 	LockTypeResourceScanning
 	LockTypeJobScheduling
+	LockTypeCheckContainer
 )
 
 var ErrLostLock = errors.New("lock was lost while held, possibly due to connection breakage")
@@ -58,6 +59,10 @@ func NewJobSchedulingLockID(jobID int) LockID {
 	return LockID{LockTypeJobScheduling, jobID}
 }
 
+func NewCheckContainerLockID(teamID int, slot int) LockID {
+	return LockID{LockTypeCheckContainer, lockIDFromString(fmt.Sprintf("%d/%d", teamID, slot))}
+}
+
 //go:generate counterfeiter . LockFactory
 
 type LockFactory interface {
@@ -0,0 +1,159 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// MaintenanceWindow is a period during which a worker, or every worker with
+// a tag, is drained and kept out of the pool.
+type MaintenanceWindow struct {
+	ID         int
+	WorkerName string
+	Tag        string
+
+	DrainStartsAt time.Time
+	StartsAt      time.Time
+	EndsAt        time.Time
+}
+
+// Draining returns whether new containers should no longer be placed on the
+// window's workers.
+func (window MaintenanceWindow) Draining(now time.Time) bool {
+	return !now.Before(window.DrainStartsAt) && now.Before(window.EndsAt)
+}
+
+func (window MaintenanceWindow) State(now time.Time) atc.WorkerMaintenanceState {
+	return atc.MaintenanceState(now, window.DrainStartsAt, window.StartsAt)
+}
+
+//go:generate counterfeiter . MaintenanceWindowFactory
+
+type MaintenanceWindowFactory interface {
+	CreateMaintenanceWindow(atc.MaintenanceWindow) (MaintenanceWindow, error)
+	MaintenanceWindows() ([]MaintenanceWindow, error)
+	DeleteMaintenanceWindow(id int) (bool, error)
+}
+
+type maintenanceWindowFactory struct {
+	conn Conn
+}
+
+func NewMaintenanceWindowFactory(conn Conn) MaintenanceWindowFactory {
+	return &maintenanceWindowFactory{
+		conn: conn,
+	}
+}
+
+var maintenanceWindowsQuery = psql.Select(`
+		id,
+		worker_name,
+		tag,
+		drain_starts_at,
+		starts_at,
+		ends_at
+	`).
+	From("worker_maintenance_windows")
+
+func (f *maintenanceWindowFactory) CreateMaintenanceWindow(window atc.MaintenanceWindow) (MaintenanceWindow, error) {
+	drainStartsAt := window.DrainStartsAt
+	if drainStartsAt == 0 {
+		drainStartsAt = window.StartsAt
+	}
+
+	var workerName, tag interface{}
+	if window.Worker != "" {
+		workerName = window.Worker
+	}
+
+	if window.Tag != "" {
+		tag = window.Tag
+	}
+
+	row := psql.Insert("worker_maintenance_windows").
+		Columns("worker_name", "tag", "drain_starts_at", "starts_at", "ends_at").
+		Values(
+			workerName,
+			tag,
+			time.Unix(drainStartsAt, 0),
+			time.Unix(window.StartsAt, 0),
+			time.Unix(window.EndsAt, 0),
+		).
+		Suffix("RETURNING id, worker_name, tag, drain_starts_at, starts_at, ends_at").
+		RunWith(f.conn).
+		QueryRow()
+
+	return scanMaintenanceWindow(row)
+}
+
+// MaintenanceWindows returns the windows that have not ended yet, soonest
+// first.
+func (f *maintenanceWindowFactory) MaintenanceWindows() ([]MaintenanceWindow, error) {
+	rows, err := maintenanceWindowsQuery.
+		Where(sq.Expr("ends_at > NOW()")).
+		OrderBy("starts_at", "id").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	windows := []MaintenanceWindow{}
+	for rows.Next() {
+		window, err := scanMaintenanceWindow(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		windows = append(windows, window)
+	}
+
+	return windows, nil
+}
+
+func (f *maintenanceWindowFactory) DeleteMaintenanceWindow(id int) (bool, error) {
+	result, err := psql.Delete("worker_maintenance_windows").
+		Where(sq.Eq{"id": id}).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func scanMaintenanceWindow(row scannable) (MaintenanceWindow, error) {
+	var (
+		window     MaintenanceWindow
+		workerName sql.NullString
+		tag        sql.NullString
+	)
+
+	err := row.Scan(
+		&window.ID,
+		&workerName,
+		&tag,
+		&window.DrainStartsAt,
+		&window.StartsAt,
+		&window.EndsAt,
+	)
+	if err != nil {
+		return MaintenanceWindow{}, err
+	}
+
+	window.WorkerName = workerName.String
+	window.Tag = tag.String
+
+	return window, nil
+}
@@ -0,0 +1,88 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MaintenanceWindowFactory", func() {
+	var (
+		window  atc.MaintenanceWindow
+		created db.MaintenanceWindow
+	)
+
+	BeforeEach(func() {
+		window = atc.MaintenanceWindow{
+			Worker:        defaultWorker.Name(),
+			DrainStartsAt: time.Now().Add(time.Hour).Unix(),
+			StartsAt:      time.Now().Add(2 * time.Hour).Unix(),
+			EndsAt:        time.Now().Add(3 * time.Hour).Unix(),
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		created, err = maintenanceWindowFactory.CreateMaintenanceWindow(window)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("CreateMaintenanceWindow", func() {
+		It("saves the window", func() {
+			Expect(created.ID).ToNot(BeZero())
+			Expect(created.WorkerName).To(Equal(defaultWorker.Name()))
+			Expect(created.Tag).To(BeEmpty())
+			Expect(created.DrainStartsAt.Unix()).To(Equal(window.DrainStartsAt))
+			Expect(created.StartsAt.Unix()).To(Equal(window.StartsAt))
+			Expect(created.EndsAt.Unix()).To(Equal(window.EndsAt))
+		})
+
+		It("is exposed on the worker", func() {
+			worker, found, err := workerFactory.GetWorker(defaultWorker.Name())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(worker.Maintenance()).ToNot(BeNil())
+			Expect(worker.Maintenance().ID).To(Equal(created.ID))
+		})
+
+		Context("when draining is not given", func() {
+			BeforeEach(func() {
+				window.DrainStartsAt = 0
+			})
+
+			It("drains when the window starts", func() {
+				Expect(created.DrainStartsAt).To(Equal(created.StartsAt))
+			})
+		})
+	})
+
+	Describe("MaintenanceWindows", func() {
+		It("returns the windows that have not ended", func() {
+			windows, err := maintenanceWindowFactory.MaintenanceWindows()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(windows).To(ConsistOf(created))
+		})
+	})
+
+	Describe("DeleteMaintenanceWindow", func() {
+		It("deletes the window", func() {
+			deleted, err := maintenanceWindowFactory.DeleteMaintenanceWindow(created.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			windows, err := maintenanceWindowFactory.MaintenanceWindows()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(windows).To(BeEmpty())
+		})
+
+		It("returns false for unknown windows", func() {
+			deleted, err := maintenanceWindowFactory.DeleteMaintenanceWindow(created.ID + 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeFalse())
+		})
+	})
+})
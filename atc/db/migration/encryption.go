@@ -16,6 +16,7 @@ var encryptedColumns = []encryptedColumn{
 	{"builds", "private_plan", "id"},
 	{"cert_cache", "cert", "domain"},
 	{"pipelines", "var_sources", "id"},
+	{"secrets", "value", "id"},
 }
 
 type encryptedColumn struct {
@@ -0,0 +1,10 @@
+BEGIN;
+
+  DROP INDEX IF EXISTS resource_config_versions_metadata;
+
+  DROP INDEX IF EXISTS resource_config_versions_scope_create_time_idx;
+
+  ALTER TABLE resource_config_versions
+    DROP COLUMN create_time;
+
+COMMIT;
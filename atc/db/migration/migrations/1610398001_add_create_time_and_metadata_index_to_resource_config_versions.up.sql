@@ -0,0 +1,32 @@
+BEGIN;
+
+  ALTER TABLE resource_config_versions
+    ADD COLUMN create_time timestamp with time zone;
+
+  -- versions saved before now were first seen no later than the first build
+  -- which used or produced them; the rest are left unknown
+  UPDATE resource_config_versions v
+  SET create_time = first_used.create_time
+  FROM (
+    SELECT r.resource_config_scope_id, io.version_md5, min(b.create_time) AS create_time
+    FROM (
+      SELECT build_id, resource_id, version_md5 FROM build_resource_config_version_inputs
+      UNION ALL
+      SELECT build_id, resource_id, version_md5 FROM build_resource_config_version_outputs
+    ) io
+    JOIN builds b ON b.id = io.build_id
+    JOIN resources r ON r.id = io.resource_id
+    WHERE r.resource_config_scope_id IS NOT NULL
+    GROUP BY r.resource_config_scope_id, io.version_md5
+  ) first_used
+  WHERE v.resource_config_scope_id = first_used.resource_config_scope_id
+  AND v.version_md5 = first_used.version_md5;
+
+  ALTER TABLE resource_config_versions
+    ALTER COLUMN create_time SET DEFAULT NOW();
+
+  CREATE INDEX resource_config_versions_scope_create_time_idx ON resource_config_versions (resource_config_scope_id, create_time);
+
+  CREATE INDEX resource_config_versions_metadata ON resource_config_versions USING gin(metadata jsonb_path_ops) WITH (FASTUPDATE = false);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+  ALTER TABLE job_inputs
+    DROP COLUMN labels;
+
+  DROP TABLE resource_version_labels;
+
+COMMIT;
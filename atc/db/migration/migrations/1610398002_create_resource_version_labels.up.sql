@@ -0,0 +1,13 @@
+BEGIN;
+
+  CREATE TABLE resource_version_labels (
+    "resource_id" integer NOT NULL REFERENCES resources (id) ON DELETE CASCADE,
+    "version_md5" text NOT NULL,
+    "label" text NOT NULL,
+    UNIQUE ("resource_id", "version_md5", "label")
+  );
+
+  ALTER TABLE job_inputs
+    ADD COLUMN labels text[];
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+  ALTER TABLE teams
+    DROP COLUMN check_rate_limit,
+    DROP COLUMN max_check_containers;
+
+COMMIT;
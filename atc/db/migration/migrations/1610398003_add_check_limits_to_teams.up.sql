@@ -0,0 +1,7 @@
+BEGIN;
+
+  ALTER TABLE teams
+    ADD COLUMN check_rate_limit double precision,
+    ADD COLUMN max_check_containers integer;
+
+COMMIT;
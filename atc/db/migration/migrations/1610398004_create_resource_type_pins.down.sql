@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE resource_type_pins;
+COMMIT;
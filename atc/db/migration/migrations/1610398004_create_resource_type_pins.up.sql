@@ -0,0 +1,7 @@
+BEGIN;
+  CREATE TABLE resource_type_pins (
+    resource_type_id integer NOT NULL PRIMARY KEY
+      REFERENCES resource_types(id) ON DELETE CASCADE,
+    version jsonb NOT NULL
+  );
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+  CREATE TABLE worker_maintenance_windows (
+    id serial PRIMARY KEY,
+    worker_name text,
+    tag text,
+    drain_starts_at timestamp with time zone NOT NULL,
+    starts_at timestamp with time zone NOT NULL,
+    ends_at timestamp with time zone NOT NULL,
+    CONSTRAINT worker_maintenance_windows_target_check CHECK ((worker_name IS NULL) <> (tag IS NULL))
+  );
+
+  CREATE INDEX worker_maintenance_windows_ends_at_idx ON worker_maintenance_windows (ends_at);
+COMMIT;
@@ -0,0 +1,5 @@
+-- enum values cannot be dropped, so degraded workers are made running again
+-- and the value is left unused.
+BEGIN;
+  UPDATE workers SET state = 'running' WHERE state = 'degraded';
+COMMIT;
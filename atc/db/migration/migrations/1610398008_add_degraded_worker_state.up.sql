@@ -0,0 +1,3 @@
+-- ALTER TYPE ... ADD VALUE cannot run inside a transaction block prior to
+-- Postgres 12, so this migration is not wrapped in BEGIN/COMMIT.
+ALTER TYPE worker_state ADD VALUE IF NOT EXISTS 'degraded';
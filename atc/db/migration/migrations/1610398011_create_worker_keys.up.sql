@@ -0,0 +1,15 @@
+BEGIN;
+  CREATE TABLE worker_keys (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    public_key text NOT NULL,
+    fingerprint text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    expires_at timestamp with time zone,
+    last_used_at timestamp with time zone,
+    CONSTRAINT worker_keys_team_id_name_key UNIQUE (team_id, name)
+  );
+
+  CREATE UNIQUE INDEX worker_keys_fingerprint_idx ON worker_keys (fingerprint);
+COMMIT;
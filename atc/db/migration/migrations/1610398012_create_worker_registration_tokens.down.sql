@@ -0,0 +1,12 @@
+BEGIN;
+  DELETE FROM worker_keys WHERE team_id IS NULL;
+
+  DROP INDEX worker_keys_global_name_idx;
+
+  ALTER TABLE worker_keys
+    DROP COLUMN tags,
+    DROP COLUMN worker_name,
+    ALTER COLUMN team_id SET NOT NULL;
+
+  DROP TABLE worker_registration_tokens;
+COMMIT;
@@ -0,0 +1,21 @@
+BEGIN;
+  CREATE TABLE worker_registration_tokens (
+    id serial PRIMARY KEY,
+    token_hash text NOT NULL,
+    team_id integer REFERENCES teams (id) ON DELETE CASCADE,
+    tags text[] NOT NULL DEFAULT '{}',
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    expires_at timestamp with time zone NOT NULL,
+    max_uses integer,
+    uses integer NOT NULL DEFAULT 0
+  );
+
+  CREATE UNIQUE INDEX worker_registration_tokens_token_hash_idx ON worker_registration_tokens (token_hash);
+
+  ALTER TABLE worker_keys
+    ALTER COLUMN team_id DROP NOT NULL,
+    ADD COLUMN worker_name text,
+    ADD COLUMN tags text[] NOT NULL DEFAULT '{}';
+
+  CREATE UNIQUE INDEX worker_keys_global_name_idx ON worker_keys (name) WHERE team_id IS NULL;
+COMMIT;
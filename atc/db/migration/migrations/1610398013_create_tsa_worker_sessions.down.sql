@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE tsa_worker_sessions;
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+  CREATE TABLE tsa_worker_sessions (
+    tsa text PRIMARY KEY,
+    sessions json NOT NULL,
+    reported_at timestamp with time zone NOT NULL DEFAULT now(),
+    expires_at timestamp with time zone NOT NULL
+  );
+COMMIT;
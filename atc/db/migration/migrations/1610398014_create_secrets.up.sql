@@ -0,0 +1,14 @@
+BEGIN;
+  CREATE TABLE secrets (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    pipeline_name text NOT NULL DEFAULT '',
+    name text NOT NULL,
+    value text NOT NULL,
+    nonce text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_at timestamp with time zone NOT NULL DEFAULT now(),
+    updated_by text NOT NULL DEFAULT '',
+    CONSTRAINT secrets_team_id_pipeline_name_name_key UNIQUE (team_id, pipeline_name, name)
+  );
+COMMIT;
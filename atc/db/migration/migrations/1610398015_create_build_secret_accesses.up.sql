@@ -0,0 +1,12 @@
+BEGIN;
+  CREATE TABLE build_secret_accesses (
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    var_source text NOT NULL DEFAULT '',
+    path text NOT NULL,
+    manager text NOT NULL DEFAULT '',
+    accessed_at timestamp with time zone NOT NULL DEFAULT now(),
+    PRIMARY KEY (build_id, var_source, path)
+  );
+
+  CREATE INDEX build_secret_accesses_path_idx ON build_secret_accesses (path);
+COMMIT;
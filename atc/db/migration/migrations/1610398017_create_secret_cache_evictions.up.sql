@@ -0,0 +1,9 @@
+BEGIN;
+  CREATE TABLE secret_cache_evictions (
+    id serial PRIMARY KEY,
+    team_name text NOT NULL DEFAULT '',
+    pipeline_name text NOT NULL DEFAULT '',
+    path_prefix text NOT NULL DEFAULT '',
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+COMMIT;
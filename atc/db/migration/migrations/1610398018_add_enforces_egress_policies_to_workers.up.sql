@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE workers ADD COLUMN enforces_egress_policies boolean NOT NULL DEFAULT false;
+COMMIT;
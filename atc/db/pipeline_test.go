@@ -652,7 +652,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(versions.Jobs).To(ConsistOf(jobs))
 
 				By("initially having no versions")
-				resourceVersions, _, _, err := resource.Versions(db.Page{Limit: 10}, nil)
+				resourceVersions, _, _, err := resource.Versions(db.Page{Limit: 10}, atc.ResourceVersionFilter{})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(resourceVersions).To(HaveLen(0))
 
@@ -998,7 +998,7 @@ var _ = Describe("Pipeline", func() {
 
 		It("can load up the latest versioned resource, enabled or not", func() {
 			By("initially having no versions")
-			resourceVersions, _, found, err := resource.Versions(db.Page{Limit: 10}, nil)
+			resourceVersions, _, found, err := resource.Versions(db.Page{Limit: 10}, atc.ResourceVersionFilter{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(resourceVersions).To(HaveLen(0))
 
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -53,13 +54,16 @@ type Resource interface {
 
 	BuildSummary() *atc.BuildSummary
 
-	Versions(page Page, versionFilter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error)
+	Versions(page Page, filter atc.ResourceVersionFilter) ([]atc.ResourceVersion, Pagination, bool, error)
 	FindVersion(filter atc.Version) (ResourceConfigVersion, bool, error) // Only used in tests!!
 	UpdateMetadata(atc.Version, ResourceConfigMetadataFields) (bool, error)
 
 	EnableVersion(rcvID int) error
 	DisableVersion(rcvID int) error
 
+	AddVersionLabel(rcvID int, label string) (bool, error)
+	RemoveVersionLabel(rcvID int, label string) (bool, error)
+
 	PinVersion(rcvID int) (bool, error)
 	UnpinVersion() error
 
@@ -475,7 +479,7 @@ func (r *resource) BuildSummary() *atc.BuildSummary {
 	return r.buildSummary
 }
 
-func (r *resource) Versions(page Page, versionFilter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error) {
+func (r *resource) Versions(page Page, filter atc.ResourceVersionFilter) ([]atc.ResourceVersion, Pagination, bool, error) {
 	tx, err := r.conn.Begin()
 	if err != nil {
 		return nil, Pagination{}, false, err
@@ -491,14 +495,21 @@ func (r *resource) Versions(page Page, versionFilter atc.Version) ([]atc.Resourc
 				WHERE v.version_md5 = d.version_md5
 				AND r.resource_config_scope_id = v.resource_config_scope_id
 				AND r.id = d.resource_id
+			),
+			ARRAY(
+				SELECT l.label
+				FROM resource_version_labels l
+				WHERE l.resource_id = r.id
+				AND l.version_md5 = v.version_md5
+				ORDER BY l.label
 			)
 		FROM resource_config_versions v, resources r
 		WHERE r.id = $1 AND r.resource_config_scope_id = v.resource_config_scope_id
 	`
 
 	filterJSON := "{}"
-	if len(versionFilter) != 0 {
-		filterBytes, err := json.Marshal(versionFilter)
+	if len(filter.Version) != 0 {
+		filterBytes, err := json.Marshal(filter.Version)
 		if err != nil {
 			return nil, Pagination{}, false, err
 		}
@@ -508,38 +519,56 @@ func (r *resource) Versions(page Page, versionFilter atc.Version) ([]atc.Resourc
 
 	var rows *sql.Rows
 	if page.From != nil {
+		conditions, args, err := versionFilterConditions(filter, 5)
+		if err != nil {
+			return nil, Pagination{}, false, err
+		}
+
 		rows, err = tx.Query(fmt.Sprintf(`
 			SELECT sub.*
 				FROM (
 						%s
 					AND version @> $4
+					%s
 					AND v.check_order >= (SELECT check_order FROM resource_config_versions WHERE id = $2)
 				ORDER BY v.check_order ASC
 				LIMIT $3
 			) sub
 			ORDER BY sub.check_order DESC
-		`, query), r.id, *page.From, page.Limit, filterJSON)
+		`, query, conditions), append([]interface{}{r.id, *page.From, page.Limit, filterJSON}, args...)...)
 		if err != nil {
 			return nil, Pagination{}, false, err
 		}
 	} else if page.To != nil {
+		conditions, args, err := versionFilterConditions(filter, 5)
+		if err != nil {
+			return nil, Pagination{}, false, err
+		}
+
 		rows, err = tx.Query(fmt.Sprintf(`
 			%s
 				AND version @> $4
+				%s
 				AND v.check_order <= (SELECT check_order FROM resource_config_versions WHERE id = $2)
 			ORDER BY v.check_order DESC
 			LIMIT $3
-		`, query), r.id, *page.To, page.Limit, filterJSON)
+		`, query, conditions), append([]interface{}{r.id, *page.To, page.Limit, filterJSON}, args...)...)
 		if err != nil {
 			return nil, Pagination{}, false, err
 		}
 	} else {
+		conditions, args, err := versionFilterConditions(filter, 4)
+		if err != nil {
+			return nil, Pagination{}, false, err
+		}
+
 		rows, err = tx.Query(fmt.Sprintf(`
 			%s
 			AND version @> $3
+			%s
 			ORDER BY v.check_order DESC
 			LIMIT $2
-		`, query), r.id, page.Limit, filterJSON)
+		`, query, conditions), append([]interface{}{r.id, page.Limit, filterJSON}, args...)...)
 		if err != nil {
 			return nil, Pagination{}, false, err
 		}
@@ -559,14 +588,19 @@ func (r *resource) Versions(page Page, versionFilter atc.Version) ([]atc.Resourc
 			metadataBytes sql.NullString
 			versionBytes  string
 			checkOrder    int
+			labels        []string
 		)
 
 		rv := atc.ResourceVersion{}
-		err := rows.Scan(&rv.ID, &versionBytes, &metadataBytes, &checkOrder, &rv.Enabled)
+		err := rows.Scan(&rv.ID, &versionBytes, &metadataBytes, &checkOrder, &rv.Enabled, pq.Array(&labels))
 		if err != nil {
 			return nil, Pagination{}, false, err
 		}
 
+		if len(labels) > 0 {
+			rv.Labels = labels
+		}
+
 		err = json.Unmarshal([]byte(versionBytes), &rv.Version)
 		if err != nil {
 			return nil, Pagination{}, false, err
@@ -647,6 +681,14 @@ func (r *resource) DisableVersion(rcvID int) error {
 	return r.toggleVersion(rcvID, false)
 }
 
+func (r *resource) AddVersionLabel(rcvID int, label string) (bool, error) {
+	return r.labelVersion(rcvID, label, true)
+}
+
+func (r *resource) RemoveVersionLabel(rcvID int, label string) (bool, error) {
+	return r.labelVersion(rcvID, label, false)
+}
+
 func (r *resource) PinVersion(rcvID int) (bool, error) {
 	tx, err := r.conn.Begin()
 	if err != nil {
@@ -788,6 +830,63 @@ func (r *resource) toggleVersion(rcvID int, enable bool) error {
 	return tx.Commit()
 }
 
+func (r *resource) labelVersion(rcvID int, label string, add bool) (bool, error) {
+	tx, err := r.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	var versionMD5 string
+	err = psql.Select("rcv.version_md5").
+		From("resource_config_versions rcv").
+		Join("resources r ON r.resource_config_scope_id = rcv.resource_config_scope_id").
+		Where(sq.Eq{
+			"rcv.id": rcvID,
+			"r.id":   r.id,
+		}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&versionMD5)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if add {
+		_, err = tx.Exec(`
+			INSERT INTO resource_version_labels (resource_id, version_md5, label)
+			VALUES ($1, $2, $3)
+			ON CONFLICT DO NOTHING
+			`, r.id, versionMD5, label)
+	} else {
+		_, err = tx.Exec(`
+			DELETE FROM resource_version_labels
+			WHERE resource_id = $1
+			AND version_md5 = $2
+			AND label = $3
+			`, r.id, versionMD5, label)
+	}
+	if err != nil {
+		return false, err
+	}
+
+	err = requestScheduleForJobsUsingResource(tx, r.id)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (r *resource) NotifyScan() error {
 	return r.conn.Bus().Notify(fmt.Sprintf("resource_scan_%d", r.id))
 }
@@ -952,3 +1051,75 @@ func requestScheduleForJobsUsingResource(tx Tx, resourceID int) error {
 
 	return nil
 }
+
+// versionFilterConditions builds the SQL conditions matching the metadata,
+// labels and time range of the filter, numbering placeholders from firstArg.
+func versionFilterConditions(filter atc.ResourceVersionFilter, firstArg int) (string, []interface{}, error) {
+	var (
+		conditions []string
+		args       []interface{}
+	)
+
+	arg := func(value interface{}) string {
+		args = append(args, value)
+		return "$" + strconv.Itoa(firstArg+len(args)-1)
+	}
+
+	for _, metadataFilter := range filter.Metadata {
+		switch metadataFilter.Match {
+		case atc.MetadataMatchExact:
+			metadataJSON, err := json.Marshal([]atc.MetadataField{{
+				Name:  metadataFilter.Name,
+				Value: metadataFilter.Value,
+			}})
+			if err != nil {
+				return "", nil, err
+			}
+
+			conditions = append(conditions, "AND v.metadata @> "+arg(string(metadataJSON)))
+
+		case atc.MetadataMatchPattern, atc.MetadataMatchContains:
+			operator := "LIKE"
+			pattern := strings.ReplaceAll(escapeLikePattern(metadataFilter.Value), "*", "%")
+			if metadataFilter.Match == atc.MetadataMatchContains {
+				operator = "ILIKE"
+				pattern = "%" + escapeLikePattern(metadataFilter.Value) + "%"
+			}
+
+			conditions = append(conditions, fmt.Sprintf(`AND EXISTS (
+				SELECT 1
+				FROM jsonb_array_elements(CASE WHEN jsonb_typeof(v.metadata) = 'array' THEN v.metadata ELSE '[]' END) m
+				WHERE m->>'name' = %s AND m->>'value' %s %s
+			)`, arg(metadataFilter.Name), operator, arg(pattern)))
+
+		default:
+			return "", nil, fmt.Errorf("unknown metadata match '%s'", metadataFilter.Match)
+		}
+	}
+
+	if labels := uniqueLabels(filter.Labels); len(labels) > 0 {
+		conditions = append(conditions, fmt.Sprintf(`AND (
+			SELECT COUNT(DISTINCT l.label)
+			FROM resource_version_labels l
+			WHERE l.resource_id = r.id
+			AND l.version_md5 = v.version_md5
+			AND l.label = ANY(%s)
+		) = %s`, arg(pq.Array(labels)), arg(len(labels))))
+	}
+
+	// versions saved before create_time existed, and never used by a build,
+	// have no known time and so are never left out
+	if !filter.Since.IsZero() {
+		conditions = append(conditions, "AND (v.create_time IS NULL OR v.create_time >= "+arg(filter.Since)+")")
+	}
+
+	if !filter.Until.IsZero() {
+		conditions = append(conditions, "AND (v.create_time IS NULL OR v.create_time <= "+arg(filter.Until)+")")
+	}
+
+	return strings.Join(conditions, "\n"), args, nil
+}
+
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -29,6 +29,20 @@ type ResourceCacheFactory interface {
 	ResourceCacheMetadata(UsedResourceCache) (ResourceConfigMetadataFields, error)
 
 	FindResourceCacheByID(id int) (UsedResourceCache, bool, error)
+
+	// FindResourceCachesToWarm returns those of the resource caches most used
+	// by the builds of the last 24 hours which are missing from the given worker but present on a running worker
+	// with the same platform, tags and team, hottest first.
+	FindResourceCachesToWarm(workerName string, limit int) ([]WarmableResourceCache, error)
+}
+
+// WarmableResourceCache is a resource cache along with a volume on another
+// worker which it can be streamed from.
+type WarmableResourceCache struct {
+	ResourceCacheID    int
+	Uses               int
+	SourceWorkerName   string
+	SourceVolumeHandle string
 }
 
 type resourceCacheFactory struct {
@@ -177,3 +191,65 @@ func findResourceCacheByID(tx Tx, resourceCacheID int, lock lock.LockFactory, co
 
 	return usedResourceCache, true, nil
 }
+
+func (f *resourceCacheFactory) FindResourceCachesToWarm(workerName string, limit int) ([]WarmableResourceCache, error) {
+	rows, err := f.conn.Query(`
+		WITH hot AS (
+			SELECT u.resource_cache_id, COUNT(DISTINCT u.build_id) AS uses
+			FROM (
+				SELECT resource_cache_id, build_id FROM resource_cache_uses
+				UNION ALL
+				SELECT resource_cache_id, build_id FROM build_image_resource_caches
+			) u
+			JOIN builds b ON b.id = u.build_id
+			WHERE b.create_time > NOW() - interval '24 hours'
+			GROUP BY u.resource_cache_id
+			ORDER BY uses DESC, u.resource_cache_id
+			LIMIT $2
+		)
+		SELECT DISTINCT ON (hot.uses, hot.resource_cache_id)
+			hot.resource_cache_id, hot.uses, v.worker_name, v.handle
+		FROM hot
+		JOIN worker_resource_caches wrc ON wrc.resource_cache_id = hot.resource_cache_id
+		JOIN worker_base_resource_types wbrt ON wbrt.id = wrc.worker_base_resource_type_id
+		JOIN volumes v ON v.worker_resource_cache_id = wrc.id AND v.state = 'created'
+		JOIN workers w ON w.name = v.worker_name
+		JOIN workers t ON t.name = $1
+		WHERE w.name != t.name
+		AND w.state = 'running'
+		AND w.platform = t.platform
+		AND w.tags::jsonb @> t.tags::jsonb
+		AND t.tags::jsonb @> w.tags::jsonb
+		AND w.team_id IS NOT DISTINCT FROM t.team_id
+		AND EXISTS (
+			SELECT 1 FROM worker_base_resource_types tb
+			WHERE tb.worker_name = t.name
+			AND tb.base_resource_type_id = wbrt.base_resource_type_id
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM worker_resource_caches twrc
+			JOIN worker_base_resource_types tb ON tb.id = twrc.worker_base_resource_type_id
+			WHERE twrc.resource_cache_id = hot.resource_cache_id
+			AND tb.worker_name = t.name
+		)
+		ORDER BY hot.uses DESC, hot.resource_cache_id, v.handle
+	`, workerName, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var caches []WarmableResourceCache
+	for rows.Next() {
+		var cache WarmableResourceCache
+		err = rows.Scan(&cache.ResourceCacheID, &cache.Uses, &cache.SourceWorkerName, &cache.SourceVolumeHandle)
+		if err != nil {
+			return nil, err
+		}
+
+		caches = append(caches, cache)
+	}
+
+	return caches, rows.Err()
+}
@@ -368,6 +368,115 @@ var _ = Describe("ResourceCacheFactory", func() {
 		})
 	})
 
+	Describe("FindResourceCachesToWarm", func() {
+		var (
+			usedResourceCache db.UsedResourceCache
+			cacheVolume       db.CreatedVolume
+		)
+
+		BeforeEach(func() {
+			usedResourceCache, err = resourceCacheFactory.FindOrCreateResourceCache(
+				db.ForBuild(build.ID()),
+				"some-base-resource-type",
+				atc.Version{"some": "version"},
+				atc.Source{"some": "source"},
+				atc.Params{"some": "params"},
+				atc.VersionedResourceTypes{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			creatingContainer, err := defaultWorker.CreateContainer(db.NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID()), db.ContainerMetadata{
+				Type:     "get",
+				StepName: "some-resource",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			creatingVolume, err := volumeRepository.CreateContainerVolume(defaultTeam.ID(), defaultWorker.Name(), creatingContainer, "some-path")
+			Expect(err).ToNot(HaveOccurred())
+
+			cacheVolume, err = creatingVolume.Created()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = cacheVolume.InitializeResourceCache(usedResourceCache)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the cache along with a volume to stream it from", func() {
+			caches, err := resourceCacheFactory.FindResourceCachesToWarm(otherWorker.Name(), 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(caches).To(Equal([]db.WarmableResourceCache{
+				{
+					ResourceCacheID:    usedResourceCache.ID(),
+					Uses:               1,
+					SourceWorkerName:   defaultWorker.Name(),
+					SourceVolumeHandle: cacheVolume.Handle(),
+				},
+			}))
+		})
+
+		It("does not return caches which the worker already has", func() {
+			caches, err := resourceCacheFactory.FindResourceCachesToWarm(defaultWorker.Name(), 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(caches).To(BeEmpty())
+		})
+
+		Context("when the workers have different tags", func() {
+			BeforeEach(func() {
+				otherWorkerPayload.Tags = []string{"some-tag"}
+				_, err = workerFactory.SaveWorker(otherWorkerPayload, 0)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not return the cache", func() {
+				caches, err := resourceCacheFactory.FindResourceCachesToWarm(otherWorker.Name(), 10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(caches).To(BeEmpty())
+			})
+		})
+
+		Context("when the cache was only used by old builds", func() {
+			BeforeEach(func() {
+				_, err = dbConn.Exec(`UPDATE builds SET create_time = NOW() - interval '2 days' WHERE id = $1`, build.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not return the cache", func() {
+				caches, err := resourceCacheFactory.FindResourceCachesToWarm(otherWorker.Name(), 10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(caches).To(BeEmpty())
+			})
+		})
+
+		Context("when the cache is used as the image of a recent build", func() {
+			BeforeEach(func() {
+				_, err = dbConn.Exec(`DELETE FROM resource_cache_uses`)
+				Expect(err).ToNot(HaveOccurred())
+
+				err = build.SaveImageResourceVersion(usedResourceCache)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the cache", func() {
+				caches, err := resourceCacheFactory.FindResourceCachesToWarm(otherWorker.Name(), 10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(caches).To(HaveLen(1))
+				Expect(caches[0].ResourceCacheID).To(Equal(usedResourceCache.ID()))
+			})
+		})
+
+		Context("when the cache is no longer in use", func() {
+			BeforeEach(func() {
+				_, err = dbConn.Exec(`DELETE FROM resource_cache_uses`)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not return the cache", func() {
+				caches, err := resourceCacheFactory.FindResourceCachesToWarm(otherWorker.Name(), 10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(caches).To(BeEmpty())
+			})
+		})
+	})
 })
 
 type resourceCache struct {
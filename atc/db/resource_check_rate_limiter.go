@@ -7,9 +7,16 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/db/lock"
 	"golang.org/x/time/rate"
 )
 
+// checkContainerPollInterval is how often a check waiting for a container
+// tries again, as slots freed up by other web nodes are not signalled.
+const checkContainerPollInterval = 5 * time.Second
+
 type ResourceCheckRateLimiter struct {
 	checkLimiter *rate.Limiter
 
@@ -17,20 +24,54 @@ type ResourceCheckRateLimiter struct {
 	checkInterval  time.Duration
 	refreshLimiter *rate.Limiter
 
-	clock clock.Clock
-	mut   *sync.Mutex
+	teamChecksPerSecond    rate.Limit
+	teamMaxCheckContainers int
+	teams                  map[int]*teamCheckLimiter
+	teamOverrides          map[int]teamCheckOverride
+	teamRefreshLimiter     *rate.Limiter
+
+	lockFactory lock.LockFactory
+
+	clock   clock.Clock
+	mut     *sync.Mutex
+	teamMut *sync.Mutex
+}
+
+type teamCheckLimiter struct {
+	checkLimiter *rate.Limiter
+
+	maxContainers int
+	released      chan struct{}
+}
+
+type teamCheckOverride struct {
+	checksPerSecond    *float64
+	maxCheckContainers *int
 }
 
 func NewResourceCheckRateLimiter(
 	checksPerSecond rate.Limit,
 	checkInterval time.Duration,
+	teamChecksPerSecond rate.Limit,
+	teamMaxCheckContainers int,
 	refreshConn Conn,
 	refreshInterval time.Duration,
+	lockFactory lock.LockFactory,
 	clock clock.Clock,
 ) *ResourceCheckRateLimiter {
 	limiter := &ResourceCheckRateLimiter{
-		clock: clock,
-		mut:   new(sync.Mutex),
+		refreshConn: refreshConn,
+		lockFactory: lockFactory,
+
+		teamChecksPerSecond:    teamChecksPerSecond,
+		teamMaxCheckContainers: teamMaxCheckContainers,
+		teams:                  map[int]*teamCheckLimiter{},
+		teamOverrides:          map[int]teamCheckOverride{},
+		teamRefreshLimiter:     rate.NewLimiter(rate.Every(refreshInterval), 1),
+
+		clock:   clock,
+		mut:     new(sync.Mutex),
+		teamMut: new(sync.Mutex),
 	}
 
 	if checksPerSecond < 0 {
@@ -41,14 +82,21 @@ func NewResourceCheckRateLimiter(
 		limiter.checkLimiter = rate.NewLimiter(checksPerSecond, 1)
 	} else {
 		limiter.checkInterval = checkInterval
-		limiter.refreshConn = refreshConn
 		limiter.refreshLimiter = rate.NewLimiter(rate.Every(refreshInterval), 1)
 	}
 
 	return limiter
 }
 
-func (limiter *ResourceCheckRateLimiter) Wait(ctx context.Context) error {
+// Wait blocks until a check for a resource belonging to the given team may
+// be started. The team's own rate is waited on first so that a team which
+// saturates its budget does not hold up checks for other teams.
+func (limiter *ResourceCheckRateLimiter) Wait(ctx context.Context, teamID int) error {
+	err := limiter.waitForTeam(ctx, teamID)
+	if err != nil {
+		return err
+	}
+
 	limiter.mut.Lock()
 	defer limiter.mut.Unlock()
 
@@ -59,7 +107,106 @@ func (limiter *ResourceCheckRateLimiter) Wait(ctx context.Context) error {
 		}
 	}
 
-	reservation := limiter.checkLimiter.ReserveN(limiter.clock.Now(), 1)
+	return limiter.wait(ctx, limiter.checkLimiter)
+}
+
+// AcquireCheckContainer blocks until the team is running fewer checks than
+// its check container budget allows, across all web nodes. The returned
+// function must be called once the check has finished to hand the slot back.
+//
+// Each container in the budget is a slot guarded by a lock, so that slots
+// held by a web node which goes away are freed along with its connection.
+func (limiter *ResourceCheckRateLimiter) AcquireCheckContainer(ctx context.Context, teamID int) (func(), error) {
+	logger := lagerctx.FromContext(ctx).Session("acquire-check-container")
+
+	for {
+		limiter.teamMut.Lock()
+
+		team, err := limiter.team(teamID)
+		if err != nil {
+			limiter.teamMut.Unlock()
+			return nil, err
+		}
+
+		maxContainers := team.maxContainers
+		released := team.released
+
+		if maxContainers <= 0 {
+			limiter.teamMut.Unlock()
+
+			return func() {}, nil
+		}
+
+		limiter.teamMut.Unlock()
+
+		for slot := 0; slot < maxContainers; slot++ {
+			slotLock, acquired, err := limiter.lockFactory.Acquire(logger, lock.NewCheckContainerLockID(teamID, slot))
+			if err != nil {
+				return nil, err
+			}
+
+			if acquired {
+				return limiter.releaseFunc(team, slotLock), nil
+			}
+		}
+
+		timer := limiter.clock.NewTimer(checkContainerPollInterval)
+
+		select {
+		case <-released:
+		case <-timer.C():
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+
+		timer.Stop()
+	}
+}
+
+func (limiter *ResourceCheckRateLimiter) releaseFunc(team *teamCheckLimiter, slotLock lock.Lock) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			slotLock.Release()
+			limiter.releaseCheckContainer(team)
+		})
+	}
+}
+
+func (limiter *ResourceCheckRateLimiter) Limit() rate.Limit {
+	limiter.mut.Lock()
+	defer limiter.mut.Unlock()
+
+	return limiter.checkLimiter.Limit()
+}
+
+// TeamLimit returns the checks per second currently enforced for the team.
+func (limiter *ResourceCheckRateLimiter) TeamLimit(teamID int) (rate.Limit, error) {
+	limiter.teamMut.Lock()
+	defer limiter.teamMut.Unlock()
+
+	team, err := limiter.team(teamID)
+	if err != nil {
+		return 0, err
+	}
+
+	return team.checkLimiter.Limit(), nil
+}
+
+func (limiter *ResourceCheckRateLimiter) waitForTeam(ctx context.Context, teamID int) error {
+	limiter.teamMut.Lock()
+	team, err := limiter.team(teamID)
+	limiter.teamMut.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return limiter.wait(ctx, team.checkLimiter)
+}
+
+func (limiter *ResourceCheckRateLimiter) wait(ctx context.Context, checkLimiter *rate.Limiter) error {
+	reservation := checkLimiter.ReserveN(limiter.clock.Now(), 1)
 
 	delay := reservation.DelayFrom(limiter.clock.Now())
 	if delay == 0 {
@@ -78,11 +225,109 @@ func (limiter *ResourceCheckRateLimiter) Wait(ctx context.Context) error {
 	}
 }
 
-func (limiter *ResourceCheckRateLimiter) Limit() rate.Limit {
-	limiter.mut.Lock()
-	defer limiter.mut.Unlock()
+func (limiter *ResourceCheckRateLimiter) releaseCheckContainer(team *teamCheckLimiter) {
+	limiter.teamMut.Lock()
+	defer limiter.teamMut.Unlock()
 
-	return limiter.checkLimiter.Limit()
+	close(team.released)
+	team.released = make(chan struct{})
+}
+
+// team returns the limiter for the team, refreshing the per-team overrides
+// if the refresh interval has elapsed. It must be called with teamMut held.
+func (limiter *ResourceCheckRateLimiter) team(teamID int) (*teamCheckLimiter, error) {
+	if limiter.teamRefreshLimiter.AllowN(limiter.clock.Now(), 1) {
+		err := limiter.refreshTeamOverrides()
+		if err != nil {
+			return nil, fmt.Errorf("refresh team limits: %w", err)
+		}
+	}
+
+	team, found := limiter.teams[teamID]
+	if !found {
+		team = &teamCheckLimiter{
+			checkLimiter: rate.NewLimiter(rate.Inf, 1),
+			released:     make(chan struct{}),
+		}
+
+		limiter.applyTeamLimits(teamID, team)
+		limiter.teams[teamID] = team
+	}
+
+	return team, nil
+}
+
+func (limiter *ResourceCheckRateLimiter) applyTeamLimits(teamID int, team *teamCheckLimiter) {
+	checksPerSecond := limiter.teamChecksPerSecond
+	maxContainers := limiter.teamMaxCheckContainers
+
+	override := limiter.teamOverrides[teamID]
+	if override.checksPerSecond != nil {
+		checksPerSecond = rate.Limit(*override.checksPerSecond)
+	}
+
+	if override.maxCheckContainers != nil {
+		maxContainers = *override.maxCheckContainers
+	}
+
+	if checksPerSecond <= 0 {
+		checksPerSecond = rate.Inf
+	}
+
+	if checksPerSecond != team.checkLimiter.Limit() {
+		team.checkLimiter.SetLimitAt(limiter.clock.Now(), checksPerSecond)
+	}
+
+	if maxContainers > team.maxContainers || maxContainers <= 0 {
+		// wake up anyone waiting on the old, smaller budget
+		close(team.released)
+		team.released = make(chan struct{})
+	}
+
+	team.maxContainers = maxContainers
+}
+
+func (limiter *ResourceCheckRateLimiter) refreshTeamOverrides() error {
+	rows, err := psql.Select("id", "check_rate_limit", "max_check_containers").
+		From("teams").
+		Where(sq.Or{
+			sq.NotEq{"check_rate_limit": nil},
+			sq.NotEq{"max_check_containers": nil},
+		}).
+		RunWith(limiter.refreshConn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	overrides := map[int]teamCheckOverride{}
+	for rows.Next() {
+		var (
+			teamID             int
+			checksPerSecond    *float64
+			maxCheckContainers *int
+		)
+
+		err = rows.Scan(&teamID, &checksPerSecond, &maxCheckContainers)
+		if err != nil {
+			return err
+		}
+
+		overrides[teamID] = teamCheckOverride{
+			checksPerSecond:    checksPerSecond,
+			maxCheckContainers: maxCheckContainers,
+		}
+	}
+
+	limiter.teamOverrides = overrides
+
+	for teamID, team := range limiter.teams {
+		limiter.applyTeamLimits(teamID, team)
+	}
+
+	return nil
 }
 
 func (limiter *ResourceCheckRateLimiter) refreshCheckLimiter() error {
@@ -7,6 +7,8 @@ import (
 	"code.cloudfoundry.org/clock/fakeclock"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/concourse/concourse/atc/metric"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"golang.org/x/time/rate"
@@ -17,7 +19,10 @@ var _ = Describe("ResourceCheckRateLimiter", func() {
 		checkInterval   time.Duration
 		checksPerSecond int
 		refreshInterval time.Duration
-		fakeClock       *fakeclock.FakeClock
+
+		teamChecksPerSecond    float64
+		teamMaxCheckContainers int
+		fakeClock              *fakeclock.FakeClock
 
 		checkableCount int
 
@@ -30,6 +35,8 @@ var _ = Describe("ResourceCheckRateLimiter", func() {
 		checkInterval = time.Minute
 		checksPerSecond = 0
 		refreshInterval = 5 * time.Minute
+		teamChecksPerSecond = 0
+		teamMaxCheckContainers = 0
 		fakeClock = fakeclock.NewFakeClock(time.Now())
 
 		checkableCount = 0
@@ -41,8 +48,11 @@ var _ = Describe("ResourceCheckRateLimiter", func() {
 		limiter = db.NewResourceCheckRateLimiter(
 			rate.Limit(checksPerSecond),
 			checkInterval,
+			rate.Limit(teamChecksPerSecond),
+			teamMaxCheckContainers,
 			dbConn,
 			refreshInterval,
+			lockFactory,
 			fakeClock,
 		)
 	})
@@ -50,7 +60,7 @@ var _ = Describe("ResourceCheckRateLimiter", func() {
 	wait := func(limiter *db.ResourceCheckRateLimiter) <-chan error {
 		errs := make(chan error)
 		go func() {
-			errs <- limiter.Wait(ctx)
+			errs <- limiter.Wait(ctx, defaultTeam.ID())
 		}()
 		return errs
 	}
@@ -161,4 +171,166 @@ var _ = Describe("ResourceCheckRateLimiter", func() {
 			Expect(limiter.Limit()).To(Equal(rate.Limit(rate.Inf)))
 		})
 	})
+
+	Context("when a per-team checks per second value is provided", func() {
+		BeforeEach(func() {
+			checksPerSecond = -1
+			teamChecksPerSecond = 2
+		})
+
+		It("rate limits checks for the team", func() {
+			teamLimit, err := limiter.TeamLimit(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(teamLimit).To(Equal(rate.Limit(teamChecksPerSecond)))
+
+			By("returning immediately for the first time")
+			Expect(<-wait(limiter)).To(Succeed())
+
+			done := wait(limiter)
+			select {
+			case <-done:
+				Fail("should not have returned yet")
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			By("unblocking after the team rate limit elapses")
+			fakeClock.Increment(time.Second / 2)
+			Expect(<-done).To(Succeed())
+		})
+
+		It("does not limit other teams by the same budget", func() {
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(<-wait(limiter)).To(Succeed())
+			Expect(limiter.Wait(ctx, otherTeam.ID())).To(Succeed())
+		})
+
+		Context("when the team has an override", func() {
+			BeforeEach(func() {
+				checksPerSecond := 10.0
+				err := defaultTeam.SetCheckLimits(atc.TeamCheckLimits{
+					ChecksPerSecond: &checksPerSecond,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("uses the override for the team", func() {
+				teamLimit, err := limiter.TeamLimit(defaultTeam.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(teamLimit).To(Equal(rate.Limit(10)))
+			})
+
+			It("picks up changes to the override after the refresh interval", func() {
+				_, err := limiter.TeamLimit(defaultTeam.ID())
+				Expect(err).ToNot(HaveOccurred())
+
+				unlimited := -1.0
+				err = defaultTeam.SetCheckLimits(atc.TeamCheckLimits{
+					ChecksPerSecond: &unlimited,
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				teamLimit, err := limiter.TeamLimit(defaultTeam.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(teamLimit).To(Equal(rate.Limit(10)))
+
+				fakeClock.Increment(refreshInterval)
+
+				teamLimit, err = limiter.TeamLimit(defaultTeam.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(teamLimit).To(Equal(rate.Inf))
+			})
+		})
+	})
+
+	Context("when a per-team check container budget is provided", func() {
+		BeforeEach(func() {
+			checksPerSecond = -1
+			teamMaxCheckContainers = 2
+		})
+
+		acquire := func(limiter *db.ResourceCheckRateLimiter) <-chan func() {
+			released := make(chan func())
+			go func() {
+				defer GinkgoRecover()
+
+				release, err := limiter.AcquireCheckContainer(ctx, defaultTeam.ID())
+				Expect(err).ToNot(HaveOccurred())
+
+				released <- release
+			}()
+			return released
+		}
+
+		It("blocks once the budget is used up until a container is released", func() {
+			release := <-acquire(limiter)
+			<-acquire(limiter)
+
+			done := acquire(limiter)
+			select {
+			case <-done:
+				Fail("should not have returned yet")
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			release()
+			Eventually(done).Should(Receive())
+		})
+
+		It("shares the budget with the other web nodes", func() {
+			otherLimiter := db.NewResourceCheckRateLimiter(
+				rate.Limit(checksPerSecond),
+				checkInterval,
+				rate.Limit(teamChecksPerSecond),
+				teamMaxCheckContainers,
+				dbConn,
+				refreshInterval,
+				lock.NewLockFactory(postgresRunner.OpenSingleton(), metric.LogLockAcquired, metric.LogLockReleased),
+				fakeClock,
+			)
+
+			release := <-acquire(limiter)
+			<-acquire(otherLimiter)
+
+			done := acquire(otherLimiter)
+			select {
+			case <-done:
+				Fail("should not have returned yet")
+			case <-time.After(100 * time.Millisecond):
+			}
+
+			release()
+			fakeClock.WaitForWatcherAndIncrement(5 * time.Second)
+
+			Eventually(done).Should(Receive())
+		})
+
+		It("returns when the context is canceled", func() {
+			<-acquire(limiter)
+			<-acquire(limiter)
+
+			cancelCtx, cancel := context.WithCancel(ctx)
+			cancel()
+
+			_, err := limiter.AcquireCheckContainer(cancelCtx, defaultTeam.ID())
+			Expect(err).To(Equal(context.Canceled))
+		})
+
+		Context("when the team has an override", func() {
+			BeforeEach(func() {
+				unlimited := 0
+				err := defaultTeam.SetCheckLimits(atc.TeamCheckLimits{
+					MaxCheckContainers: &unlimited,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("uses the override for the team", func() {
+				for i := 0; i < 5; i++ {
+					<-acquire(limiter)
+				}
+			})
+		})
+	})
 })
@@ -25,7 +25,7 @@ type ResourceConfigScope interface {
 	Resource() Resource
 	ResourceConfig() ResourceConfig
 
-	SaveVersions(SpanContext, []atc.Version) error
+	SaveVersions(SpanContext, []atc.Version, atc.VersionSchema) error
 	FindVersion(atc.Version) (ResourceConfigVersion, bool, error)
 	LatestVersion() (ResourceConfigVersion, bool, error)
 
@@ -74,7 +74,17 @@ func (r *resourceConfigScope) LastCheckEndTime() (time.Time, error) {
 // In the case of a check resource from an older version, the versions
 // that already exist in the DB will be re-ordered using
 // incrementCheckOrder to input the correct check order
-func (r *resourceConfigScope) SaveVersions(spanContext SpanContext, versions []atc.Version) error {
+//
+// If any version does not satisfy the schema, none of the versions are saved
+// and an atc.InvalidVersionError is returned.
+func (r *resourceConfigScope) SaveVersions(spanContext SpanContext, versions []atc.Version, schema atc.VersionSchema) error {
+	for _, version := range versions {
+		err := schema.ValidateVersion(version)
+		if err != nil {
+			return err
+		}
+	}
+
 	return saveVersions(r.conn, r.ID(), versions, spanContext)
 }
 
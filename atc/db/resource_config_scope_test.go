@@ -79,7 +79,7 @@ var _ = Describe("Resource Config Scope", func() {
 
 		// XXX: Can make test more resilient if there is a method that gives all versions by descending check order
 		It("ensures versioned resources have the correct check_order", func() {
-			err := resourceScope.SaveVersions(nil, originalVersionSlice)
+			err := resourceScope.SaveVersions(nil, originalVersionSlice, atc.VersionSchema{})
 			Expect(err).ToNot(HaveOccurred())
 
 			latestVR, found, err := resourceScope.LatestVersion()
@@ -94,7 +94,7 @@ var _ = Describe("Resource Config Scope", func() {
 				{"ref": "v3"},
 			}
 
-			err = resourceScope.SaveVersions(nil, pretendCheckResults)
+			err = resourceScope.SaveVersions(nil, pretendCheckResults, atc.VersionSchema{})
 			Expect(err).ToNot(HaveOccurred())
 
 			latestVR, found, err = resourceScope.LatestVersion()
@@ -105,6 +105,28 @@ var _ = Describe("Resource Config Scope", func() {
 			Expect(latestVR.CheckOrder()).To(Equal(4))
 		})
 
+		Context("when a version does not satisfy the schema", func() {
+			var schema atc.VersionSchema
+
+			BeforeEach(func() {
+				schema = atc.VersionSchema{
+					RequiredKeys: []string{"ref"},
+				}
+			})
+
+			It("returns an invalid version error and saves none of the versions", func() {
+				err := resourceScope.SaveVersions(nil, []atc.Version{{"ref": "v1"}, {"digest": "v2"}}, schema)
+				Expect(err).To(MatchError(atc.InvalidVersionError{
+					Version: atc.Version{"digest": "v2"},
+					Reason:  "missing required keys: ref",
+				}))
+
+				_, found, err := resourceScope.LatestVersion()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
 		Context("when the versions already exists", func() {
 			var newVersionSlice []atc.Version
 
@@ -114,7 +136,7 @@ var _ = Describe("Resource Config Scope", func() {
 					{"ref": "v3"},
 				}
 
-				err := resourceScope.SaveVersions(nil, originalVersionSlice)
+				err := resourceScope.SaveVersions(nil, originalVersionSlice, atc.VersionSchema{})
 				Expect(err).ToNot(HaveOccurred())
 
 				latestVR, found, err := resourceScope.LatestVersion()
@@ -126,7 +148,7 @@ var _ = Describe("Resource Config Scope", func() {
 			})
 
 			It("does not change the check order", func() {
-				err := resourceScope.SaveVersions(nil, newVersionSlice)
+				err := resourceScope.SaveVersions(nil, newVersionSlice, atc.VersionSchema{})
 				Expect(err).ToNot(HaveOccurred())
 
 				latestVR, found, err := resourceScope.LatestVersion()
@@ -139,7 +161,7 @@ var _ = Describe("Resource Config Scope", func() {
 
 			Context("when a new version is added", func() {
 				It("requests schedule on the jobs that use the resource", func() {
-					err := resourceScope.SaveVersions(nil, originalVersionSlice)
+					err := resourceScope.SaveVersions(nil, originalVersionSlice, atc.VersionSchema{})
 					Expect(err).ToNot(HaveOccurred())
 
 					requestedSchedule := scenario.Job("some-job").ScheduleRequestedTime()
@@ -148,14 +170,14 @@ var _ = Describe("Resource Config Scope", func() {
 						{"ref": "v0"},
 						{"ref": "v3"},
 					}
-					err = resourceScope.SaveVersions(nil, newVersions)
+					err = resourceScope.SaveVersions(nil, newVersions, atc.VersionSchema{})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(scenario.Job("some-job").ScheduleRequestedTime()).Should(BeTemporally(">", requestedSchedule))
 				})
 
 				It("does not request schedule on the jobs that use the resource but through passed constraints", func() {
-					err := resourceScope.SaveVersions(nil, originalVersionSlice)
+					err := resourceScope.SaveVersions(nil, originalVersionSlice, atc.VersionSchema{})
 					Expect(err).ToNot(HaveOccurred())
 
 					requestedSchedule := scenario.Job("downstream-job").ScheduleRequestedTime()
@@ -164,14 +186,14 @@ var _ = Describe("Resource Config Scope", func() {
 						{"ref": "v0"},
 						{"ref": "v3"},
 					}
-					err = resourceScope.SaveVersions(nil, newVersions)
+					err = resourceScope.SaveVersions(nil, newVersions, atc.VersionSchema{})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(scenario.Job("downstream-job").ScheduleRequestedTime()).Should(BeTemporally("==", requestedSchedule))
 				})
 
 				It("does not request schedule on the jobs that do not use the resource", func() {
-					err := resourceScope.SaveVersions(nil, originalVersionSlice)
+					err := resourceScope.SaveVersions(nil, originalVersionSlice, atc.VersionSchema{})
 					Expect(err).ToNot(HaveOccurred())
 
 					requestedSchedule := scenario.Job("some-other-job").ScheduleRequestedTime()
@@ -180,7 +202,7 @@ var _ = Describe("Resource Config Scope", func() {
 						{"ref": "v0"},
 						{"ref": "v3"},
 					}
-					err = resourceScope.SaveVersions(nil, newVersions)
+					err = resourceScope.SaveVersions(nil, newVersions, atc.VersionSchema{})
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(scenario.Job("some-other-job").ScheduleRequestedTime()).Should(BeTemporally("==", requestedSchedule))
@@ -199,7 +221,7 @@ var _ = Describe("Resource Config Scope", func() {
 					{"ref": "v3"},
 				}
 
-				err := resourceScope.SaveVersions(nil, originalVersionSlice)
+				err := resourceScope.SaveVersions(nil, originalVersionSlice, atc.VersionSchema{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -214,7 +236,7 @@ var _ = Describe("Resource Config Scope", func() {
 			})
 
 			It("disabled versions do not affect fetching the latest version", func() {
-				err := resourceScope.SaveVersions(nil, []atc.Version{{"version": "1"}})
+				err := resourceScope.SaveVersions(nil, []atc.Version{{"version": "1"}}, atc.VersionSchema{})
 				Expect(err).ToNot(HaveOccurred())
 
 				savedRCV, found, err := resourceScope.LatestVersion()
@@ -239,7 +261,7 @@ var _ = Describe("Resource Config Scope", func() {
 			})
 
 			It("saving versioned resources updates the latest versioned resource", func() {
-				err := resourceScope.SaveVersions(nil, []atc.Version{{"ref": "4"}, {"ref": "5"}})
+				err := resourceScope.SaveVersions(nil, []atc.Version{{"ref": "4"}, {"ref": "5"}}, atc.VersionSchema{})
 				Expect(err).ToNot(HaveOccurred())
 
 				savedVR, found, err := resourceScope.LatestVersion()
@@ -258,7 +280,7 @@ var _ = Describe("Resource Config Scope", func() {
 				{"ref": "v3"},
 			}
 
-			err := resourceScope.SaveVersions(nil, originalVersionSlice)
+			err := resourceScope.SaveVersions(nil, originalVersionSlice, atc.VersionSchema{})
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -226,7 +226,7 @@ var _ = Describe("Resource", func() {
 			It("successfully disables the version", func() {
 				Expect(disableErr).ToNot(HaveOccurred())
 
-				versions, _, found, err := scenario.Resource("some-other-resource").Versions(db.Page{Limit: 3}, nil)
+				versions, _, found, err := scenario.Resource("some-other-resource").Versions(db.Page{Limit: 3}, atc.ResourceVersionFilter{})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(versions).To(HaveLen(1))
@@ -256,7 +256,7 @@ var _ = Describe("Resource", func() {
 				})
 
 				It("successfully enables the version", func() {
-					versions, _, found, err := scenario.Resource("some-other-resource").Versions(db.Page{Limit: 3}, nil)
+					versions, _, found, err := scenario.Resource("some-other-resource").Versions(db.Page{Limit: 3}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(versions).To(HaveLen(1))
@@ -631,7 +631,7 @@ var _ = Describe("Resource", func() {
 		)
 
 		Context("with version filters", func() {
-			var filter atc.Version
+			var filter atc.ResourceVersionFilter
 			var resourceVersions []atc.ResourceVersion
 
 			BeforeEach(func() {
@@ -680,7 +680,7 @@ var _ = Describe("Resource", func() {
 
 			Context("when filter include one field that matches", func() {
 				BeforeEach(func() {
-					filter = atc.Version{"ref": "v2"}
+					filter = atc.ResourceVersionFilter{Version: atc.Version{"ref": "v2"}}
 				})
 
 				It("return version that matches field filter", func() {
@@ -694,7 +694,7 @@ var _ = Describe("Resource", func() {
 
 			Context("when filter include one field that doesn't match", func() {
 				BeforeEach(func() {
-					filter = atc.Version{"ref": "v20"}
+					filter = atc.ResourceVersionFilter{Version: atc.Version{"ref": "v20"}}
 				})
 
 				It("return no version", func() {
@@ -707,7 +707,7 @@ var _ = Describe("Resource", func() {
 
 			Context("when filter include two fields that match", func() {
 				BeforeEach(func() {
-					filter = atc.Version{"ref": "v1", "commit": "v1"}
+					filter = atc.ResourceVersionFilter{Version: atc.Version{"ref": "v1", "commit": "v1"}}
 				})
 
 				It("return version", func() {
@@ -721,7 +721,7 @@ var _ = Describe("Resource", func() {
 
 			Context("when filter include two fields and one of them does not match", func() {
 				BeforeEach(func() {
-					filter = atc.Version{"ref": "v1", "commit": "v2"}
+					filter = atc.ResourceVersionFilter{Version: atc.Version{"ref": "v1", "commit": "v2"}}
 				})
 
 				It("return no version", func() {
@@ -731,6 +731,96 @@ var _ = Describe("Resource", func() {
 					Expect(len(result)).To(Equal(0))
 				})
 			})
+
+			Context("when filtering by metadata", func() {
+				BeforeEach(func() {
+					for i, author := range []string{"alice", "bob", "alice"} {
+						found, err := scenario.Resource("some-resource").UpdateMetadata(
+							resourceVersions[i].Version,
+							db.ResourceConfigMetadataFields{
+								{Name: "author", Value: author},
+								{Name: "message", Value: "Fix bug #" + strconv.Itoa(i)},
+								{Name: "tag", Value: "v1." + strconv.Itoa(i) + ".0"},
+							},
+						)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(found).To(BeTrue())
+					}
+				})
+
+				It("matches exact values", func() {
+					filter = atc.ResourceVersionFilter{Metadata: []atc.MetadataFilter{
+						{Name: "author", Value: "alice", Match: atc.MetadataMatchExact},
+					}}
+
+					result, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 10}, filter)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(len(result)).To(Equal(2))
+					Expect(result[0].Version).To(Equal(resourceVersions[2].Version))
+					Expect(result[1].Version).To(Equal(resourceVersions[0].Version))
+				})
+
+				It("matches substrings ignoring case", func() {
+					filter = atc.ResourceVersionFilter{Metadata: []atc.MetadataFilter{
+						{Name: "message", Value: "BUG #1", Match: atc.MetadataMatchContains},
+					}}
+
+					result, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 10}, filter)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(len(result)).To(Equal(1))
+					Expect(result[0].Version).To(Equal(resourceVersions[1].Version))
+				})
+
+				It("matches glob patterns", func() {
+					filter = atc.ResourceVersionFilter{Metadata: []atc.MetadataFilter{
+						{Name: "tag", Value: "v1.*.0", Match: atc.MetadataMatchPattern},
+						{Name: "author", Value: "bob", Match: atc.MetadataMatchExact},
+					}}
+
+					result, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 10}, filter)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(len(result)).To(Equal(1))
+					Expect(result[0].Version).To(Equal(resourceVersions[1].Version))
+				})
+
+				It("does not treat other characters as wildcards", func() {
+					filter = atc.ResourceVersionFilter{Metadata: []atc.MetadataFilter{
+						{Name: "tag", Value: "v1_*", Match: atc.MetadataMatchPattern},
+					}}
+
+					result, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 10}, filter)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(len(result)).To(Equal(0))
+				})
+			})
+
+			Context("when filtering by check time", func() {
+				BeforeEach(func() {
+					_, err := dbConn.Exec(`UPDATE resource_config_versions SET create_time = $1 WHERE id = $2`, time.Now().Add(-time.Hour), resourceVersions[0].ID)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns versions checked within the range", func() {
+					filter = atc.ResourceVersionFilter{Since: time.Now().Add(-time.Minute)}
+
+					result, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 10}, filter)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(len(result)).To(Equal(2))
+
+					filter = atc.ResourceVersionFilter{Until: time.Now().Add(-time.Minute)}
+
+					result, _, found, err = scenario.Resource("some-resource").Versions(db.Page{Limit: 10}, filter)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(len(result)).To(Equal(1))
+					Expect(result[0].Version).To(Equal(resourceVersions[0].Version))
+				})
+			})
 		})
 
 		Context("when resource has versions created in order of check order", func() {
@@ -786,7 +876,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with no from/to", func() {
 				It("returns the first page, with the given limit, and a next page", func() {
-					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 2}, nil)
+					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 2}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(2))
@@ -799,7 +889,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with a to that places it in the middle of the versions", func() {
 				It("returns the versions, with previous/next pages", func() {
-					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{To: db.NewIntPtr(resourceVersions[6].ID), Limit: 2}, nil)
+					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{To: db.NewIntPtr(resourceVersions[6].ID), Limit: 2}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(2))
@@ -812,7 +902,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with a to that places it to the oldest version", func() {
 				It("returns the versions, with no next page", func() {
-					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{To: db.NewIntPtr(resourceVersions[1].ID), Limit: 2}, nil)
+					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{To: db.NewIntPtr(resourceVersions[1].ID), Limit: 2}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(2))
@@ -825,7 +915,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with a from that places it in the middle of the versions", func() {
 				It("returns the versions, with previous/next pages", func() {
-					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{From: db.NewIntPtr(resourceVersions[6].ID), Limit: 2}, nil)
+					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{From: db.NewIntPtr(resourceVersions[6].ID), Limit: 2}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(2))
@@ -838,7 +928,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with a from that places it at the beginning of the most recent versions", func() {
 				It("returns the versions, with no previous page", func() {
-					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{From: db.NewIntPtr(resourceVersions[8].ID), Limit: 2}, nil)
+					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{From: db.NewIntPtr(resourceVersions[8].ID), Limit: 2}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(2))
@@ -857,7 +947,7 @@ var _ = Describe("Resource", func() {
 				})
 
 				It("returns the metadata in the version history", func() {
-					historyPage, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 1}, nil)
+					historyPage, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 1}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(1))
@@ -868,7 +958,7 @@ var _ = Describe("Resource", func() {
 				It("maintains existing metadata after same version is saved with no metadata", func() {
 					scenario.Run(builder.WithResourceVersions("some-resource", atc.Version(resourceVersions[9].Version)))
 
-					historyPage, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 1}, atc.Version{})
+					historyPage, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 1}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(1))
@@ -880,7 +970,7 @@ var _ = Describe("Resource", func() {
 					newMetadata := []db.ResourceConfigMetadataField{{Name: "name-new", Value: "value-new"}}
 					scenario.Run(builder.WithVersionMetadata("some-resource", atc.Version(resourceVersions[9].Version), newMetadata))
 
-					historyPage, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 1}, atc.Version{})
+					historyPage, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 1}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(1))
@@ -897,7 +987,7 @@ var _ = Describe("Resource", func() {
 				})
 
 				It("returns a disabled version", func() {
-					historyPage, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 1}, nil)
+					historyPage, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 1}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(historyPage).To(ConsistOf([]atc.ResourceVersion{resourceVersions[9]}))
@@ -914,7 +1004,7 @@ var _ = Describe("Resource", func() {
 				})
 
 				It("returns a version with metadata updated", func() {
-					historyPage, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 1}, nil)
+					historyPage, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 1}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(len(historyPage)).To(Equal(1))
@@ -976,7 +1066,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with no from/to", func() {
 				It("returns versions ordered by check order", func() {
-					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 4}, nil)
+					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 4}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(historyPage).To(HaveLen(4))
@@ -991,7 +1081,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with from", func() {
 				It("returns the versions, with previous/next pages including from", func() {
-					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{From: db.NewIntPtr(resourceVersions[1].ID), Limit: 2}, nil)
+					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{From: db.NewIntPtr(resourceVersions[1].ID), Limit: 2}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(historyPage).To(HaveLen(2))
@@ -1004,7 +1094,7 @@ var _ = Describe("Resource", func() {
 
 			Context("with to", func() {
 				It("returns the builds, with previous/next pages including to", func() {
-					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{To: db.NewIntPtr(resourceVersions[2].ID), Limit: 2}, nil)
+					historyPage, pagination, found, err := scenario.Resource("some-resource").Versions(db.Page{To: db.NewIntPtr(resourceVersions[2].ID), Limit: 2}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(historyPage).To(HaveLen(2))
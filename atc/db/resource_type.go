@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"strconv"
 	"time"
 
@@ -39,15 +40,21 @@ type ResourceType interface {
 	Tags() atc.Tags
 	CheckEvery() *atc.CheckEvery
 	CheckTimeout() string
+	VersionSchema() *atc.VersionSchema
 	LastCheckStartTime() time.Time
 	LastCheckEndTime() time.Time
 	CurrentPinnedVersion() atc.Version
+	CandidateVersion() atc.Version
 	ResourceConfigScopeID() int
 
 	HasWebhook() bool
 
 	SetResourceConfigScope(ResourceConfigScope) error
 
+	PinVersion(atc.Version) (bool, error)
+	UnpinVersion() error
+	PromoteCandidateVersion() (bool, error)
+
 	CheckPlan(atc.Version, time.Duration, ResourceTypes, atc.Source) atc.CheckPlan
 	CreateBuild(context.Context, bool, atc.Plan) (Build, bool, error)
 
@@ -109,6 +116,8 @@ func (resourceTypes ResourceTypes) Deserialize() atc.VersionedResourceTypes {
 				CheckEvery: t.CheckEvery(),
 				Tags:       t.Tags(),
 				Params:     t.Params(),
+
+				VersionSchema: t.VersionSchema(),
 			},
 			Version: t.Version(),
 		})
@@ -130,6 +139,8 @@ func (resourceTypes ResourceTypes) Configs() atc.ResourceTypes {
 			CheckEvery: r.CheckEvery(),
 			Tags:       r.Tags(),
 			Params:     r.Params(),
+
+			VersionSchema: r.VersionSchema(),
 		})
 	}
 
@@ -151,12 +162,14 @@ var resourceTypesQuery = psql.Select(
 	"ro.id",
 	"ro.last_check_start_time",
 	"ro.last_check_end_time",
+	"rtp.version",
 ).
 	From("resource_types r").
 	Join("pipelines p ON p.id = r.pipeline_id").
 	Join("teams t ON t.id = p.team_id").
 	LeftJoin("resource_configs c ON c.id = r.resource_config_id").
 	LeftJoin("resource_config_scopes ro ON ro.resource_config_id = c.id").
+	LeftJoin("resource_type_pins rtp ON rtp.resource_type_id = r.id").
 	LeftJoin(`LATERAL (
 		SELECT rcv.*
 		FROM resource_config_versions rcv
@@ -181,7 +194,9 @@ type resourceType struct {
 	params                atc.Params
 	tags                  atc.Tags
 	version               atc.Version
+	pinnedVersion         atc.Version
 	checkEvery            *atc.CheckEvery
+	versionSchema         *atc.VersionSchema
 	lastCheckStartTime    time.Time
 	lastCheckEndTime      time.Time
 }
@@ -202,8 +217,35 @@ func (t *resourceType) Params() atc.Params            { return t.params }
 func (t *resourceType) Tags() atc.Tags                { return t.tags }
 func (t *resourceType) ResourceConfigScopeID() int    { return t.resourceConfigScopeID }
 
-func (t *resourceType) Version() atc.Version              { return t.version }
-func (t *resourceType) CurrentPinnedVersion() atc.Version { return nil }
+func (t *resourceType) VersionSchema() *atc.VersionSchema { return t.versionSchema }
+
+func (t *resourceType) CurrentPinnedVersion() atc.Version { return t.pinnedVersion }
+
+// Version returns the version of the resource type used to run its
+// resources. If the resource type is pinned, this is the pinned version
+// rather than the latest version found by its check.
+func (t *resourceType) Version() atc.Version {
+	if t.pinnedVersion != nil {
+		return t.pinnedVersion
+	}
+
+	return t.version
+}
+
+// CandidateVersion returns the latest version found by the check of a pinned
+// resource type, if it differs from the pinned version. The candidate is not
+// used until it is promoted.
+func (t *resourceType) CandidateVersion() atc.Version {
+	if t.pinnedVersion == nil || t.version == nil {
+		return nil
+	}
+
+	if reflect.DeepEqual(t.pinnedVersion, t.version) {
+		return nil
+	}
+
+	return t.version
+}
 
 func (t *resourceType) HasWebhook() bool {
 	return false
@@ -248,6 +290,91 @@ func (r *resourceType) SetResourceConfigScope(scope ResourceConfigScope) error {
 	return nil
 }
 
+func (r *resourceType) PinVersion(version atc.Version) (bool, error) {
+	versionJSON, err := json.Marshal(version)
+	if err != nil {
+		return false, err
+	}
+
+	results, err := r.conn.Exec(`
+		INSERT INTO resource_type_pins (resource_type_id, version)
+		SELECT $1, rcv.version
+		FROM resource_config_versions rcv
+		WHERE rcv.resource_config_scope_id = $2
+		AND rcv.version = $3::jsonb
+		ON CONFLICT (resource_type_id) DO UPDATE SET version = EXCLUDED.version`,
+		r.id, r.resourceConfigScopeID, versionJSON)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := results.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rowsAffected != 1 {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+func (r *resourceType) UnpinVersion() error {
+	results, err := psql.Delete("resource_type_pins").
+		Where(sq.Eq{"resource_type_id": r.id}).
+		RunWith(r.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := results.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected != 1 {
+		return NonOneRowAffectedError{rowsAffected}
+	}
+
+	return nil
+}
+
+// PromoteCandidateVersion moves the pin of the resource type to its
+// candidate version. It returns false if the resource type has no candidate.
+func (r *resourceType) PromoteCandidateVersion() (bool, error) {
+	candidate := r.CandidateVersion()
+	if candidate == nil {
+		return false, nil
+	}
+
+	versionJSON, err := json.Marshal(candidate)
+	if err != nil {
+		return false, err
+	}
+
+	results, err := psql.Update("resource_type_pins").
+		Set("version", string(versionJSON)).
+		Where(sq.Eq{"resource_type_id": r.id}).
+		RunWith(r.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := results.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rowsAffected != 1 {
+		return false, nil
+	}
+
+	return true, nil
+}
+
 func (r *resourceType) CheckPlan(from atc.Version, interval time.Duration, resourceTypes ResourceTypes, sourceDefaults atc.Source) atc.CheckPlan {
 	return atc.CheckPlan{
 		Name:   r.Name(),
@@ -362,12 +489,12 @@ func (r *resourceType) CreateBuild(ctx context.Context, manuallyTriggered bool,
 func scanResourceType(t *resourceType, row scannable) error {
 	var (
 		configJSON                           sql.NullString
-		rcsID, version, nonce                sql.NullString
+		rcsID, version, nonce, pinnedVersion sql.NullString
 		lastCheckStartTime, lastCheckEndTime pq.NullTime
 		pipelineInstanceVars                 sql.NullString
 	)
 
-	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &t.pipelineName, &pipelineInstanceVars, &t.teamID, &t.teamName, &rcsID, &lastCheckStartTime, &lastCheckEndTime)
+	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &t.pipelineName, &pipelineInstanceVars, &t.teamID, &t.teamName, &rcsID, &lastCheckStartTime, &lastCheckEndTime, &pinnedVersion)
 	if err != nil {
 		return err
 	}
@@ -382,6 +509,14 @@ func scanResourceType(t *resourceType, row scannable) error {
 		}
 	}
 
+	t.pinnedVersion = nil
+	if pinnedVersion.Valid {
+		err = json.Unmarshal([]byte(pinnedVersion.String), &t.pinnedVersion)
+		if err != nil {
+			return err
+		}
+	}
+
 	es := t.conn.EncryptionStrategy()
 
 	var noncense *string
@@ -410,6 +545,7 @@ func scanResourceType(t *resourceType, row scannable) error {
 	t.privileged = config.Privileged
 	t.tags = config.Tags
 	t.checkEvery = config.CheckEvery
+	t.versionSchema = config.VersionSchema
 
 	if rcsID.Valid {
 		t.resourceConfigScopeID, err = strconv.Atoi(rcsID.String)
@@ -454,6 +454,122 @@ var _ = Describe("ResourceType", func() {
 		})
 	})
 
+	Describe("pinning a version", func() {
+		var scenario *dbtest.Scenario
+
+		BeforeEach(func() {
+			scenario = dbtest.Setup(
+				builder.WithPipeline(atc.Config{
+					ResourceTypes: atc.ResourceTypes{
+						{
+							Name:   "some-type",
+							Type:   "some-base-resource-type",
+							Source: atc.Source{"some": "repository"},
+						},
+					},
+				}),
+				builder.WithResourceTypeVersions("some-type",
+					atc.Version{"version": "1"},
+					atc.Version{"version": "2"},
+				),
+			)
+		})
+
+		It("is not pinned by default", func() {
+			Expect(scenario.ResourceType("some-type").CurrentPinnedVersion()).To(BeNil())
+			Expect(scenario.ResourceType("some-type").CandidateVersion()).To(BeNil())
+		})
+
+		Context("when pinned to an older version", func() {
+			BeforeEach(func() {
+				pinned, err := scenario.ResourceType("some-type").PinVersion(atc.Version{"version": "1"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pinned).To(BeTrue())
+			})
+
+			It("uses the pinned version", func() {
+				resourceType := scenario.ResourceType("some-type")
+				Expect(resourceType.CurrentPinnedVersion()).To(Equal(atc.Version{"version": "1"}))
+				Expect(resourceType.Version()).To(Equal(atc.Version{"version": "1"}))
+			})
+
+			It("surfaces the latest version as the candidate", func() {
+				Expect(scenario.ResourceType("some-type").CandidateVersion()).To(Equal(atc.Version{"version": "2"}))
+			})
+
+			Context("when newer versions are found", func() {
+				BeforeEach(func() {
+					scenario.Run(builder.WithResourceTypeVersions("some-type",
+						atc.Version{"version": "1"},
+						atc.Version{"version": "2"},
+						atc.Version{"version": "3"},
+					))
+				})
+
+				It("keeps using the pinned version", func() {
+					Expect(scenario.ResourceType("some-type").Version()).To(Equal(atc.Version{"version": "1"}))
+				})
+
+				It("surfaces the newest version as the candidate", func() {
+					Expect(scenario.ResourceType("some-type").CandidateVersion()).To(Equal(atc.Version{"version": "3"}))
+				})
+			})
+
+			Context("when the candidate is promoted", func() {
+				BeforeEach(func() {
+					promoted, err := scenario.ResourceType("some-type").PromoteCandidateVersion()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(promoted).To(BeTrue())
+				})
+
+				It("pins the candidate version", func() {
+					resourceType := scenario.ResourceType("some-type")
+					Expect(resourceType.CurrentPinnedVersion()).To(Equal(atc.Version{"version": "2"}))
+					Expect(resourceType.Version()).To(Equal(atc.Version{"version": "2"}))
+					Expect(resourceType.CandidateVersion()).To(BeNil())
+				})
+
+				It("has nothing left to promote", func() {
+					promoted, err := scenario.ResourceType("some-type").PromoteCandidateVersion()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(promoted).To(BeFalse())
+				})
+			})
+
+			Context("when unpinned", func() {
+				BeforeEach(func() {
+					err := scenario.ResourceType("some-type").UnpinVersion()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("uses the latest version again", func() {
+					resourceType := scenario.ResourceType("some-type")
+					Expect(resourceType.CurrentPinnedVersion()).To(BeNil())
+					Expect(resourceType.Version()).To(Equal(atc.Version{"version": "2"}))
+					Expect(resourceType.CandidateVersion()).To(BeNil())
+				})
+			})
+		})
+
+		Context("when pinning a version that has not been found", func() {
+			It("does not pin the resource type", func() {
+				pinned, err := scenario.ResourceType("some-type").PinVersion(atc.Version{"version": "bogus"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pinned).To(BeFalse())
+
+				Expect(scenario.ResourceType("some-type").CurrentPinnedVersion()).To(BeNil())
+			})
+		})
+
+		Context("when promoting without a pin", func() {
+			It("does not promote anything", func() {
+				promoted, err := scenario.ResourceType("some-type").PromoteCandidateVersion()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(promoted).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("SetResourceConfigScope", func() {
 		var resourceType db.ResourceType
 		var scope db.ResourceConfigScope
@@ -0,0 +1,175 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// Secret is kept by the built-in credential manager. Its value is only read
+// when looking up credentials.
+type Secret struct {
+	TeamName     string
+	PipelineName string
+	Name         string
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	UpdatedBy string
+}
+
+//go:generate counterfeiter . SecretFactory
+
+// SecretFactory stores the secrets of the built-in credential manager,
+// encrypted with the database's encryption strategy.
+type SecretFactory interface {
+	Secrets(teamID int) ([]Secret, error)
+	SetSecret(teamID int, pipelineName string, name string, request atc.SetSecretRequest, updatedBy string) error
+	DeleteSecret(teamID int, pipelineName string, name string) (bool, error)
+
+	FindSecretValue(teamName string, pipelineName string, name string) (interface{}, bool, error)
+}
+
+type secretFactory struct {
+	conn Conn
+}
+
+func NewSecretFactory(conn Conn) SecretFactory {
+	return &secretFactory{
+		conn: conn,
+	}
+}
+
+// Secrets returns the secrets of the team, including those of its
+// pipelines, without their values.
+func (f *secretFactory) Secrets(teamID int) ([]Secret, error) {
+	rows, err := psql.Select("t.name", "s.pipeline_name", "s.name", "s.created_at", "s.updated_at", "s.updated_by").
+		From("secrets s").
+		Join("teams t ON t.id = s.team_id").
+		Where(sq.Eq{"s.team_id": teamID}).
+		OrderBy("s.pipeline_name", "s.name").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	secrets := []Secret{}
+	for rows.Next() {
+		var secret Secret
+		err := rows.Scan(
+			&secret.TeamName,
+			&secret.PipelineName,
+			&secret.Name,
+			&secret.CreatedAt,
+			&secret.UpdatedAt,
+			&secret.UpdatedBy,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		secrets = append(secrets, secret)
+	}
+
+	return secrets, nil
+}
+
+// SetSecret creates or replaces the secret, encrypting its value.
+func (f *secretFactory) SetSecret(teamID int, pipelineName string, name string, request atc.SetSecretRequest, updatedBy string) error {
+	payload, err := json.Marshal(request.Value)
+	if err != nil {
+		return err
+	}
+
+	encryptedValue, nonce, err := f.conn.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("secrets").
+		Columns("team_id", "pipeline_name", "name", "value", "nonce", "updated_by").
+		Values(teamID, pipelineName, name, encryptedValue, nonce, updatedBy).
+		Suffix(`
+			ON CONFLICT (team_id, pipeline_name, name) DO UPDATE SET
+				value = EXCLUDED.value,
+				nonce = EXCLUDED.nonce,
+				updated_at = NOW(),
+				updated_by = EXCLUDED.updated_by
+		`).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+func (f *secretFactory) DeleteSecret(teamID int, pipelineName string, name string) (bool, error) {
+	result, err := psql.Delete("secrets").
+		Where(sq.Eq{
+			"team_id":       teamID,
+			"pipeline_name": pipelineName,
+			"name":          name,
+		}).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// FindSecretValue returns the decrypted value of the secret. An empty
+// pipeline name finds the team's secret shared by all of its pipelines.
+func (f *secretFactory) FindSecretValue(teamName string, pipelineName string, name string) (interface{}, bool, error) {
+	var (
+		encryptedValue string
+		nonce          sql.NullString
+	)
+
+	err := psql.Select("s.value", "s.nonce").
+		From("secrets s").
+		Join("teams t ON t.id = s.team_id").
+		Where(sq.Eq{
+			"t.name":          teamName,
+			"s.pipeline_name": pipelineName,
+			"s.name":          name,
+		}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&encryptedValue, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	payload, err := f.conn.EncryptionStrategy().Decrypt(encryptedValue, noncense)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var value interface{}
+	err = json.Unmarshal(payload, &value)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return value, true, nil
+}
@@ -0,0 +1,105 @@
+package db
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// SecretAccess records that a build resolved a credential. The value of the
+// credential is never recorded.
+type SecretAccess struct {
+	BuildID    int
+	VarSource  string
+	Path       string
+	Manager    string
+	AccessedAt time.Time
+}
+
+// SecretAccessSummary aggregates the accesses of a team's builds to a
+// credential.
+type SecretAccessSummary struct {
+	TeamName  string
+	VarSource string
+	Path      string
+	Manager   string
+
+	BuildIDs       []int
+	LastAccessedAt time.Time
+}
+
+type SecretAccessFilter struct {
+	Path  string
+	Since time.Time
+}
+
+//go:generate counterfeiter . SecretAccessFactory
+
+type SecretAccessFactory interface {
+	SecretAccessSummaries(filter SecretAccessFilter) ([]SecretAccessSummary, error)
+}
+
+type secretAccessFactory struct {
+	conn Conn
+}
+
+func NewSecretAccessFactory(conn Conn) SecretAccessFactory {
+	return &secretAccessFactory{
+		conn: conn,
+	}
+}
+
+// SecretAccessSummaries groups the recorded accesses by team and credential,
+// listing the builds which accessed each credential from the newest.
+func (f *secretAccessFactory) SecretAccessSummaries(filter SecretAccessFilter) ([]SecretAccessSummary, error) {
+	query := psql.Select("t.name", "a.var_source", "a.path", "a.manager", "array_agg(a.build_id ORDER BY a.build_id DESC)", "max(a.accessed_at)").
+		From("build_secret_accesses a").
+		Join("builds b ON b.id = a.build_id").
+		Join("teams t ON t.id = b.team_id").
+		GroupBy("t.name", "a.var_source", "a.path", "a.manager").
+		OrderBy("a.path", "a.var_source", "t.name", "a.manager")
+
+	if filter.Path != "" {
+		query = query.Where(sq.Eq{"a.path": filter.Path})
+	}
+
+	if !filter.Since.IsZero() {
+		query = query.Where(sq.GtOrEq{"a.accessed_at": filter.Since})
+	}
+
+	rows, err := query.RunWith(f.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	summaries := []SecretAccessSummary{}
+	for rows.Next() {
+		var (
+			summary  SecretAccessSummary
+			buildIDs pq.Int64Array
+		)
+
+		err := rows.Scan(
+			&summary.TeamName,
+			&summary.VarSource,
+			&summary.Path,
+			&summary.Manager,
+			&buildIDs,
+			&summary.LastAccessedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range buildIDs {
+			summary.BuildIDs = append(summary.BuildIDs, int(id))
+		}
+
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, nil
+}
@@ -0,0 +1,91 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SecretAccess", func() {
+	var (
+		firstBuild  db.Build
+		secondBuild db.Build
+	)
+
+	BeforeEach(func() {
+		var err error
+		firstBuild, err = defaultTeam.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		secondBuild, err = defaultTeam.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		err = firstBuild.SaveSecretAccesses([]db.SecretAccess{
+			{Path: "deploy-key", Manager: "vault"},
+			{VarSource: "some-source", Path: "token", Manager: "ssm"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = secondBuild.SaveSecretAccesses([]db.SecretAccess{
+			{Path: "deploy-key", Manager: "vault"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("SecretAccesses", func() {
+		It("lists the accesses of the build", func() {
+			accesses, err := firstBuild.SecretAccesses()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(accesses).To(HaveLen(2))
+			Expect(accesses[0].BuildID).To(Equal(firstBuild.ID()))
+			Expect(accesses[0].VarSource).To(Equal(""))
+			Expect(accesses[0].Path).To(Equal("deploy-key"))
+			Expect(accesses[0].Manager).To(Equal("vault"))
+			Expect(accesses[1].VarSource).To(Equal("some-source"))
+			Expect(accesses[1].Path).To(Equal("token"))
+			Expect(accesses[1].Manager).To(Equal("ssm"))
+		})
+
+		It("ignores accesses which have already been saved", func() {
+			err := firstBuild.SaveSecretAccesses([]db.SecretAccess{
+				{Path: "deploy-key", Manager: "vault"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			accesses, err := firstBuild.SecretAccesses()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(accesses).To(HaveLen(2))
+		})
+	})
+
+	Describe("SecretAccessSummaries", func() {
+		It("groups the accesses by credential", func() {
+			summaries, err := secretAccessFactory.SecretAccessSummaries(db.SecretAccessFilter{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summaries).To(HaveLen(2))
+			Expect(summaries[0].TeamName).To(Equal("default-team"))
+			Expect(summaries[0].Path).To(Equal("deploy-key"))
+			Expect(summaries[0].BuildIDs).To(Equal([]int{secondBuild.ID(), firstBuild.ID()}))
+			Expect(summaries[1].Path).To(Equal("token"))
+			Expect(summaries[1].BuildIDs).To(Equal([]int{firstBuild.ID()}))
+		})
+
+		It("filters by path", func() {
+			summaries, err := secretAccessFactory.SecretAccessSummaries(db.SecretAccessFilter{Path: "token"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summaries).To(HaveLen(1))
+			Expect(summaries[0].VarSource).To(Equal("some-source"))
+		})
+
+		It("filters by time", func() {
+			summaries, err := secretAccessFactory.SecretAccessSummaries(db.SecretAccessFilter{
+				Since: time.Now().Add(time.Hour),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(summaries).To(BeEmpty())
+		})
+	})
+})
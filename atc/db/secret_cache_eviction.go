@@ -0,0 +1,96 @@
+package db
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+// secretCacheEvictionRetention is how long evictions are kept around for the
+// web nodes to carry them out. Anything cached before then has expired.
+const secretCacheEvictionRetention = time.Hour
+
+//go:generate counterfeiter . SecretCacheEvictionFactory
+
+type SecretCacheEvictionFactory interface {
+	// CreateEviction notifies every web node of the eviction.
+	CreateEviction(filter creds.SecretCacheFilter) error
+
+	EvictionsSince(id int) ([]creds.SecretCacheFilter, int, error)
+}
+
+type secretCacheEvictionFactory struct {
+	conn Conn
+}
+
+func NewSecretCacheEvictionFactory(conn Conn) SecretCacheEvictionFactory {
+	return &secretCacheEvictionFactory{
+		conn: conn,
+	}
+}
+
+func (f *secretCacheEvictionFactory) CreateEviction(filter creds.SecretCacheFilter) error {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Delete("secret_cache_evictions").
+		Where(sq.Expr(fmt.Sprintf("created_at < now() - '%d seconds'::interval", int(secretCacheEvictionRetention.Seconds())))).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("secret_cache_evictions").
+		Columns("team_name", "pipeline_name", "path_prefix").
+		Values(filter.TeamName, filter.PipelineName, filter.PathPrefix).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return f.conn.Bus().Notify(atc.SecretCacheEvictionChannel)
+}
+
+// EvictionsSince returns the evictions created after the given one, along
+// with the ID of the last of them. The given ID is returned if there are none.
+func (f *secretCacheEvictionFactory) EvictionsSince(id int) ([]creds.SecretCacheFilter, int, error) {
+	rows, err := psql.Select("id", "team_name", "pipeline_name", "path_prefix").
+		From("secret_cache_evictions").
+		Where(sq.Gt{"id": id}).
+		OrderBy("id").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, id, err
+	}
+
+	defer Close(rows)
+
+	lastID := id
+	filters := []creds.SecretCacheFilter{}
+	for rows.Next() {
+		var filter creds.SecretCacheFilter
+		err := rows.Scan(&lastID, &filter.TeamName, &filter.PipelineName, &filter.PathPrefix)
+		if err != nil {
+			return nil, id, err
+		}
+
+		filters = append(filters, filter)
+	}
+
+	return filters, lastID, nil
+}
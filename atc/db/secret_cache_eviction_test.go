@@ -0,0 +1,81 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SecretCacheEviction", func() {
+	Describe("CreateEviction", func() {
+		It("notifies the web nodes", func() {
+			notifier, err := dbConn.Bus().Listen(atc.SecretCacheEvictionChannel)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer dbConn.Bus().Unlisten(atc.SecretCacheEvictionChannel, notifier)
+
+			err = secretCacheEvictionFactory.CreateEviction(creds.SecretCacheFilter{TeamName: "main"})
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(notifier).Should(Receive())
+		})
+
+		It("removes evictions which are too old to matter", func() {
+			err := secretCacheEvictionFactory.CreateEviction(creds.SecretCacheFilter{TeamName: "old"})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dbConn.Exec(`UPDATE secret_cache_evictions SET created_at = now() - interval '2 hours'`)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = secretCacheEvictionFactory.CreateEviction(creds.SecretCacheFilter{TeamName: "new"})
+			Expect(err).ToNot(HaveOccurred())
+
+			filters, _, err := secretCacheEvictionFactory.EvictionsSince(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filters).To(Equal([]creds.SecretCacheFilter{{TeamName: "new"}}))
+		})
+	})
+
+	Describe("EvictionsSince", func() {
+		var firstID int
+
+		BeforeEach(func() {
+			err := secretCacheEvictionFactory.CreateEviction(creds.SecretCacheFilter{TeamName: "main", PipelineName: "some-pipeline"})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, firstID, err = secretCacheEvictionFactory.EvictionsSince(0)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = secretCacheEvictionFactory.CreateEviction(creds.SecretCacheFilter{PathPrefix: "/concourse/"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the evictions in order, along with the last ID", func() {
+			filters, lastID, err := secretCacheEvictionFactory.EvictionsSince(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filters).To(Equal([]creds.SecretCacheFilter{
+				{TeamName: "main", PipelineName: "some-pipeline"},
+				{PathPrefix: "/concourse/"},
+			}))
+			Expect(lastID).To(BeNumerically(">", firstID))
+		})
+
+		It("skips the evictions up to the given one", func() {
+			filters, _, err := secretCacheEvictionFactory.EvictionsSince(firstID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filters).To(Equal([]creds.SecretCacheFilter{{PathPrefix: "/concourse/"}}))
+		})
+
+		It("returns the given ID when there are no newer evictions", func() {
+			_, lastID, err := secretCacheEvictionFactory.EvictionsSince(0)
+			Expect(err).ToNot(HaveOccurred())
+
+			filters, id, err := secretCacheEvictionFactory.EvictionsSince(lastID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filters).To(BeEmpty())
+			Expect(id).To(Equal(lastID))
+		})
+	})
+})
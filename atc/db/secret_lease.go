@@ -0,0 +1,106 @@
+package db
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// SecretLease is held on a dynamic secret requested by a build, and is
+// revoked once the build is done.
+type SecretLease struct {
+	ID        string
+	BuildID   int
+	Renewable bool
+	ExpiresAt time.Time
+}
+
+//go:generate counterfeiter . SecretLeaseFactory
+
+type SecretLeaseFactory interface {
+	CreateLease(lease SecretLease) error
+	RenewLease(leaseID string, expiresAt time.Time) error
+	DeleteLease(leaseID string) error
+
+	BuildLeases(buildID int) ([]SecretLease, error)
+
+	// OrphanedLeases returns the leases of builds which are done or have been
+	// deleted, but which were not revoked, e.g. because the ATC running the
+	// build went away.
+	OrphanedLeases() ([]SecretLease, error)
+}
+
+type secretLeaseFactory struct {
+	conn Conn
+}
+
+func NewSecretLeaseFactory(conn Conn) SecretLeaseFactory {
+	return &secretLeaseFactory{
+		conn: conn,
+	}
+}
+
+func (f *secretLeaseFactory) CreateLease(lease SecretLease) error {
+	_, err := psql.Insert("build_secret_leases").
+		Columns("lease_id", "build_id", "renewable", "expires_at").
+		Values(lease.ID, lease.BuildID, lease.Renewable, lease.ExpiresAt).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+func (f *secretLeaseFactory) RenewLease(leaseID string, expiresAt time.Time) error {
+	_, err := psql.Update("build_secret_leases").
+		Set("expires_at", expiresAt).
+		Where(sq.Eq{"lease_id": leaseID}).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+func (f *secretLeaseFactory) DeleteLease(leaseID string) error {
+	_, err := psql.Delete("build_secret_leases").
+		Where(sq.Eq{"lease_id": leaseID}).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+func (f *secretLeaseFactory) BuildLeases(buildID int) ([]SecretLease, error) {
+	return f.leases(sq.Eq{"l.build_id": buildID})
+}
+
+func (f *secretLeaseFactory) OrphanedLeases() ([]SecretLease, error) {
+	return f.leases(sq.Or{
+		sq.Eq{"b.id": nil},
+		sq.Eq{"b.completed": true},
+	})
+}
+
+func (f *secretLeaseFactory) leases(where sq.Sqlizer) ([]SecretLease, error) {
+	rows, err := psql.Select("l.lease_id", "l.build_id", "l.renewable", "l.expires_at").
+		From("build_secret_leases l").
+		LeftJoin("builds b ON b.id = l.build_id").
+		Where(where).
+		OrderBy("l.created_at").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	leases := []SecretLease{}
+	for rows.Next() {
+		var lease SecretLease
+		err := rows.Scan(&lease.ID, &lease.BuildID, &lease.Renewable, &lease.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+
+		leases = append(leases, lease)
+	}
+
+	return leases, nil
+}
@@ -0,0 +1,94 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SecretLease", func() {
+	var (
+		build     db.Build
+		expiresAt time.Time
+	)
+
+	BeforeEach(func() {
+		var err error
+		build, err = defaultTeam.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		expiresAt = time.Now().Add(time.Hour).Truncate(time.Second)
+
+		err = secretLeaseFactory.CreateLease(db.SecretLease{
+			ID:        "some-lease",
+			BuildID:   build.ID(),
+			Renewable: true,
+			ExpiresAt: expiresAt,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("BuildLeases", func() {
+		It("returns the leases of the build", func() {
+			leases, err := secretLeaseFactory.BuildLeases(build.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(leases).To(HaveLen(1))
+			Expect(leases[0].ID).To(Equal("some-lease"))
+			Expect(leases[0].BuildID).To(Equal(build.ID()))
+			Expect(leases[0].Renewable).To(BeTrue())
+			Expect(leases[0].ExpiresAt).To(BeTemporally("==", expiresAt))
+		})
+	})
+
+	Describe("RenewLease", func() {
+		It("updates when the lease expires", func() {
+			renewedAt := expiresAt.Add(time.Hour)
+			err := secretLeaseFactory.RenewLease("some-lease", renewedAt)
+			Expect(err).ToNot(HaveOccurred())
+
+			leases, err := secretLeaseFactory.BuildLeases(build.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(leases[0].ExpiresAt).To(BeTemporally("==", renewedAt))
+		})
+	})
+
+	Describe("DeleteLease", func() {
+		It("removes the lease", func() {
+			err := secretLeaseFactory.DeleteLease("some-lease")
+			Expect(err).ToNot(HaveOccurred())
+
+			leases, err := secretLeaseFactory.BuildLeases(build.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(leases).To(BeEmpty())
+		})
+	})
+
+	Describe("OrphanedLeases", func() {
+		It("does not return the leases of running builds", func() {
+			leases, err := secretLeaseFactory.OrphanedLeases()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(leases).To(BeEmpty())
+		})
+
+		It("returns the leases of completed builds", func() {
+			Expect(build.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+			leases, err := secretLeaseFactory.OrphanedLeases()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(leases).To(HaveLen(1))
+			Expect(leases[0].ID).To(Equal("some-lease"))
+		})
+
+		It("returns the leases of deleted builds", func() {
+			_, err := build.Delete()
+			Expect(err).ToNot(HaveOccurred())
+
+			leases, err := secretLeaseFactory.OrphanedLeases()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(leases).To(HaveLen(1))
+		})
+	})
+})
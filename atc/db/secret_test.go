@@ -0,0 +1,90 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secret", func() {
+	BeforeEach(func() {
+		err := secretFactory.SetSecret(defaultTeam.ID(), "", "some-secret", atc.SetSecretRequest{Value: "some-value"}, "some-user")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("SetSecret", func() {
+		It("can be found by team", func() {
+			value, found, err := secretFactory.FindSecretValue("default-team", "", "some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("some-value"))
+		})
+
+		It("is not found for a pipeline", func() {
+			_, found, err := secretFactory.FindSecretValue("default-team", "some-pipeline", "some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("keeps structured values", func() {
+			err := secretFactory.SetSecret(defaultTeam.ID(), "some-pipeline", "some-secret", atc.SetSecretRequest{
+				Value: map[string]interface{}{"username": "some-user"},
+			}, "some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			value, found, err := secretFactory.FindSecretValue("default-team", "some-pipeline", "some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(map[string]interface{}{"username": "some-user"}))
+		})
+
+		It("replaces the existing value", func() {
+			err := secretFactory.SetSecret(defaultTeam.ID(), "", "some-secret", atc.SetSecretRequest{Value: "some-other-value"}, "some-other-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			value, _, err := secretFactory.FindSecretValue("default-team", "", "some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal("some-other-value"))
+
+			secrets, err := secretFactory.Secrets(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secrets).To(HaveLen(1))
+			Expect(secrets[0].UpdatedBy).To(Equal("some-other-user"))
+		})
+	})
+
+	Describe("Secrets", func() {
+		It("lists the secrets without their values", func() {
+			err := secretFactory.SetSecret(defaultTeam.ID(), "some-pipeline", "some-secret", atc.SetSecretRequest{Value: "some-value"}, "some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			secrets, err := secretFactory.Secrets(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secrets).To(HaveLen(2))
+			Expect(secrets[0].TeamName).To(Equal("default-team"))
+			Expect(secrets[0].PipelineName).To(Equal(""))
+			Expect(secrets[0].Name).To(Equal("some-secret"))
+			Expect(secrets[0].UpdatedBy).To(Equal("some-user"))
+			Expect(secrets[1].PipelineName).To(Equal("some-pipeline"))
+		})
+	})
+
+	Describe("DeleteSecret", func() {
+		It("removes the secret", func() {
+			deleted, err := secretFactory.DeleteSecret(defaultTeam.ID(), "", "some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			_, found, err := secretFactory.FindSecretValue("default-team", "", "some-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns false when there is no such secret", func() {
+			deleted, err := secretFactory.DeleteSecret(defaultTeam.ID(), "", "some-other-secret")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeFalse())
+		})
+	})
+})
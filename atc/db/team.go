@@ -41,6 +41,13 @@ type Team interface {
 	Delete() error
 	Rename(string) error
 
+	CheckLimits() (atc.TeamCheckLimits, error)
+	SetCheckLimits(atc.TeamCheckLimits) error
+
+	WorkerKeys() ([]WorkerKey, error)
+	SetWorkerKey(name string, request atc.SetWorkerKeyRequest) error
+	DeleteWorkerKey(name string) (bool, error)
+
 	SavePipeline(
 		pipelineRef atc.PipelineRef,
 		config atc.Config,
@@ -119,6 +126,47 @@ func (t *team) Rename(name string) error {
 	return err
 }
 
+func (t *team) CheckLimits() (atc.TeamCheckLimits, error) {
+	var checksPerSecond sql.NullFloat64
+	var maxCheckContainers sql.NullInt64
+	err := psql.Select("check_rate_limit", "max_check_containers").
+		From("teams").
+		Where(sq.Eq{
+			"id": t.id,
+		}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&checksPerSecond, &maxCheckContainers)
+	if err != nil {
+		return atc.TeamCheckLimits{}, err
+	}
+
+	var limits atc.TeamCheckLimits
+	if checksPerSecond.Valid {
+		limits.ChecksPerSecond = &checksPerSecond.Float64
+	}
+
+	if maxCheckContainers.Valid {
+		max := int(maxCheckContainers.Int64)
+		limits.MaxCheckContainers = &max
+	}
+
+	return limits, nil
+}
+
+func (t *team) SetCheckLimits(limits atc.TeamCheckLimits) error {
+	_, err := psql.Update("teams").
+		Set("check_rate_limit", limits.ChecksPerSecond).
+		Set("max_check_containers", limits.MaxCheckContainers).
+		Where(sq.Eq{
+			"id": t.id,
+		}).
+		RunWith(t.conn).
+		Exec()
+
+	return err
+}
+
 func (t *team) Workers() ([]Worker, error) {
 	return getWorkers(t.conn, workersQuery.Where(sq.Or{
 		sq.Eq{"t.id": t.id},
@@ -1605,8 +1653,8 @@ func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID m
 			}
 
 			_, err := psql.Insert("job_inputs").
-				Columns("name", "job_id", "resource_id", "passed_job_id", "trigger", "version").
-				Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], jobNameToID[passedJob], step.Trigger, version).
+				Columns("name", "job_id", "resource_id", "passed_job_id", "trigger", "version", "labels").
+				Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], jobNameToID[passedJob], step.Trigger, version, jobInputLabels(step)).
 				RunWith(tx).
 				Exec()
 			if err != nil {
@@ -1625,8 +1673,8 @@ func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID m
 		}
 
 		_, err := psql.Insert("job_inputs").
-			Columns("name", "job_id", "resource_id", "trigger", "version").
-			Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], step.Trigger, version).
+			Columns("name", "job_id", "resource_id", "trigger", "version", "labels").
+			Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], step.Trigger, version, jobInputLabels(step)).
 			RunWith(tx).
 			Exec()
 		if err != nil {
@@ -1637,6 +1685,14 @@ func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID m
 	return nil
 }
 
+func jobInputLabels(step *atc.GetStep) interface{} {
+	if len(step.Labels) == 0 {
+		return nil
+	}
+
+	return pq.Array(step.Labels)
+}
+
 func insertJobOutput(tx Tx, step *atc.PutStep, jobName string, resourceNameToID map[string]int, jobNameToID map[string]int) error {
 	_, err := psql.Insert("job_outputs").
 		Columns("name", "job_id", "resource_id").
@@ -74,6 +74,54 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("CheckLimits", func() {
+		It("has no overrides by default", func() {
+			limits, err := team.CheckLimits()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(limits).To(Equal(atc.TeamCheckLimits{}))
+		})
+
+		Context("when the check limits are set", func() {
+			var checksPerSecond float64
+			var maxCheckContainers int
+
+			BeforeEach(func() {
+				checksPerSecond = 2.5
+				maxCheckContainers = 10
+
+				err := team.SetCheckLimits(atc.TeamCheckLimits{
+					ChecksPerSecond:    &checksPerSecond,
+					MaxCheckContainers: &maxCheckContainers,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the overrides", func() {
+				limits, err := team.CheckLimits()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(limits).To(Equal(atc.TeamCheckLimits{
+					ChecksPerSecond:    &checksPerSecond,
+					MaxCheckContainers: &maxCheckContainers,
+				}))
+			})
+
+			It("does not affect other teams", func() {
+				limits, err := otherTeam.CheckLimits()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(limits).To(Equal(atc.TeamCheckLimits{}))
+			})
+
+			It("can be cleared", func() {
+				err := team.SetCheckLimits(atc.TeamCheckLimits{})
+				Expect(err).ToNot(HaveOccurred())
+
+				limits, err := team.CheckLimits()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(limits).To(Equal(atc.TeamCheckLimits{}))
+			})
+		})
+	})
+
 	Describe("SaveWorker", func() {
 		var (
 			team      db.Team
@@ -2390,7 +2438,7 @@ var _ = Describe("Team", func() {
 						builder.WithDisabledVersion("some-resource", atc.Version{"disabled": "version"}),
 					)
 
-					versions, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 3}, nil)
+					versions, _, found, err := scenario.Resource("some-resource").Versions(db.Page{Limit: 3}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(versions).To(HaveLen(1))
@@ -2424,7 +2472,7 @@ var _ = Describe("Team", func() {
 					updatedResource := scenario.Resource("disabled-resource")
 					Expect(updatedResource.ID()).To(Equal(resource.ID()))
 
-					versions, _, found, err := updatedResource.Versions(db.Page{Limit: 3}, nil)
+					versions, _, found, err := updatedResource.Versions(db.Page{Limit: 3}, atc.ResourceVersionFilter{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(found).To(BeTrue())
 					Expect(versions).To(HaveLen(1))
@@ -12,6 +12,7 @@ import (
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tracing"
+	"github.com/lib/pq"
 	gocache "github.com/patrickmn/go-cache"
 	"go.opentelemetry.io/otel/api/trace"
 	"go.opentelemetry.io/otel/label"
@@ -71,7 +72,31 @@ func (versions VersionsDB) VersionIsDisabled(ctx context.Context, resourceID int
 	return exists, nil
 }
 
-func (versions VersionsDB) LatestVersionOfResource(ctx context.Context, resourceID int) (ResourceVersion, bool, error) {
+// VersionHasLabels returns whether the version of the resource has been given
+// all of the labels.
+func (versions VersionsDB) VersionHasLabels(ctx context.Context, resourceID int, versionMD5 ResourceVersion, labels []string) (bool, error) {
+	labels = uniqueLabels(labels)
+	if len(labels) == 0 {
+		return true, nil
+	}
+
+	var count int
+	err := versions.conn.QueryRowContext(ctx, `
+		SELECT COUNT(DISTINCT label)
+		FROM resource_version_labels
+		WHERE resource_id = $1
+		AND version_md5 = $2
+		AND label = ANY($3)
+		`, resourceID, versionMD5, pq.Array(labels)).
+		Scan(&count)
+	if err != nil {
+		return false, err
+	}
+
+	return count == len(labels), nil
+}
+
+func (versions VersionsDB) LatestVersionOfResource(ctx context.Context, resourceID int, labels []string) (ResourceVersion, bool, error) {
 	tx, err := versions.conn.Begin()
 	if err != nil {
 		return "", false, err
@@ -79,7 +104,7 @@ func (versions VersionsDB) LatestVersionOfResource(ctx context.Context, resource
 
 	defer tx.Rollback()
 
-	version, found, err := versions.latestVersionOfResource(ctx, tx, resourceID)
+	version, found, err := versions.latestVersionOfResource(ctx, tx, resourceID, labels)
 	if err != nil {
 		return "", false, err
 	}
@@ -266,7 +291,7 @@ func (versions VersionsDB) FindVersionOfResource(ctx context.Context, resourceID
 	return version, true, err
 }
 
-func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, resourceID int) (ResourceVersion, bool, bool, error) {
+func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, resourceID int, labels []string) (ResourceVersion, bool, bool, error) {
 	tx, err := versions.conn.Begin()
 	if err != nil {
 		return "", false, false, err
@@ -297,7 +322,7 @@ func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, reso
 		LIMIT 1;`, jobID, resourceID).Scan(&checkOrder)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			version, found, err := versions.latestVersionOfResource(ctx, tx, resourceID)
+			version, found, err := versions.latestVersionOfResource(ctx, tx, resourceID, labels)
 			if err != nil {
 				return "", false, false, err
 			}
@@ -322,6 +347,7 @@ func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, reso
 		From("resource_config_versions rcv").
 		Where(sq.Expr("rcv.resource_config_scope_id = (SELECT resource_config_scope_id FROM resources WHERE id = ?)", resourceID)).
 		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM resource_disabled_versions WHERE resource_id = ? AND version_md5 = rcv.version_md5)", resourceID)).
+		Where(versionHasLabels(resourceID, "rcv.version_md5", labels)).
 		Where(sq.Gt{"rcv.check_order": checkOrder}).
 		OrderBy("rcv.check_order ASC").
 		Limit(2).
@@ -356,6 +382,7 @@ func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, reso
 		From("resource_config_versions rcv").
 		Where(sq.Expr("rcv.resource_config_scope_id = (SELECT resource_config_scope_id FROM resources WHERE id = ?)", resourceID)).
 		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM resource_disabled_versions WHERE resource_id = ? AND version_md5 = rcv.version_md5)", resourceID)).
+		Where(versionHasLabels(resourceID, "rcv.version_md5", labels)).
 		Where(sq.LtOrEq{"rcv.check_order": checkOrder}).
 		OrderBy("rcv.check_order DESC").
 		Limit(1).
@@ -540,7 +567,7 @@ func (versions VersionsDB) newerBuilds(ctx context.Context, jobID int, lastUsedB
 	return builds, nil
 }
 
-func (versions VersionsDB) latestVersionOfResource(ctx context.Context, tx Tx, resourceID int) (ResourceVersion, bool, error) {
+func (versions VersionsDB) latestVersionOfResource(ctx context.Context, tx Tx, resourceID int, labels []string) (ResourceVersion, bool, error) {
 	var scopeID sql.NullInt64
 	err := psql.Select("resource_config_scope_id").
 		From("resources").
@@ -564,6 +591,7 @@ func (versions VersionsDB) latestVersionOfResource(ctx context.Context, tx Tx, r
 		From("resource_config_versions").
 		Where(sq.Eq{"resource_config_scope_id": scopeID}).
 		Where(sq.Expr("version_md5 NOT IN (SELECT version_md5 FROM resource_disabled_versions WHERE resource_id = ?)", resourceID)).
+		Where(versionHasLabels(resourceID, "resource_config_versions.version_md5", labels)).
 		OrderBy("check_order DESC").
 		Limit(1).
 		RunWith(tx).
@@ -627,6 +655,37 @@ func (versions VersionsDB) migrateSingle(ctx context.Context, buildID int) (stri
 	return outputs, nil
 }
 
+// versionHasLabels constrains the version identified by md5Col to versions of
+// the resource which have been given all of the labels.
+func versionHasLabels(resourceID int, md5Col string, labels []string) sq.Sqlizer {
+	labels = uniqueLabels(labels)
+	if len(labels) == 0 {
+		return sq.And{}
+	}
+
+	return sq.Expr(`(
+		SELECT COUNT(DISTINCT l.label)
+		FROM resource_version_labels l
+		WHERE l.resource_id = ?
+		AND l.version_md5 = `+md5Col+`
+		AND l.label = ANY(?)
+	) = ?`, resourceID, pq.Array(labels), len(labels))
+}
+
+func uniqueLabels(labels []string) []string {
+	seen := map[string]bool{}
+
+	var unique []string
+	for _, label := range labels {
+		if !seen[label] {
+			seen[label] = true
+			unique = append(unique, label)
+		}
+	}
+
+	return unique
+}
+
 type BuildCursor struct {
 	ID      int
 	RerunOf sql.NullInt64
@@ -276,11 +276,9 @@ func (repository *volumeRepository) CreateBaseResourceTypeVolume(uwbrt *UsedWork
 
 func (repository *volumeRepository) CreateVolume(teamID int, workerName string, volumeType VolumeType) (CreatingVolume, error) {
 	volume, err := repository.createVolume(
-		0,
+		teamID,
 		workerName,
-		map[string]interface{}{
-			"team_id": teamID,
-		},
+		map[string]interface{}{},
 		volumeType,
 	)
 	if err != nil {
@@ -489,6 +487,7 @@ func (repository *volumeRepository) GetOrphanedVolumes() ([]CreatedVolume, error
 			sq.Eq{"w.state": string(WorkerStateRunning)},
 			sq.Eq{"w.state": string(WorkerStateLanding)},
 			sq.Eq{"w.state": string(WorkerStateRetiring)},
+			sq.Eq{"w.state": string(WorkerStateDegraded)},
 		}).
 		ToSql()
 	if err != nil {
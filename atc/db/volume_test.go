@@ -295,6 +295,20 @@ var _ = Describe("Volume", func() {
 			Expect(found).To(BeTrue())
 			Expect(created.WorkerArtifactID()).To(Equal(workerArtifact.ID()))
 		})
+
+		Context("when the artifact is destroyed", func() {
+			JustBeforeEach(func() {
+				err := workerArtifact.Destroy()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("leaves the volume to be garbage collected", func() {
+				created, found, err := volumeRepository.FindCreatedVolume(createdVolume.Handle())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(created.WorkerArtifactID()).To(BeZero())
+			})
+		})
 	})
 
 	Describe("createdVolume.InitializeTaskCache", func() {
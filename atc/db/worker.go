@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
@@ -34,6 +35,7 @@ const (
 	WorkerStateLanding  = WorkerState("landing")
 	WorkerStateLanded   = WorkerState("landed")
 	WorkerStateRetiring = WorkerState("retiring")
+	WorkerStateDegraded = WorkerState("degraded")
 )
 
 func AllWorkerStates() []WorkerState {
@@ -43,6 +45,7 @@ func AllWorkerStates() []WorkerState {
 		WorkerStateLanding,
 		WorkerStateLanded,
 		WorkerStateRetiring,
+		WorkerStateDegraded,
 	}
 }
 
@@ -61,9 +64,14 @@ type Worker interface {
 	NoProxy() string
 	ActiveContainers() int
 	ActiveVolumes() int
+	Resources() *atc.WorkerResources
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
+	Labels() map[string]string
+	RuntimeClasses() []string
+	EnforcesEgressPolicies() bool
+	Maintenance() *MaintenanceWindow
 	TeamID() int
 	TeamName() string
 	StartTime() time.Time
@@ -74,9 +82,13 @@ type Worker interface {
 
 	Land() error
 	Retire() error
+	Degrade() (bool, error)
+	Recover() (bool, error)
 	Prune() error
 	Delete() error
 
+	SetResources(atc.WorkerResources) error
+
 	ActiveTasks() (int, error)
 	IncreaseActiveTasks() error
 	DecreaseActiveTasks() error
@@ -99,9 +111,14 @@ type worker struct {
 	activeContainers int
 	activeVolumes    int
 	activeTasks      int
+	resources        *atc.WorkerResources
 	resourceTypes    []atc.WorkerResourceType
 	platform         string
 	tags             []string
+	labels           map[string]string
+	runtimeClasses   []string
+	enforcesEgress   bool
+	maintenance      *MaintenanceWindow
 	teamID           int
 	teamName         string
 	startTime        time.Time
@@ -122,9 +139,14 @@ func (worker *worker) HTTPSProxyURL() string                   { return worker.h
 func (worker *worker) NoProxy() string                         { return worker.noProxy }
 func (worker *worker) ActiveContainers() int                   { return worker.activeContainers }
 func (worker *worker) ActiveVolumes() int                      { return worker.activeVolumes }
+func (worker *worker) Resources() *atc.WorkerResources         { return worker.resources }
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
 func (worker *worker) Tags() []string                          { return worker.tags }
+func (worker *worker) Labels() map[string]string               { return worker.labels }
+func (worker *worker) RuntimeClasses() []string                { return worker.runtimeClasses }
+func (worker *worker) EnforcesEgressPolicies() bool            { return worker.enforcesEgress }
+func (worker *worker) Maintenance() *MaintenanceWindow         { return worker.maintenance }
 func (worker *worker) TeamID() int                             { return worker.teamID }
 func (worker *worker) TeamName() string                        { return worker.teamName }
 func (worker *worker) Ephemeral() bool                         { return worker.ephemeral }
@@ -179,6 +201,45 @@ func (worker *worker) Land() error {
 	return nil
 }
 
+// Degrade takes a running worker out of the pool while it is under resource
+// pressure. It returns false if the worker was not running.
+func (worker *worker) Degrade() (bool, error) {
+	return worker.transition(WorkerStateRunning, WorkerStateDegraded)
+}
+
+// Recover returns a degraded worker to the pool once its pressure has
+// cleared. It returns false if the worker was not degraded.
+func (worker *worker) Recover() (bool, error) {
+	return worker.transition(WorkerStateDegraded, WorkerStateRunning)
+}
+
+func (worker *worker) transition(from WorkerState, to WorkerState) (bool, error) {
+	result, err := psql.Update("workers").
+		Set("state", string(to)).
+		Where(sq.Eq{
+			"name":  worker.name,
+			"state": string(from),
+		}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if count == 0 {
+		return false, nil
+	}
+
+	worker.state = to
+
+	return true, nil
+}
+
 func (worker *worker) Retire() error {
 	result, err := psql.Update("workers").
 		SetMap(map[string]interface{}{
@@ -216,7 +277,10 @@ func (worker *worker) Prune() error {
 			"name": worker.name,
 		}).
 		Where(sq.NotEq{
-			"state": string(WorkerStateRunning),
+			"state": []string{
+				string(WorkerStateRunning),
+				string(WorkerStateDegraded),
+			},
 		}).
 		PlaceholderFormat(sq.Dollar).
 		RunWith(tx).
@@ -251,6 +315,36 @@ func (worker *worker) Prune() error {
 	return tx.Commit()
 }
 
+// SetResources records the headroom last reported by the worker.
+func (worker *worker) SetResources(resources atc.WorkerResources) error {
+	resourcesJSON, err := json.Marshal(resources)
+	if err != nil {
+		return err
+	}
+
+	result, err := psql.Update("workers").
+		Set("resources", string(resourcesJSON)).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	worker.resources = &resources
+
+	return nil
+}
+
 func (worker *worker) Delete() error {
 	_, err := sq.Delete("workers").
 		Where(sq.Eq{
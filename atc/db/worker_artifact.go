@@ -19,6 +19,7 @@ type WorkerArtifact interface {
 	BuildID() int
 	CreatedAt() time.Time
 	Volume(teamID int) (CreatedVolume, bool, error)
+	Destroy() error
 }
 
 type artifact struct {
@@ -53,6 +54,16 @@ func (a *artifact) Volume(teamID int) (CreatedVolume, bool, error) {
 	return created, true, nil
 }
 
+// Destroy removes the artifact, orphaning its volume so that it is garbage
+// collected.
+func (a *artifact) Destroy() error {
+	_, err := psql.Delete("worker_artifacts").
+		Where(sq.Eq{"id": a.id}).
+		RunWith(a.conn).
+		Exec()
+	return err
+}
+
 func saveWorkerArtifact(tx Tx, conn Conn, atcArtifact atc.WorkerArtifact) (WorkerArtifact, error) {
 
 	var artifactID int
@@ -47,17 +47,33 @@ var workersQuery = psql.Select(`
 		w.no_proxy,
 		w.active_containers,
 		w.active_volumes,
+		w.resources,
 		w.resource_types,
 		w.platform,
 		w.tags,
+		w.labels,
+		w.runtime_classes,
+		w.enforces_egress_policies,
 		t.name,
 		w.team_id,
 		w.start_time,
 		w.expires,
-		w.ephemeral
+		w.ephemeral,
+		mw.id,
+		mw.drain_starts_at,
+		mw.starts_at,
+		mw.ends_at
 	`).
 	From("workers w").
-	LeftJoin("teams t ON w.team_id = t.id")
+	LeftJoin("teams t ON w.team_id = t.id").
+	LeftJoin(`LATERAL (
+		SELECT m.id, m.drain_starts_at, m.starts_at, m.ends_at
+		FROM worker_maintenance_windows m
+		WHERE m.ends_at > NOW()
+		AND (m.worker_name = w.name OR w.tags::jsonb @> jsonb_build_array(m.tag))
+		ORDER BY m.starts_at
+		LIMIT 1
+	) mw ON true`)
 
 func (f *workerFactory) GetWorker(name string) (Worker, bool, error) {
 	return getWorker(f.conn, workersQuery.Where(sq.Eq{"w.name": name}))
@@ -132,14 +148,22 @@ func scanWorker(worker *worker, row scannable) error {
 		httpProxyURL  sql.NullString
 		httpsProxyURL sql.NullString
 		noProxy       sql.NullString
+		resources     []byte
 		resourceTypes []byte
 		platform      sql.NullString
 		tags          []byte
+		labels        []byte
+		classes       []byte
 		teamName      sql.NullString
 		teamID        sql.NullInt64
 		startTime     pq.NullTime
 		expiresAt     pq.NullTime
 		ephemeral     sql.NullBool
+
+		maintenanceID            sql.NullInt64
+		maintenanceDrainStartsAt pq.NullTime
+		maintenanceStartsAt      pq.NullTime
+		maintenanceEndsAt        pq.NullTime
 	)
 
 	err := row.Scan(
@@ -154,14 +178,22 @@ func scanWorker(worker *worker, row scannable) error {
 		&noProxy,
 		&worker.activeContainers,
 		&worker.activeVolumes,
+		&resources,
 		&resourceTypes,
 		&platform,
 		&tags,
+		&labels,
+		&classes,
+		&worker.enforcesEgress,
 		&teamName,
 		&teamID,
 		&startTime,
 		&expiresAt,
 		&ephemeral,
+		&maintenanceID,
+		&maintenanceDrainStartsAt,
+		&maintenanceStartsAt,
+		&maintenanceEndsAt,
 	)
 	if err != nil {
 		return err
@@ -215,6 +247,40 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.ephemeral = ephemeral.Bool
 	}
 
+	worker.maintenance = nil
+	if maintenanceID.Valid {
+		worker.maintenance = &MaintenanceWindow{
+			ID:            int(maintenanceID.Int64),
+			DrainStartsAt: maintenanceDrainStartsAt.Time,
+			StartsAt:      maintenanceStartsAt.Time,
+			EndsAt:        maintenanceEndsAt.Time,
+		}
+	}
+
+	worker.resources = nil
+	if resources != nil {
+		err = json.Unmarshal(resources, &worker.resources)
+		if err != nil {
+			return err
+		}
+	}
+
+	worker.labels = nil
+	if labels != nil {
+		err = json.Unmarshal(labels, &worker.labels)
+		if err != nil {
+			return err
+		}
+	}
+
+	worker.runtimeClasses = nil
+	if classes != nil {
+		err = json.Unmarshal(classes, &worker.runtimeClasses)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = json.Unmarshal(resourceTypes, &worker.resourceTypes)
 	if err != nil {
 		return err
@@ -245,6 +311,7 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		When("'landing'::worker_state", "'landing'::worker_state").
 		When("'landed'::worker_state", "'landed'::worker_state").
 		When("'retiring'::worker_state", "'retiring'::worker_state").
+		When("'degraded'::worker_state", "'degraded'::worker_state").
 		Else("'running'::worker_state").
 		ToSql()
 
@@ -375,6 +442,16 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		return nil, err
 	}
 
+	labels, err := json.Marshal(atcWorker.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	classes, err := json.Marshal(atcWorker.RuntimeClasses)
+	if err != nil {
+		return nil, err
+	}
+
 	expires := "NULL"
 	if ttl != 0 {
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
@@ -400,6 +477,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		atcWorker.ActiveVolumes,
 		resourceTypes,
 		tags,
+		labels,
+		classes,
+		atcWorker.EnforcesEgressPolicies,
 		atcWorker.Platform,
 		atcWorker.BaggageclaimURL,
 		atcWorker.CertsPath,
@@ -431,6 +511,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"active_volumes",
 			"resource_types",
 			"tags",
+			"labels",
+			"runtime_classes",
+			"enforces_egress_policies",
 			"platform",
 			"baggageclaim_url",
 			"certs_path",
@@ -456,6 +539,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				active_volumes = ?,
 				resource_types = ?,
 				tags = ?,
+				labels = ?,
+				runtime_classes = ?,
+				enforces_egress_policies = ?,
 				platform = ?,
 				baggageclaim_url = ?,
 				certs_path = ?,
@@ -505,6 +591,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		resourceTypes:    atcWorker.ResourceTypes,
 		platform:         atcWorker.Platform,
 		tags:             atcWorker.Tags,
+		labels:           atcWorker.Labels,
+		runtimeClasses:   atcWorker.RuntimeClasses,
+		enforcesEgress:   atcWorker.EnforcesEgressPolicies,
 		teamName:         atcWorker.Team,
 		teamID:           workerTeamID,
 		startTime:        time.Unix(atcWorker.StartTime, 0),
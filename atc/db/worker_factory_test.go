@@ -43,10 +43,13 @@ var _ = Describe("WorkerFactory", func() {
 					Privileged: false,
 				},
 			},
-			Platform:  "some-platform",
-			Tags:      atc.Tags{"some", "tags"},
-			Name:      "some-name",
-			StartTime: 1565367209,
+			Platform:               "some-platform",
+			Tags:                   atc.Tags{"some", "tags"},
+			Labels:                 map[string]string{"arch": "arm64"},
+			RuntimeClasses:         []string{"gvisor"},
+			EnforcesEgressPolicies: true,
+			Name:                   "some-name",
+			StartTime:              1565367209,
 		}
 	})
 
@@ -243,6 +246,9 @@ var _ = Describe("WorkerFactory", func() {
 				}))
 				Expect(foundWorker.Platform()).To(Equal("some-platform"))
 				Expect(foundWorker.Tags()).To(Equal([]string{"some", "tags"}))
+				Expect(foundWorker.Labels()).To(Equal(map[string]string{"arch": "arm64"}))
+				Expect(foundWorker.RuntimeClasses()).To(Equal([]string{"gvisor"}))
+				Expect(foundWorker.EnforcesEgressPolicies()).To(BeTrue())
 				Expect(foundWorker.StartTime().Unix()).To(Equal(int64(1565367209)))
 				Expect(foundWorker.State()).To(Equal(db.WorkerStateRunning))
 			})
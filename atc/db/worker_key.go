@@ -0,0 +1,258 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+	"golang.org/x/crypto/ssh"
+)
+
+var (
+	ErrWorkerKeyInUse    = errors.New("public key is already in use by another worker key")
+	ErrWorkerKeyNotFound = errors.New("worker key not found")
+)
+
+// WorkerKey is a public key with which the workers of a team register
+// through the TSA.
+type WorkerKey struct {
+	TeamName    string
+	Name        string
+	PublicKey   string
+	Fingerprint string
+
+	WorkerName string
+	Tags       []string
+
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+}
+
+//go:generate counterfeiter . WorkerKeyFactory
+
+type WorkerKeyFactory interface {
+	ActiveWorkerKeys() ([]WorkerKey, error)
+	MarkWorkerKeysUsed([]atc.WorkerKeyUse) error
+
+	CreateWorkerRegistrationToken(atc.WorkerRegistrationToken) (WorkerRegistrationToken, string, error)
+	WorkerRegistrationTokens() ([]WorkerRegistrationToken, error)
+	DeleteWorkerRegistrationToken(id int) (bool, error)
+	RedeemWorkerRegistrationToken(atc.RedeemWorkerRegistrationTokenRequest) (WorkerKey, error)
+}
+
+type workerKeyFactory struct {
+	conn Conn
+}
+
+func NewWorkerKeyFactory(conn Conn) WorkerKeyFactory {
+	return &workerKeyFactory{
+		conn: conn,
+	}
+}
+
+var workerKeysQuery = psql.Select(`
+		COALESCE(t.name, ''),
+		k.name,
+		k.public_key,
+		k.fingerprint,
+		k.worker_name,
+		k.tags,
+		k.created_at,
+		k.expires_at,
+		k.last_used_at
+	`).
+	From("worker_keys k").
+	LeftJoin("teams t ON t.id = k.team_id")
+
+// ActiveWorkerKeys returns the keys of every team, and the global keys
+// registered by workers, which have not expired.
+func (f *workerKeyFactory) ActiveWorkerKeys() ([]WorkerKey, error) {
+	return getWorkerKeys(f.conn, workerKeysQuery.
+		Where(sq.Expr("(k.expires_at IS NULL OR k.expires_at > NOW())")))
+}
+
+// MarkWorkerKeysUsed records when the keys were last used. Uses of keys
+// which no longer exist are ignored.
+func (f *workerKeyFactory) MarkWorkerKeysUsed(uses []atc.WorkerKeyUse) error {
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	for _, use := range uses {
+		usedAt := time.Unix(use.UsedAt, 0)
+
+		_, err := psql.Update("worker_keys").
+			Set("last_used_at", sq.Expr("GREATEST(last_used_at, ?)", usedAt)).
+			Where(sq.Expr("team_id IS NOT DISTINCT FROM (SELECT id FROM teams WHERE name = ?)", use.TeamName)).
+			Where(sq.Eq{"name": use.Name}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (t *team) WorkerKeys() ([]WorkerKey, error) {
+	return getWorkerKeys(t.conn, workerKeysQuery.
+		Where(sq.Eq{"k.team_id": t.id}))
+}
+
+// SetWorkerKey creates or replaces the team's key with the given name. When
+// the request replaces another key, the other key's expiry is brought
+// forward to the requested time.
+func (t *team) SetWorkerKey(name string, request atc.SetWorkerKeyRequest) error {
+	key, err := atc.ParseWorkerKey(request.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	publicKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+	fingerprint := ssh.FingerprintSHA256(key)
+
+	var expiresAt interface{}
+	if request.ExpiresAt != 0 {
+		expiresAt = time.Unix(request.ExpiresAt, 0)
+	}
+
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	if request.Replaces != "" {
+		replacedKeyExpiresAt := time.Unix(request.ReplacedKeyExpiresAt, 0)
+
+		result, err := psql.Update("worker_keys").
+			Set("expires_at", sq.Expr("LEAST(expires_at, ?)", replacedKeyExpiresAt)).
+			Where(sq.Eq{
+				"team_id": t.id,
+				"name":    request.Replaces,
+			}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if affected == 0 {
+			return ErrWorkerKeyNotFound
+		}
+	}
+
+	_, err = psql.Insert("worker_keys").
+		Columns("team_id", "name", "public_key", "fingerprint", "expires_at").
+		Values(t.id, name, publicKey, fingerprint, expiresAt).
+		Suffix(`
+			ON CONFLICT (team_id, name) DO UPDATE SET
+				public_key = EXCLUDED.public_key,
+				fingerprint = EXCLUDED.fingerprint,
+				expires_at = EXCLUDED.expires_at,
+				worker_name = EXCLUDED.worker_name,
+				tags = EXCLUDED.tags,
+				created_at = CASE WHEN worker_keys.fingerprint = EXCLUDED.fingerprint THEN worker_keys.created_at ELSE NOW() END,
+				last_used_at = CASE WHEN worker_keys.fingerprint = EXCLUDED.fingerprint THEN worker_keys.last_used_at END
+		`).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
+			return ErrWorkerKeyInUse
+		}
+
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (t *team) DeleteWorkerKey(name string) (bool, error) {
+	result, err := psql.Delete("worker_keys").
+		Where(sq.Eq{
+			"team_id": t.id,
+			"name":    name,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func getWorkerKeys(conn Conn, query sq.SelectBuilder) ([]WorkerKey, error) {
+	rows, err := query.
+		OrderBy("t.name NULLS FIRST", "k.name").
+		RunWith(conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	keys := []WorkerKey{}
+	for rows.Next() {
+		key, err := scanWorkerKey(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func scanWorkerKey(row scannable) (WorkerKey, error) {
+	var (
+		key        WorkerKey
+		expiresAt  sql.NullTime
+		lastUsedAt sql.NullTime
+		workerName sql.NullString
+	)
+
+	err := row.Scan(
+		&key.TeamName,
+		&key.Name,
+		&key.PublicKey,
+		&key.Fingerprint,
+		&workerName,
+		pq.Array(&key.Tags),
+		&key.CreatedAt,
+		&expiresAt,
+		&lastUsedAt,
+	)
+	if err != nil {
+		return WorkerKey{}, err
+	}
+
+	key.WorkerName = workerName.String
+	key.ExpiresAt = expiresAt.Time
+	key.LastUsedAt = lastUsedAt.Time
+
+	return key, nil
+}
@@ -0,0 +1,67 @@
+package db
+
+import (
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+//go:generate counterfeiter . WorkerKeyLifecycle
+
+type WorkerKeyLifecycle interface {
+	RemoveUnusableWorkerRegistrationTokens() (int, error)
+	RemoveOrphanedWorkerKeys(gracePeriod time.Duration) (int, error)
+}
+
+type workerKeyLifecycle struct {
+	conn Conn
+}
+
+func NewWorkerKeyLifecycle(conn Conn) WorkerKeyLifecycle {
+	return &workerKeyLifecycle{conn}
+}
+
+// RemoveUnusableWorkerRegistrationTokens removes the registration tokens
+// which have expired or have been used up.
+func (l workerKeyLifecycle) RemoveUnusableWorkerRegistrationTokens() (int, error) {
+	res, err := psql.Delete("worker_registration_tokens").
+		Where(sq.Or{
+			sq.Expr("expires_at <= NOW()"),
+			sq.Expr("uses >= max_uses"),
+		}).
+		RunWith(l.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(n), nil
+}
+
+// RemoveOrphanedWorkerKeys removes the keys registered by workers which no
+// longer exist, once the keys have not been used for the grace period. Keys
+// of workers which are only landed or stalled are kept.
+func (l workerKeyLifecycle) RemoveOrphanedWorkerKeys(gracePeriod time.Duration) (int, error) {
+	res, err := psql.Delete("worker_keys k").
+		Where(sq.Expr("k.worker_name IS NOT NULL")).
+		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM workers w WHERE w.name = k.worker_name)")).
+		Where(sq.Expr(fmt.Sprintf("now() - COALESCE(k.last_used_at, k.created_at) > '%d seconds'::interval", int(gracePeriod.Seconds())))).
+		RunWith(l.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(n), nil
+}
@@ -0,0 +1,189 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerKey", func() {
+	const (
+		somePublicKey        = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFdKVCFpynKib6/W5IGS6qXrd/yxmYbgJKdH/zOv51fo"
+		someFingerprint      = "SHA256:orNo+yGcf8NIJR4M2xIcj8/sIIYxm+sP7r1BVu9zhIo"
+		someOtherPublicKey   = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKpWKvUBVBFBliOp07H0SeNoCg6V2FMAmJ15lNJRhm3U"
+		someOtherFingerprint = "SHA256:Q0EhtkonaeM0bFx3L4xzrrQ+pDprvOI8CfCqde4OYKA"
+	)
+
+	var (
+		team      db.Team
+		otherTeam db.Team
+	)
+
+	BeforeEach(func() {
+		var err error
+		team, err = teamFactory.CreateTeam(atc.Team{Name: "some-team"})
+		Expect(err).ToNot(HaveOccurred())
+
+		otherTeam, err = teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+		Expect(err).ToNot(HaveOccurred())
+
+		err = team.SetWorkerKey("some-key", atc.SetWorkerKeyRequest{
+			PublicKey: somePublicKey + " some-comment",
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("SetWorkerKey", func() {
+		It("saves the key without its comment", func() {
+			keys, err := team.WorkerKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keys).To(HaveLen(1))
+			Expect(keys[0].TeamName).To(Equal("some-team"))
+			Expect(keys[0].Name).To(Equal("some-key"))
+			Expect(keys[0].PublicKey).To(Equal(somePublicKey))
+			Expect(keys[0].Fingerprint).To(Equal(someFingerprint))
+			Expect(keys[0].CreatedAt).ToNot(BeZero())
+			Expect(keys[0].ExpiresAt).To(BeZero())
+			Expect(keys[0].LastUsedAt).To(BeZero())
+		})
+
+		It("replaces the key with the same name", func() {
+			err := team.SetWorkerKey("some-key", atc.SetWorkerKeyRequest{
+				PublicKey: someOtherPublicKey,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			keys, err := team.WorkerKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keys).To(HaveLen(1))
+			Expect(keys[0].Fingerprint).To(Equal(someOtherFingerprint))
+		})
+
+		It("does not allow the key to be used by another team", func() {
+			err := otherTeam.SetWorkerKey("some-key", atc.SetWorkerKeyRequest{
+				PublicKey: somePublicKey,
+			})
+			Expect(err).To(Equal(db.ErrWorkerKeyInUse))
+		})
+
+		Context("when replacing another key", func() {
+			var replacedKeyExpiresAt time.Time
+
+			BeforeEach(func() {
+				replacedKeyExpiresAt = time.Now().Add(time.Hour).Truncate(time.Second)
+			})
+
+			It("keeps the other key until it expires", func() {
+				err := team.SetWorkerKey("some-new-key", atc.SetWorkerKeyRequest{
+					PublicKey:            someOtherPublicKey,
+					Replaces:             "some-key",
+					ReplacedKeyExpiresAt: replacedKeyExpiresAt.Unix(),
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				keys, err := team.WorkerKeys()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(keys).To(HaveLen(2))
+				Expect(keys[0].Name).To(Equal("some-key"))
+				Expect(keys[0].ExpiresAt.Unix()).To(Equal(replacedKeyExpiresAt.Unix()))
+				Expect(keys[1].Name).To(Equal("some-new-key"))
+				Expect(keys[1].ExpiresAt).To(BeZero())
+			})
+
+			It("does not extend an earlier expiry", func() {
+				err := team.SetWorkerKey("some-key", atc.SetWorkerKeyRequest{
+					PublicKey: somePublicKey,
+					ExpiresAt: replacedKeyExpiresAt.Unix(),
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				err = team.SetWorkerKey("some-new-key", atc.SetWorkerKeyRequest{
+					PublicKey:            someOtherPublicKey,
+					Replaces:             "some-key",
+					ReplacedKeyExpiresAt: replacedKeyExpiresAt.Add(time.Hour).Unix(),
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				keys, err := team.WorkerKeys()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(keys[0].ExpiresAt.Unix()).To(Equal(replacedKeyExpiresAt.Unix()))
+			})
+
+			It("errors when the other key does not exist", func() {
+				err := team.SetWorkerKey("some-new-key", atc.SetWorkerKeyRequest{
+					PublicKey:            someOtherPublicKey,
+					Replaces:             "bogus-key",
+					ReplacedKeyExpiresAt: replacedKeyExpiresAt.Unix(),
+				})
+				Expect(err).To(Equal(db.ErrWorkerKeyNotFound))
+
+				keys, err := team.WorkerKeys()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(keys).To(HaveLen(1))
+			})
+		})
+	})
+
+	Describe("DeleteWorkerKey", func() {
+		It("deletes the key", func() {
+			deleted, err := team.DeleteWorkerKey("some-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			keys, err := team.WorkerKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keys).To(BeEmpty())
+		})
+
+		It("does not delete keys of other teams", func() {
+			deleted, err := otherTeam.DeleteWorkerKey("some-key")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeFalse())
+		})
+	})
+
+	Describe("ActiveWorkerKeys", func() {
+		BeforeEach(func() {
+			err := otherTeam.SetWorkerKey("expiring-key", atc.SetWorkerKeyRequest{
+				PublicKey: someOtherPublicKey,
+				ExpiresAt: time.Now().Add(time.Second).Unix(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the keys of every team until they expire", func() {
+			keys, err := workerKeyFactory.ActiveWorkerKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keys).To(HaveLen(2))
+
+			Eventually(func() ([]db.WorkerKey, error) {
+				return workerKeyFactory.ActiveWorkerKeys()
+			}, 5*time.Second).Should(HaveLen(1))
+		})
+	})
+
+	Describe("MarkWorkerKeysUsed", func() {
+		It("records when the key was last used", func() {
+			usedAt := time.Now().Add(-time.Minute).Truncate(time.Second)
+
+			err := workerKeyFactory.MarkWorkerKeysUsed([]atc.WorkerKeyUse{
+				{TeamName: "some-team", Name: "some-key", UsedAt: usedAt.Unix()},
+				{TeamName: "some-other-team", Name: "some-key", UsedAt: usedAt.Unix()},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = workerKeyFactory.MarkWorkerKeysUsed([]atc.WorkerKeyUse{
+				{TeamName: "some-team", Name: "some-key", UsedAt: usedAt.Add(-time.Hour).Unix()},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			keys, err := team.WorkerKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keys[0].LastUsedAt.Unix()).To(Equal(usedAt.Unix()))
+		})
+	})
+})
@@ -13,6 +13,7 @@ type WorkerLifecycle interface {
 	StallUnresponsiveWorkers() ([]string, error)
 	LandFinishedLandingWorkers() ([]string, error)
 	DeleteFinishedRetiringWorkers() ([]string, error)
+	AbortBuildsInMaintenance() ([]int, error)
 	GetWorkerStateByName() (map[string]WorkerState, error)
 }
 
@@ -51,7 +52,10 @@ func (lifecycle *workerLifecycle) StallUnresponsiveWorkers() ([]string, error) {
 			"state":   string(WorkerStateStalled),
 			"expires": nil,
 		}).
-		Where(sq.Eq{"state": string(WorkerStateRunning)}).
+		Where(sq.Eq{"state": []string{
+			string(WorkerStateRunning),
+			string(WorkerStateDegraded),
+		}}).
 		Where(sq.Expr("expires < NOW()")).
 		Suffix("RETURNING name").
 		ToSql()
@@ -167,6 +171,65 @@ func (lifecycle *workerLifecycle) LandFinishedLandingWorkers() ([]string, error)
 	return workersAffected(rows)
 }
 
+// AbortBuildsInMaintenance aborts the builds still running on workers whose
+// maintenance window has started. The workers themselves are left registered
+// and are only kept out of the pool until their window ends, so that they take
+// work again once it is over.
+func (lifecycle *workerLifecycle) AbortBuildsInMaintenance() ([]int, error) {
+	rows, err := psql.Update("builds").
+		Set("aborted", true).
+		Where(sq.Eq{
+			"status":  string(BuildStatusStarted),
+			"aborted": false,
+		}).
+		Where(sq.Expr(`id IN (
+			SELECT c.build_id FROM containers c
+			JOIN workers ON workers.name = c.worker_name
+			WHERE c.build_id IS NOT NULL
+			AND EXISTS (
+				SELECT 1 FROM worker_maintenance_windows m
+				WHERE ` + maintenanceWindowMatchesWorker + `
+				AND m.starts_at <= NOW()
+				AND m.ends_at > NOW()
+			)
+		)`)).
+		Suffix("RETURNING id").
+		RunWith(lifecycle.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var buildIDs []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+
+		buildIDs = append(buildIDs, id)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range buildIDs {
+		err = lifecycle.conn.Bus().Notify(buildAbortChannel(id))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return buildIDs, nil
+}
+
+const maintenanceWindowMatchesWorker = `(m.worker_name = workers.name OR workers.tags::jsonb @> jsonb_build_array(m.tag))`
+
 func (lifecycle *workerLifecycle) GetWorkerStateByName() (map[string]WorkerState, error) {
 	rows, err := psql.Select(`
 		name,
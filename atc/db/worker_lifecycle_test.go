@@ -553,6 +553,120 @@ var _ = Describe("Worker Lifecycle", func() {
 		})
 	})
 
+	Describe("AbortBuildsInMaintenance", func() {
+		var (
+			window atc.MaintenanceWindow
+			build  db.Build
+		)
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = build.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = defaultWorker.CreateContainer(
+				db.NewBuildStepContainerOwner(build.ID(), "some-plan", defaultTeam.ID()),
+				db.ContainerMetadata{},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			window = atc.MaintenanceWindow{
+				Worker:   defaultWorker.Name(),
+				StartsAt: time.Now().Add(-time.Minute).Unix(),
+				EndsAt:   time.Now().Add(time.Hour).Unix(),
+			}
+		})
+
+		JustBeforeEach(func() {
+			_, err := maintenanceWindowFactory.CreateMaintenanceWindow(window)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("aborts the builds still running on the worker", func() {
+			aborted, err := workerLifecycle.AbortBuildsInMaintenance()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(aborted).To(ConsistOf(build.ID()))
+
+			_, err = build.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(build.IsAborted()).To(BeTrue())
+		})
+
+		It("leaves the worker running", func() {
+			_, err := workerLifecycle.AbortBuildsInMaintenance()
+			Expect(err).ToNot(HaveOccurred())
+
+			worker, found, err := workerFactory.GetWorker(defaultWorker.Name())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(worker.State()).To(Equal(db.WorkerStateRunning))
+		})
+
+		It("only aborts each build once", func() {
+			_, err := workerLifecycle.AbortBuildsInMaintenance()
+			Expect(err).ToNot(HaveOccurred())
+
+			aborted, err := workerLifecycle.AbortBuildsInMaintenance()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(aborted).To(BeEmpty())
+		})
+
+		Context("when the window matches the worker by tag", func() {
+			BeforeEach(func() {
+				atcWorker.Name = defaultWorker.Name()
+				atcWorker.Tags = []string{"some-tag"}
+				_, err := workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+
+				window.Worker = ""
+				window.Tag = "some-tag"
+			})
+
+			It("aborts the builds still running on the worker", func() {
+				aborted, err := workerLifecycle.AbortBuildsInMaintenance()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(aborted).To(ConsistOf(build.ID()))
+			})
+		})
+
+		Context("when the window is only draining", func() {
+			BeforeEach(func() {
+				window.DrainStartsAt = time.Now().Add(-time.Minute).Unix()
+				window.StartsAt = time.Now().Add(time.Minute).Unix()
+			})
+
+			It("lets the builds finish", func() {
+				aborted, err := workerLifecycle.AbortBuildsInMaintenance()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(aborted).To(BeEmpty())
+			})
+		})
+
+		Context("when the window has ended", func() {
+			BeforeEach(func() {
+				window.StartsAt = time.Now().Add(-2 * time.Hour).Unix()
+				window.EndsAt = time.Now().Add(-time.Hour).Unix()
+			})
+
+			It("leaves the builds alone", func() {
+				aborted, err := workerLifecycle.AbortBuildsInMaintenance()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(aborted).To(BeEmpty())
+			})
+
+			It("puts the worker back in the pool", func() {
+				worker, found, err := workerFactory.GetWorker(defaultWorker.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(worker.State()).To(Equal(db.WorkerStateRunning))
+				Expect(worker.Maintenance()).To(BeNil())
+			})
+		})
+	})
+
 	Describe("GetWorkersState", func() {
 
 		JustBeforeEach(func() {
@@ -0,0 +1,341 @@
+package db
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+	"golang.org/x/crypto/ssh"
+)
+
+var (
+	ErrWorkerRegistrationTokenInvalid   = errors.New("registration token is invalid, expired or used up")
+	ErrWorkerRegistrationTokenMismatch  = errors.New("worker does not match the team and tags of the registration token")
+	ErrWorkerRegistrationTokenNameTaken = errors.New("worker name is taken by a worker of another team or a global worker")
+)
+
+// ErrWorkerRegistrationTokenTeamNotFound is returned when a registration
+// token is created for, or redeemed by a worker of, a team which does not
+// exist.
+type ErrWorkerRegistrationTokenTeamNotFound struct {
+	TeamName string
+}
+
+func (err ErrWorkerRegistrationTokenTeamNotFound) Error() string {
+	return fmt.Sprintf("team '%s' not found", err.TeamName)
+}
+
+// WorkerRegistrationToken lets workers register a key of their own through
+// the TSA. Only a hash of the token is stored.
+type WorkerRegistrationToken struct {
+	ID       int
+	TeamName string
+	Tags     []string
+
+	CreatedAt time.Time
+	ExpiresAt time.Time
+
+	MaxUses int
+	Uses    int
+}
+
+var workerRegistrationTokensQuery = psql.Select(`
+		r.id,
+		COALESCE(t.name, ''),
+		r.tags,
+		r.created_at,
+		r.expires_at,
+		COALESCE(r.max_uses, 0),
+		r.uses
+	`).
+	From("worker_registration_tokens r").
+	LeftJoin("teams t ON t.id = r.team_id")
+
+// CreateWorkerRegistrationToken saves a new registration token, returning it
+// along with the secret to give to the workers.
+func (f *workerKeyFactory) CreateWorkerRegistrationToken(token atc.WorkerRegistrationToken) (WorkerRegistrationToken, string, error) {
+	secret, err := generateWorkerRegistrationToken()
+	if err != nil {
+		return WorkerRegistrationToken{}, "", err
+	}
+
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return WorkerRegistrationToken{}, "", err
+	}
+
+	defer Rollback(tx)
+
+	var teamID interface{}
+	if token.TeamName != "" {
+		teamID, err = teamIDByName(tx, token.TeamName)
+		if err != nil {
+			return WorkerRegistrationToken{}, "", err
+		}
+	}
+
+	var maxUses interface{}
+	if token.MaxUses != 0 {
+		maxUses = token.MaxUses
+	}
+
+	tags := token.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	var id int
+	err = psql.Insert("worker_registration_tokens").
+		Columns("token_hash", "team_id", "tags", "expires_at", "max_uses").
+		Values(hashWorkerRegistrationToken(secret), teamID, pq.Array(tags), time.Unix(token.ExpiresAt, 0), maxUses).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return WorkerRegistrationToken{}, "", err
+	}
+
+	created, err := scanWorkerRegistrationToken(workerRegistrationTokensQuery.
+		Where(sq.Eq{"r.id": id}).
+		RunWith(tx).
+		QueryRow())
+	if err != nil {
+		return WorkerRegistrationToken{}, "", err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return WorkerRegistrationToken{}, "", err
+	}
+
+	return created, secret, nil
+}
+
+// WorkerRegistrationTokens returns every token, including the ones which
+// can no longer be used but have not been garbage collected yet.
+func (f *workerKeyFactory) WorkerRegistrationTokens() ([]WorkerRegistrationToken, error) {
+	rows, err := workerRegistrationTokensQuery.
+		OrderBy("r.id").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	tokens := []WorkerRegistrationToken{}
+	for rows.Next() {
+		token, err := scanWorkerRegistrationToken(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+func (f *workerKeyFactory) DeleteWorkerRegistrationToken(id int) (bool, error) {
+	result, err := psql.Delete("worker_registration_tokens").
+		Where(sq.Eq{"id": id}).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// RedeemWorkerRegistrationToken uses up the token to save a key which only
+// the requesting worker can register with. A key previously registered by
+// the same worker is replaced. The worker's name cannot be taken over from a
+// worker or key of another team, or from a global one by a team's worker.
+func (f *workerKeyFactory) RedeemWorkerRegistrationToken(request atc.RedeemWorkerRegistrationTokenRequest) (WorkerKey, error) {
+	key, err := atc.ParseWorkerKey(request.PublicKey)
+	if err != nil {
+		return WorkerKey{}, err
+	}
+
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return WorkerKey{}, err
+	}
+
+	defer Rollback(tx)
+
+	var (
+		tokenID   int
+		tokenTeam string
+		tokenTags []string
+	)
+
+	err = psql.Select("r.id", "COALESCE(t.name, '')", "r.tags").
+		From("worker_registration_tokens r").
+		LeftJoin("teams t ON t.id = r.team_id").
+		Where(sq.Eq{"r.token_hash": hashWorkerRegistrationToken(request.Token)}).
+		Where(sq.Expr("r.expires_at > NOW()")).
+		Where(sq.Expr("(r.max_uses IS NULL OR r.uses < r.max_uses)")).
+		Suffix("FOR UPDATE OF r").
+		RunWith(tx).
+		QueryRow().
+		Scan(&tokenID, &tokenTeam, pq.Array(&tokenTags))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return WorkerKey{}, ErrWorkerRegistrationTokenInvalid
+		}
+
+		return WorkerKey{}, err
+	}
+
+	if tokenTeam != "" && tokenTeam != request.TeamName {
+		return WorkerKey{}, ErrWorkerRegistrationTokenMismatch
+	}
+
+	if len(tokenTags) > 0 && !atc.SameTags(tokenTags, request.Tags) {
+		return WorkerKey{}, ErrWorkerRegistrationTokenMismatch
+	}
+
+	var teamID interface{}
+	if request.TeamName != "" {
+		teamID, err = teamIDByName(tx, request.TeamName)
+		if err != nil {
+			return WorkerKey{}, err
+		}
+	}
+
+	var taken bool
+	err = tx.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM worker_keys WHERE worker_name = $1 AND team_id IS DISTINCT FROM $2::integer
+			UNION ALL
+			SELECT 1 FROM workers WHERE name = $1 AND team_id IS DISTINCT FROM $2::integer
+		)
+	`, request.WorkerName, teamID).Scan(&taken)
+	if err != nil {
+		return WorkerKey{}, err
+	}
+
+	if taken {
+		return WorkerKey{}, ErrWorkerRegistrationTokenNameTaken
+	}
+
+	_, err = psql.Update("worker_registration_tokens").
+		Set("uses", sq.Expr("uses + 1")).
+		Where(sq.Eq{"id": tokenID}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return WorkerKey{}, err
+	}
+
+	_, err = psql.Delete("worker_keys").
+		Where(sq.Eq{
+			"worker_name": request.WorkerName,
+			"team_id":     teamID,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return WorkerKey{}, err
+	}
+
+	workerKey := WorkerKey{
+		TeamName:    request.TeamName,
+		Name:        request.WorkerName,
+		PublicKey:   strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))),
+		Fingerprint: ssh.FingerprintSHA256(key),
+		WorkerName:  request.WorkerName,
+		Tags:        tokenTags,
+	}
+
+	err = psql.Insert("worker_keys").
+		Columns("team_id", "name", "public_key", "fingerprint", "worker_name", "tags").
+		Values(teamID, workerKey.Name, workerKey.PublicKey, workerKey.Fingerprint, workerKey.WorkerName, pq.Array(workerKey.Tags)).
+		Suffix("RETURNING created_at").
+		RunWith(tx).
+		QueryRow().
+		Scan(&workerKey.CreatedAt)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
+			return WorkerKey{}, ErrWorkerKeyInUse
+		}
+
+		return WorkerKey{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return WorkerKey{}, err
+	}
+
+	return workerKey, nil
+}
+
+func teamIDByName(tx Tx, teamName string) (int, error) {
+	var teamID int
+	err := psql.Select("id").
+		From("teams").
+		Where(sq.Eq{"name": teamName}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&teamID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, ErrWorkerRegistrationTokenTeamNotFound{TeamName: teamName}
+		}
+
+		return 0, err
+	}
+
+	return teamID, nil
+}
+
+func generateWorkerRegistrationToken() (string, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+func hashWorkerRegistrationToken(token string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(token)))
+}
+
+func scanWorkerRegistrationToken(row scannable) (WorkerRegistrationToken, error) {
+	var token WorkerRegistrationToken
+
+	err := row.Scan(
+		&token.ID,
+		&token.TeamName,
+		pq.Array(&token.Tags),
+		&token.CreatedAt,
+		&token.ExpiresAt,
+		&token.MaxUses,
+		&token.Uses,
+	)
+	if err != nil {
+		return WorkerRegistrationToken{}, err
+	}
+
+	return token, nil
+}
@@ -0,0 +1,235 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerRegistrationToken", func() {
+	const (
+		somePublicKey      = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFdKVCFpynKib6/W5IGS6qXrd/yxmYbgJKdH/zOv51fo"
+		someOtherPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKpWKvUBVBFBliOp07H0SeNoCg6V2FMAmJ15lNJRhm3U"
+	)
+
+	var (
+		token  db.WorkerRegistrationToken
+		secret string
+	)
+
+	BeforeEach(func() {
+		_, err := teamFactory.CreateTeam(atc.Team{Name: "some-team"})
+		Expect(err).ToNot(HaveOccurred())
+
+		token, secret, err = workerKeyFactory.CreateWorkerRegistrationToken(atc.WorkerRegistrationToken{
+			TeamName:  "some-team",
+			Tags:      []string{"some-tag"},
+			ExpiresAt: time.Now().Add(time.Hour).Unix(),
+			MaxUses:   1,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("CreateWorkerRegistrationToken", func() {
+		It("returns the token and its secret", func() {
+			Expect(token.ID).ToNot(BeZero())
+			Expect(token.TeamName).To(Equal("some-team"))
+			Expect(token.Tags).To(Equal([]string{"some-tag"}))
+			Expect(token.MaxUses).To(Equal(1))
+			Expect(token.Uses).To(BeZero())
+			Expect(secret).To(HaveLen(43))
+		})
+
+		It("errors when the team does not exist", func() {
+			_, _, err := workerKeyFactory.CreateWorkerRegistrationToken(atc.WorkerRegistrationToken{
+				TeamName:  "bogus-team",
+				ExpiresAt: time.Now().Add(time.Hour).Unix(),
+			})
+			Expect(err).To(Equal(db.ErrWorkerRegistrationTokenTeamNotFound{TeamName: "bogus-team"}))
+		})
+	})
+
+	Describe("WorkerRegistrationTokens", func() {
+		It("lists the tokens", func() {
+			tokens, err := workerKeyFactory.WorkerRegistrationTokens()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tokens).To(Equal([]db.WorkerRegistrationToken{token}))
+		})
+	})
+
+	Describe("DeleteWorkerRegistrationToken", func() {
+		It("deletes the token", func() {
+			deleted, err := workerKeyFactory.DeleteWorkerRegistrationToken(token.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+
+			deleted, err = workerKeyFactory.DeleteWorkerRegistrationToken(token.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeFalse())
+		})
+	})
+
+	Describe("RedeemWorkerRegistrationToken", func() {
+		var request atc.RedeemWorkerRegistrationTokenRequest
+
+		BeforeEach(func() {
+			request = atc.RedeemWorkerRegistrationTokenRequest{
+				Token:      secret,
+				WorkerName: "some-worker",
+				TeamName:   "some-team",
+				Tags:       []string{"some-tag"},
+				PublicKey:  somePublicKey,
+			}
+		})
+
+		It("saves a key bound to the worker", func() {
+			key, err := workerKeyFactory.RedeemWorkerRegistrationToken(request)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(key.TeamName).To(Equal("some-team"))
+			Expect(key.Name).To(Equal("some-worker"))
+			Expect(key.WorkerName).To(Equal("some-worker"))
+			Expect(key.Tags).To(Equal([]string{"some-tag"}))
+
+			keys, err := workerKeyFactory.ActiveWorkerKeys()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keys).To(HaveLen(1))
+			Expect(keys[0].WorkerName).To(Equal("some-worker"))
+			Expect(keys[0].Tags).To(Equal([]string{"some-tag"}))
+		})
+
+		It("can only be used up to its max uses", func() {
+			_, err := workerKeyFactory.RedeemWorkerRegistrationToken(request)
+			Expect(err).ToNot(HaveOccurred())
+
+			request.WorkerName = "some-other-worker"
+			request.PublicKey = someOtherPublicKey
+			_, err = workerKeyFactory.RedeemWorkerRegistrationToken(request)
+			Expect(err).To(Equal(db.ErrWorkerRegistrationTokenInvalid))
+		})
+
+		It("rejects unknown tokens", func() {
+			request.Token = "bogus"
+			_, err := workerKeyFactory.RedeemWorkerRegistrationToken(request)
+			Expect(err).To(Equal(db.ErrWorkerRegistrationTokenInvalid))
+		})
+
+		It("rejects workers of another team", func() {
+			request.TeamName = ""
+			_, err := workerKeyFactory.RedeemWorkerRegistrationToken(request)
+			Expect(err).To(Equal(db.ErrWorkerRegistrationTokenMismatch))
+		})
+
+		It("rejects workers with other tags", func() {
+			request.Tags = []string{"some-tag", "some-other-tag"}
+			_, err := workerKeyFactory.RedeemWorkerRegistrationToken(request)
+			Expect(err).To(Equal(db.ErrWorkerRegistrationTokenMismatch))
+		})
+
+		It("rejects the name of a global worker", func() {
+			request.WorkerName = defaultWorker.Name()
+			_, err := workerKeyFactory.RedeemWorkerRegistrationToken(request)
+			Expect(err).To(Equal(db.ErrWorkerRegistrationTokenNameTaken))
+		})
+
+		Context("when the token is not bound to a team", func() {
+			BeforeEach(func() {
+				var err error
+				_, request.Token, err = workerKeyFactory.CreateWorkerRegistrationToken(atc.WorkerRegistrationToken{
+					ExpiresAt: time.Now().Add(time.Hour).Unix(),
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				request.TeamName = ""
+			})
+
+			It("saves a global key which can be replaced by the worker", func() {
+				_, err := workerKeyFactory.RedeemWorkerRegistrationToken(request)
+				Expect(err).ToNot(HaveOccurred())
+
+				request.PublicKey = someOtherPublicKey
+				_, err = workerKeyFactory.RedeemWorkerRegistrationToken(request)
+				Expect(err).ToNot(HaveOccurred())
+
+				keys, err := workerKeyFactory.ActiveWorkerKeys()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(keys).To(HaveLen(1))
+				Expect(keys[0].TeamName).To(BeEmpty())
+				Expect(keys[0].Tags).To(BeEmpty())
+				Expect(keys[0].PublicKey).To(Equal(someOtherPublicKey))
+			})
+
+			It("rejects the name of a team's worker key and keeps the key", func() {
+				_, err := workerKeyFactory.RedeemWorkerRegistrationToken(atc.RedeemWorkerRegistrationTokenRequest{
+					Token:      secret,
+					WorkerName: "some-worker",
+					TeamName:   "some-team",
+					Tags:       []string{"some-tag"},
+					PublicKey:  somePublicKey,
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				request.PublicKey = someOtherPublicKey
+				_, err = workerKeyFactory.RedeemWorkerRegistrationToken(request)
+				Expect(err).To(Equal(db.ErrWorkerRegistrationTokenNameTaken))
+
+				keys, err := workerKeyFactory.ActiveWorkerKeys()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(keys).To(HaveLen(1))
+				Expect(keys[0].TeamName).To(Equal("some-team"))
+				Expect(keys[0].PublicKey).To(Equal(somePublicKey))
+			})
+		})
+	})
+
+	Describe("WorkerKeyLifecycle", func() {
+		var lifecycle db.WorkerKeyLifecycle
+
+		BeforeEach(func() {
+			lifecycle = db.NewWorkerKeyLifecycle(dbConn)
+		})
+
+		It("removes tokens which are used up", func() {
+			_, err := workerKeyFactory.RedeemWorkerRegistrationToken(atc.RedeemWorkerRegistrationTokenRequest{
+				Token:      secret,
+				WorkerName: "some-worker",
+				TeamName:   "some-team",
+				Tags:       []string{"some-tag"},
+				PublicKey:  somePublicKey,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			removed, err := lifecycle.RemoveUnusableWorkerRegistrationTokens()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+		})
+
+		It("keeps tokens which can still be used", func() {
+			removed, err := lifecycle.RemoveUnusableWorkerRegistrationTokens()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeZero())
+		})
+
+		It("removes keys of workers which no longer exist", func() {
+			_, err := workerKeyFactory.RedeemWorkerRegistrationToken(atc.RedeemWorkerRegistrationTokenRequest{
+				Token:      secret,
+				WorkerName: "some-missing-worker",
+				TeamName:   "some-team",
+				Tags:       []string{"some-tag"},
+				PublicKey:  somePublicKey,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			removed, err := lifecycle.RemoveOrphanedWorkerKeys(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeZero())
+
+			removed, err = lifecycle.RemoveOrphanedWorkerKeys(0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+		})
+	})
+})
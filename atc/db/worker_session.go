@@ -0,0 +1,113 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . WorkerSessionFactory
+
+// WorkerSessionFactory keeps the sessions last reported by each TSA, so that
+// they can be listed across web nodes.
+type WorkerSessionFactory interface {
+	SaveWorkerSessions(report atc.WorkerSessionsReport, ttl time.Duration) error
+	WorkerSessions() ([]atc.WorkerSession, error)
+}
+
+type workerSessionFactory struct {
+	conn Conn
+}
+
+func NewWorkerSessionFactory(conn Conn) WorkerSessionFactory {
+	return &workerSessionFactory{
+		conn: conn,
+	}
+}
+
+// SaveWorkerSessions replaces the sessions of the reporting TSA. They are
+// no longer listed after the ttl, unless the TSA reports them again, and
+// TSAs which have stopped reporting are cleaned up along the way.
+func (f *workerSessionFactory) SaveWorkerSessions(report atc.WorkerSessionsReport, ttl time.Duration) error {
+	sessions := report.Sessions
+	if sessions == nil {
+		sessions = []atc.WorkerSession{}
+	}
+
+	payload, err := json.Marshal(sessions)
+	if err != nil {
+		return err
+	}
+
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Delete("tsa_worker_sessions").
+		Where(sq.Expr("expires_at < NOW()")).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	expires := sq.Expr(fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds())))
+
+	_, err = psql.Insert("tsa_worker_sessions").
+		Columns("tsa", "sessions", "expires_at").
+		Values(report.TSA, payload, expires).
+		Suffix(`
+			ON CONFLICT (tsa) DO UPDATE SET
+				sessions = EXCLUDED.sessions,
+				reported_at = NOW(),
+				expires_at = EXCLUDED.expires_at
+		`).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// WorkerSessions returns the sessions of every TSA which has reported
+// within its ttl, ordered by TSA.
+func (f *workerSessionFactory) WorkerSessions() ([]atc.WorkerSession, error) {
+	rows, err := psql.Select("sessions").
+		From("tsa_worker_sessions").
+		Where(sq.Expr("expires_at >= NOW()")).
+		OrderBy("tsa").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	sessions := []atc.WorkerSession{}
+	for rows.Next() {
+		var payload []byte
+		err := rows.Scan(&payload)
+		if err != nil {
+			return nil, err
+		}
+
+		var reported []atc.WorkerSession
+		err = json.Unmarshal(payload, &reported)
+		if err != nil {
+			return nil, err
+		}
+
+		sessions = append(sessions, reported...)
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,78 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerSession", func() {
+	someSession := atc.WorkerSession{
+		ID:          "some-session",
+		TSA:         "some-tsa:2222",
+		WorkerName:  "some-worker",
+		RemoteAddr:  "1.2.3.4:5678",
+		Transport:   "ssh",
+		ConnectedAt: 100,
+		ForwardedPorts: []atc.WorkerSessionForward{
+			{BindAddr: "0.0.0.0:7777", BoundPort: 40000},
+		},
+		BytesProxied: 1024,
+	}
+
+	someOtherSession := atc.WorkerSession{
+		ID:          "some-other-session",
+		TSA:         "some-other-tsa:2222",
+		RemoteAddr:  "1.2.3.5:5678",
+		Transport:   "websocket",
+		ConnectedAt: 200,
+	}
+
+	Describe("SaveWorkerSessions", func() {
+		BeforeEach(func() {
+			err := workerSessionFactory.SaveWorkerSessions(atc.WorkerSessionsReport{
+				TSA:      "some-tsa:2222",
+				Sessions: []atc.WorkerSession{someSession},
+			}, time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("lists the sessions of every TSA", func() {
+			err := workerSessionFactory.SaveWorkerSessions(atc.WorkerSessionsReport{
+				TSA:      "some-other-tsa:2222",
+				Sessions: []atc.WorkerSession{someOtherSession},
+			}, time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+
+			sessions, err := workerSessionFactory.WorkerSessions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(Equal([]atc.WorkerSession{someSession, someOtherSession}))
+		})
+
+		It("replaces the sessions previously reported by the TSA", func() {
+			err := workerSessionFactory.SaveWorkerSessions(atc.WorkerSessionsReport{
+				TSA: "some-tsa:2222",
+			}, time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+
+			sessions, err := workerSessionFactory.WorkerSessions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(BeEmpty())
+		})
+
+		It("stops listing the sessions of a TSA once they expire", func() {
+			err := workerSessionFactory.SaveWorkerSessions(atc.WorkerSessionsReport{
+				TSA:      "some-other-tsa:2222",
+				Sessions: []atc.WorkerSession{someOtherSession},
+			}, -time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+
+			sessions, err := workerSessionFactory.WorkerSessions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sessions).To(Equal([]atc.WorkerSession{someSession}))
+		})
+	})
+})
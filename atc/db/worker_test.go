@@ -98,6 +98,58 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("SetResources", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("has no resources until they are reported", func() {
+			Expect(worker.Resources()).To(BeNil())
+		})
+
+		Context("when the worker is present", func() {
+			resources := atc.WorkerResources{
+				MemoryFree:  1024,
+				MemoryTotal: 4096,
+				CPULoad:     0.5,
+				DiskFree:    2048,
+				DiskTotal:   8192,
+			}
+
+			It("saves the resources", func() {
+				err := worker.SetResources(resources)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.Resources()).To(Equal(&resources))
+			})
+
+			It("keeps the resources across heartbeats", func() {
+				err := worker.SetResources(resources)
+				Expect(err).NotTo(HaveOccurred())
+
+				worker, err = workerFactory.HeartbeatWorker(atcWorker, 5*time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.Resources()).To(Equal(&resources))
+			})
+		})
+
+		Context("when the worker is not present", func() {
+			BeforeEach(func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				err := worker.SetResources(atc.WorkerResources{})
+				Expect(err).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
 	Describe("Retire", func() {
 		BeforeEach(func() {
 			var err error
@@ -131,6 +183,65 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("Degrade/Recover", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("degrades a running worker and recovers it", func() {
+			degraded, err := worker.Degrade()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(degraded).To(BeTrue())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.State()).To(Equal(WorkerStateDegraded))
+
+			degraded, err = worker.Degrade()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(degraded).To(BeFalse())
+
+			recovered, err := worker.Recover()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recovered).To(BeTrue())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.State()).To(Equal(WorkerStateRunning))
+		})
+
+		It("keeps the worker degraded across heartbeats", func() {
+			_, err := worker.Degrade()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = workerFactory.HeartbeatWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(worker.State()).To(Equal(WorkerStateDegraded))
+		})
+
+		Context("when the worker is landing", func() {
+			BeforeEach(func() {
+				err := worker.Land()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not degrade it", func() {
+				degraded, err := worker.Degrade()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(degraded).To(BeFalse())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(worker.State()).To(Equal(WorkerStateLanding))
+			})
+		})
+	})
+
 	Describe("Delete", func() {
 		BeforeEach(func() {
 			var err error
@@ -0,0 +1,159 @@
+package atc
+
+import (
+	"fmt"
+	"net"
+
+	"sigs.k8s.io/yaml"
+)
+
+// MetadataNetwork is the link-local range of the metadata endpoints of the
+// major cloud providers.
+const MetadataNetwork = "169.254.169.254/32"
+
+// EgressPolicy restricts the network destinations containers can reach.
+type EgressPolicy struct {
+	// Destinations containers can reach. When empty, any destination which
+	// isn't denied can be reached.
+	Allow []EgressRule `json:"allow,omitempty"`
+
+	// Destinations containers can never reach, even when they are allowed.
+	Deny []EgressRule `json:"deny,omitempty"`
+
+	// Deny the cloud metadata endpoint.
+	BlockMetadata bool `json:"block_metadata,omitempty"`
+}
+
+// EgressRule matches traffic to a network, optionally restricted to a protocol
+// and its ports.
+type EgressRule struct {
+	Network  string `json:"network"`
+	Protocol string `json:"protocol,omitempty"`
+	Ports    []int  `json:"ports,omitempty"`
+}
+
+// EgressPolicies are the egress policies enforced on the containers of each
+// team and pipeline, as configured by the operator.
+type EgressPolicies struct {
+	// Policy enforced on teams without a policy of their own.
+	Default *EgressPolicy `json:"default,omitempty"`
+
+	Teams map[string]TeamEgressPolicy `json:"teams,omitempty"`
+}
+
+type TeamEgressPolicy struct {
+	EgressPolicy
+
+	// Policies enforced on the pipelines of the team in place of the team's
+	// policy.
+	Pipelines map[string]EgressPolicy `json:"pipelines,omitempty"`
+}
+
+func NewEgressPolicies(configBytes []byte) (EgressPolicies, error) {
+	var policies EgressPolicies
+	err := yaml.UnmarshalStrict(configBytes, &policies, yaml.DisallowUnknownFields)
+	if err != nil {
+		return EgressPolicies{}, err
+	}
+
+	err = policies.Validate()
+	if err != nil {
+		return EgressPolicies{}, err
+	}
+
+	return policies, nil
+}
+
+// For returns the policy enforced on the containers of the pipeline, or nil
+// if there is none. An empty pipeline name returns the team's policy.
+func (policies EgressPolicies) For(teamName string, pipelineName string) *EgressPolicy {
+	team, found := policies.Teams[teamName]
+	if !found {
+		return policies.Default
+	}
+
+	if pipeline, found := team.Pipelines[pipelineName]; found && pipelineName != "" {
+		return &pipeline
+	}
+
+	return &team.EgressPolicy
+}
+
+// Validate returns an error describing the first invalid rule, if any.
+func (policies EgressPolicies) Validate() error {
+	if policies.Default != nil {
+		if err := policies.Default.Validate(); err != nil {
+			return fmt.Errorf("default: %w", err)
+		}
+	}
+
+	for teamName, team := range policies.Teams {
+		if err := team.EgressPolicy.Validate(); err != nil {
+			return fmt.Errorf("team '%s': %w", teamName, err)
+		}
+
+		for pipelineName, pipeline := range team.Pipelines {
+			if err := pipeline.Validate(); err != nil {
+				return fmt.Errorf("team '%s' pipeline '%s': %w", teamName, pipelineName, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Validate returns an error describing the first invalid rule, if any.
+func (policy EgressPolicy) Validate() error {
+	for i, rule := range policy.Allow {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("allow[%d]: %w", i, err)
+		}
+	}
+
+	for i, rule := range policy.Deny {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("deny[%d]: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// DeniedRules returns the deny rules of the policy, including the metadata
+// endpoint when it is blocked.
+func (policy EgressPolicy) DeniedRules() []EgressRule {
+	rules := policy.Deny
+	if policy.BlockMetadata {
+		rules = append(rules[:len(rules):len(rules)], EgressRule{Network: MetadataNetwork})
+	}
+
+	return rules
+}
+
+func (rule EgressRule) Validate() error {
+	if _, _, err := net.ParseCIDR(rule.Network); err != nil {
+		return fmt.Errorf("invalid network '%s': must be in CIDR notation", rule.Network)
+	}
+
+	switch rule.Protocol {
+	case "":
+		if len(rule.Ports) > 0 {
+			return fmt.Errorf("ports require a protocol")
+		}
+	case "tcp", "udp":
+	default:
+		return fmt.Errorf("invalid protocol '%s': must be tcp or udp", rule.Protocol)
+	}
+
+	if len(rule.Ports) > 15 {
+		return fmt.Errorf("at most 15 ports can be given")
+	}
+
+	for _, port := range rule.Ports {
+		if port < 1 || port > 65535 {
+			return fmt.Errorf("invalid port %d", port)
+		}
+	}
+
+	return nil
+}
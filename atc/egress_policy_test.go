@@ -0,0 +1,114 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EgressPolicies", func() {
+	var policies atc.EgressPolicies
+
+	BeforeEach(func() {
+		var err error
+		policies, err = atc.NewEgressPolicies([]byte(`
+default:
+  block_metadata: true
+teams:
+  finance:
+    allow:
+    - network: 10.0.0.0/8
+      protocol: tcp
+      ports: [443]
+    pipelines:
+      deploy:
+        deny:
+        - network: 192.168.0.0/16
+`))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("NewEgressPolicies", func() {
+		It("rejects unknown fields", func() {
+			_, err := atc.NewEgressPolicies([]byte(`default: {block_metadta: true}`))
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("rejects invalid rules", func() {
+			_, err := atc.NewEgressPolicies([]byte(`default: {deny: [{network: 10.0.0.1}]}`))
+			Expect(err).To(MatchError("default: deny[0]: invalid network '10.0.0.1': must be in CIDR notation"))
+		})
+	})
+
+	Describe("For", func() {
+		It("returns the team's policy", func() {
+			Expect(policies.For("finance", "some-pipeline")).To(Equal(&atc.EgressPolicy{
+				Allow: []atc.EgressRule{{Network: "10.0.0.0/8", Protocol: "tcp", Ports: []int{443}}},
+			}))
+		})
+
+		It("returns the pipeline's policy in place of the team's", func() {
+			Expect(policies.For("finance", "deploy")).To(Equal(&atc.EgressPolicy{
+				Deny: []atc.EgressRule{{Network: "192.168.0.0/16"}},
+			}))
+		})
+
+		It("returns the default policy for other teams", func() {
+			Expect(policies.For("main", "deploy")).To(Equal(&atc.EgressPolicy{BlockMetadata: true}))
+		})
+
+		It("returns nil without a default policy", func() {
+			policies.Default = nil
+			Expect(policies.For("main", "deploy")).To(BeNil())
+		})
+	})
+
+	Describe("DeniedRules", func() {
+		It("includes the metadata endpoint when it is blocked", func() {
+			policy := atc.EgressPolicy{
+				Deny:          []atc.EgressRule{{Network: "192.168.0.0/16"}},
+				BlockMetadata: true,
+			}
+
+			Expect(policy.DeniedRules()).To(Equal([]atc.EgressRule{
+				{Network: "192.168.0.0/16"},
+				{Network: atc.MetadataNetwork},
+			}))
+			Expect(policy.Deny).To(HaveLen(1))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("accepts valid policies", func() {
+			Expect(policies.Validate()).To(Succeed())
+		})
+
+		It("names the invalid rule", func() {
+			policies.Teams["finance"].Pipelines["deploy"] = atc.EgressPolicy{
+				Deny: []atc.EgressRule{{Network: "192.168.0.0"}},
+			}
+
+			Expect(policies.Validate()).To(MatchError("team 'finance' pipeline 'deploy': deny[0]: invalid network '192.168.0.0': must be in CIDR notation"))
+		})
+	})
+
+	DescribeTable("rules",
+		func(rule atc.EgressRule, message string) {
+			err := rule.Validate()
+			if message == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(message))
+			}
+		},
+		Entry("a network", atc.EgressRule{Network: "10.0.0.0/8"}, ""),
+		Entry("a protocol and ports", atc.EgressRule{Network: "10.0.0.0/8", Protocol: "udp", Ports: []int{53}}, ""),
+		Entry("an invalid network", atc.EgressRule{Network: "example.com"}, "invalid network 'example.com': must be in CIDR notation"),
+		Entry("an invalid protocol", atc.EgressRule{Network: "10.0.0.0/8", Protocol: "icmp"}, "invalid protocol 'icmp': must be tcp or udp"),
+		Entry("ports without a protocol", atc.EgressRule{Network: "10.0.0.0/8", Ports: []int{443}}, "ports require a protocol"),
+		Entry("too many ports", atc.EgressRule{Network: "10.0.0.0/8", Protocol: "tcp", Ports: []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}}, "at most 15 ports can be given"),
+		Entry("an invalid port", atc.EgressRule{Network: "10.0.0.0/8", Protocol: "tcp", Ports: []int{70000}}, "invalid port 70000"),
+	)
+})
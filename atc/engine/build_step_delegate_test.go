@@ -639,7 +639,7 @@ var _ = Describe("BuildStepDelegate", func() {
 
 		BeforeEach(func() {
 			credVars := vars.StaticVariables{}
-			runState = exec.NewRunState(noopStepper, credVars, false)
+			runState = exec.NewRunState(noopStepper, credVars, false, nil)
 			delegate = engine.NewBuildStepDelegate(fakeBuild, "some-plan-id", runState, fakeClock, fakePolicyChecker, fakeArtifactSourcer)
 		})
 
@@ -739,7 +739,7 @@ var _ = Describe("BuildStepDelegate", func() {
 		)
 
 		BeforeEach(func() {
-			runState = exec.NewRunState(noopStepper, credVars, true)
+			runState = exec.NewRunState(noopStepper, credVars, true, nil)
 			delegate = engine.NewBuildStepDelegate(fakeBuild, "some-plan-id", runState, fakeClock, fakePolicyChecker, fakeArtifactSourcer)
 
 			runState.Get(vars.Reference{Path: "source-param"})
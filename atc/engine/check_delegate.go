@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/worker"
 )
@@ -19,7 +20,8 @@ import (
 //go:generate counterfeiter . RateLimiter
 
 type RateLimiter interface {
-	Wait(context.Context) error
+	Wait(ctx context.Context, teamID int) error
+	AcquireCheckContainer(ctx context.Context, teamID int) (func(), error)
 }
 
 func NewCheckDelegate(
@@ -78,8 +80,12 @@ func (d *checkDelegate) WaitToRun(ctx context.Context, scope db.ResourceConfigSc
 
 	// rate limit periodic resource checks so worker load (plus load on external
 	// services) isn't too spiky
-	if !d.build.IsManuallyTriggered() && d.plan.Resource != "" {
-		err := d.limiter.Wait(ctx)
+	limited := !d.build.IsManuallyTriggered() && d.plan.Resource != ""
+	if limited {
+		waiting := metric.Metrics.ChecksWaiting(d.build.TeamName())
+		waiting.Inc()
+		err := d.limiter.Wait(ctx, d.build.TeamID())
+		waiting.Dec()
 		if err != nil {
 			return nil, false, fmt.Errorf("rate limit: %w", err)
 		}
@@ -152,9 +158,40 @@ func (d *checkDelegate) WaitToRun(ctx context.Context, scope db.ResourceConfigSc
 		return nil, false, nil
 	}
 
+	if limited {
+		// hold on to one of the team's check containers until the check is done
+		// and the lock is released
+		waiting := metric.Metrics.ChecksWaiting(d.build.TeamName())
+		waiting.Inc()
+		release, err := d.limiter.AcquireCheckContainer(ctx, d.build.TeamID())
+		waiting.Dec()
+		if err != nil {
+			if releaseErr := lock.Release(); releaseErr != nil {
+				logger.Error("failed-to-release-lock", releaseErr)
+			}
+
+			return nil, false, fmt.Errorf("acquire check container: %w", err)
+		}
+
+		lock = checkContainerLock{Lock: lock, release: release}
+	}
+
 	return lock, true, nil
 }
 
+// checkContainerLock gives back the team's check container once the check
+// releases its lock.
+type checkContainerLock struct {
+	lock.Lock
+
+	release func()
+}
+
+func (l checkContainerLock) Release() error {
+	defer l.release()
+	return l.Lock.Release()
+}
+
 func (d *checkDelegate) PointToCheckedConfig(scope db.ResourceConfigScope) error {
 	resource, found, err := d.resource()
 	if err != nil {
@@ -51,7 +51,7 @@ var _ = Describe("CheckDelegate", func() {
 			"source-param": "super-secret-source",
 			"git-key":      "{\n123\n456\n789\n}\n",
 		}
-		state = exec.NewRunState(noopStepper, credVars, true)
+		state = exec.NewRunState(noopStepper, credVars, true, nil)
 
 		plan = atc.Plan{
 			ID:    "some-plan-id",
@@ -218,16 +218,53 @@ var _ = Describe("CheckDelegate", func() {
 
 		Context("when running for a resource", func() {
 			var fakeLock *lockfakes.FakeLock
+			var checkContainersReleased int
 
 			BeforeEach(func() {
 				plan.Check.Resource = "some-resource"
 
+				fakeBuild.TeamIDReturns(42)
+				fakeBuild.TeamNameReturns("some-team")
+
 				fakeLock = new(lockfakes.FakeLock)
 				fakeResourceConfigScope.AcquireResourceCheckingLockReturns(fakeLock, true, nil)
+
+				checkContainersReleased = 0
+				fakeRateLimiter.AcquireCheckContainerReturns(func() { checkContainersReleased++ }, nil)
+			})
+
+			It("rate limits for the build's team", func() {
+				_, teamID := fakeRateLimiter.WaitArgsForCall(0)
+				Expect(teamID).To(Equal(42))
 			})
 
-			It("returns a lock", func() {
-				Expect(runLock).To(Equal(fakeLock))
+			It("acquires a check container for the build's team", func() {
+				Expect(fakeRateLimiter.AcquireCheckContainerCallCount()).To(Equal(1))
+				_, teamID := fakeRateLimiter.AcquireCheckContainerArgsForCall(0)
+				Expect(teamID).To(Equal(42))
+			})
+
+			It("returns a lock which gives back the check container when released", func() {
+				Expect(run).To(BeTrue())
+				Expect(checkContainersReleased).To(Equal(0))
+
+				Expect(runLock.Release()).To(Succeed())
+				Expect(fakeLock.ReleaseCallCount()).To(Equal(1))
+				Expect(checkContainersReleased).To(Equal(1))
+			})
+
+			Context("when acquiring a check container fails", func() {
+				BeforeEach(func() {
+					fakeRateLimiter.AcquireCheckContainerReturns(nil, context.Canceled)
+				})
+
+				It("returns an error", func() {
+					Expect(runErr).To(MatchError(context.Canceled))
+				})
+
+				It("releases the lock", func() {
+					Expect(fakeLock.ReleaseCallCount()).To(Equal(1))
+				})
 			})
 
 			Context("before acquiring the lock", func() {
@@ -251,6 +288,14 @@ var _ = Describe("CheckDelegate", func() {
 				It("does not rate limit", func() {
 					Expect(fakeRateLimiter.WaitCallCount()).To(Equal(0))
 				})
+
+				It("does not acquire a check container", func() {
+					Expect(fakeRateLimiter.AcquireCheckContainerCallCount()).To(Equal(0))
+				})
+
+				It("returns the lock", func() {
+					Expect(runLock).To(Equal(fakeLock))
+				})
 			})
 
 			Context("when getting the last check end time errors", func() {
@@ -286,6 +331,10 @@ var _ = Describe("CheckDelegate", func() {
 					It("releases the lock", func() {
 						Expect(fakeLock.ReleaseCallCount()).To(Equal(1))
 					})
+
+					It("does not acquire a check container", func() {
+						Expect(fakeRateLimiter.AcquireCheckContainerCallCount()).To(Equal(0))
+					})
 				})
 			})
 		})
@@ -17,6 +17,7 @@ import (
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/util"
 	"github.com/concourse/concourse/tracing"
+	"github.com/concourse/concourse/vars"
 )
 
 //go:generate counterfeiter . Engine
@@ -36,6 +37,9 @@ type Runnable interface {
 func NewEngine(
 	stepperFactory StepperFactory,
 	secrets creds.Secrets,
+	secretsManager string,
+	leaser creds.Leaser,
+	secretLeaseFactory db.SecretLeaseFactory,
 	varSourcePool creds.VarSourcePool,
 ) Engine {
 	return &engine{
@@ -44,8 +48,11 @@ func NewEngine(
 		trackedStates:  new(sync.Map),
 		waitGroup:      new(sync.WaitGroup),
 
-		globalSecrets: secrets,
-		varSourcePool: varSourcePool,
+		globalSecrets:      secrets,
+		secretsManager:     secretsManager,
+		leaser:             leaser,
+		secretLeaseFactory: secretLeaseFactory,
+		varSourcePool:      varSourcePool,
 	}
 }
 
@@ -55,8 +62,11 @@ type engine struct {
 	trackedStates  *sync.Map
 	waitGroup      *sync.WaitGroup
 
-	globalSecrets creds.Secrets
-	varSourcePool creds.VarSourcePool
+	globalSecrets      creds.Secrets
+	secretsManager     string
+	leaser             creds.Leaser
+	secretLeaseFactory db.SecretLeaseFactory
+	varSourcePool      creds.VarSourcePool
 }
 
 func (engine *engine) Drain(ctx context.Context) {
@@ -77,6 +87,9 @@ func (engine *engine) NewBuild(build db.Build) Runnable {
 		build,
 		engine.stepperFactory,
 		engine.globalSecrets,
+		engine.secretsManager,
+		engine.leaser,
+		engine.secretLeaseFactory,
 		engine.varSourcePool,
 		engine.release,
 		engine.trackedStates,
@@ -88,17 +101,31 @@ func NewBuild(
 	build db.Build,
 	builder StepperFactory,
 	globalSecrets creds.Secrets,
+	secretsManager string,
+	leaser creds.Leaser,
+	secretLeaseFactory db.SecretLeaseFactory,
 	varSourcePool creds.VarSourcePool,
 	release chan bool,
 	trackedStates *sync.Map,
 	waitGroup *sync.WaitGroup,
 ) Runnable {
+	var leases *secretLeases
+	if leaser != nil {
+		leases = &secretLeases{
+			build:   build,
+			leaser:  leaser,
+			factory: secretLeaseFactory,
+		}
+	}
+
 	return &engineBuild{
 		build:   build,
 		builder: builder,
 
-		globalSecrets: globalSecrets,
-		varSourcePool: varSourcePool,
+		globalSecrets:  globalSecrets,
+		secretsManager: secretsManager,
+		secretLeases:   leases,
+		varSourcePool:  varSourcePool,
 
 		release:       release,
 		trackedStates: trackedStates,
@@ -110,8 +137,10 @@ type engineBuild struct {
 	build   db.Build
 	builder StepperFactory
 
-	globalSecrets creds.Secrets
-	varSourcePool creds.VarSourcePool
+	globalSecrets  creds.Secrets
+	secretsManager string
+	secretLeases   *secretLeases
+	varSourcePool  creds.VarSourcePool
 
 	release       chan bool
 	trackedStates *sync.Map
@@ -194,6 +223,13 @@ func (b *engineBuild) Run(ctx context.Context) {
 	}
 	defer b.clearRunState()
 
+	if b.secretLeases != nil {
+		renewCtx, stopRenewing := context.WithCancel(ctx)
+		defer stopRenewing()
+
+		go b.secretLeases.Renew(renewCtx, logger.Session("renew-secret-leases"))
+	}
+
 	ctx, cancel := context.WithCancel(ctx)
 
 	noleak := make(chan bool)
@@ -235,6 +271,10 @@ func (b *engineBuild) Run(ctx context.Context) {
 		}
 
 		b.finish(logger.Session("finish"), runErr, succeeded)
+
+		if b.secretLeases != nil {
+			b.secretLeases.Revoke(logger.Session("revoke-secret-leases"))
+		}
 	}
 }
 
@@ -307,11 +347,23 @@ func (b *engineBuild) runState(logger lager.Logger, stepper exec.Stepper) (exec.
 	if ok {
 		return existingState.(exec.RunState), nil
 	}
-	credVars, err := b.build.Variables(logger, b.globalSecrets, b.varSourcePool)
+	accesses := newSecretAccesses(b.build, b.globalSecrets, b.secretsManager)
+
+	secrets := accesses.Secrets(b.globalSecrets)
+	if b.secretLeases != nil {
+		secrets = b.secretLeases.Secrets(secrets)
+	}
+
+	credVars, err := b.build.Variables(logger, secrets, b.varSourcePool)
 	if err != nil {
 		return nil, err
 	}
-	state, _ := b.trackedStates.LoadOrStore(id, exec.NewRunState(stepper, credVars, atc.EnableRedactSecrets))
+
+	saveAccess := func(ref vars.Reference) {
+		accesses.Save(logger.Session("save-secret-access"), ref)
+	}
+
+	state, _ := b.trackedStates.LoadOrStore(id, exec.NewRunState(stepper, credVars, atc.EnableRedactSecrets, saveAccess))
 	return state.(exec.RunState), nil
 }
 
@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/lager/lagerctx"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
@@ -31,8 +32,11 @@ var _ = Describe("Engine", func() {
 		fakeBuild          *dbfakes.FakeBuild
 		fakeStepperFactory *enginefakes.FakeStepperFactory
 
-		fakeGlobalCreds   *credsfakes.FakeSecrets
-		fakeVarSourcePool *credsfakes.FakeVarSourcePool
+		fakeGlobalCreds        *credsfakes.FakeSecrets
+		globalSecrets          creds.Secrets
+		fakeLeaser             creds.Leaser
+		fakeSecretLeaseFactory *dbfakes.FakeSecretLeaseFactory
+		fakeVarSourcePool      *credsfakes.FakeVarSourcePool
 	)
 
 	BeforeEach(func() {
@@ -42,6 +46,9 @@ var _ = Describe("Engine", func() {
 		fakeStepperFactory = new(enginefakes.FakeStepperFactory)
 
 		fakeGlobalCreds = new(credsfakes.FakeSecrets)
+		globalSecrets = fakeGlobalCreds
+		fakeLeaser = nil
+		fakeSecretLeaseFactory = new(dbfakes.FakeSecretLeaseFactory)
 		fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
 	})
 
@@ -52,7 +59,7 @@ var _ = Describe("Engine", func() {
 		)
 
 		BeforeEach(func() {
-			engine = NewEngine(fakeStepperFactory, fakeGlobalCreds, fakeVarSourcePool)
+			engine = NewEngine(fakeStepperFactory, fakeGlobalCreds, "vault", nil, nil, fakeVarSourcePool)
 		})
 
 		JustBeforeEach(func() {
@@ -72,15 +79,20 @@ var _ = Describe("Engine", func() {
 		)
 
 		BeforeEach(func() {
-
 			release = make(chan bool)
-			trackedStates := new(sync.Map)
 			waitGroup = new(sync.WaitGroup)
+		})
+
+		JustBeforeEach(func() {
+			trackedStates := new(sync.Map)
 
 			build = NewBuild(
 				fakeBuild,
 				fakeStepperFactory,
-				fakeGlobalCreds,
+				globalSecrets,
+				"vault",
+				fakeLeaser,
+				fakeSecretLeaseFactory,
 				fakeVarSourcePool,
 				release,
 				trackedStates,
@@ -247,6 +259,177 @@ var _ = Describe("Engine", func() {
 									})
 								})
 
+								Context("when the build resolves credentials", func() {
+									var fakePipeline *dbfakes.FakePipeline
+
+									BeforeEach(func() {
+										fakeBuild.VariablesReturns(vars.NewMultiVars([]vars.Variables{
+											vars.NamedVariables{
+												"some-source": vars.StaticVariables{"token": "some-token"},
+											},
+											vars.StaticVariables{
+												"deploy-key": map[string]interface{}{"private_key": "some-key"},
+											},
+										}), nil)
+
+										fakePipeline = new(dbfakes.FakePipeline)
+										fakePipeline.VarSourcesReturns(atc.VarSourceConfigs{
+											{Name: "some-source", Type: "ssm"},
+										})
+										fakeBuild.PipelineReturns(fakePipeline, true, nil)
+
+										fakeStep.RunStub = func(ctx context.Context, state exec.RunState) (bool, error) {
+											state.Get(vars.Reference{Path: "deploy-key", Fields: []string{"private_key"}})
+											state.Get(vars.Reference{Path: "deploy-key"})
+											state.Get(vars.Reference{Source: "some-source", Path: "token"})
+											state.Get(vars.Reference{Path: "missing"})
+
+											state.AddLocalVar("loaded", "some-value", true)
+											state.Get(vars.Reference{Source: ".", Path: "loaded"})
+											return true, nil
+										}
+									})
+
+									It("saves each accessed secret once, with its manager", func() {
+										waitGroup.Wait()
+										Expect(savedSecretAccesses(fakeBuild)).To(ConsistOf(
+											db.SecretAccess{Path: "deploy-key", Manager: "vault"},
+											db.SecretAccess{VarSource: "some-source", Path: "token", Manager: "ssm"},
+											db.SecretAccess{VarSource: ".", Path: "loaded", Manager: "load_var"},
+										))
+									})
+
+									Context("when the build is released before it finishes", func() {
+										BeforeEach(func() {
+											readyToRelease := make(chan bool)
+
+											go func() {
+												<-readyToRelease
+												release <- true
+											}()
+
+											fakeStep.RunStub = func(ctx context.Context, state exec.RunState) (bool, error) {
+												state.Get(vars.Reference{Path: "deploy-key"})
+												close(readyToRelease)
+												<-time.After(time.Hour)
+												return true, nil
+											}
+										})
+
+										It("has already saved the accessed secrets", func() {
+											waitGroup.Wait()
+											Expect(fakeBuild.FinishCallCount()).To(Equal(0))
+											Expect(savedSecretAccesses(fakeBuild)).To(ConsistOf(
+												db.SecretAccess{Path: "deploy-key", Manager: "vault"},
+											))
+										})
+									})
+
+									Context("when the credentials come from a chain of managers", func() {
+										BeforeEach(func() {
+											oldSecrets := new(credsfakes.FakeSecrets)
+											oldSecrets.GetStub = func(path string) (interface{}, *time.Time, bool, error) {
+												return "old-value", nil, path == "token", nil
+											}
+
+											newSecrets := new(credsfakes.FakeSecrets)
+											newSecrets.GetReturns("new-value", nil, true, nil)
+
+											globalSecrets = creds.NewChainedSecrets([]creds.ChainedSecretsMember{
+												{Name: "old", Secrets: oldSecrets},
+												{Name: "new", Secrets: newSecrets},
+											}, nil)
+
+											fakeBuild.TeamNameReturns("some-team")
+											fakeBuild.VariablesStub = func(logger lager.Logger, secrets creds.Secrets, pool creds.VarSourcePool) (vars.Variables, error) {
+												return creds.NewVariables(secrets, "some-team", "", false), nil
+											}
+
+											fakeStep.RunStub = func(ctx context.Context, state exec.RunState) (bool, error) {
+												state.Get(vars.Reference{Path: "deploy-key"})
+												state.Get(vars.Reference{Path: "token"})
+												return true, nil
+											}
+										})
+
+										It("saves the member of the chain which served each secret", func() {
+											waitGroup.Wait()
+											Expect(savedSecretAccesses(fakeBuild)).To(ConsistOf(
+												db.SecretAccess{Path: "deploy-key", Manager: "new"},
+												db.SecretAccess{Path: "token", Manager: "old"},
+											))
+										})
+									})
+								})
+
+								Context("when the build requests dynamic secrets", func() {
+									var leaser *credsfakes.FakeLeaser
+
+									BeforeEach(func() {
+										leaser = new(credsfakes.FakeLeaser)
+										leaser.IsDynamicReturns(true)
+										leaser.LeaseReturns(
+											map[string]interface{}{"username": "some-user"},
+											creds.Lease{ID: "some-lease", Duration: time.Hour, Renewable: true},
+											true,
+											nil,
+										)
+										fakeLeaser = leaser
+
+										fakeBuild.VariablesStub = func(logger lager.Logger, secrets creds.Secrets, pool creds.VarSourcePool) (vars.Variables, error) {
+											return creds.NewVariables(secrets, "some-team", "some-pipeline", false), nil
+										}
+
+										fakeSecretLeaseFactory.BuildLeasesReturns([]db.SecretLease{
+											{ID: "some-lease", BuildID: 128},
+										}, nil)
+
+										fakeStep.RunStub = func(ctx context.Context, state exec.RunState) (bool, error) {
+											_, _, err := state.Get(vars.Reference{Path: "database/creds/some-role", Fields: []string{"username"}})
+											return true, err
+										}
+									})
+
+									It("records the lease against the build", func() {
+										waitGroup.Wait()
+										Expect(leaser.LeaseArgsForCall(0)).To(Equal("database/creds/some-role"))
+										Expect(fakeSecretLeaseFactory.CreateLeaseCallCount()).To(Equal(1))
+
+										lease := fakeSecretLeaseFactory.CreateLeaseArgsForCall(0)
+										Expect(lease.ID).To(Equal("some-lease"))
+										Expect(lease.BuildID).To(Equal(128))
+										Expect(lease.Renewable).To(BeTrue())
+									})
+
+									It("revokes the leases of the build once it finishes", func() {
+										waitGroup.Wait()
+										Expect(fakeBuild.FinishCallCount()).To(Equal(1))
+										Expect(fakeSecretLeaseFactory.BuildLeasesArgsForCall(0)).To(Equal(128))
+										Expect(leaser.RevokeCallCount()).To(Equal(1))
+										Expect(leaser.RevokeArgsForCall(0)).To(Equal("some-lease"))
+										Expect(fakeSecretLeaseFactory.DeleteLeaseCallCount()).To(Equal(1))
+										Expect(fakeSecretLeaseFactory.DeleteLeaseArgsForCall(0)).To(Equal("some-lease"))
+									})
+
+									Context("when revoking the lease fails", func() {
+										BeforeEach(func() {
+											leaser.RevokeReturns(errors.New("sealed"))
+										})
+
+										It("keeps the lease to be revoked later", func() {
+											waitGroup.Wait()
+											Expect(fakeSecretLeaseFactory.DeleteLeaseCallCount()).To(Equal(0))
+										})
+									})
+								})
+
+								Context("when the build does not resolve any credentials", func() {
+									It("does not save any secret accesses", func() {
+										waitGroup.Wait()
+										Expect(fakeBuild.SaveSecretAccessesCallCount()).To(Equal(0))
+									})
+								})
+
 								Context("when the build finishes woefully", func() {
 									BeforeEach(func() {
 										fakeStep.RunReturns(false, nil)
@@ -421,3 +604,12 @@ var _ = Describe("Engine", func() {
 		})
 	})
 })
+
+func savedSecretAccesses(build *dbfakes.FakeBuild) []db.SecretAccess {
+	accesses := []db.SecretAccess{}
+	for i := 0; i < build.SaveSecretAccessesCallCount(); i++ {
+		accesses = append(accesses, build.SaveSecretAccessesArgsForCall(i)...)
+	}
+
+	return accesses
+}
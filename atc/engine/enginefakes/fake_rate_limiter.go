@@ -9,10 +9,25 @@ import (
 )
 
 type FakeRateLimiter struct {
-	WaitStub        func(context.Context) error
+	AcquireCheckContainerStub        func(context.Context, int) (func(), error)
+	acquireCheckContainerMutex       sync.RWMutex
+	acquireCheckContainerArgsForCall []struct {
+		arg1 context.Context
+		arg2 int
+	}
+	acquireCheckContainerReturns struct {
+		result1 func()
+		result2 error
+	}
+	acquireCheckContainerReturnsOnCall map[int]struct {
+		result1 func()
+		result2 error
+	}
+	WaitStub        func(context.Context, int) error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct {
 		arg1 context.Context
+		arg2 int
 	}
 	waitReturns struct {
 		result1 error
@@ -24,16 +39,81 @@ type FakeRateLimiter struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeRateLimiter) Wait(arg1 context.Context) error {
+func (fake *FakeRateLimiter) AcquireCheckContainer(arg1 context.Context, arg2 int) (func(), error) {
+	fake.acquireCheckContainerMutex.Lock()
+	ret, specificReturn := fake.acquireCheckContainerReturnsOnCall[len(fake.acquireCheckContainerArgsForCall)]
+	fake.acquireCheckContainerArgsForCall = append(fake.acquireCheckContainerArgsForCall, struct {
+		arg1 context.Context
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("AcquireCheckContainer", []interface{}{arg1, arg2})
+	fake.acquireCheckContainerMutex.Unlock()
+	if fake.AcquireCheckContainerStub != nil {
+		return fake.AcquireCheckContainerStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.acquireCheckContainerReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeRateLimiter) AcquireCheckContainerCallCount() int {
+	fake.acquireCheckContainerMutex.RLock()
+	defer fake.acquireCheckContainerMutex.RUnlock()
+	return len(fake.acquireCheckContainerArgsForCall)
+}
+
+func (fake *FakeRateLimiter) AcquireCheckContainerCalls(stub func(context.Context, int) (func(), error)) {
+	fake.acquireCheckContainerMutex.Lock()
+	defer fake.acquireCheckContainerMutex.Unlock()
+	fake.AcquireCheckContainerStub = stub
+}
+
+func (fake *FakeRateLimiter) AcquireCheckContainerArgsForCall(i int) (context.Context, int) {
+	fake.acquireCheckContainerMutex.RLock()
+	defer fake.acquireCheckContainerMutex.RUnlock()
+	argsForCall := fake.acquireCheckContainerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRateLimiter) AcquireCheckContainerReturns(result1 func(), result2 error) {
+	fake.acquireCheckContainerMutex.Lock()
+	defer fake.acquireCheckContainerMutex.Unlock()
+	fake.AcquireCheckContainerStub = nil
+	fake.acquireCheckContainerReturns = struct {
+		result1 func()
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRateLimiter) AcquireCheckContainerReturnsOnCall(i int, result1 func(), result2 error) {
+	fake.acquireCheckContainerMutex.Lock()
+	defer fake.acquireCheckContainerMutex.Unlock()
+	fake.AcquireCheckContainerStub = nil
+	if fake.acquireCheckContainerReturnsOnCall == nil {
+		fake.acquireCheckContainerReturnsOnCall = make(map[int]struct {
+			result1 func()
+			result2 error
+		})
+	}
+	fake.acquireCheckContainerReturnsOnCall[i] = struct {
+		result1 func()
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeRateLimiter) Wait(arg1 context.Context, arg2 int) error {
 	fake.waitMutex.Lock()
 	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
 	fake.waitArgsForCall = append(fake.waitArgsForCall, struct {
 		arg1 context.Context
-	}{arg1})
-	fake.recordInvocation("Wait", []interface{}{arg1})
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("Wait", []interface{}{arg1, arg2})
 	fake.waitMutex.Unlock()
 	if fake.WaitStub != nil {
-		return fake.WaitStub(arg1)
+		return fake.WaitStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -48,17 +128,17 @@ func (fake *FakeRateLimiter) WaitCallCount() int {
 	return len(fake.waitArgsForCall)
 }
 
-func (fake *FakeRateLimiter) WaitCalls(stub func(context.Context) error) {
+func (fake *FakeRateLimiter) WaitCalls(stub func(context.Context, int) error) {
 	fake.waitMutex.Lock()
 	defer fake.waitMutex.Unlock()
 	fake.WaitStub = stub
 }
 
-func (fake *FakeRateLimiter) WaitArgsForCall(i int) context.Context {
+func (fake *FakeRateLimiter) WaitArgsForCall(i int) (context.Context, int) {
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	argsForCall := fake.waitArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeRateLimiter) WaitReturns(result1 error) {
@@ -87,6 +167,8 @@ func (fake *FakeRateLimiter) WaitReturnsOnCall(i int, result1 error) {
 func (fake *FakeRateLimiter) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.acquireCheckContainerMutex.RLock()
+	defer fake.acquireCheckContainerMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -46,7 +46,7 @@ var _ = Describe("PutDelegate", func() {
 			"source-param": "super-secret-source",
 			"git-key":      "{\n123\n456\n789\n}\n",
 		}
-		state = exec.NewRunState(noopStepper, credVars, true)
+		state = exec.NewRunState(noopStepper, credVars, true, nil)
 
 		info = runtime.VersionResult{
 			Version:  atc.Version{"foo": "bar"},
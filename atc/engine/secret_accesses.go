@@ -0,0 +1,163 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/vars"
+)
+
+// secretAccesses saves each credential resolved by a build as soon as it is
+// first resolved, along with the credential manager which served it, so that
+// the accesses are kept even if the build never finishes on this ATC.
+type secretAccesses struct {
+	build          db.Build
+	secretsManager string
+
+	// chain is set when the cluster-wide credentials come from a chain of
+	// credential managers, in which case the member which served each
+	// credential is recorded in place of the chain.
+	chain *creds.ChainedSecrets
+
+	lock             sync.Mutex
+	saved            map[string]bool
+	served           map[string]bool
+	varSources       atc.VarSourceConfigs
+	varSourcesLoaded bool
+}
+
+func newSecretAccesses(build db.Build, globalSecrets creds.Secrets, secretsManager string) *secretAccesses {
+	chain, _ := globalSecrets.(*creds.ChainedSecrets)
+
+	return &secretAccesses{
+		build:          build,
+		secretsManager: secretsManager,
+		chain:          chain,
+
+		saved:  map[string]bool{},
+		served: map[string]bool{},
+	}
+}
+
+// Secrets returns the cluster-wide secrets of the build, noting which secret
+// paths of the chain served its credentials.
+func (a *secretAccesses) Secrets(secrets creds.Secrets) creds.Secrets {
+	if a.chain == nil {
+		return secrets
+	}
+
+	return servedSecrets{
+		Secrets: secrets,
+		served: func(secretPath string) {
+			a.lock.Lock()
+			a.served[secretPath] = true
+			a.lock.Unlock()
+		},
+	}
+}
+
+// Save records the access of the credential, unless it has already been
+// recorded. Only the secret is recorded, not which of its fields were read.
+func (a *secretAccesses) Save(logger lager.Logger, ref vars.Reference) {
+	key := vars.Reference{Source: ref.Source, Path: ref.Path}.String()
+
+	a.lock.Lock()
+	if a.saved[key] {
+		a.lock.Unlock()
+		return
+	}
+
+	a.saved[key] = true
+
+	access := db.SecretAccess{
+		VarSource: ref.Source,
+		Path:      ref.Path,
+		Manager:   a.manager(logger, ref),
+	}
+	a.lock.Unlock()
+
+	err := a.build.SaveSecretAccesses([]db.SecretAccess{access})
+	if err != nil {
+		logger.Error("failed-to-save-secret-access", err)
+
+		// let the next access of the credential try again
+		a.lock.Lock()
+		delete(a.saved, key)
+		a.lock.Unlock()
+	}
+}
+
+func (a *secretAccesses) manager(logger lager.Logger, ref vars.Reference) string {
+	switch ref.Source {
+	case "":
+		return a.globalManager(ref.Path)
+	case ".":
+		return "load_var"
+	}
+
+	if !a.varSourcesLoaded {
+		pipeline, found, err := a.build.Pipeline()
+		if err != nil {
+			logger.Error("failed-to-find-pipeline", err)
+			return ""
+		}
+
+		if found {
+			a.varSources = pipeline.VarSources()
+		}
+
+		a.varSourcesLoaded = true
+	}
+
+	varSource, found := a.varSources.Lookup(ref.Source)
+	if !found {
+		return ""
+	}
+
+	return varSource.Type
+}
+
+// globalManager finds the member of the chain which served the credential,
+// trying its secret paths in the same order as they were looked up.
+func (a *secretAccesses) globalManager(path string) string {
+	if a.chain == nil {
+		return a.secretsManager
+	}
+
+	for _, lookupPath := range a.chain.NewSecretLookupPaths(a.build.TeamName(), a.build.PipelineName(), false) {
+		secretPath, err := lookupPath.VariableToSecretPath(path)
+		if err != nil {
+			break
+		}
+
+		if !a.served[secretPath] {
+			continue
+		}
+
+		member, found := a.chain.Member(secretPath)
+		if found {
+			return member
+		}
+	}
+
+	return a.secretsManager
+}
+
+type servedSecrets struct {
+	creds.Secrets
+
+	served func(string)
+}
+
+func (secrets servedSecrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	value, expiration, found, err := secrets.Secrets.Get(secretPath)
+	if found {
+		secrets.served(secretPath)
+	}
+
+	return value, expiration, found, err
+}
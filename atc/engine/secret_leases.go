@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// leaseRenewalInterval is how often the leases of a running build are
+// checked. Those which would expire before the check after next are renewed.
+const leaseRenewalInterval = 30 * time.Second
+
+// secretLeases keeps the leases on the dynamic secrets requested by a build
+// for as long as it runs. They are kept in the database, so that another ATC
+// can take them over along with the build.
+type secretLeases struct {
+	build   db.Build
+	leaser  creds.Leaser
+	factory db.SecretLeaseFactory
+}
+
+// Secrets returns the secrets of the build, recording the lease of each
+// dynamic secret it requests.
+func (l secretLeases) Secrets(secrets creds.Secrets) creds.Secrets {
+	return creds.NewLeasedSecrets(secrets, l.leaser, l.build.TeamName(), func(lease creds.Lease) error {
+		return l.factory.CreateLease(db.SecretLease{
+			ID:        lease.ID,
+			BuildID:   l.build.ID(),
+			Renewable: lease.Renewable,
+			ExpiresAt: time.Now().Add(lease.Duration),
+		})
+	})
+}
+
+// Renew renews the leases of the build until the context is done.
+func (l secretLeases) Renew(ctx context.Context, logger lager.Logger) {
+	ticker := time.NewTicker(leaseRenewalInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.renewExpiring(logger)
+		}
+	}
+}
+
+func (l secretLeases) renewExpiring(logger lager.Logger) {
+	leases, err := l.factory.BuildLeases(l.build.ID())
+	if err != nil {
+		logger.Error("failed-to-get-leases", err)
+		return
+	}
+
+	for _, lease := range leases {
+		if !lease.Renewable || time.Until(lease.ExpiresAt) > 2*leaseRenewalInterval {
+			continue
+		}
+
+		renewed, err := l.leaser.Renew(lease.ID)
+		if err != nil {
+			logger.Error("failed-to-renew-lease", err, lager.Data{"lease": lease.ID})
+			continue
+		}
+
+		err = l.factory.RenewLease(lease.ID, time.Now().Add(renewed.Duration))
+		if err != nil {
+			logger.Error("failed-to-save-renewed-lease", err, lager.Data{"lease": lease.ID})
+		}
+	}
+}
+
+// Revoke revokes the leases of the build. Those which fail to be revoked are
+// left to be revoked by the secret lease collector.
+func (l secretLeases) Revoke(logger lager.Logger) {
+	leases, err := l.factory.BuildLeases(l.build.ID())
+	if err != nil {
+		logger.Error("failed-to-get-leases", err)
+		return
+	}
+
+	for _, lease := range leases {
+		err := l.leaser.Revoke(lease.ID)
+		if err != nil {
+			logger.Error("failed-to-revoke-lease", err, lager.Data{"lease": lease.ID})
+			continue
+		}
+
+		err = l.factory.DeleteLease(lease.ID)
+		if err != nil {
+			logger.Error("failed-to-delete-lease", err, lager.Data{"lease": lease.ID})
+		}
+	}
+}
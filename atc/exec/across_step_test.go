@@ -90,7 +90,7 @@ var _ = Describe("AcrossStep", func() {
 		ctx, cancel = context.WithCancel(context.Background())
 		ctx = lagerctx.NewContext(ctx, testLogger)
 
-		state = exec.NewRunState(noopStepper, vars.StaticVariables{}, false)
+		state = exec.NewRunState(noopStepper, vars.StaticVariables{}, false, nil)
 
 		stderr = gbytes.NewBuffer()
 
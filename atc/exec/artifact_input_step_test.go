@@ -32,7 +32,7 @@ var _ = Describe("ArtifactInputStep", func() {
 	BeforeEach(func() {
 		ctx, cancel = context.WithCancel(context.Background())
 
-		state = exec.NewRunState(noopStepper, vars.StaticVariables{}, false)
+		state = exec.NewRunState(noopStepper, vars.StaticVariables{}, false, nil)
 
 		fakeBuild = new(dbfakes.FakeBuild)
 		fakeWorkerPool = new(workerfakes.FakePool)
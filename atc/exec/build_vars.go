@@ -10,22 +10,32 @@ type buildVariables struct {
 	parentScope interface {
 		vars.Variables
 		IterateInterpolatedCreds(iter vars.TrackedVarsIterator)
+		IterateAccessedVars(iter vars.AccessedVarsIterator)
+		TrackAccess(ref vars.Reference)
 	}
 
 	localVars vars.StaticVariables
 	tracker   *vars.Tracker
 
+	// secretLocalVars are the local vars whose reads are recorded as
+	// accessed secrets, i.e. those which would be redacted.
+	secretLocalVars map[string]bool
+
 	lock sync.RWMutex
 }
 
-func newBuildVariables(credVars vars.Variables, enableRedaction bool) *buildVariables {
+func newBuildVariables(credVars vars.Variables, enableRedaction bool, onAccess func(vars.Reference)) *buildVariables {
+	accessTracker := vars.NewTracker(enableRedaction)
+	accessTracker.OnAccess = onAccess
+
 	return &buildVariables{
 		parentScope: &vars.CredVarsTracker{
 			CredVars: credVars,
-			Tracker:  vars.NewTracker(enableRedaction),
+			Tracker:  accessTracker,
 		},
-		localVars: vars.StaticVariables{},
-		tracker:   vars.NewTracker(enableRedaction),
+		localVars:       vars.StaticVariables{},
+		tracker:         vars.NewTracker(enableRedaction),
+		secretLocalVars: map[string]bool{},
 	}
 }
 
@@ -33,7 +43,11 @@ func (b *buildVariables) Get(ref vars.Reference) (interface{}, bool, error) {
 	if ref.Source == "." {
 		b.lock.RLock()
 		val, found, err := b.localVars.Get(ref)
+		secret := b.secretLocalVars[ref.Path]
 		b.lock.RUnlock()
+		if found && secret {
+			b.TrackAccess(ref)
+		}
 		if found || err != nil {
 			return val, found, err
 		}
@@ -59,17 +73,29 @@ func (b *buildVariables) IterateInterpolatedCreds(iter vars.TrackedVarsIterator)
 	b.parentScope.IterateInterpolatedCreds(iter)
 }
 
+// TrackAccess records the access in the outermost scope, so that the
+// accesses made by every step of the build can be iterated from there.
+func (b *buildVariables) TrackAccess(ref vars.Reference) {
+	b.parentScope.TrackAccess(ref)
+}
+
+func (b *buildVariables) IterateAccessedVars(iter vars.AccessedVarsIterator) {
+	b.parentScope.IterateAccessedVars(iter)
+}
+
 func (b *buildVariables) NewLocalScope() *buildVariables {
 	return &buildVariables{
-		parentScope: b,
-		localVars:   vars.StaticVariables{},
-		tracker:     vars.NewTracker(b.tracker.Enabled),
+		parentScope:     b,
+		localVars:       vars.StaticVariables{},
+		tracker:         vars.NewTracker(b.tracker.Enabled),
+		secretLocalVars: map[string]bool{},
 	}
 }
 
 func (b *buildVariables) AddLocalVar(name string, val interface{}, redact bool) {
 	b.lock.Lock()
 	b.localVars[name] = val
+	b.secretLocalVars[name] = redact
 	b.lock.Unlock()
 
 	if redact {
@@ -192,16 +192,40 @@ func (step *CheckStep) run(ctx context.Context, state RunState, delegate CheckDe
 				return false, nil
 			}
 
+			if errors.As(runErr, &atc.InvalidVersionError{}) {
+				// the output is discarded as soon as a version fails to parse, so
+				// only the offending version is known to have been rejected
+				metric.Metrics.VersionsRejected.Inc()
+				delegate.Errored(logger, runErr.Error())
+				return false, nil
+			}
+
 			return false, fmt.Errorf("run check: %w", runErr)
 		}
 
-		metric.Metrics.ChecksFinishedWithSuccess.Inc()
-
-		err = scope.SaveVersions(db.NewSpanContext(ctx), result.Versions)
+		err = scope.SaveVersions(db.NewSpanContext(ctx), result.Versions, versionSchema(step.plan.VersionedResourceTypes, step.plan.Type))
 		if err != nil {
-			return false, fmt.Errorf("save versions: %w", err)
+			if !errors.As(err, &atc.InvalidVersionError{}) {
+				return false, fmt.Errorf("save versions: %w", err)
+			}
+
+			metric.Metrics.ChecksFinishedWithError.Inc()
+			metric.Metrics.VersionsRejected.IncDelta(len(result.Versions))
+
+			if _, err := scope.UpdateLastCheckEndTime(); err != nil {
+				return false, fmt.Errorf("update check end time: %w", err)
+			}
+
+			if err := delegate.PointToCheckedConfig(scope); err != nil {
+				return false, fmt.Errorf("update resource config scope: %w", err)
+			}
+
+			delegate.Errored(logger, err.Error())
+			return false, nil
 		}
 
+		metric.Metrics.ChecksFinishedWithSuccess.Inc()
+
 		if len(result.Versions) > 0 {
 			state.StoreResult(step.planID, result.Versions[len(result.Versions)-1])
 		}
@@ -231,6 +255,17 @@ func (step *CheckStep) run(ctx context.Context, state RunState, delegate CheckDe
 	return true, nil
 }
 
+// versionSchema returns the version schema of the given type, if it is a
+// custom resource type which configures one.
+func versionSchema(resourceTypes atc.VersionedResourceTypes, typeName string) atc.VersionSchema {
+	resourceType, found := resourceTypes.Lookup(typeName)
+	if !found || resourceType.VersionSchema == nil {
+		return atc.VersionSchema{}
+	}
+
+	return *resourceType.VersionSchema
+}
+
 func (step *CheckStep) runCheck(
 	ctx context.Context,
 	logger lager.Logger,
@@ -280,13 +315,23 @@ func (step *CheckStep) runCheck(
 		},
 		TeamID: step.metadata.TeamID,
 		Env:    step.metadata.Env(),
+
+		TeamName:     step.metadata.TeamName,
+		PipelineName: step.metadata.PipelineName,
+		JobName:      step.metadata.JobName,
+		Tags:         step.plan.Tags,
 	}
 	tracing.Inject(ctx, &containerSpec)
 
-	checkable := step.resourceFactory.NewResource(
+	var schema *atc.VersionSchema
+	if found {
+		schema = resourceType.VersionSchema
+	}
+
+	checkable := step.resourceFactory.NewCheckResource(
 		source,
-		nil,
 		fromVersion,
+		schema,
 	)
 
 	processSpec := runtime.ProcessSpec{
@@ -14,6 +14,7 @@ import (
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/resource/resourcefakes"
 	"github.com/concourse/concourse/atc/runtime"
@@ -88,7 +89,7 @@ var _ = Describe("CheckStep", func() {
 		stepMetadata = exec.StepMetadata{}
 		containerMetadata = db.ContainerMetadata{}
 
-		fakeResourceFactory.NewResourceReturns(fakeResource)
+		fakeResourceFactory.NewCheckResourceReturns(fakeResource)
 
 		fakeResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
 		fakeResourceConfig = new(dbfakes.FakeResourceConfig)
@@ -221,8 +222,8 @@ var _ = Describe("CheckStep", func() {
 				})
 
 				It("constructs the resource with the version", func() {
-					Expect(fakeResourceFactory.NewResourceCallCount()).To(Equal(1))
-					_, _, fromVersion := fakeResourceFactory.NewResourceArgsForCall(0)
+					Expect(fakeResourceFactory.NewCheckResourceCallCount()).To(Equal(1))
+					_, fromVersion, _ := fakeResourceFactory.NewCheckResourceArgsForCall(0)
 					Expect(fromVersion).To(Equal(checkPlan.FromVersion))
 				})
 			})
@@ -246,8 +247,8 @@ var _ = Describe("CheckStep", func() {
 				})
 
 				It("finds the latest version itself - it's a strong, independent check step who dont need no plan", func() {
-					Expect(fakeResourceFactory.NewResourceCallCount()).To(Equal(1))
-					_, _, fromVersion := fakeResourceFactory.NewResourceArgsForCall(0)
+					Expect(fakeResourceFactory.NewCheckResourceCallCount()).To(Equal(1))
+					_, fromVersion, _ := fakeResourceFactory.NewCheckResourceArgsForCall(0)
 					Expect(fromVersion).To(Equal(atc.Version{"latest": "version"}))
 				})
 			})
@@ -568,7 +569,7 @@ var _ = Describe("CheckStep", func() {
 
 				It("propagates span context to scope", func() {
 					Expect(fakeResourceConfigScope.SaveVersionsCallCount()).To(Equal(1))
-					spanContext, _ := fakeResourceConfigScope.SaveVersionsArgsForCall(0)
+					spanContext, _, _ := fakeResourceConfigScope.SaveVersionsArgsForCall(0)
 					traceID := buildSpan.SpanContext().TraceID.String()
 					traceParent := spanContext.Get("traceparent")
 					Expect(traceParent).To(ContainSubstring(traceID))
@@ -622,12 +623,44 @@ var _ = Describe("CheckStep", func() {
 					config := fakeDelegate.FindOrCreateScopeArgsForCall(0)
 					Expect(config).To(Equal(fakeResourceConfig))
 
-					spanContext, versions := fakeResourceConfigScope.SaveVersionsArgsForCall(0)
+					spanContext, versions, schema := fakeResourceConfigScope.SaveVersionsArgsForCall(0)
 					Expect(spanContext).To(Equal(db.SpanContext{}))
 					Expect(versions).To(Equal([]atc.Version{
 						{"version": "1"},
 						{"version": "2"},
 					}))
+					Expect(schema).To(Equal(atc.VersionSchema{}))
+				})
+
+				It("constructs the resource without a version schema", func() {
+					Expect(fakeResourceFactory.NewCheckResourceCallCount()).To(Equal(1))
+					_, _, schema := fakeResourceFactory.NewCheckResourceArgsForCall(0)
+					Expect(schema).To(BeNil())
+				})
+
+				Context("when checking a custom resource type with a version schema", func() {
+					BeforeEach(func() {
+						checkPlan.Type = "some-custom-type"
+						checkPlan.VersionedResourceTypes[0].VersionSchema = &atc.VersionSchema{
+							RequiredKeys: []string{"version"},
+						}
+					})
+
+					It("saves the versions with the resource type's schema", func() {
+						Expect(fakeResourceConfigScope.SaveVersionsCallCount()).To(Equal(1))
+						_, _, schema := fakeResourceConfigScope.SaveVersionsArgsForCall(0)
+						Expect(schema).To(Equal(atc.VersionSchema{
+							RequiredKeys: []string{"version"},
+						}))
+					})
+
+					It("constructs the resource with the resource type's schema", func() {
+						Expect(fakeResourceFactory.NewCheckResourceCallCount()).To(Equal(1))
+						_, _, schema := fakeResourceFactory.NewCheckResourceArgsForCall(0)
+						Expect(schema).To(Equal(&atc.VersionSchema{
+							RequiredKeys: []string{"version"},
+						}))
+					})
 				})
 
 				It("stores the latest version as the step result", func() {
@@ -674,7 +707,7 @@ var _ = Describe("CheckStep", func() {
 
 				Context("after saving", func() {
 					BeforeEach(func() {
-						fakeResourceConfigScope.SaveVersionsStub = func(db.SpanContext, []atc.Version) error {
+						fakeResourceConfigScope.SaveVersionsStub = func(db.SpanContext, []atc.Version, atc.VersionSchema) error {
 							Expect(fakeDelegate.PointToCheckedConfigCallCount()).To(BeZero())
 							Expect(fakeResourceConfigScope.UpdateLastCheckEndTimeCallCount()).To(Equal(0))
 							return nil
@@ -763,6 +796,29 @@ var _ = Describe("CheckStep", func() {
 						Expect(succeeded).To(BeFalse())
 					})
 				})
+
+				Context("with an invalid version", func() {
+					BeforeEach(func() {
+						fakeClient.RunCheckStepReturns(worker.CheckResult{}, fmt.Errorf("check: %w", atc.InvalidVersionError{
+							Reason: "value of key 'ref' in version 0 is not a string: 123",
+						}))
+					})
+
+					It("does not error", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(stepOk).To(BeFalse())
+					})
+
+					It("updates the scope's last check end time", func() {
+						Expect(fakeResourceConfigScope.UpdateLastCheckEndTimeCallCount()).To(Equal(1))
+					})
+
+					It("emits an Errored event explaining why the version was rejected", func() {
+						Expect(fakeDelegate.ErroredCallCount()).To(Equal(1))
+						_, message := fakeDelegate.ErroredArgsForCall(0)
+						Expect(message).To(ContainSubstring("invalid version: value of key 'ref' in version 0 is not a string: 123"))
+					})
+				})
 			})
 
 			Context("having SaveVersions failing", func() {
@@ -778,6 +834,51 @@ var _ = Describe("CheckStep", func() {
 					Expect(stepErr).To(HaveOccurred())
 					Expect(errors.Is(stepErr, expectedErr)).To(BeTrue())
 				})
+
+				Context("because a version does not satisfy the schema", func() {
+					BeforeEach(func() {
+						fakeClient.RunCheckStepReturns(worker.CheckResult{
+							Versions: []atc.Version{
+								{"version": "1"},
+								{"version": "2"},
+							},
+						}, nil)
+
+						fakeResourceConfigScope.SaveVersionsReturns(atc.InvalidVersionError{
+							Version: atc.Version{"version": "1"},
+							Reason:  "missing required keys: ref",
+						})
+
+						metric.Metrics.VersionsRejected.Delta()
+					})
+
+					It("does not error", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(stepOk).To(BeFalse())
+					})
+
+					It("updates the scope's last check end time", func() {
+						Expect(fakeResourceConfigScope.UpdateLastCheckEndTimeCallCount()).To(Equal(1))
+					})
+
+					It("points the resource or resource type to the scope", func() {
+						Expect(fakeDelegate.PointToCheckedConfigCallCount()).To(Equal(1))
+					})
+
+					It("emits an Errored event explaining why the version was rejected", func() {
+						Expect(fakeDelegate.ErroredCallCount()).To(Equal(1))
+						_, message := fakeDelegate.ErroredArgsForCall(0)
+						Expect(message).To(Equal(`invalid version {"version":"1"}: missing required keys: ref`))
+					})
+
+					It("does not emit a Finished event", func() {
+						Expect(fakeDelegate.FinishedCallCount()).To(Equal(0))
+					})
+
+					It("counts every version emitted by the check as rejected", func() {
+						Expect(metric.Metrics.VersionsRejected.Delta()).To(Equal(float64(2)))
+					})
+				})
 			})
 		})
 	})
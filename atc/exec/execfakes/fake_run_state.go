@@ -44,6 +44,11 @@ type FakeRunState struct {
 		result2 bool
 		result3 error
 	}
+	IterateAccessedVarsStub        func(vars.AccessedVarsIterator)
+	iterateAccessedVarsMutex       sync.RWMutex
+	iterateAccessedVarsArgsForCall []struct {
+		arg1 vars.AccessedVarsIterator
+	}
 	IterateInterpolatedCredsStub        func(vars.TrackedVarsIterator)
 	iterateInterpolatedCredsMutex       sync.RWMutex
 	iterateInterpolatedCredsArgsForCall []struct {
@@ -278,6 +283,37 @@ func (fake *FakeRunState) GetReturnsOnCall(i int, result1 interface{}, result2 b
 	}{result1, result2, result3}
 }
 
+func (fake *FakeRunState) IterateAccessedVars(arg1 vars.AccessedVarsIterator) {
+	fake.iterateAccessedVarsMutex.Lock()
+	fake.iterateAccessedVarsArgsForCall = append(fake.iterateAccessedVarsArgsForCall, struct {
+		arg1 vars.AccessedVarsIterator
+	}{arg1})
+	fake.recordInvocation("IterateAccessedVars", []interface{}{arg1})
+	fake.iterateAccessedVarsMutex.Unlock()
+	if fake.IterateAccessedVarsStub != nil {
+		fake.IterateAccessedVarsStub(arg1)
+	}
+}
+
+func (fake *FakeRunState) IterateAccessedVarsCallCount() int {
+	fake.iterateAccessedVarsMutex.RLock()
+	defer fake.iterateAccessedVarsMutex.RUnlock()
+	return len(fake.iterateAccessedVarsArgsForCall)
+}
+
+func (fake *FakeRunState) IterateAccessedVarsCalls(stub func(vars.AccessedVarsIterator)) {
+	fake.iterateAccessedVarsMutex.Lock()
+	defer fake.iterateAccessedVarsMutex.Unlock()
+	fake.IterateAccessedVarsStub = stub
+}
+
+func (fake *FakeRunState) IterateAccessedVarsArgsForCall(i int) vars.AccessedVarsIterator {
+	fake.iterateAccessedVarsMutex.RLock()
+	defer fake.iterateAccessedVarsMutex.RUnlock()
+	argsForCall := fake.iterateAccessedVarsArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeRunState) IterateInterpolatedCreds(arg1 vars.TrackedVarsIterator) {
 	fake.iterateInterpolatedCredsMutex.Lock()
 	fake.iterateInterpolatedCredsArgsForCall = append(fake.iterateInterpolatedCredsArgsForCall, struct {
@@ -686,6 +722,8 @@ func (fake *FakeRunState) Invocations() map[string][][]interface{} {
 	defer fake.artifactRepositoryMutex.RUnlock()
 	fake.getMutex.RLock()
 	defer fake.getMutex.RUnlock()
+	fake.iterateAccessedVarsMutex.RLock()
+	defer fake.iterateAccessedVarsMutex.RUnlock()
 	fake.iterateInterpolatedCredsMutex.RLock()
 	defer fake.iterateInterpolatedCredsMutex.RUnlock()
 	fake.listMutex.RLock()
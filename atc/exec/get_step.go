@@ -133,6 +133,7 @@ func (step *GetStep) run(ctx context.Context, state RunState, delegate GetDelega
 		Tags:         step.plan.Tags,
 		TeamID:       step.metadata.TeamID,
 		ResourceType: step.plan.VersionedResourceTypes.Base(step.plan.Type),
+		Selector:     step.plan.WorkerSelector,
 	}
 
 	var imageSpec worker.ImageSpec
@@ -175,6 +176,13 @@ func (step *GetStep) run(ctx context.Context, state RunState, delegate GetDelega
 		ImageSpec: imageSpec,
 		TeamID:    step.metadata.TeamID,
 		Env:       step.metadata.Env(),
+
+		TeamName:     step.metadata.TeamName,
+		PipelineName: step.metadata.PipelineName,
+		JobName:      step.metadata.JobName,
+		Tags:         step.plan.Tags,
+
+		PreferredLabels: step.plan.WorkerSelector.PreferredExpressions(),
 	}
 	tracing.Inject(ctx, &containerSpec)
 
@@ -226,7 +234,7 @@ func (step *GetStep) run(ctx context.Context, state RunState, delegate GetDelega
 	delegate.SelectedWorker(logger, worker.Name())
 
 	getResult, err := worker.RunGetStep(
-		lagerctx.NewContext(processCtx, logger),
+		lagerctx.NewContext(ReportStreams(processCtx, delegate.Stderr()), logger),
 		containerOwner,
 		containerSpec,
 		step.containerMetadata,
@@ -254,7 +262,12 @@ func (step *GetStep) run(ctx context.Context, state RunState, delegate GetDelega
 		)
 
 		if step.plan.Resource != "" {
-			delegate.UpdateVersion(logger, step.plan, getResult.VersionResult)
+			err := versionSchema(step.plan.VersionedResourceTypes, step.plan.Type).ValidateMetadata(getResult.VersionResult.Metadata)
+			if err != nil {
+				fmt.Fprintf(delegate.Stderr(), "\x1b[1;33mWARNING: not saving the metadata of the fetched version: %s\x1b[0m\n", err)
+			} else {
+				delegate.UpdateVersion(logger, step.plan, getResult.VersionResult)
+			}
 		}
 
 		succeeded = true
@@ -189,7 +189,7 @@ var _ = Describe("GetStep", func() {
 	})
 
 	It("propagates span context to the worker client", func() {
-		Expect(runCtx).To(Equal(rewrapLogger(spanCtx)))
+		Expect(runCtx).To(Equal(rewrapLogger(exec.ReportStreams(spanCtx, stderrBuf))))
 	})
 
 	It("constructs the resource cache correctly", func() {
@@ -238,7 +238,7 @@ var _ = Describe("GetStep", func() {
 		})
 
 		It("propagates span context to the worker client", func() {
-			Expect(runCtx).To(Equal(rewrapLogger(spanCtx)))
+			Expect(runCtx).To(Equal(rewrapLogger(exec.ReportStreams(spanCtx, stderrBuf))))
 		})
 
 		It("populates the TRACEPARENT env var", func() {
@@ -262,6 +262,9 @@ var _ = Describe("GetStep", func() {
 				},
 				TeamID: stepMetadata.TeamID,
 				Env:    stepMetadata.Env(),
+
+				TeamName:     "some-team",
+				PipelineName: "some-pipeline",
 			},
 		))
 	})
@@ -567,6 +570,27 @@ var _ = Describe("GetStep", func() {
 				Expect(actualVersionResult.Version).To(Equal(atc.Version{"some": "version"}))
 				Expect(actualVersionResult.Metadata).To(Equal([]atc.MetadataField{{Name: "some", Value: "metadata"}}))
 			})
+
+			Context("when the metadata exceeds the resource type's maximum metadata size", func() {
+				BeforeEach(func() {
+					getPlan.Type = "some-custom-type"
+					getPlan.VersionedResourceTypes[0].VersionSchema = &atc.VersionSchema{
+						MaxMetadataSize: 10,
+					}
+				})
+
+				It("does not save the version's metadata", func() {
+					Expect(fakeDelegate.UpdateVersionCallCount()).To(Equal(0))
+				})
+
+				It("warns that the metadata was not saved", func() {
+					Expect(stderrBuf).To(gbytes.Say("WARNING: not saving the metadata of the fetched version: metadata size of 36 bytes exceeds the maximum of 10 bytes"))
+				})
+
+				It("still succeeds", func() {
+					Expect(stepOk).To(BeTrue())
+				})
+			})
 		})
 
 		Context("when getting an anonymous resource", func() {
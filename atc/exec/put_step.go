@@ -3,6 +3,7 @@ package exec
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 
 	"code.cloudfoundry.org/lager"
@@ -158,6 +159,7 @@ func (step *PutStep) run(ctx context.Context, state RunState, delegate PutDelega
 		Tags:         step.plan.Tags,
 		TeamID:       step.metadata.TeamID,
 		ResourceType: step.plan.VersionedResourceTypes.Base(step.plan.Type),
+		Selector:     step.plan.WorkerSelector,
 	}
 
 	var imageSpec worker.ImageSpec
@@ -194,6 +196,13 @@ func (step *PutStep) run(ctx context.Context, state RunState, delegate PutDelega
 
 		Env: step.metadata.Env(),
 
+		TeamName:     step.metadata.TeamName,
+		PipelineName: step.metadata.PipelineName,
+		JobName:      step.metadata.JobName,
+		Tags:         step.plan.Tags,
+
+		PreferredLabels: step.plan.WorkerSelector.PreferredExpressions(),
+
 		Inputs: containerInputs,
 	}
 	tracing.Inject(ctx, &containerSpec)
@@ -233,7 +242,7 @@ func (step *PutStep) run(ctx context.Context, state RunState, delegate PutDelega
 	delegate.SelectedWorker(logger, worker.Name())
 
 	result, err := worker.RunPutStep(
-		lagerctx.NewContext(processCtx, logger),
+		lagerctx.NewContext(ReportStreams(processCtx, delegate.Stderr()), logger),
 		owner,
 		containerSpec,
 		step.containerMetadata,
@@ -259,7 +268,15 @@ func (step *PutStep) run(ctx context.Context, state RunState, delegate PutDelega
 	// step.plan.Resource maps to an actual resource that may have been used outside of a pipeline context.
 	// Hence, if it was used outside the pipeline context, we don't want to save the output.
 	if step.plan.Resource != "" {
-		delegate.SaveOutput(logger, step.plan, source, resourceTypes, versionResult)
+		outputResult := versionResult
+
+		err := versionSchema(step.plan.VersionedResourceTypes, step.plan.Type).ValidateMetadata(versionResult.Metadata)
+		if err != nil {
+			fmt.Fprintf(delegate.Stderr(), "\x1b[1;33mWARNING: not saving the metadata of the created version: %s\x1b[0m\n", err)
+			outputResult.Metadata = nil
+		}
+
+		delegate.SaveOutput(logger, step.plan, source, resourceTypes, outputResult)
 	}
 
 	state.StoreResult(step.planID, versionResult)
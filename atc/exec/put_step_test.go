@@ -387,7 +387,7 @@ var _ = Describe("PutStep", func() {
 	})
 
 	It("calls workerClient -> RunPutStep with the appropriate arguments", func() {
-		Expect(runCtx).To(Equal(rewrapLogger(spanCtx)))
+		Expect(runCtx).To(Equal(rewrapLogger(exec.ReportStreams(spanCtx, stderrBuf))))
 		Expect(owner).To(Equal(db.NewBuildStepContainerOwner(42, atc.PlanID(planID), 123)))
 		Expect(containerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 			ResourceType: "some-resource-type",
@@ -583,7 +583,7 @@ var _ = Describe("PutStep", func() {
 		})
 
 		It("propagates span context to the worker client", func() {
-			Expect(runCtx).To(Equal(rewrapLogger(spanCtx)))
+			Expect(runCtx).To(Equal(rewrapLogger(exec.ReportStreams(spanCtx, stderrBuf))))
 		})
 
 		It("populates the TRACEPARENT env var", func() {
@@ -628,6 +628,40 @@ var _ = Describe("PutStep", func() {
 		Expect(info.Metadata).To(Equal([]atc.MetadataField{{Name: "some", Value: "metadata"}}))
 	})
 
+	Context("when the metadata exceeds the resource type's maximum metadata size", func() {
+		BeforeEach(func() {
+			putPlan.Type = "some-custom-type"
+			putPlan.VersionedResourceTypes = atc.VersionedResourceTypes{
+				{
+					ResourceType: atc.ResourceType{
+						Name: "some-custom-type",
+						Type: "registry-image",
+						VersionSchema: &atc.VersionSchema{
+							MaxMetadataSize: 10,
+						},
+					},
+				},
+			}
+		})
+
+		It("saves the build output without its metadata", func() {
+			Expect(fakeDelegate.SaveOutputCallCount()).To(Equal(1))
+
+			_, _, _, _, info := fakeDelegate.SaveOutputArgsForCall(0)
+			Expect(info.Version).To(Equal(atc.Version{"some": "version"}))
+			Expect(info.Metadata).To(BeNil())
+		})
+
+		It("warns that the metadata was not saved", func() {
+			Expect(stderrBuf).To(gbytes.Say("WARNING: not saving the metadata of the created version: metadata size of 36 bytes exceeds the maximum of 10 bytes"))
+		})
+
+		It("still reports the metadata when finishing", func() {
+			_, _, info := fakeDelegate.FinishedArgsForCall(0)
+			Expect(info.Metadata).To(Equal([]atc.MetadataField{{Name: "some", Value: "metadata"}}))
+		})
+	})
+
 	Context("when the step.Plan.Resource is blank", func() {
 		BeforeEach(func() {
 			putPlan.Resource = ""
@@ -0,0 +1,30 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/worker"
+)
+
+// ReportStreams returns a context which prints a line to the given writer for
+// every volume streamed through the ATC on behalf of the step, so that slow or
+// flaky transfers show up in the build log. It only does so when volume
+// stream logs are enabled.
+func ReportStreams(ctx context.Context, writer io.Writer) context.Context {
+	if !atc.EnableVolumeStreamLogs {
+		return ctx
+	}
+
+	return worker.WithStreamReporter(ctx, streamLogger{writer})
+}
+
+type streamLogger struct {
+	writer io.Writer
+}
+
+func (logger streamLogger) ReportStream(stats worker.StreamStats) {
+	fmt.Fprintln(logger.writer, stats)
+}
@@ -0,0 +1,76 @@
+package exec_test
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/runtime/runtimefakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReportStreams", func() {
+	var (
+		stderr *gbytes.Buffer
+		source worker.StreamableArtifactSource
+
+		destination *workerfakes.FakeArtifactDestination
+	)
+
+	BeforeEach(func() {
+		stderr = gbytes.NewBuffer()
+
+		volume := new(workerfakes.FakeVolume)
+		volume.WorkerNameReturns("some-worker")
+		volume.StreamOutReturns(ioutil.NopCloser(strings.NewReader("some-bits")), nil)
+
+		destination = new(workerfakes.FakeArtifactDestination)
+		destination.StreamInStub = func(_ context.Context, _ string, _ baggageclaim.Encoding, in io.Reader) error {
+			_, err := io.Copy(ioutil.Discard, in)
+			return err
+		}
+
+		source = worker.NewStreamableArtifactSource(
+			new(runtimefakes.FakeArtifact),
+			volume,
+			compression.NewGzipCompression(),
+			false,
+			0,
+			worker.StreamChunking{},
+		)
+	})
+
+	It("prints nothing", func() {
+		err := source.StreamTo(exec.ReportStreams(context.Background(), stderr), destination)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(stderr.Contents()).To(BeEmpty())
+	})
+
+	Context("when volume stream logs are enabled", func() {
+		BeforeEach(func() {
+			atc.EnableVolumeStreamLogs = true
+		})
+
+		AfterEach(func() {
+			atc.EnableVolumeStreamLogs = false
+		})
+
+		It("prints the volumes streamed with the context", func() {
+			err := source.StreamTo(exec.ReportStreams(context.Background(), stderr), destination)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(stderr).To(gbytes.Say(`streamed 9B from some-worker in`))
+		})
+	})
+})
@@ -23,15 +23,18 @@ type runState struct {
 
 type Stepper func(atc.Plan) Step
 
+// NewRunState creates the state of a build. onAccess, if given, is called
+// with each secret the first time the build accesses it.
 func NewRunState(
 	stepper Stepper,
 	credVars vars.Variables,
 	enableRedaction bool,
+	onAccess func(vars.Reference),
 ) RunState {
 	return &runState{
 		stepper: stepper,
 
-		vars: newBuildVariables(credVars, enableRedaction),
+		vars: newBuildVariables(credVars, enableRedaction, onAccess),
 
 		artifacts: build.NewRepository(),
 		results:   &sync.Map{},
@@ -72,6 +75,10 @@ func (state *runState) IterateInterpolatedCreds(iter vars.TrackedVarsIterator) {
 	state.vars.IterateInterpolatedCreds(iter)
 }
 
+func (state *runState) IterateAccessedVars(iter vars.AccessedVarsIterator) {
+	state.vars.IterateAccessedVars(iter)
+}
+
 func (state *runState) NewLocalScope() RunState {
 	clone := *state
 	clone.vars = state.vars.NewLocalScope()
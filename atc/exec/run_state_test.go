@@ -32,7 +32,7 @@ var _ = Describe("RunState", func() {
 
 		credVars = vars.StaticVariables{"k1": "v1", "k2": "v2", "k3": "v3"}
 
-		state = exec.NewRunState(stepper, credVars, false)
+		state = exec.NewRunState(stepper, credVars, false, nil)
 	})
 
 	Describe("Run", func() {
@@ -166,7 +166,7 @@ var _ = Describe("RunState", func() {
 
 	Describe("Get", func() {
 		BeforeEach(func() {
-			state = exec.NewRunState(stepper, credVars, false)
+			state = exec.NewRunState(stepper, credVars, false, nil)
 		})
 
 		It("fetches from cred vars", func() {
@@ -186,7 +186,7 @@ var _ = Describe("RunState", func() {
 
 		Context("when redaction is enabled", func() {
 			BeforeEach(func() {
-				state = exec.NewRunState(stepper, credVars, true)
+				state = exec.NewRunState(stepper, credVars, true, nil)
 			})
 
 			It("fetched variables are tracked", func() {
@@ -203,7 +203,7 @@ var _ = Describe("RunState", func() {
 
 		Context("when redaction is not enabled", func() {
 			BeforeEach(func() {
-				state = exec.NewRunState(stepper, credVars, false)
+				state = exec.NewRunState(stepper, credVars, false, nil)
 			})
 
 			It("fetched variables are not tracked", func() {
@@ -249,7 +249,7 @@ var _ = Describe("RunState", func() {
 	Describe("AddLocalVar", func() {
 		Describe("redact", func() {
 			BeforeEach(func() {
-				state = exec.NewRunState(stepper, credVars, true)
+				state = exec.NewRunState(stepper, credVars, true, nil)
 				state.AddLocalVar("foo", "bar", true)
 			})
 
@@ -288,6 +288,49 @@ var _ = Describe("RunState", func() {
 		})
 	})
 
+	Describe("IterateAccessedVars", func() {
+		It("includes the cred vars fetched even when redaction is not enabled", func() {
+			state.Get(vars.Reference{Path: "k1"})
+			state.Get(vars.Reference{Path: "k1"})
+			state.Get(vars.Reference{Path: "missing"})
+
+			var accessed vars.AccessedVarsList
+			state.IterateAccessedVars(&accessed)
+			Expect(accessed).To(ConsistOf(vars.Reference{Path: "k1"}))
+		})
+
+		It("includes the redacted local vars fetched in any scope", func() {
+			state.AddLocalVar("secret", "s3cr3t", true)
+			state.AddLocalVar("revealed", "public", false)
+
+			scope := state.NewLocalScope()
+			scope.Get(vars.Reference{Source: ".", Path: "secret"})
+			scope.Get(vars.Reference{Source: ".", Path: "revealed"})
+
+			var accessed vars.AccessedVarsList
+			state.IterateAccessedVars(&accessed)
+			Expect(accessed).To(ConsistOf(vars.Reference{Source: ".", Path: "secret"}))
+		})
+
+		It("passes each var to the access hook the first time it is fetched", func() {
+			var hooked []vars.Reference
+			state = exec.NewRunState(stepper, credVars, false, func(ref vars.Reference) {
+				hooked = append(hooked, ref)
+			})
+
+			state.AddLocalVar("secret", "s3cr3t", true)
+
+			state.Get(vars.Reference{Path: "k1"})
+			state.Get(vars.Reference{Path: "k1"})
+			state.NewLocalScope().Get(vars.Reference{Source: ".", Path: "secret"})
+
+			Expect(hooked).To(Equal([]vars.Reference{
+				{Path: "k1"},
+				{Source: ".", Path: "secret"},
+			}))
+		})
+	})
+
 	Describe("NewLocalScope", func() {
 		It("maintains a reference to the parent", func() {
 			Expect(state.NewLocalScope().Parent()).To(Equal(state))
@@ -357,7 +400,7 @@ var _ = Describe("RunState", func() {
 
 		Describe("TrackedVarsMap", func() {
 			BeforeEach(func() {
-				state = exec.NewRunState(stepper, credVars, true)
+				state = exec.NewRunState(stepper, credVars, true, nil)
 			})
 
 			It("prefers the value set in the current scope over the parent scope", func() {
@@ -42,6 +42,7 @@ type RunState interface {
 	AddLocalVar(name string, val interface{}, redact bool)
 
 	IterateInterpolatedCreds(vars.TrackedVarsIterator)
+	IterateAccessedVars(vars.AccessedVarsIterator)
 	RedactionEnabled() bool
 
 	ArtifactRepository() *build.Repository
@@ -274,7 +274,7 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 	delegate.SelectedWorker(logger, chosenWorker.Name())
 
 	result, runErr := chosenWorker.RunTaskStep(
-		lagerctx.NewContext(processCtx, logger),
+		lagerctx.NewContext(ReportStreams(processCtx, delegate.Stderr()), logger),
 		owner,
 		containerSpec,
 		step.containerMetadata,
@@ -414,6 +414,15 @@ func (step *TaskStep) containerSpec(logger lager.Logger, state RunState, imageSp
 		Env:       config.Params.Env(),
 		Type:      metadata.Type,
 
+		TeamName:     step.metadata.TeamName,
+		PipelineName: step.metadata.PipelineName,
+		JobName:      step.metadata.JobName,
+		Tags:         step.plan.Tags,
+
+		PreferredLabels: step.plan.WorkerSelector.PreferredExpressions(),
+
+		RuntimeClass: step.runtimeClass(config),
+
 		Outputs: worker.OutputPaths{},
 	}
 
@@ -436,9 +445,22 @@ func (step *TaskStep) workerSpec(config atc.TaskConfig) worker.WorkerSpec {
 		Platform: config.Platform,
 		Tags:     step.plan.Tags,
 		TeamID:   step.metadata.TeamID,
+		Selector: step.plan.WorkerSelector,
+
+		RuntimeClass: step.runtimeClass(config),
 	}
 }
 
+// runtimeClass returns the runtime class set on the step, falling back to the
+// one in the task's config.
+func (step *TaskStep) runtimeClass(config atc.TaskConfig) string {
+	if step.plan.RuntimeClass != "" {
+		return step.plan.RuntimeClass
+	}
+
+	return config.RuntimeClass
+}
+
 func (step *TaskStep) registerOutputs(logger lager.Logger, repository *build.Repository, config atc.TaskConfig, volumeMounts []worker.VolumeMount, metadata db.ContainerMetadata) {
 	logger.Debug("registering-outputs", lager.Data{"outputs": config.Outputs})
 
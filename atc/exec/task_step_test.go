@@ -226,6 +226,35 @@ var _ = Describe("TaskStep", func() {
 				It("creates a worker spec with the tags", func() {
 					Expect(workerSpec.Tags).To(Equal([]string{"plan", "tags"}))
 				})
+
+				It("creates a containerSpec with the tags", func() {
+					Expect(containerSpec.Tags).To(Equal([]string{"plan", "tags"}))
+				})
+			})
+
+			Context("when a runtime class is configured", func() {
+				BeforeEach(func() {
+					taskPlan.Config.RuntimeClass = "kata"
+				})
+
+				It("creates a worker spec with the runtime class", func() {
+					Expect(workerSpec.RuntimeClass).To(Equal("kata"))
+				})
+
+				It("creates a containerSpec with the runtime class", func() {
+					Expect(containerSpec.RuntimeClass).To(Equal("kata"))
+				})
+
+				Context("when the plan also specifies a runtime class", func() {
+					BeforeEach(func() {
+						taskPlan.RuntimeClass = "gvisor"
+					})
+
+					It("uses the plan's runtime class", func() {
+						Expect(workerSpec.RuntimeClass).To(Equal("gvisor"))
+						Expect(containerSpec.RuntimeClass).To(Equal("gvisor"))
+					})
+				})
 			})
 
 			Context("when selecting a worker fails", func() {
@@ -351,7 +380,7 @@ var _ = Describe("TaskStep", func() {
 			})
 
 			It("propagates span context to the worker client", func() {
-				Expect(runCtx).To(Equal(rewrapLogger(spanCtx)))
+				Expect(runCtx).To(Equal(rewrapLogger(exec.ReportStreams(spanCtx, stderrBuf))))
 			})
 
 			It("populates the TRACEPARENT env var", func() {
@@ -0,0 +1,56 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type secretLeaseCollector struct {
+	factory db.SecretLeaseFactory
+	leaser  creds.Leaser
+}
+
+// NewSecretLeaseCollector revokes the leases on dynamic secrets which were
+// left behind by builds which are done, e.g. because the ATC running the
+// build went away before revoking them.
+func NewSecretLeaseCollector(factory db.SecretLeaseFactory, leaser creds.Leaser) *secretLeaseCollector {
+	return &secretLeaseCollector{
+		factory: factory,
+		leaser:  leaser,
+	}
+}
+
+func (c *secretLeaseCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("secret-lease-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	leases, err := c.factory.OrphanedLeases()
+	if err != nil {
+		logger.Error("failed-to-get-orphaned-leases", err)
+		return err
+	}
+
+	for _, lease := range leases {
+		err := c.leaser.Revoke(lease.ID)
+		if err != nil {
+			logger.Error("failed-to-revoke-lease", err, lager.Data{"lease": lease.ID, "build": lease.BuildID})
+			continue
+		}
+
+		err = c.factory.DeleteLease(lease.ID)
+		if err != nil {
+			logger.Error("failed-to-delete-lease", err, lager.Data{"lease": lease.ID})
+			return err
+		}
+
+		logger.Info("revoked-orphaned-lease", lager.Data{"lease": lease.ID, "build": lease.BuildID})
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SecretLeaseCollector", func() {
+	var collector GcCollector
+	var fakeFactory *dbfakes.FakeSecretLeaseFactory
+	var fakeLeaser *credsfakes.FakeLeaser
+
+	BeforeEach(func() {
+		fakeFactory = new(dbfakes.FakeSecretLeaseFactory)
+		fakeFactory.OrphanedLeasesReturns([]db.SecretLease{
+			{ID: "some-lease", BuildID: 1},
+			{ID: "other-lease", BuildID: 2},
+		}, nil)
+
+		fakeLeaser = new(credsfakes.FakeLeaser)
+
+		collector = gc.NewSecretLeaseCollector(fakeFactory, fakeLeaser)
+	})
+
+	Describe("Run", func() {
+		It("revokes and removes the orphaned leases", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeLeaser.RevokeCallCount()).To(Equal(2))
+			Expect(fakeLeaser.RevokeArgsForCall(0)).To(Equal("some-lease"))
+			Expect(fakeLeaser.RevokeArgsForCall(1)).To(Equal("other-lease"))
+
+			Expect(fakeFactory.DeleteLeaseCallCount()).To(Equal(2))
+			Expect(fakeFactory.DeleteLeaseArgsForCall(0)).To(Equal("some-lease"))
+			Expect(fakeFactory.DeleteLeaseArgsForCall(1)).To(Equal("other-lease"))
+		})
+
+		Context("when revoking a lease fails", func() {
+			BeforeEach(func() {
+				fakeLeaser.RevokeReturnsOnCall(0, errors.New("sealed"))
+			})
+
+			It("keeps it to be revoked the next time around", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeFactory.DeleteLeaseCallCount()).To(Equal(1))
+				Expect(fakeFactory.DeleteLeaseArgsForCall(0)).To(Equal("other-lease"))
+			})
+		})
+
+		Context("when getting the orphaned leases fails", func() {
+			BeforeEach(func() {
+				fakeFactory.OrphanedLeasesReturns(nil, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("nope"))
+			})
+		})
+	})
+})
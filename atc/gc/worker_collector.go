@@ -63,6 +63,16 @@ func (wc *workerCollector) Run(ctx context.Context) error {
 		logger.Info("marked-workers-as-retired", lager.Data{"count": len(affected), "workers": affected})
 	}
 
+	aborted, err := wc.workerLifecycle.AbortBuildsInMaintenance()
+	if err != nil {
+		logger.Error("failed-to-abort-builds-in-maintenance", err)
+		return err
+	}
+
+	if len(aborted) > 0 {
+		logger.Info("aborted-builds-for-maintenance", lager.Data{"count": len(aborted), "builds": aborted})
+	}
+
 	affected, err = wc.workerLifecycle.LandFinishedLandingWorkers()
 	if err != nil {
 		logger.Error("failed-to-land-finished-landing-workers", err)
@@ -58,6 +58,13 @@ var _ = Describe("WorkerCollector", func() {
 			Expect(fakeWorkerLifecycle.LandFinishedLandingWorkersCallCount()).To(Equal(1))
 		})
 
+		It("tells the worker factory to abort builds on workers in maintenance", func() {
+			err := workerCollector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeWorkerLifecycle.AbortBuildsInMaintenanceCallCount()).To(Equal(1))
+		})
+
 		It("returns an error if stalling unresponsive workers fails", func() {
 			returnedErr := errors.New("some-error")
 			fakeWorkerLifecycle.StallUnresponsiveWorkersReturns(nil, returnedErr)
@@ -74,6 +81,14 @@ var _ = Describe("WorkerCollector", func() {
 			Expect(err).To(MatchError(returnedErr))
 		})
 
+		It("returns an error if aborting builds on workers in maintenance fails", func() {
+			returnedErr := errors.New("some-error")
+			fakeWorkerLifecycle.AbortBuildsInMaintenanceReturns(nil, returnedErr)
+
+			err := workerCollector.Run(context.TODO())
+			Expect(err).To(MatchError(returnedErr))
+		})
+
 		It("returns an error if landing finished landing workers fails", func() {
 			returnedErr := errors.New("some-error")
 			fakeWorkerLifecycle.LandFinishedLandingWorkersReturns(nil, returnedErr)
@@ -0,0 +1,54 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type workerKeysCollector struct {
+	lifecycle   db.WorkerKeyLifecycle
+	gracePeriod time.Duration
+}
+
+// NewWorkerKeysCollector removes the registration tokens which can no longer
+// be used, and the keys registered by workers which have gone away and not
+// come back within the grace period.
+func NewWorkerKeysCollector(lifecycle db.WorkerKeyLifecycle, gracePeriod time.Duration) *workerKeysCollector {
+	return &workerKeysCollector{
+		lifecycle:   lifecycle,
+		gracePeriod: gracePeriod,
+	}
+}
+
+func (c *workerKeysCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("worker-keys-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	removed, err := c.lifecycle.RemoveUnusableWorkerRegistrationTokens()
+	if err != nil {
+		logger.Error("failed-to-remove-unusable-worker-registration-tokens", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-worker-registration-tokens", lager.Data{"count": removed})
+	}
+
+	removed, err = c.lifecycle.RemoveOrphanedWorkerKeys(c.gracePeriod)
+	if err != nil {
+		logger.Error("failed-to-remove-orphaned-worker-keys", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Info("removed-orphaned-worker-keys", lager.Data{"count": removed})
+	}
+
+	return nil
+}
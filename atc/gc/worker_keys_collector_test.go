@@ -0,0 +1,45 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerKeysCollector", func() {
+	var collector GcCollector
+	var fakeLifecycle *dbfakes.FakeWorkerKeyLifecycle
+
+	BeforeEach(func() {
+		fakeLifecycle = new(dbfakes.FakeWorkerKeyLifecycle)
+
+		collector = gc.NewWorkerKeysCollector(fakeLifecycle, time.Hour)
+	})
+
+	Describe("Run", func() {
+		It("removes unusable registration tokens and orphaned worker keys", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeLifecycle.RemoveUnusableWorkerRegistrationTokensCallCount()).To(Equal(1))
+			Expect(fakeLifecycle.RemoveOrphanedWorkerKeysCallCount()).To(Equal(1))
+			Expect(fakeLifecycle.RemoveOrphanedWorkerKeysArgsForCall(0)).To(Equal(time.Hour))
+		})
+
+		Context("when removing the tokens fails", func() {
+			BeforeEach(func() {
+				fakeLifecycle.RemoveUnusableWorkerRegistrationTokensReturns(0, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("nope"))
+			})
+		})
+	})
+})
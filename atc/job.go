@@ -32,6 +32,7 @@ type JobInput struct {
 	Trigger  bool           `json:"trigger"`
 	Passed   []string       `json:"passed,omitempty"`
 	Version  *VersionConfig `json:"version,omitempty"`
+	Labels   []string       `json:"labels,omitempty"`
 }
 
 type JobInputParams struct {
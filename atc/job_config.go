@@ -100,6 +100,7 @@ func (config JobConfig) Inputs() []JobInputParams {
 					Passed:   step.Passed,
 					Version:  step.Version,
 					Trigger:  step.Trigger,
+					Labels:   step.Labels,
 				},
 				Params: step.Params,
 				Tags:   step.Tags,
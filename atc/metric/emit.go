@@ -3,6 +3,7 @@ package metric
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/lager"
@@ -63,11 +64,25 @@ type Monitor struct {
 	ChecksFinishedWithSuccess Counter
 	ChecksStarted             Counter
 	ChecksEnqueued            Counter
+	VersionsRejected          Counter
+
+	checksWaiting   map[string]*Gauge
+	checksWaitingMu sync.Mutex
+
+	credentialLookups   map[string]*Counter
+	credentialLookupsMu sync.Mutex
 
 	ConcurrentRequests         map[string]*Gauge
 	ConcurrentRequestsLimitHit map[string]*Counter
 
-	VolumesStreamed Counter
+	VolumesStreamed      Counter
+	VolumesStreamedBytes Counter
+	VolumeStreamRetries  Counter
+
+	ResourceCachesWarmed Counter
+
+	ExternalPlacementsSucceeded Counter
+	ExternalPlacementsFailed    Counter
 }
 
 var Metrics = NewMonitor()
@@ -75,11 +90,43 @@ var Metrics = NewMonitor()
 func NewMonitor() *Monitor {
 	return &Monitor{
 		TasksWaiting:               map[TasksWaitingLabels]*Gauge{},
+		checksWaiting:              map[string]*Gauge{},
+		credentialLookups:          map[string]*Counter{},
 		ConcurrentRequests:         map[string]*Gauge{},
 		ConcurrentRequestsLimitHit: map[string]*Counter{},
 	}
 }
 
+// ChecksWaiting returns the gauge tracking the checks waiting to run for the
+// given team.
+func (m *Monitor) ChecksWaiting(teamName string) *Gauge {
+	m.checksWaitingMu.Lock()
+	defer m.checksWaitingMu.Unlock()
+
+	gauge, found := m.checksWaiting[teamName]
+	if !found {
+		gauge = &Gauge{}
+		m.checksWaiting[teamName] = gauge
+	}
+
+	return gauge
+}
+
+// CredentialLookups returns the counter of the credentials found by the
+// given manager of a credential manager chain.
+func (m *Monitor) CredentialLookups(manager string) *Counter {
+	m.credentialLookupsMu.Lock()
+	defer m.credentialLookupsMu.Unlock()
+
+	counter, found := m.credentialLookups[manager]
+	if !found {
+		counter = &Counter{}
+		m.credentialLookups[manager] = counter
+	}
+
+	return counter
+}
+
 func (m *Monitor) RegisterEmitter(factory EmitterFactory) {
 	m.emitterFactories = append(m.emitterFactories, factory)
 }
@@ -27,6 +27,8 @@ type PrometheusEmitter struct {
 	concurrentRequestsLimitHit *prometheus.CounterVec
 	concurrentRequests         *prometheus.GaugeVec
 
+	credentialLookups *prometheus.CounterVec
+
 	tasksWaiting         *prometheus.GaugeVec
 	tasksWaitingDuration *prometheus.HistogramVec
 
@@ -51,8 +53,17 @@ type PrometheusEmitter struct {
 	checksQueueSize prometheus.Gauge
 	checksStarted   prometheus.Counter
 	checksEnqueued  prometheus.Counter
+	checksWaiting   *prometheus.GaugeVec
+
+	versionsRejected prometheus.Counter
+
+	volumesStreamed      prometheus.Counter
+	volumesStreamedBytes prometheus.Counter
+	volumeStreamRetries  prometheus.Counter
 
-	volumesStreamed prometheus.Counter
+	resourceCachesWarmed prometheus.Counter
+
+	externalPlacements *prometheus.CounterVec
 
 	workerContainers        *prometheus.GaugeVec
 	workerUnknownContainers *prometheus.GaugeVec
@@ -60,6 +71,13 @@ type PrometheusEmitter struct {
 	workerUnknownVolumes    *prometheus.GaugeVec
 	workerTasks             *prometheus.GaugeVec
 	workersRegistered       *prometheus.GaugeVec
+	workerStateTransitions  *prometheus.CounterVec
+
+	workerEgressPacketsDenied *prometheus.GaugeVec
+
+	tsaWorkerSessions   *prometheus.GaugeVec
+	tsaBytesProxied     *prometheus.GaugeVec
+	tsaMissedKeepalives *prometheus.GaugeVec
 
 	workerContainersLabels map[string]map[string]prometheus.Labels
 	workerVolumesLabels    map[string]map[string]prometheus.Labels
@@ -167,6 +185,14 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	}, []string{"action"})
 	prometheus.MustRegister(concurrentRequestsLimitHit)
 
+	credentialLookups := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "concourse",
+		Subsystem: "credentials",
+		Name:      "lookups_total",
+		Help:      "Total number of credentials found by each manager of the credential manager chain.",
+	}, []string{"manager"})
+	prometheus.MustRegister(credentialLookups)
+
 	concurrentRequests := prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: "concourse",
 		Name:      "concurrent_requests",
@@ -321,6 +347,62 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(workersRegistered)
 
+	workerStateTransitions := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "state_transitions_total",
+			Help:      "Number of times workers were degraded by or recovered from resource pressure",
+		},
+		[]string{"state"},
+	)
+	prometheus.MustRegister(workerStateTransitions)
+
+	workerEgressPacketsDenied := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "egress_packets_denied",
+			Help:      "Number of packets denied by the egress policies of the worker's containers since the worker started",
+		},
+		[]string{"worker"},
+	)
+	prometheus.MustRegister(workerEgressPacketsDenied)
+
+	// tsa metrics
+	tsaWorkerSessions := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "tsa",
+			Name:      "worker_sessions",
+			Help:      "Number of worker sessions per TSA",
+		},
+		[]string{"tsa"},
+	)
+	prometheus.MustRegister(tsaWorkerSessions)
+
+	tsaBytesProxied := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "tsa",
+			Name:      "bytes_proxied",
+			Help:      "Bytes proxied through the current worker sessions per TSA",
+		},
+		[]string{"tsa"},
+	)
+	prometheus.MustRegister(tsaBytesProxied)
+
+	tsaMissedKeepalives := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "tsa",
+			Name:      "missed_keepalives",
+			Help:      "Keepalives which workers did not send in time on the current worker sessions per TSA",
+		},
+		[]string{"tsa"},
+	)
+	prometheus.MustRegister(tsaMissedKeepalives)
+
 	// http metrics
 	httpRequestsDuration := prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -373,6 +455,27 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(checksQueueSize)
 
+	checksWaiting := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "lidar",
+			Name:      "checks_waiting",
+			Help:      "Number of checks waiting on their team's check rate limit or check container budget",
+		},
+		[]string{"team"},
+	)
+	prometheus.MustRegister(checksWaiting)
+
+	versionsRejected := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "lidar",
+			Name:      "versions_rejected_total",
+			Help:      "Total number of versions discarded for not satisfying the resource type's version schema",
+		},
+	)
+	prometheus.MustRegister(versionsRejected)
+
 	checksStarted := prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Namespace: "concourse",
@@ -403,6 +506,47 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(volumesStreamed)
 
+	volumesStreamedBytes := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "volumes",
+			Name:      "volumes_streamed_bytes",
+			Help:      "Total number of compressed bytes streamed from one worker to the other through the ATC",
+		},
+	)
+	prometheus.MustRegister(volumesStreamedBytes)
+
+	volumeStreamRetries := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "volumes",
+			Name:      "stream_retries",
+			Help:      "Total number of volume stream chunks resent and streams resumed",
+		},
+	)
+	prometheus.MustRegister(volumeStreamRetries)
+
+	resourceCachesWarmed := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "volumes",
+			Name:      "resource_caches_warmed",
+			Help:      "Total number of resource caches streamed to new workers ahead of their use",
+		},
+	)
+	prometheus.MustRegister(resourceCachesWarmed)
+
+	externalPlacements := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "placement",
+			Name:      "external_requests_total",
+			Help:      "Total number of requests made to the external container placement endpoint",
+		},
+		[]string{"status"},
+	)
+	prometheus.MustRegister(externalPlacements)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -420,6 +564,8 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		concurrentRequestsLimitHit: concurrentRequestsLimitHit,
 		concurrentRequests:         concurrentRequests,
 
+		credentialLookups: credentialLookups,
+
 		tasksWaiting:         tasksWaiting,
 		tasksWaitingDuration: tasksWaitingDuration,
 
@@ -444,9 +590,13 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		checksQueueSize: checksQueueSize,
 		checksStarted:   checksStarted,
 		checksEnqueued:  checksEnqueued,
+		checksWaiting:   checksWaiting,
+
+		versionsRejected: versionsRejected,
 
 		workerContainers:        workerContainers,
 		workersRegistered:       workersRegistered,
+		workerStateTransitions:  workerStateTransitions,
 		workerContainersLabels:  map[string]map[string]prometheus.Labels{},
 		workerVolumesLabels:     map[string]map[string]prometheus.Labels{},
 		workerTasksLabels:       map[string]map[string]prometheus.Labels{},
@@ -456,7 +606,19 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		workerUnknownContainers: workerUnknownContainers,
 		workerUnknownVolumes:    workerUnknownVolumes,
 
-		volumesStreamed: volumesStreamed,
+		workerEgressPacketsDenied: workerEgressPacketsDenied,
+
+		tsaWorkerSessions:   tsaWorkerSessions,
+		tsaBytesProxied:     tsaBytesProxied,
+		tsaMissedKeepalives: tsaMissedKeepalives,
+
+		volumesStreamed:      volumesStreamed,
+		volumesStreamedBytes: volumesStreamedBytes,
+		volumeStreamRetries:  volumeStreamRetries,
+
+		resourceCachesWarmed: resourceCachesWarmed,
+
+		externalPlacements: externalPlacements,
 	}
 	go emitter.periodicMetricGC()
 
@@ -487,6 +649,8 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.buildsRunning.Set(event.Value)
 	case "concurrent requests limit hit":
 		emitter.concurrentRequestsLimitHit.WithLabelValues(event.Attributes["action"]).Add(event.Value)
+	case "credential lookups":
+		emitter.credentialLookups.WithLabelValues(event.Attributes["manager"]).Add(event.Value)
 	case "concurrent requests":
 		emitter.concurrentRequests.
 			WithLabelValues(event.Attributes["action"]).Set(event.Value)
@@ -518,6 +682,16 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.workerTasksMetric(logger, event)
 	case "worker state":
 		emitter.workersRegisteredMetric(logger, event)
+	case "worker state transition":
+		emitter.workerStateTransitions.WithLabelValues(event.Attributes["state"]).Add(event.Value)
+	case "worker egress packets denied":
+		emitter.workerEgressPacketsDenied.WithLabelValues(event.Attributes["worker"]).Set(event.Value)
+	case "tsa worker sessions":
+		emitter.tsaWorkerSessions.WithLabelValues(event.Attributes["tsa"]).Set(event.Value)
+	case "tsa bytes proxied":
+		emitter.tsaBytesProxied.WithLabelValues(event.Attributes["tsa"]).Set(event.Value)
+	case "tsa missed keepalives":
+		emitter.tsaMissedKeepalives.WithLabelValues(event.Attributes["tsa"]).Set(event.Value)
 	case "http response time":
 		emitter.httpResponseTimeMetrics(logger, event)
 	case "database queries":
@@ -532,8 +706,20 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.checksEnqueued.Add(event.Value)
 	case "checks queue size":
 		emitter.checksQueueSize.Set(event.Value)
+	case "versions rejected":
+		emitter.versionsRejected.Add(event.Value)
+	case "checks waiting":
+		emitter.checksWaiting.WithLabelValues(event.Attributes["team_name"]).Set(event.Value)
 	case "volumes streamed":
 		emitter.volumesStreamed.Add(event.Value)
+	case "volumes streamed bytes":
+		emitter.volumesStreamedBytes.Add(event.Value)
+	case "volume stream retries":
+		emitter.volumeStreamRetries.Add(event.Value)
+	case "resource caches warmed":
+		emitter.resourceCachesWarmed.Add(event.Value)
+	case "external placements":
+		emitter.externalPlacements.WithLabelValues(event.Attributes["status"]).Add(event.Value)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -798,7 +984,7 @@ func (emitter *PrometheusEmitter) updateLastSeen(event metric.Event) {
 	}
 }
 
-//periodically remove stale metrics for workers
+// periodically remove stale metrics for workers
 func (emitter *PrometheusEmitter) periodicMetricGC() {
 	for {
 		emitter.mu.Lock()
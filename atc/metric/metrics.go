@@ -227,6 +227,26 @@ func (event WorkerUnknownContainers) Emit(logger lager.Logger) {
 	)
 }
 
+// WorkerEgressPacketsDenied is the number of packets the egress policies of a
+// worker's containers have denied since the worker started.
+type WorkerEgressPacketsDenied struct {
+	WorkerName string
+	Packets    uint64
+}
+
+func (event WorkerEgressPacketsDenied) Emit(logger lager.Logger) {
+	Metrics.emit(
+		logger.Session("worker-egress-packets-denied"),
+		Event{
+			Name:  "worker egress packets denied",
+			Value: float64(event.Packets),
+			Attributes: map[string]string{
+				"worker": event.WorkerName,
+			},
+		},
+	)
+}
+
 type WorkerVolumes struct {
 	WorkerName string
 	Platform   string
@@ -289,6 +309,46 @@ func (event WorkerTasks) Emit(logger lager.Logger) {
 	)
 }
 
+type TSAWorkerSessions struct {
+	TSA              string
+	Sessions         int
+	BytesProxied     int64
+	MissedKeepalives int
+}
+
+func (event TSAWorkerSessions) Emit(logger lager.Logger) {
+	attributes := map[string]string{
+		"tsa": event.TSA,
+	}
+
+	Metrics.emit(
+		logger.Session("tsa-worker-sessions"),
+		Event{
+			Name:       "tsa worker sessions",
+			Value:      float64(event.Sessions),
+			Attributes: attributes,
+		},
+	)
+
+	Metrics.emit(
+		logger.Session("tsa-bytes-proxied"),
+		Event{
+			Name:       "tsa bytes proxied",
+			Value:      float64(event.BytesProxied),
+			Attributes: attributes,
+		},
+	)
+
+	Metrics.emit(
+		logger.Session("tsa-missed-keepalives"),
+		Event{
+			Name:       "tsa missed keepalives",
+			Value:      float64(event.MissedKeepalives),
+			Attributes: attributes,
+		},
+	)
+}
+
 type VolumesToBeGarbageCollected struct {
 	Volumes int
 }
@@ -426,6 +486,27 @@ func (event GarbageCollectionContainerCollectorJobDropped) Emit(logger lager.Log
 	)
 }
 
+type WorkerStateTransition struct {
+	WorkerName string
+	State      db.WorkerState
+	Pressure   []string
+}
+
+func (event WorkerStateTransition) Emit(logger lager.Logger) {
+	Metrics.emit(
+		logger.Session("worker-state-transition"),
+		Event{
+			Name:  "worker state transition",
+			Value: 1,
+			Attributes: map[string]string{
+				"worker":   event.WorkerName,
+				"state":    string(event.State),
+				"pressure": strings.Join(event.Pressure, ","),
+			},
+		},
+	)
+}
+
 type BuildStarted struct {
 	Build db.Build
 }
@@ -83,6 +83,52 @@ func tick(logger lager.Logger, m *Monitor) {
 		},
 	)
 
+	m.emit(
+		logger.Session("volumes-streamed-bytes"),
+		Event{
+			Name:  "volumes streamed bytes",
+			Value: m.VolumesStreamedBytes.Delta(),
+		},
+	)
+
+	m.emit(
+		logger.Session("volume-stream-retries"),
+		Event{
+			Name:  "volume stream retries",
+			Value: m.VolumeStreamRetries.Delta(),
+		},
+	)
+
+	m.emit(
+		logger.Session("resource-caches-warmed"),
+		Event{
+			Name:  "resource caches warmed",
+			Value: m.ResourceCachesWarmed.Delta(),
+		},
+	)
+
+	m.emit(
+		logger.Session("external-placements-succeeded"),
+		Event{
+			Name:  "external placements",
+			Value: m.ExternalPlacementsSucceeded.Delta(),
+			Attributes: map[string]string{
+				"status": "success",
+			},
+		},
+	)
+
+	m.emit(
+		logger.Session("external-placements-failed"),
+		Event{
+			Name:  "external placements",
+			Value: m.ExternalPlacementsFailed.Delta(),
+			Attributes: map[string]string{
+				"status": "error",
+			},
+		},
+	)
+
 	m.emit(
 		logger.Session("containers-created"),
 		Event{
@@ -188,6 +234,46 @@ func tick(logger lager.Logger, m *Monitor) {
 		)
 	}
 
+	m.checksWaitingMu.Lock()
+	checksWaiting := make(map[string]*Gauge, len(m.checksWaiting))
+	for teamName, gauge := range m.checksWaiting {
+		checksWaiting[teamName] = gauge
+	}
+	m.checksWaitingMu.Unlock()
+
+	for teamName, gauge := range checksWaiting {
+		m.emit(
+			logger.Session("checks-waiting"),
+			Event{
+				Name:  "checks waiting",
+				Value: gauge.Max(),
+				Attributes: map[string]string{
+					"team_name": teamName,
+				},
+			},
+		)
+	}
+
+	m.credentialLookupsMu.Lock()
+	credentialLookups := make(map[string]*Counter, len(m.credentialLookups))
+	for manager, counter := range m.credentialLookups {
+		credentialLookups[manager] = counter
+	}
+	m.credentialLookupsMu.Unlock()
+
+	for manager, counter := range credentialLookups {
+		m.emit(
+			logger.Session("credential-lookups"),
+			Event{
+				Name:  "credential lookups",
+				Value: counter.Delta(),
+				Attributes: map[string]string{
+					"manager": manager,
+				},
+			},
+		)
+	}
+
 	m.emit(
 		logger.Session("checks-finished-with-error"),
 		Event{
@@ -219,6 +305,14 @@ func tick(logger lager.Logger, m *Monitor) {
 		},
 	)
 
+	m.emit(
+		logger.Session("versions-rejected"),
+		Event{
+			Name:  "versions rejected",
+			Value: m.VersionsRejected.Delta(),
+		},
+	)
+
 	m.emit(
 
 		logger.Session("checks-enqueued"),
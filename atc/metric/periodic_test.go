@@ -140,6 +140,26 @@ var _ = Describe("Periodic emission of metrics", func() {
 		})
 	})
 
+	Context("credential lookups", func() {
+		BeforeEach(func() {
+			monitor.CredentialLookups("vault").IncDelta(3)
+		})
+
+		It("emits the lookups served by each manager", func() {
+			Eventually(events).Should(
+				ContainElement(
+					MatchFields(IgnoreExtras, Fields{
+						"Name":  Equal("credential lookups"),
+						"Value": Equal(float64(3)),
+						"Attributes": Equal(map[string]string{
+							"manager": "vault",
+						}),
+					}),
+				),
+			)
+		})
+	})
+
 	Context("limit-active-tasks metrics", func() {
 		labels := metric.TasksWaitingLabels{
 			TeamId:     "42",
@@ -194,6 +194,9 @@ type GetPlan struct {
 	// Worker tags to influence placement of the container.
 	Tags Tags `json:"tags,omitempty"`
 
+	// Worker labels to influence placement of the container.
+	WorkerSelector *WorkerSelector `json:"worker_selector,omitempty"`
+
 	// A timeout to enforce on the resource `get` process. Note that fetching the
 	// resource's image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
@@ -220,6 +223,9 @@ type PutPlan struct {
 	// Worker tags to influence placement of the container.
 	Tags Tags `json:"tags,omitempty"`
 
+	// Worker labels to influence placement of the container.
+	WorkerSelector *WorkerSelector `json:"worker_selector,omitempty"`
+
 	// A timeout to enforce on the resource `put` process. Note that fetching the
 	// resource's image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
@@ -267,6 +273,13 @@ type TaskPlan struct {
 	// Worker tags to influence placement of the container.
 	Tags Tags `json:"tags,omitempty"`
 
+	// Worker labels to influence placement of the container.
+	WorkerSelector *WorkerSelector `json:"worker_selector,omitempty"`
+
+	// The runtime class to run the container with. Overrides any runtime
+	// class set in the task's config.
+	RuntimeClass string `json:"runtime_class,omitempty"`
+
 	// The task config to execute - either fetched from a path at runtime, or
 	// provided statically.
 	ConfigPath string      `json:"config_path,omitempty"`
@@ -13,6 +13,11 @@ import (
 //go:generate counterfeiter . ResourceFactory
 type ResourceFactory interface {
 	NewResource(source atc.Source, params atc.Params, version atc.Version) Resource
+
+	// NewCheckResource returns a resource to check from the given version. When
+	// the resource type declares a version schema, the check must emit string
+	// values so that they can be validated against it.
+	NewCheckResource(source atc.Source, version atc.Version, versionSchema *atc.VersionSchema) Resource
 }
 
 type resourceFactory struct {
@@ -30,6 +35,15 @@ func (rf resourceFactory) NewResource(source atc.Source, params atc.Params, vers
 	}
 }
 
+func (rf resourceFactory) NewCheckResource(source atc.Source, version atc.Version, versionSchema *atc.VersionSchema) Resource {
+	return &resource{
+		Source:  source,
+		Version: version,
+
+		stringVersions: versionSchema != nil,
+	}
+}
+
 //go:generate counterfeiter . Resource
 
 type Resource interface {
@@ -53,6 +67,8 @@ type resource struct {
 	Source  atc.Source  `json:"source"`
 	Params  atc.Params  `json:"params,omitempty"`
 	Version atc.Version `json:"version,omitempty"`
+
+	stringVersions bool
 }
 
 func (resource *resource) Signature() ([]byte, error) {
@@ -2,6 +2,7 @@ package resource
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/runtime"
@@ -12,10 +13,18 @@ func (resource *resource) Check(
 	spec runtime.ProcessSpec,
 	runnable runtime.Runner) ([]atc.Version, error) {
 	var versions []atc.Version
+	var output []map[string]interface{}
+
+	// types without a version schema keep the lenient decoding, e.g. a null
+	// value becomes an empty string
+	var result interface{} = &versions
+	if resource.stringVersions {
+		result = &output
+	}
 
 	input, err := resource.Signature()
 	if err != nil {
-		return versions, err
+		return nil, err
 	}
 
 	err = runnable.RunScript(
@@ -23,10 +32,41 @@ func (resource *resource) Check(
 		spec.Path,
 		spec.Args,
 		input,
-		&versions,
+		result,
 		spec.StderrWriter,
 		false,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !resource.stringVersions {
+		return versions, nil
+	}
+
+	return parseCheckVersions(output)
+}
+
+// parseCheckVersions converts the output of a check into versions, rejecting
+// values which are not strings rather than failing to parse the output, so
+// that they can be validated against the version schema of the type.
+func parseCheckVersions(output []map[string]interface{}) ([]atc.Version, error) {
+	var versions []atc.Version
+	for i, raw := range output {
+		version := atc.Version{}
+		for key, value := range raw {
+			str, ok := value.(string)
+			if !ok {
+				return nil, atc.InvalidVersionError{
+					Reason: fmt.Sprintf("value of key '%s' in version %d is not a string: %v", key, i, value),
+				}
+			}
+
+			version[key] = str
+		}
+
+		versions = append(versions, version)
+	}
 
-	return versions, err
+	return versions, nil
 }
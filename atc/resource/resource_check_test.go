@@ -3,6 +3,7 @@ package resource_test
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 
@@ -36,6 +37,7 @@ var _ = Describe("Resource Check", func() {
 
 	BeforeEach(func() {
 		ctx = context.Background()
+		fakeRunnable = runtimefakes.FakeRunner{}
 
 		source = atc.Source{"some": "source"}
 		version = atc.Version{"some": "version"}
@@ -74,7 +76,7 @@ var _ = Describe("Resource Check", func() {
 			Expect(actualSpecPath).To(Equal(someProcessSpec.Path))
 			Expect(actualArgs).To(Equal(someProcessSpec.Args))
 			Expect(actualInput).To(Equal(signature))
-			Expect(actualVersionResultRef).To(Equal(&checkVersions))
+			Expect(actualVersionResultRef).To(BeAssignableToTypeOf(&[]atc.Version{}))
 			Expect(actualSpecStdErrWriter).To(Equal(fakeStderr))
 			Expect(actualRecoverableBool).To(BeFalse())
 		})
@@ -82,6 +84,95 @@ var _ = Describe("Resource Check", func() {
 		It("doesnt return an error", func() {
 			Expect(checkErr).To(BeNil())
 		})
+
+		Context("when the check emits versions", func() {
+			BeforeEach(func() {
+				fakeRunnable.RunScriptStub = func(_ context.Context, _ string, _ []string, _ []byte, output interface{}, _ io.Writer, _ bool) error {
+					return json.Unmarshal([]byte(`[{"ref":"abc"},{"ref":"def","branch":"main"}]`), output)
+				}
+			})
+
+			It("returns the versions", func() {
+				Expect(checkErr).ToNot(HaveOccurred())
+				Expect(checkVersions).To(Equal([]atc.Version{
+					{"ref": "abc"},
+					{"ref": "def", "branch": "main"},
+				}))
+			})
+		})
+
+		Context("when the check emits a version with a null value", func() {
+			BeforeEach(func() {
+				fakeRunnable.RunScriptStub = func(_ context.Context, _ string, _ []string, _ []byte, output interface{}, _ io.Writer, _ bool) error {
+					return json.Unmarshal([]byte(`[{"ref":"abc","branch":null}]`), output)
+				}
+			})
+
+			It("decodes the value as an empty string", func() {
+				Expect(checkErr).ToNot(HaveOccurred())
+				Expect(checkVersions).To(Equal([]atc.Version{
+					{"ref": "abc", "branch": ""},
+				}))
+			})
+		})
+
+		Context("when the resource type declares a version schema", func() {
+			BeforeEach(func() {
+				resource = resourceFactory.NewCheckResource(source, version, &atc.VersionSchema{
+					RequiredKeys: []string{"ref"},
+				})
+			})
+
+			It("decodes the output without assuming the values are strings", func() {
+				_, _, _, _, actualVersionResultRef, _, _ := fakeRunnable.RunScriptArgsForCall(0)
+				Expect(actualVersionResultRef).To(BeAssignableToTypeOf(&[]map[string]interface{}{}))
+			})
+
+			Context("when the check emits versions", func() {
+				BeforeEach(func() {
+					fakeRunnable.RunScriptStub = func(_ context.Context, _ string, _ []string, _ []byte, output interface{}, _ io.Writer, _ bool) error {
+						return json.Unmarshal([]byte(`[{"ref":"abc"},{"ref":"def","branch":"main"}]`), output)
+					}
+				})
+
+				It("returns the versions", func() {
+					Expect(checkErr).ToNot(HaveOccurred())
+					Expect(checkVersions).To(Equal([]atc.Version{
+						{"ref": "abc"},
+						{"ref": "def", "branch": "main"},
+					}))
+				})
+			})
+
+			Context("when the check emits a version with a value which is not a string", func() {
+				BeforeEach(func() {
+					fakeRunnable.RunScriptStub = func(_ context.Context, _ string, _ []string, _ []byte, output interface{}, _ io.Writer, _ bool) error {
+						return json.Unmarshal([]byte(`[{"ref":"abc"},{"ref":123}]`), output)
+					}
+				})
+
+				It("returns an invalid version error", func() {
+					Expect(checkErr).To(MatchError(atc.InvalidVersionError{
+						Reason: "value of key 'ref' in version 1 is not a string: 123",
+					}))
+					Expect(checkVersions).To(BeNil())
+				})
+			})
+
+			Context("when the check emits a version with a null value", func() {
+				BeforeEach(func() {
+					fakeRunnable.RunScriptStub = func(_ context.Context, _ string, _ []string, _ []byte, output interface{}, _ io.Writer, _ bool) error {
+						return json.Unmarshal([]byte(`[{"ref":null}]`), output)
+					}
+				})
+
+				It("returns an invalid version error", func() {
+					Expect(checkErr).To(MatchError(atc.InvalidVersionError{
+						Reason: "value of key 'ref' in version 0 is not a string: <nil>",
+					}))
+				})
+			})
+		})
 	})
 
 	Context("when Runnable -> RunScript returns an error", func() {
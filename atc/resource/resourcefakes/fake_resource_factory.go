@@ -9,6 +9,19 @@ import (
 )
 
 type FakeResourceFactory struct {
+	NewCheckResourceStub        func(atc.Source, atc.Version, *atc.VersionSchema) resource.Resource
+	newCheckResourceMutex       sync.RWMutex
+	newCheckResourceArgsForCall []struct {
+		arg1 atc.Source
+		arg2 atc.Version
+		arg3 *atc.VersionSchema
+	}
+	newCheckResourceReturns struct {
+		result1 resource.Resource
+	}
+	newCheckResourceReturnsOnCall map[int]struct {
+		result1 resource.Resource
+	}
 	NewResourceStub        func(atc.Source, atc.Params, atc.Version) resource.Resource
 	newResourceMutex       sync.RWMutex
 	newResourceArgsForCall []struct {
@@ -26,6 +39,69 @@ type FakeResourceFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeResourceFactory) NewCheckResource(arg1 atc.Source, arg2 atc.Version, arg3 *atc.VersionSchema) resource.Resource {
+	fake.newCheckResourceMutex.Lock()
+	ret, specificReturn := fake.newCheckResourceReturnsOnCall[len(fake.newCheckResourceArgsForCall)]
+	fake.newCheckResourceArgsForCall = append(fake.newCheckResourceArgsForCall, struct {
+		arg1 atc.Source
+		arg2 atc.Version
+		arg3 *atc.VersionSchema
+	}{arg1, arg2, arg3})
+	stub := fake.NewCheckResourceStub
+	fakeReturns := fake.newCheckResourceReturns
+	fake.recordInvocation("NewCheckResource", []interface{}{arg1, arg2, arg3})
+	fake.newCheckResourceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeResourceFactory) NewCheckResourceCallCount() int {
+	fake.newCheckResourceMutex.RLock()
+	defer fake.newCheckResourceMutex.RUnlock()
+	return len(fake.newCheckResourceArgsForCall)
+}
+
+func (fake *FakeResourceFactory) NewCheckResourceCalls(stub func(atc.Source, atc.Version, *atc.VersionSchema) resource.Resource) {
+	fake.newCheckResourceMutex.Lock()
+	defer fake.newCheckResourceMutex.Unlock()
+	fake.NewCheckResourceStub = stub
+}
+
+func (fake *FakeResourceFactory) NewCheckResourceArgsForCall(i int) (atc.Source, atc.Version, *atc.VersionSchema) {
+	fake.newCheckResourceMutex.RLock()
+	defer fake.newCheckResourceMutex.RUnlock()
+	argsForCall := fake.newCheckResourceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeResourceFactory) NewCheckResourceReturns(result1 resource.Resource) {
+	fake.newCheckResourceMutex.Lock()
+	defer fake.newCheckResourceMutex.Unlock()
+	fake.NewCheckResourceStub = nil
+	fake.newCheckResourceReturns = struct {
+		result1 resource.Resource
+	}{result1}
+}
+
+func (fake *FakeResourceFactory) NewCheckResourceReturnsOnCall(i int, result1 resource.Resource) {
+	fake.newCheckResourceMutex.Lock()
+	defer fake.newCheckResourceMutex.Unlock()
+	fake.NewCheckResourceStub = nil
+	if fake.newCheckResourceReturnsOnCall == nil {
+		fake.newCheckResourceReturnsOnCall = make(map[int]struct {
+			result1 resource.Resource
+		})
+	}
+	fake.newCheckResourceReturnsOnCall[i] = struct {
+		result1 resource.Resource
+	}{result1}
+}
+
 func (fake *FakeResourceFactory) NewResource(arg1 atc.Source, arg2 atc.Params, arg3 atc.Version) resource.Resource {
 	fake.newResourceMutex.Lock()
 	ret, specificReturn := fake.newResourceReturnsOnCall[len(fake.newResourceArgsForCall)]
@@ -34,15 +110,16 @@ func (fake *FakeResourceFactory) NewResource(arg1 atc.Source, arg2 atc.Params, a
 		arg2 atc.Params
 		arg3 atc.Version
 	}{arg1, arg2, arg3})
+	stub := fake.NewResourceStub
+	fakeReturns := fake.newResourceReturns
 	fake.recordInvocation("NewResource", []interface{}{arg1, arg2, arg3})
 	fake.newResourceMutex.Unlock()
-	if fake.NewResourceStub != nil {
-		return fake.NewResourceStub(arg1, arg2, arg3)
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	fakeReturns := fake.newResourceReturns
 	return fakeReturns.result1
 }
 
@@ -91,6 +168,8 @@ func (fake *FakeResourceFactory) NewResourceReturnsOnCall(i int, result1 resourc
 func (fake *FakeResourceFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.newCheckResourceMutex.RLock()
+	defer fake.newCheckResourceMutex.RUnlock()
 	fake.newResourceMutex.RLock()
 	defer fake.newResourceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
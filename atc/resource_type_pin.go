@@ -0,0 +1,5 @@
+package atc
+
+type PinResourceTypeRequestBody struct {
+	Version Version `json:"version"`
+}
@@ -0,0 +1,84 @@
+package atc
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	ResourceVersionQueryFilter   = "filter"
+	ResourceVersionQueryMetadata = "metadata"
+	ResourceVersionQueryLabel    = "label"
+	ResourceVersionQuerySince    = "since"
+	ResourceVersionQueryUntil    = "until"
+)
+
+// ResourceVersionFilter narrows down the versions listed for a resource. A
+// version must satisfy every configured criterion to be included.
+type ResourceVersionFilter struct {
+	// Version matches versions containing all of the given fields.
+	Version Version
+
+	// Metadata matches versions whose metadata satisfies every filter.
+	Metadata []MetadataFilter
+
+	// Labels matches versions which have been given all of the labels.
+	Labels []string
+
+	// Since and Until bound the time at which a version was first saved by a
+	// check. Zero values are ignored, as are versions whose time is unknown.
+	Since time.Time
+	Until time.Time
+}
+
+type MetadataMatch string
+
+const (
+	// MetadataMatchExact matches a metadata value exactly.
+	MetadataMatchExact MetadataMatch = "exact"
+
+	// MetadataMatchPattern matches a metadata value against a glob pattern
+	// where '*' matches any sequence of characters.
+	MetadataMatchPattern MetadataMatch = "pattern"
+
+	// MetadataMatchContains matches a metadata value containing the given
+	// substring, ignoring case.
+	MetadataMatchContains MetadataMatch = "contains"
+)
+
+type MetadataFilter struct {
+	Name  string
+	Value string
+	Match MetadataMatch
+}
+
+// ParseMetadataFilter parses a filter of the form 'name:value' or
+// 'name~value'. The former matches exactly, or as a glob pattern if the value
+// contains a '*'; the latter matches metadata values containing the value.
+func ParseMetadataFilter(filter string) (MetadataFilter, error) {
+	i := strings.IndexAny(filter, ":~")
+	if i <= 0 {
+		return MetadataFilter{}, fmt.Errorf("invalid metadata filter '%s': expected 'name:value' or 'name~value'", filter)
+	}
+
+	name, value := filter[:i], filter[i+1:]
+
+	if filter[i] == '~' {
+		return MetadataFilter{Name: name, Value: value, Match: MetadataMatchContains}, nil
+	}
+
+	if strings.Contains(value, "*") {
+		return MetadataFilter{Name: name, Value: value, Match: MetadataMatchPattern}, nil
+	}
+
+	return MetadataFilter{Name: name, Value: value, Match: MetadataMatchExact}, nil
+}
+
+func (filter MetadataFilter) String() string {
+	if filter.Match == MetadataMatchContains {
+		return filter.Name + "~" + filter.Value
+	}
+
+	return filter.Name + ":" + filter.Value
+}
@@ -0,0 +1,33 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseMetadataFilter", func() {
+	DescribeTable("parsing filters",
+		func(input string, expected atc.MetadataFilter) {
+			filter, err := atc.ParseMetadataFilter(input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filter).To(Equal(expected))
+			Expect(filter.String()).To(Equal(input))
+		},
+		Entry("exact", "author:alice", atc.MetadataFilter{Name: "author", Value: "alice", Match: atc.MetadataMatchExact}),
+		Entry("pattern", "tag:v1.*", atc.MetadataFilter{Name: "tag", Value: "v1.*", Match: atc.MetadataMatchPattern}),
+		Entry("contains", "message~fix: bug", atc.MetadataFilter{Name: "message", Value: "fix: bug", Match: atc.MetadataMatchContains}),
+		Entry("value with colons", "url:http://example.com", atc.MetadataFilter{Name: "url", Value: "http://example.com", Match: atc.MetadataMatchExact}),
+	)
+
+	DescribeTable("invalid filters",
+		func(input string) {
+			_, err := atc.ParseMetadataFilter(input)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("no separator", "author"),
+		Entry("no name", ":alice"),
+	)
+})
@@ -15,6 +15,9 @@ const (
 	AbortBuild          = "AbortBuild"
 	GetBuildPreparation = "GetBuildPreparation"
 
+	GetBuildSecretAccesses = "GetBuildSecretAccesses"
+	ListSecretAccesses     = "ListSecretAccesses"
+
 	GetJob         = "GetJob"
 	CreateJobBuild = "CreateJobBuild"
 	RerunJobBuild  = "RerunJobBuild"
@@ -40,10 +43,16 @@ const (
 	CheckResourceWebHook = "CheckResourceWebHook"
 	CheckResourceType    = "CheckResourceType"
 
+	PinResourceTypeVersion       = "PinResourceTypeVersion"
+	UnpinResourceType            = "UnpinResourceType"
+	PromoteResourceTypeCandidate = "PromoteResourceTypeCandidate"
+
 	ListResourceVersions          = "ListResourceVersions"
 	GetResourceVersion            = "GetResourceVersion"
 	EnableResourceVersion         = "EnableResourceVersion"
 	DisableResourceVersion        = "DisableResourceVersion"
+	AddResourceVersionLabel       = "AddResourceVersionLabel"
+	RemoveResourceVersionLabel    = "RemoveResourceVersionLabel"
 	PinResourceVersion            = "PinResourceVersion"
 	UnpinResource                 = "UnpinResource"
 	SetPinCommentOnResource       = "SetPinCommentOnResource"
@@ -76,6 +85,21 @@ const (
 	ListWorkers     = "ListWorkers"
 	DeleteWorker    = "DeleteWorker"
 
+	ListMaintenanceWindows  = "ListMaintenanceWindows"
+	CreateMaintenanceWindow = "CreateMaintenanceWindow"
+	DeleteMaintenanceWindow = "DeleteMaintenanceWindow"
+
+	ListWorkerKeys     = "ListWorkerKeys"
+	MarkWorkerKeysUsed = "MarkWorkerKeysUsed"
+
+	ListWorkerRegistrationTokens  = "ListWorkerRegistrationTokens"
+	CreateWorkerRegistrationToken = "CreateWorkerRegistrationToken"
+	DeleteWorkerRegistrationToken = "DeleteWorkerRegistrationToken"
+	RedeemWorkerRegistrationToken = "RedeemWorkerRegistrationToken"
+
+	ListWorkerSessions   = "ListWorkerSessions"
+	ReportWorkerSessions = "ReportWorkerSessions"
+
 	SetLogLevel = "SetLogLevel"
 	GetLogLevel = "GetLogLevel"
 
@@ -100,6 +124,20 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
+	GetTeamCheckLimits = "GetTeamCheckLimits"
+	SetTeamCheckLimits = "SetTeamCheckLimits"
+
+	ListTeamWorkerKeys  = "ListTeamWorkerKeys"
+	SetTeamWorkerKey    = "SetTeamWorkerKey"
+	DeleteTeamWorkerKey = "DeleteTeamWorkerKey"
+
+	ListTeamSecrets  = "ListTeamSecrets"
+	SetTeamSecret    = "SetTeamSecret"
+	DeleteTeamSecret = "DeleteTeamSecret"
+
+	ClearSecretCache     = "ClearSecretCache"
+	ClearTeamSecretCache = "ClearTeamSecretCache"
+
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
@@ -113,8 +151,10 @@ const (
 )
 
 const (
-	ClearTaskCacheQueryPath = "cache_path"
-	SaveConfigCheckCreds    = "check_creds"
+	ClearTaskCacheQueryPath         = "cache_path"
+	SaveConfigCheckCreds            = "check_creds"
+	ClearSecretCacheQueryPipeline   = "pipeline"
+	ClearSecretCacheQueryPathPrefix = "path_prefix"
 )
 
 var Routes = rata.Routes([]rata.Route{
@@ -131,6 +171,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
 	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
+	{Path: "/api/v1/builds/:build_id/secrets-accessed", Method: "GET", Name: GetBuildSecretAccesses},
+	{Path: "/api/v1/secrets-accessed", Method: "GET", Name: ListSecretAccesses},
 
 	{Path: "/api/v1/jobs", Method: "GET", Name: ListAllJobs},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs", Method: "GET", Name: ListJobs},
@@ -171,11 +213,16 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check", Method: "POST", Name: CheckResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", Method: "POST", Name: CheckResourceWebHook},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/check", Method: "POST", Name: CheckResourceType},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/pin", Method: "PUT", Name: PinResourceTypeVersion},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/unpin", Method: "PUT", Name: UnpinResourceType},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/promote", Method: "PUT", Name: PromoteResourceTypeCandidate},
 
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions", Method: "GET", Name: ListResourceVersions},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id", Method: "GET", Name: GetResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/enable", Method: "PUT", Name: EnableResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/disable", Method: "PUT", Name: DisableResourceVersion},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/labels/:label", Method: "PUT", Name: AddResourceVersionLabel},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/labels/:label", Method: "DELETE", Name: RemoveResourceVersionLabel},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_config_version_id/pin", Method: "PUT", Name: PinResourceVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/unpin", Method: "PUT", Name: UnpinResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/pin_comment", Method: "PUT", Name: SetPinCommentOnResource},
@@ -193,6 +240,21 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/workers/:worker_name/heartbeat", Method: "PUT", Name: HeartbeatWorker},
 	{Path: "/api/v1/workers/:worker_name", Method: "DELETE", Name: DeleteWorker},
 
+	{Path: "/api/v1/maintenance-windows", Method: "GET", Name: ListMaintenanceWindows},
+	{Path: "/api/v1/maintenance-windows", Method: "POST", Name: CreateMaintenanceWindow},
+	{Path: "/api/v1/maintenance-windows/:window_id", Method: "DELETE", Name: DeleteMaintenanceWindow},
+
+	{Path: "/api/v1/worker-keys", Method: "GET", Name: ListWorkerKeys},
+	{Path: "/api/v1/worker-keys/used", Method: "PUT", Name: MarkWorkerKeysUsed},
+
+	{Path: "/api/v1/worker-registration-tokens", Method: "GET", Name: ListWorkerRegistrationTokens},
+	{Path: "/api/v1/worker-registration-tokens", Method: "POST", Name: CreateWorkerRegistrationToken},
+	{Path: "/api/v1/worker-registration-tokens/:worker_registration_token_id", Method: "DELETE", Name: DeleteWorkerRegistrationToken},
+	{Path: "/api/v1/worker-registration-tokens/redeem", Method: "POST", Name: RedeemWorkerRegistrationToken},
+
+	{Path: "/api/v1/worker-sessions", Method: "GET", Name: ListWorkerSessions},
+	{Path: "/api/v1/worker-sessions", Method: "PUT", Name: ReportWorkerSessions},
+
 	{Path: "/api/v1/log-level", Method: "GET", Name: GetLogLevel},
 	{Path: "/api/v1/log-level", Method: "PUT", Name: SetLogLevel},
 
@@ -219,6 +281,16 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/rename", Method: "PUT", Name: RenameTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
+	{Path: "/api/v1/teams/:team_name/check-limits", Method: "GET", Name: GetTeamCheckLimits},
+	{Path: "/api/v1/teams/:team_name/check-limits", Method: "PUT", Name: SetTeamCheckLimits},
+	{Path: "/api/v1/teams/:team_name/worker-keys", Method: "GET", Name: ListTeamWorkerKeys},
+	{Path: "/api/v1/teams/:team_name/worker-keys/:worker_key_name", Method: "PUT", Name: SetTeamWorkerKey},
+	{Path: "/api/v1/teams/:team_name/worker-keys/:worker_key_name", Method: "DELETE", Name: DeleteTeamWorkerKey},
+	{Path: "/api/v1/teams/:team_name/secrets", Method: "GET", Name: ListTeamSecrets},
+	{Path: "/api/v1/teams/:team_name/secrets/:secret_name", Method: "PUT", Name: SetTeamSecret},
+	{Path: "/api/v1/teams/:team_name/secrets/:secret_name", Method: "DELETE", Name: DeleteTeamSecret},
+	{Path: "/api/v1/teams/:team_name/secrets-cache", Method: "DELETE", Name: ClearTeamSecretCache},
+	{Path: "/api/v1/secrets-cache", Method: "DELETE", Name: ClearSecretCache},
 
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
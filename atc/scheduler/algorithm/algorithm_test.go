@@ -335,6 +335,120 @@ var _ = DescribeTable("Input resolving",
 		},
 	}),
 
+	Entry("finds the latest version with all labels for inputs with no passed constraints", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1, Labels: []string{"qa-approved"}},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2, Labels: []string{"qa-approved", "signed"}},
+				{Resource: "resource-x", Version: "rxv3", CheckOrder: 3, Labels: []string{"qa-approved", "signed"}, Disabled: true},
+				{Resource: "resource-x", Version: "rxv4", CheckOrder: 4, Labels: []string{"signed"}},
+				{Resource: "resource-x", Version: "rxv5", CheckOrder: 5},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Labels:   []string{"qa-approved", "signed"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv2",
+			},
+		},
+	}),
+
+	Entry("returns a missing input reason when no version has the labels", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1, Labels: []string{"bad-release"}},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Labels:   []string{"qa-approved"},
+			},
+		},
+
+		Result: Result{
+			OK: false,
+			Errors: map[string]string{
+				"resource-x": "latest version of resource not found",
+			},
+		},
+	}),
+
+	Entry("finds next labeled version for inputs that use every version when there is a build for that resource", Example{
+		DB: DB{
+			BuildInputs: []DBRow{
+				{Job: CurrentJobName, BuildID: 4, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1, Labels: []string{"qa-approved"}},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+				{Resource: "resource-x", Version: "rxv3", CheckOrder: 3, Labels: []string{"qa-approved"}},
+				{Resource: "resource-x", Version: "rxv4", CheckOrder: 4},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Version:  Version{Every: true},
+				Labels:   []string{"qa-approved"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv3",
+			},
+		},
+	}),
+
+	Entry("skips passed versions missing labels for inputs with passed constraints", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1, Labels: []string{"qa-approved"}},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2, Labels: []string{"bad-release"}},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"simple-a"},
+				Labels:   []string{"qa-approved"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+			PassedBuildIDs: map[string][]int{
+				"resource-x": []int{1},
+			},
+		},
+	}),
+
 	Entry("returns a missing input reason when no input version satisfies the passed constraint", Example{
 		DB: DB{
 			BuildInputs: []DBRow{
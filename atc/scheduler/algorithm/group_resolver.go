@@ -459,6 +459,23 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 		return false, false, nil
 	}
 
+	labeled, err := r.vdb.VersionHasLabels(ctx, output.ResourceID, output.Version, inputConfig.Labels)
+	if err != nil {
+		return false, false, err
+	}
+
+	if !labeled {
+		// this version is missing labels required by the input so it cannot be
+		// used
+		span.AddEvent(
+			ctx,
+			"version missing labels",
+			label.Int("resourceID", output.ResourceID),
+			label.String("version", string(output.Version)),
+		)
+		return false, false, nil
+	}
+
 	if inputConfig.PinnedVersion != nil && r.pins[candidateIdx] != output.Version {
 		// input is both pinned and assigned a 'passed' constraint, but the pinned
 		// version doesn't match the job's output version
@@ -39,6 +39,7 @@ type DBRow struct {
 	CheckOrder            int
 	VersionID             int
 	Disabled              bool
+	Labels                []string
 	FromBuildID           int
 	ToBuildID             int
 	RerunOfBuildID        int
@@ -63,6 +64,7 @@ type Input struct {
 	Resource              string
 	Passed                []string
 	Version               Version
+	Labels                []string
 	NoResourceConfigScope bool
 }
 
@@ -166,6 +168,7 @@ func (example Example) Run() {
 			Passed:          passed,
 			ResourceID:      setup.resourceIDs.ID(input.Resource),
 			UseEveryVersion: input.Version.Every,
+			Labels:          input.Labels,
 			JobID:           setup.jobIDs.ID(CurrentJobName),
 		}
 
@@ -804,6 +807,15 @@ func (s setupDB) insertRowVersion(resources map[string]atc.ResourceConfig, row D
 			Exec()
 		Expect(err).ToNot(HaveOccurred())
 	}
+
+	for _, label := range row.Labels {
+		_, err = s.psql.Insert("resource_version_labels").
+			Columns("resource_id", "version_md5", "label").
+			Values(resourceID, sq.Expr("md5(?)", versionJSON), label).
+			Suffix("ON CONFLICT DO NOTHING").
+			Exec()
+		Expect(err).ToNot(HaveOccurred())
+	}
 }
 
 func (s setupDB) insertRowBuild(row DBRow, needsV6Migration bool) {
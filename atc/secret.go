@@ -0,0 +1,92 @@
+package atc
+
+import (
+	"errors"
+	"strings"
+)
+
+// Secret describes a secret kept by the built-in credential manager. Its
+// value is never returned by the API.
+type Secret struct {
+	TeamName string `json:"team_name"`
+
+	// PipelineName is empty for secrets which every pipeline of the team can
+	// use.
+	PipelineName string `json:"pipeline_name,omitempty"`
+
+	Name string `json:"name"`
+
+	CreatedAt int64  `json:"created_at"`
+	UpdatedAt int64  `json:"updated_at"`
+	UpdatedBy string `json:"updated_by,omitempty"`
+}
+
+// SecretAccess records that a build resolved a credential, whether from the
+// cluster-wide credential manager, a var source or a load_var step.
+type SecretAccess struct {
+	BuildID int `json:"build_id"`
+
+	// VarSource is empty for credentials of the cluster-wide credential
+	// manager and "." for load_var steps.
+	VarSource string `json:"var_source,omitempty"`
+
+	Path       string `json:"path"`
+	Manager    string `json:"manager,omitempty"`
+	AccessedAt int64  `json:"accessed_at"`
+}
+
+// SecretAccessSummary lists the builds of a team which accessed a credential,
+// from the newest.
+type SecretAccessSummary struct {
+	TeamName  string `json:"team_name"`
+	VarSource string `json:"var_source,omitempty"`
+	Path      string `json:"path"`
+	Manager   string `json:"manager,omitempty"`
+
+	BuildIDs       []int `json:"build_ids"`
+	LastAccessedAt int64 `json:"last_accessed_at"`
+}
+
+// SetSecretRequest sets the value of a secret. The value can be a string or
+// a map, whose fields are used as ((secret.field)).
+type SetSecretRequest struct {
+	Value interface{} `json:"value"`
+}
+
+var (
+	ErrSecretNameRequired = errors.New("secret name must be specified")
+	ErrSecretNameInvalid  = errors.New("secret name cannot contain '/'")
+	ErrSecretPipelineName = errors.New("pipeline name cannot contain '/'")
+	ErrSecretValueMissing = errors.New("secret value must be specified")
+)
+
+func (request SetSecretRequest) Validate(pipelineName string, name string) error {
+	err := ValidateSecretName(pipelineName, name)
+	if err != nil {
+		return err
+	}
+
+	if request.Value == nil {
+		return ErrSecretValueMissing
+	}
+
+	return nil
+}
+
+// ValidateSecretName checks that the secret can be found through the
+// built-in credential manager's lookup paths, which are separated by '/'.
+func ValidateSecretName(pipelineName string, name string) error {
+	if name == "" {
+		return ErrSecretNameRequired
+	}
+
+	if strings.Contains(name, "/") {
+		return ErrSecretNameInvalid
+	}
+
+	if strings.Contains(pipelineName, "/") {
+		return ErrSecretPipelineName
+	}
+
+	return nil
+}
@@ -103,6 +103,8 @@ func (validator *StepValidator) VisitTask(plan *TaskStep) error {
 		validator.popContext()
 	}
 
+	validator.validateWorkerSelector(plan.WorkerSelector)
+
 	return nil
 }
 
@@ -164,6 +166,19 @@ func (validator *StepValidator) VisitGet(step *GetStep) error {
 
 	validator.popContext()
 
+	validator.pushContext(".labels")
+
+	for _, label := range step.Labels {
+		_, err := ValidateIdentifier(label, validator.context...)
+		if err != nil {
+			validator.recordError(err.Error())
+		}
+	}
+
+	validator.popContext()
+
+	validator.validateWorkerSelector(step.WorkerSelector)
+
 	return nil
 }
 
@@ -186,6 +201,8 @@ func (validator *StepValidator) VisitPut(step *PutStep) error {
 		validator.recordError("unknown resource '%s'", resourceName)
 	}
 
+	validator.validateWorkerSelector(step.WorkerSelector)
+
 	return nil
 }
 
@@ -400,6 +417,19 @@ func (validator *StepValidator) recordWarning(warning ConfigWarning) {
 	validator.Warnings = append(validator.Warnings, warning)
 }
 
+func (validator *StepValidator) validateWorkerSelector(selector *WorkerSelector) {
+	if selector == nil {
+		return
+	}
+
+	validator.pushContext(".worker_selector")
+	defer validator.popContext()
+
+	for _, msg := range selector.Validate() {
+		validator.recordError(msg)
+	}
+}
+
 func (validator *StepValidator) recordError(message string, args ...interface{}) {
 	validator.Errors = append(validator.Errors, validator.annotate(fmt.Sprintf(message, args...)))
 }
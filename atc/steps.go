@@ -294,8 +294,11 @@ type GetStep struct {
 	Params   Params         `json:"params,omitempty"`
 	Passed   []string       `json:"passed,omitempty"`
 	Trigger  bool           `json:"trigger,omitempty"`
+	Labels   []string       `json:"labels,omitempty"`
 	Tags     Tags           `json:"tags,omitempty"`
 	Timeout  string         `json:"timeout,omitempty"`
+
+	WorkerSelector *WorkerSelector `json:"worker_selector,omitempty"`
 }
 
 func (step *GetStep) ResourceName() string {
@@ -318,6 +321,8 @@ type PutStep struct {
 	Tags      Tags          `json:"tags,omitempty"`
 	GetParams Params        `json:"get_params,omitempty"`
 	Timeout   string        `json:"timeout,omitempty"`
+
+	WorkerSelector *WorkerSelector `json:"worker_selector,omitempty"`
 }
 
 func (step *PutStep) ResourceName() string {
@@ -344,6 +349,8 @@ type TaskStep struct {
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 	Timeout           string            `json:"timeout,omitempty"`
+	WorkerSelector    *WorkerSelector   `json:"worker_selector,omitempty"`
+	RuntimeClass      string            `json:"runtime_class,omitempty"`
 }
 
 func (step *TaskStep) Visit(v StepVisitor) error {
@@ -103,6 +103,47 @@ var factoryTests = []StepTest{
 			Timeout:           "1h",
 		},
 	},
+	{
+		Title: "task step with worker selector",
+
+		ConfigYAML: `
+			task: some-task
+			file: some-task-file
+			worker_selector:
+			  required:
+			  - {key: arch, operator: In, values: [arm64]}
+			  preferred:
+			  - {key: disk, operator: Exists}
+		`,
+
+		StepConfig: &atc.TaskStep{
+			Name:       "some-task",
+			ConfigPath: "some-task-file",
+			WorkerSelector: &atc.WorkerSelector{
+				Required: []atc.WorkerSelectorExpression{
+					{Key: "arch", Operator: atc.WorkerSelectorOperatorIn, Values: []string{"arm64"}},
+				},
+				Preferred: []atc.WorkerSelectorExpression{
+					{Key: "disk", Operator: atc.WorkerSelectorOperatorExists},
+				},
+			},
+		},
+	},
+	{
+		Title: "task step with runtime class",
+
+		ConfigYAML: `
+			task: some-task
+			file: some-task-file
+			runtime_class: gvisor
+		`,
+
+		StepConfig: &atc.TaskStep{
+			Name:         "some-task",
+			ConfigPath:   "some-task-file",
+			RuntimeClass: "gvisor",
+		},
+	},
 	{
 		Title: "task step with non-string params",
 
@@ -22,6 +22,10 @@ type TaskConfig struct {
 	// Limits to set on the Task Container
 	Limits *ContainerLimits `json:"container_limits,omitempty"`
 
+	// The runtime class to run the task's container with (e.g. a sandboxed
+	// runtime such as gVisor). Only workers advertising it are chosen.
+	RuntimeClass string `json:"runtime_class,omitempty"`
+
 	// Parameters to pass to the task via environment variables.
 	Params TaskEnv `json:"params,omitempty"`
 
@@ -37,3 +37,11 @@ func (auth TeamAuth) Validate() error {
 
 	return nil
 }
+
+// TeamCheckLimits overrides the per-team check limits configured on the web
+// node. A nil value falls back to the default; a value of zero or less
+// removes the limit for the team.
+type TeamCheckLimits struct {
+	ChecksPerSecond    *float64 `json:"checks_per_second,omitempty"`
+	MaxCheckContainers *int     `json:"max_check_containers,omitempty"`
+}
@@ -0,0 +1,140 @@
+package atc
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// VersionSchema constrains the versions and metadata emitted by a resource
+// type. Zero values are not enforced.
+type VersionSchema struct {
+	// MaxVersionSize is the maximum size in bytes of a JSON-encoded version.
+	MaxVersionSize int `json:"max_version_size,omitempty"`
+
+	// MaxMetadataSize is the maximum size in bytes of the JSON-encoded
+	// metadata of a version.
+	MaxMetadataSize int `json:"max_metadata_size,omitempty"`
+
+	// RequiredKeys must be present in every version.
+	RequiredKeys []string `json:"required_keys,omitempty"`
+
+	// AllowedKeys, if set, are the only keys a version may have. Required
+	// keys are always allowed.
+	AllowedKeys []string `json:"allowed_keys,omitempty"`
+}
+
+func (schema VersionSchema) Validate() error {
+	var errorMessages []string
+
+	if schema.MaxVersionSize < 0 {
+		errorMessages = append(errorMessages, "max_version_size must not be negative")
+	}
+
+	if schema.MaxMetadataSize < 0 {
+		errorMessages = append(errorMessages, "max_metadata_size must not be negative")
+	}
+
+	if len(errorMessages) > 0 {
+		return fmt.Errorf("%s", strings.Join(errorMessages, "; "))
+	}
+
+	return nil
+}
+
+// InvalidVersionError is returned when a resource type emits a version that
+// does not satisfy its version schema.
+type InvalidVersionError struct {
+	Version Version
+	Reason  string
+}
+
+func (err InvalidVersionError) Error() string {
+	if err.Version == nil {
+		return fmt.Sprintf("invalid version: %s", err.Reason)
+	}
+
+	version, _ := json.Marshal(err.Version)
+	return fmt.Sprintf("invalid version %s: %s", version, err.Reason)
+}
+
+// ValidateVersion checks a version emitted by a resource type against the
+// schema.
+func (schema VersionSchema) ValidateVersion(version Version) error {
+	if schema.MaxVersionSize > 0 {
+		payload, err := json.Marshal(version)
+		if err != nil {
+			return err
+		}
+
+		if len(payload) > schema.MaxVersionSize {
+			return InvalidVersionError{
+				Version: version,
+				Reason:  fmt.Sprintf("size of %d bytes exceeds the maximum of %d bytes", len(payload), schema.MaxVersionSize),
+			}
+		}
+	}
+
+	var missing []string
+	for _, key := range schema.RequiredKeys {
+		if _, found := version[key]; !found {
+			missing = append(missing, key)
+		}
+	}
+
+	if len(missing) > 0 {
+		return InvalidVersionError{
+			Version: version,
+			Reason:  fmt.Sprintf("missing required keys: %s", strings.Join(missing, ", ")),
+		}
+	}
+
+	if len(schema.AllowedKeys) > 0 {
+		allowed := map[string]bool{}
+		for _, key := range schema.AllowedKeys {
+			allowed[key] = true
+		}
+
+		for _, key := range schema.RequiredKeys {
+			allowed[key] = true
+		}
+
+		var unexpected []string
+		for key := range version {
+			if !allowed[key] {
+				unexpected = append(unexpected, key)
+			}
+		}
+
+		if len(unexpected) > 0 {
+			sort.Strings(unexpected)
+
+			return InvalidVersionError{
+				Version: version,
+				Reason:  fmt.Sprintf("keys not allowed: %s", strings.Join(unexpected, ", ")),
+			}
+		}
+	}
+
+	return nil
+}
+
+// ValidateMetadata checks the metadata of a version emitted by a resource
+// type against the schema.
+func (schema VersionSchema) ValidateMetadata(metadata []MetadataField) error {
+	if schema.MaxMetadataSize == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	if len(payload) > schema.MaxMetadataSize {
+		return fmt.Errorf("metadata size of %d bytes exceeds the maximum of %d bytes", len(payload), schema.MaxMetadataSize)
+	}
+
+	return nil
+}
@@ -4,6 +4,9 @@ type VersionedResourceType struct {
 	ResourceType
 
 	Version Version `json:"version"`
+
+	Pinned           bool    `json:"pinned,omitempty"`
+	CandidateVersion Version `json:"candidate_version,omitempty"`
 }
 
 type VersionedResourceTypes []VersionedResourceType
@@ -20,16 +20,29 @@ type Worker struct {
 	ActiveVolumes    int `json:"active_volumes"`
 	ActiveTasks      int `json:"active_tasks"`
 
+	Resources *WorkerResources `json:"resources,omitempty"`
+
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
-	Platform  string   `json:"platform"`
-	Tags      []string `json:"tags"`
-	Team      string   `json:"team"`
-	Name      string   `json:"name"`
-	Version   string   `json:"version"`
-	StartTime int64    `json:"start_time"`
-	Ephemeral bool     `json:"ephemeral"`
-	State     string   `json:"state"`
+	// RuntimeClasses are the runtime classes task steps can request to be run
+	// with on the worker.
+	RuntimeClasses []string `json:"runtime_classes,omitempty"`
+
+	// EnforcesEgressPolicies is whether the worker's runtime enforces egress
+	// policies on its containers.
+	EnforcesEgressPolicies bool `json:"enforces_egress_policies,omitempty"`
+
+	Platform  string            `json:"platform"`
+	Tags      []string          `json:"tags"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Team      string            `json:"team"`
+	Name      string            `json:"name"`
+	Version   string            `json:"version"`
+	StartTime int64             `json:"start_time"`
+	Ephemeral bool              `json:"ephemeral"`
+	State     string            `json:"state"`
+
+	Maintenance *WorkerMaintenance `json:"maintenance,omitempty"`
 }
 
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
@@ -48,6 +61,34 @@ func (w Worker) Validate() error {
 	return nil
 }
 
+// WorkerResources describes the headroom of a worker, and the traffic denied
+// by the egress policies of its containers, as last reported by the worker
+// itself.
+type WorkerResources struct {
+	MemoryFree  uint64 `json:"memory_free"`
+	MemoryTotal uint64 `json:"memory_total"`
+
+	// CPULoad is the one minute load average divided by the number of CPUs.
+	CPULoad float64 `json:"cpu_load"`
+
+	// DiskFree and DiskTotal describe the filesystem of the work dir.
+	DiskFree  uint64 `json:"disk_free"`
+	DiskTotal uint64 `json:"disk_total"`
+
+	// InodesFree and InodesTotal describe the inodes of the work dir.
+	InodesFree  uint64 `json:"inodes_free,omitempty"`
+	InodesTotal uint64 `json:"inodes_total,omitempty"`
+
+	// EgressPacketsDenied is the number of packets the egress policies of the
+	// worker's containers have denied since the worker started.
+	EgressPacketsDenied uint64 `json:"egress_packets_denied,omitempty"`
+
+	// Pressure lists the resources which have fallen below the worker's
+	// configured thresholds, e.g. "disk", "inodes" or "memory". A worker
+	// under pressure is degraded and receives no new containers.
+	Pressure []string `json:"pressure,omitempty"`
+}
+
 type WorkerResourceType struct {
 	Type                 string `json:"type"`
 	Image                string `json:"image"`
@@ -29,6 +29,7 @@ type artifactSourcer struct {
 	volumeFinder        VolumeFinder
 	enableP2PStreaming  bool
 	p2pStreamingTimeout time.Duration
+	chunking            StreamChunking
 }
 
 func NewArtifactSourcer(
@@ -36,12 +37,14 @@ func NewArtifactSourcer(
 	volumeFinder VolumeFinder,
 	enableP2PStreaming bool,
 	p2pStreamingTimeout time.Duration,
+	chunking StreamChunking,
 ) ArtifactSourcer {
 	return artifactSourcer{
 		compression:         compression,
 		volumeFinder:        volumeFinder,
 		enableP2PStreaming:  enableP2PStreaming,
 		p2pStreamingTimeout: p2pStreamingTimeout,
+		chunking:            chunking,
 	}
 }
 
@@ -62,7 +65,7 @@ func (w artifactSourcer) SourceInputsAndCaches(logger lager.Logger, teamID int,
 				return nil, fmt.Errorf("volume not found for artifact id %v type %T", artifact.ID(), artifact)
 			}
 
-			source := NewStreamableArtifactSource(artifact, artifactVolume, w.compression, w.enableP2PStreaming, w.p2pStreamingTimeout)
+			source := NewStreamableArtifactSource(artifact, artifactVolume, w.compression, w.enableP2PStreaming, w.p2pStreamingTimeout, w.chunking)
 			inputs = append(inputs, inputSource{source, path})
 		}
 	}
@@ -79,7 +82,7 @@ func (w artifactSourcer) SourceImage(logger lager.Logger, imageArtifact runtime.
 		return nil, fmt.Errorf("volume not found for artifact id %v type %T", imageArtifact.ID(), imageArtifact)
 	}
 
-	return NewStreamableArtifactSource(imageArtifact, artifactVolume, w.compression, w.enableP2PStreaming, w.p2pStreamingTimeout), nil
+	return NewStreamableArtifactSource(imageArtifact, artifactVolume, w.compression, w.enableP2PStreaming, w.p2pStreamingTimeout, w.chunking), nil
 }
 
 //go:generate counterfeiter . ArtifactSource
@@ -113,6 +116,7 @@ type artifactSource struct {
 	compression         compression.Compression
 	enabledP2pStreaming bool
 	p2pStreamingTimeout time.Duration
+	chunking            StreamChunking
 }
 
 func NewStreamableArtifactSource(
@@ -121,6 +125,7 @@ func NewStreamableArtifactSource(
 	compression compression.Compression,
 	enabledP2pStreaming bool,
 	p2pStreamingTimeout time.Duration,
+	chunking StreamChunking,
 ) StreamableArtifactSource {
 	return &artifactSource{
 		artifact:            artifact,
@@ -128,6 +133,7 @@ func NewStreamableArtifactSource(
 		compression:         compression,
 		enabledP2pStreaming: enabledP2pStreaming,
 		p2pStreamingTimeout: p2pStreamingTimeout,
+		chunking:            chunking,
 	}
 }
 
@@ -142,16 +148,44 @@ func (source *artifactSource) StreamTo(
 	ctx, span := tracing.StartSpan(ctx, "artifactSource.StreamTo", nil)
 	defer span.End()
 
+	comp := source.compression
+	selector, selecting := source.compression.(CompressionSelector)
+	if selecting {
+		comp = selector.ForStream(logger, source.volume, destination)
+	}
+
+	logger = logger.WithData(lager.Data{"encoding": comp.Encoding()})
+
+	start := time.Now()
+
+	var stats StreamStats
 	var err error
-	if !source.enabledP2pStreaming {
-		err = source.streamTo(ctx, destination)
+	if source.enabledP2pStreaming {
+		err = source.p2pStreamTo(ctx, comp, destination)
+	} else if source.chunking.Enabled() {
+		stats, err = source.chunkedStreamTo(ctx, logger, comp, destination)
 	} else {
-		err = source.p2pStreamTo(ctx, destination)
+		stats, err = source.streamTo(ctx, comp, destination)
 	}
 
 	// Inc counter if no error occurred.
 	if err == nil {
 		metric.Metrics.VolumesStreamed.Inc()
+
+		stats.SourceWorker = source.volume.WorkerName()
+		stats.Duration = time.Since(start)
+
+		logger.Info("streamed", lager.Data{
+			"bytes":    stats.Bytes,
+			"duration": stats.Duration.String(),
+			"retries":  stats.Retries,
+		})
+
+		reportStream(ctx, stats)
+
+		if selecting {
+			selector.Streamed(logger, source.volume, stats)
+		}
 	}
 
 	return err
@@ -159,27 +193,65 @@ func (source *artifactSource) StreamTo(
 
 func (source *artifactSource) streamTo(
 	ctx context.Context,
+	comp compression.Compression,
 	destination ArtifactDestination,
-) error {
+) (StreamStats, error) {
 	_, outSpan := tracing.StartSpan(ctx, "volume.StreamOut", tracing.Attrs{
 		"origin-volume": source.volume.Handle(),
 		"origin-worker": source.volume.WorkerName(),
 	})
 	defer outSpan.End()
-	out, err := source.volume.StreamOut(ctx, ".", source.compression.Encoding())
+	out, err := source.volume.StreamOut(ctx, ".", comp.Encoding())
 
 	if err != nil {
 		tracing.End(outSpan, err)
-		return err
+		return StreamStats{}, err
 	}
 
 	defer out.Close()
 
-	return destination.StreamIn(ctx, ".", source.compression.Encoding(), out)
+	counter := &countingReader{reader: out}
+
+	err = destination.StreamIn(ctx, ".", comp.Encoding(), counter)
+	if err != nil {
+		return StreamStats{}, err
+	}
+
+	return StreamStats{Bytes: counter.count}, nil
+}
+
+func (source *artifactSource) chunkedStreamTo(
+	ctx context.Context,
+	logger lager.Logger,
+	comp compression.Compression,
+	destination ArtifactDestination,
+) (StreamStats, error) {
+	ctx, span := tracing.StartSpan(ctx, "volume.ChunkedStreamOut", tracing.Attrs{
+		"origin-volume": source.volume.Handle(),
+		"origin-worker": source.volume.WorkerName(),
+	})
+	defer span.End()
+
+	relay := &chunkedRelay{
+		logger:      logger,
+		source:      source.volume,
+		destination: destination,
+		compression: comp,
+		chunking:    source.chunking,
+	}
+
+	err := relay.run(ctx)
+	if err != nil {
+		tracing.End(span, err)
+		return StreamStats{}, err
+	}
+
+	return relay.stats, nil
 }
 
 func (source *artifactSource) p2pStreamTo(
 	ctx context.Context,
+	comp compression.Compression,
 	destination ArtifactDestination,
 ) error {
 	getCtx, getCancel := context.WithTimeout(ctx, 5*time.Second)
@@ -204,7 +276,7 @@ func (source *artifactSource) p2pStreamTo(
 
 	putCtx, putCancel := context.WithTimeout(ctx, source.p2pStreamingTimeout)
 	defer putCancel()
-	return source.volume.StreamP2pOut(putCtx, ".", streamInUrl, source.compression.Encoding())
+	return source.volume.StreamP2pOut(putCtx, ".", streamInUrl, comp.Encoding())
 }
 
 func (source *artifactSource) StreamFile(
@@ -269,6 +341,17 @@ func (source *cacheArtifactSource) ExistsOn(logger lager.Logger, worker Worker)
 	return worker.FindVolumeForTaskCache(logger, source.TeamID, source.JobID, source.StepName, source.Path)
 }
 
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (counter *countingReader) Read(p []byte) (int, error) {
+	n, err := counter.reader.Read(p)
+	counter.count += int64(n)
+	return n, err
+}
+
 type fileReadMultiCloser struct {
 	reader  io.Reader
 	closers []io.Closer
@@ -2,12 +2,15 @@ package worker_test
 
 import (
 	"archive/tar"
+	"bytes"
 	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"math/rand"
+	"testing/iotest"
 	"time"
 
 	"code.cloudfoundry.org/lager"
@@ -44,7 +47,7 @@ var _ = Describe("ArtifactSourcer", func() {
 			"image": newVolumeWithContent(content{".": []byte("image content")}),
 		}}
 
-		sourcer := worker.NewArtifactSourcer(fakeCompression, vf, false, 0)
+		sourcer := worker.NewArtifactSourcer(fakeCompression, vf, false, 0, worker.StreamChunking{})
 		source, err := sourcer.SourceImage(logger, artifact)
 		Expect(err).ToNot(HaveOccurred())
 
@@ -82,7 +85,7 @@ var _ = Describe("ArtifactSourcer", func() {
 			"output": newVolumeWithContent(content{".": []byte("output")})},
 		}
 
-		sourcer := worker.NewArtifactSourcer(fakeCompression, vf, false, 0)
+		sourcer := worker.NewArtifactSourcer(fakeCompression, vf, false, 0, worker.StreamChunking{})
 		inputSources, err := sourcer.SourceInputsAndCaches(logger, 0, inputs)
 		Expect(err).ToNot(HaveOccurred())
 
@@ -107,6 +110,7 @@ var _ = Describe("StreamableArtifactSource", func() {
 
 		enabledP2pStreaming bool
 		p2pStreamingTimeout time.Duration
+		chunking            worker.StreamChunking
 
 		artifactSource worker.StreamableArtifactSource
 		comp           compression.Compression
@@ -123,20 +127,30 @@ var _ = Describe("StreamableArtifactSource", func() {
 
 		enabledP2pStreaming = false
 		p2pStreamingTimeout = 15 * time.Minute
+		chunking = worker.StreamChunking{}
 
 		testLogger = lager.NewLogger("test")
 		disaster = errors.New("disaster")
 	})
 
 	JustBeforeEach(func() {
-		artifactSource = worker.NewStreamableArtifactSource(fakeArtifact, fakeVolume, comp, enabledP2pStreaming, p2pStreamingTimeout)
+		artifactSource = worker.NewStreamableArtifactSource(fakeArtifact, fakeVolume, comp, enabledP2pStreaming, p2pStreamingTimeout, chunking)
 	})
 
 	Context("StreamTo", func() {
-		var streamToErr error
+		var (
+			streamToErr error
+			reporter    *recordingReporter
+		)
+
+		BeforeEach(func() {
+			reporter = new(recordingReporter)
+			fakeVolume.WorkerNameReturns("source-worker")
+		})
 
 		JustBeforeEach(func() {
-			streamToErr = artifactSource.StreamTo(context.TODO(), fakeDestination)
+			ctx := worker.WithStreamReporter(context.TODO(), reporter)
+			streamToErr = artifactSource.StreamTo(ctx, fakeDestination)
 		})
 
 		Context("via atc", func() {
@@ -144,10 +158,20 @@ var _ = Describe("StreamableArtifactSource", func() {
 
 			BeforeEach(func() {
 				outStream = gbytes.NewBuffer()
+				outStream.Write([]byte("some-bits"))
 				fakeVolume.StreamOutReturns(outStream, nil)
 			})
 
 			Context("when ArtifactSource can successfully stream to ArtifactDestination", func() {
+				var streamedIn []byte
+
+				BeforeEach(func() {
+					fakeDestination.StreamInStub = func(_ context.Context, _ string, _ baggageclaim.Encoding, in io.Reader) error {
+						var err error
+						streamedIn, err = ioutil.ReadAll(in)
+						return err
+					}
+				})
 
 				It("calls StreamOut and StreamIn with the correct params", func() {
 					Expect(fakeVolume.StreamOutCallCount()).To(Equal(1))
@@ -156,15 +180,22 @@ var _ = Describe("StreamableArtifactSource", func() {
 					Expect(actualPath).To(Equal("."))
 					Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
 
-					_, actualPath, encoding, actualStreamedOutBits := fakeDestination.StreamInArgsForCall(0)
+					_, actualPath, encoding, _ = fakeDestination.StreamInArgsForCall(0)
 					Expect(actualPath).To(Equal("."))
-					Expect(actualStreamedOutBits).To(Equal(outStream))
+					Expect(streamedIn).To(Equal([]byte("some-bits")))
 					Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
 				})
 
 				It("does not return an err", func() {
 					Expect(streamToErr).ToNot(HaveOccurred())
 				})
+
+				It("reports the stream", func() {
+					Expect(reporter.reported).To(HaveLen(1))
+					Expect(reporter.reported[0].SourceWorker).To(Equal("source-worker"))
+					Expect(reporter.reported[0].Bytes).To(Equal(int64(len("some-bits"))))
+					Expect(reporter.reported[0].Retries).To(BeZero())
+				})
 			})
 
 			Context("when streaming out of source fails ", func() {
@@ -186,6 +217,208 @@ var _ = Describe("StreamableArtifactSource", func() {
 				It("closes the streamOut io.reader", func() {
 					Expect(outStream.Closed()).To(BeTrue())
 				})
+
+				It("does not report the stream", func() {
+					Expect(reporter.reported).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("in chunks", func() {
+			var (
+				tgz       []byte
+				delivered map[string][]byte
+			)
+
+			BeforeEach(func() {
+				chunking = worker.StreamChunking{ChunkSize: 8000, MaxRetries: 2}
+
+				// random contents so that the compressed offsets roughly match
+				// the uncompressed ones
+				random := rand.New(rand.NewSource(42))
+				files := map[string][]byte{}
+				for _, name := range []string{"file-1", "file-2", "file-3"} {
+					content := make([]byte, 4096)
+					random.Read(content)
+					files[name] = content
+				}
+
+				tgz = buildTgz(files, "file-1", "file-2", "file-3")
+
+				fakeVolume.StreamOutStub = func(context.Context, string, baggageclaim.Encoding) (io.ReadCloser, error) {
+					return ioutil.NopCloser(bytes.NewReader(tgz)), nil
+				}
+
+				delivered = map[string][]byte{}
+				fakeDestination.StreamInStub = func(_ context.Context, _ string, _ baggageclaim.Encoding, in io.Reader) error {
+					extractTgz(in, delivered)
+					return nil
+				}
+			})
+
+			It("delivers every file in chunks split at file boundaries", func() {
+				Expect(streamToErr).ToNot(HaveOccurred())
+				Expect(fakeDestination.StreamInCallCount()).To(Equal(2))
+				Expect(delivered).To(HaveLen(3))
+				Expect(delivered).To(HaveKey("file-3"))
+			})
+
+			It("reports the size of the stream", func() {
+				Expect(reporter.reported).To(HaveLen(1))
+				Expect(reporter.reported[0].Bytes).To(BeNumerically(">", 3*4096))
+				Expect(reporter.reported[0].Retries).To(BeZero())
+			})
+
+			Context("when the destination does not consume a whole chunk", func() {
+				BeforeEach(func() {
+					fakeDestination.StreamInStub = func(_ context.Context, _ string, _ baggageclaim.Encoding, in io.Reader) error {
+						if fakeDestination.StreamInCallCount() == 1 {
+							_, err := io.CopyN(ioutil.Discard, in, 100)
+							return err
+						}
+
+						extractTgz(in, delivered)
+						return nil
+					}
+				})
+
+				It("resends the chunk", func() {
+					Expect(streamToErr).ToNot(HaveOccurred())
+					Expect(fakeDestination.StreamInCallCount()).To(Equal(3))
+					Expect(delivered).To(HaveLen(3))
+					Expect(reporter.reported[0].Retries).To(Equal(1))
+				})
+			})
+
+			Context("when a chunk is corrupted on its way to the destination", func() {
+				BeforeEach(func() {
+					fakeDestination.StreamInStub = func(_ context.Context, _ string, _ baggageclaim.Encoding, in io.Reader) error {
+						chunk, err := ioutil.ReadAll(in)
+						if err != nil {
+							return err
+						}
+
+						if fakeDestination.StreamInCallCount() == 1 {
+							// flip a byte of the checksum in the gzip trailer
+							chunk[len(chunk)-8] ^= 0xff
+						}
+
+						return extractVerifiedTgz(comp, chunk, delivered)
+					}
+				})
+
+				It("rejects the chunk and resends it", func() {
+					Expect(streamToErr).ToNot(HaveOccurred())
+					Expect(fakeDestination.StreamInCallCount()).To(Equal(3))
+					Expect(delivered).To(HaveLen(3))
+					Expect(reporter.reported[0].Retries).To(Equal(1))
+				})
+			})
+
+			Context("when the stream out of the source is corrupted", func() {
+				BeforeEach(func() {
+					corrupted := append([]byte{}, tgz...)
+					corrupted[len(corrupted)-8] ^= 0xff
+
+					fakeVolume.StreamOutStub = func(context.Context, string, baggageclaim.Encoding) (io.ReadCloser, error) {
+						return ioutil.NopCloser(bytes.NewReader(corrupted)), nil
+					}
+				})
+
+				It("fails the stream without resuming it", func() {
+					Expect(errors.Is(streamToErr, compression.ErrChecksumMismatch)).To(BeTrue())
+					Expect(fakeVolume.StreamOutCallCount()).To(Equal(1))
+				})
+
+				It("does not report the stream", func() {
+					Expect(reporter.reported).To(BeEmpty())
+				})
+			})
+
+			Context("when the destination keeps failing", func() {
+				BeforeEach(func() {
+					fakeDestination.StreamInStub = nil
+					fakeDestination.StreamInReturns(disaster)
+				})
+
+				It("gives up after the retries", func() {
+					Expect(streamToErr).To(Equal(disaster))
+					Expect(fakeDestination.StreamInCallCount()).To(Equal(3))
+					Expect(fakeVolume.StreamOutCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the source fails part way through", func() {
+				BeforeEach(func() {
+					fakeVolume.StreamOutStub = func(context.Context, string, baggageclaim.Encoding) (io.ReadCloser, error) {
+						if fakeVolume.StreamOutCallCount() == 1 {
+							return ioutil.NopCloser(io.MultiReader(
+								bytes.NewReader(tgz[:11000]),
+								iotest.ErrReader(disaster),
+							)), nil
+						}
+
+						return ioutil.NopCloser(bytes.NewReader(tgz)), nil
+					}
+				})
+
+				It("resumes after the files already delivered", func() {
+					Expect(streamToErr).ToNot(HaveOccurred())
+					Expect(fakeVolume.StreamOutCallCount()).To(Equal(2))
+					Expect(fakeDestination.StreamInCallCount()).To(Equal(2))
+					Expect(delivered).To(HaveLen(3))
+					Expect(reporter.reported[0].Retries).To(Equal(1))
+				})
+			})
+
+			Context("when the source keeps failing", func() {
+				BeforeEach(func() {
+					fakeVolume.StreamOutStub = nil
+					fakeVolume.StreamOutReturns(nil, disaster)
+				})
+
+				It("gives up after the retries", func() {
+					Expect(streamToErr).To(Equal(disaster))
+					Expect(fakeVolume.StreamOutCallCount()).To(Equal(3))
+				})
+			})
+		})
+
+		Context("when the compression is picked per stream", func() {
+			var fakeSelector *workerfakes.FakeCompressionSelector
+
+			BeforeEach(func() {
+				fakeSelector = new(workerfakes.FakeCompressionSelector)
+				fakeSelector.ForStreamReturns(compression.NewZstdCompression())
+				comp = fakeSelector
+
+				fakeVolume.StreamOutReturns(ioutil.NopCloser(bytes.NewReader([]byte("some-bits"))), nil)
+				fakeDestination.StreamInStub = func(_ context.Context, _ string, _ baggageclaim.Encoding, in io.Reader) error {
+					_, err := io.Copy(ioutil.Discard, in)
+					return err
+				}
+			})
+
+			It("streams with the picked compression", func() {
+				Expect(streamToErr).ToNot(HaveOccurred())
+
+				_, source, destination := fakeSelector.ForStreamArgsForCall(0)
+				Expect(source).To(Equal(fakeVolume))
+				Expect(destination).To(Equal(fakeDestination))
+
+				_, _, encoding := fakeVolume.StreamOutArgsForCall(0)
+				Expect(encoding).To(Equal(baggageclaim.ZstdEncoding))
+
+				_, _, encoding, _ = fakeDestination.StreamInArgsForCall(0)
+				Expect(encoding).To(Equal(baggageclaim.ZstdEncoding))
+			})
+
+			It("tells the selector about the stream", func() {
+				Expect(fakeSelector.StreamedCallCount()).To(Equal(1))
+
+				_, source, stats := fakeSelector.StreamedArgsForCall(0)
+				Expect(source).To(Equal(fakeVolume))
+				Expect(stats.Bytes).To(Equal(int64(len("some-bits"))))
 			})
 		})
 
@@ -454,3 +687,97 @@ var _ = Describe("CacheArtifactSource", func() {
 		})
 	})
 })
+
+func buildTgz(files map[string][]byte, names ...string) []byte {
+	buf := new(bytes.Buffer)
+
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	for _, name := range names {
+		err := tarWriter.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0644,
+			Size: int64(len(files[name])),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = tarWriter.Write(files[name])
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Expect(tarWriter.Close()).To(Succeed())
+	Expect(gzipWriter.Close()).To(Succeed())
+
+	return buf.Bytes()
+}
+
+func extractTgz(in io.Reader, files map[string][]byte) {
+	gzipReader, err := gzip.NewReader(in)
+	Expect(err).NotTo(HaveOccurred())
+
+	tarReader := tar.NewReader(gzipReader)
+
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		Expect(err).NotTo(HaveOccurred())
+
+		content, err := ioutil.ReadAll(tarReader)
+		Expect(err).NotTo(HaveOccurred())
+
+		files[header.Name] = content
+	}
+
+	_, err = io.Copy(ioutil.Discard, in)
+	Expect(err).NotTo(HaveOccurred())
+}
+
+// extractVerifiedTgz extracts the files of the compressed tarball, like
+// baggageclaim does, only keeping them if it matches its checksum.
+func extractVerifiedTgz(comp compression.Compression, tgz []byte, files map[string][]byte) error {
+	decompressed, err := comp.NewReader(ioutil.NopCloser(bytes.NewReader(tgz)))
+	if err != nil {
+		return err
+	}
+
+	tarReader := tar.NewReader(decompressed)
+
+	extracted := map[string][]byte{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		extracted[header.Name], err = ioutil.ReadAll(tarReader)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = io.Copy(ioutil.Discard, decompressed)
+	if err != nil {
+		return err
+	}
+
+	for name, content := range extracted {
+		files[name] = content
+	}
+
+	return nil
+}
+
+type recordingReporter struct {
+	reported []worker.StreamStats
+}
+
+func (reporter *recordingReporter) ReportStream(stats worker.StreamStats) {
+	reporter.reported = append(reporter.reported, stats)
+}
@@ -0,0 +1,278 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+// CacheWarmingBudget limits how much a newly registered worker is warmed.
+type CacheWarmingBudget struct {
+	// Window is how long after registering a worker is considered new.
+	Window time.Duration
+
+	// MaxCaches is the number of the most used resource caches which are
+	// considered for each worker.
+	MaxCaches int
+
+	// MaxActiveContainers pauses warming a worker once builds are running
+	// this many containers on it.
+	MaxActiveContainers int
+}
+
+// CacheWarmer is a component which streams the most used resource caches to
+// newly registered workers, so that their first builds don't all fetch the
+// same images and repositories from scratch.
+//
+// Each run warms at most one cache per worker, so that streaming never
+// competes with builds for more than one volume's worth of bandwidth. A cache
+// which fails to warm on a worker is skipped there for a while, so that one
+// broken cache does not hold up the others.
+type CacheWarmer struct {
+	provider             WorkerProvider
+	volumeFinder         VolumeFinder
+	resourceCacheFactory db.ResourceCacheFactory
+	compression          compression.Compression
+	enableP2PStreaming   bool
+	p2pStreamingTimeout  time.Duration
+	chunking             StreamChunking
+	budget               CacheWarmingBudget
+
+	failuresLock sync.Mutex
+	failures     map[cacheWarming]time.Time
+}
+
+// cacheWarmingBackoff is how long a cache which failed to warm on a worker is
+// skipped there.
+const cacheWarmingBackoff = 10 * time.Minute
+
+type cacheWarming struct {
+	workerName      string
+	resourceCacheID int
+}
+
+func NewCacheWarmer(
+	provider WorkerProvider,
+	volumeFinder VolumeFinder,
+	resourceCacheFactory db.ResourceCacheFactory,
+	compression compression.Compression,
+	enableP2PStreaming bool,
+	p2pStreamingTimeout time.Duration,
+	chunking StreamChunking,
+	budget CacheWarmingBudget,
+) *CacheWarmer {
+	return &CacheWarmer{
+		provider:             provider,
+		volumeFinder:         volumeFinder,
+		resourceCacheFactory: resourceCacheFactory,
+		compression:          compression,
+		enableP2PStreaming:   enableP2PStreaming,
+		p2pStreamingTimeout:  p2pStreamingTimeout,
+		chunking:             chunking,
+		budget:               budget,
+
+		failures: map[cacheWarming]time.Time{},
+	}
+}
+
+func (warmer *CacheWarmer) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("cache-warmer")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	workers, err := warmer.provider.RunningWorkers(logger)
+	if err != nil {
+		logger.Error("failed-to-get-running-workers", err)
+		return err
+	}
+
+	warmer.forgetFailures()
+
+	wg := new(sync.WaitGroup)
+	for _, worker := range workers {
+		if worker.Uptime() > warmer.budget.Window {
+			continue
+		}
+
+		if warmer.budget.MaxActiveContainers > 0 && worker.ActiveContainers() >= warmer.budget.MaxActiveContainers {
+			logger.Debug("worker-busy", lager.Data{"worker": worker.Name()})
+			continue
+		}
+
+		wg.Add(1)
+		go func(worker Worker) {
+			defer wg.Done()
+
+			wLog := logger.Session("warm", lager.Data{"worker": worker.Name()})
+
+			err := warmer.warm(lagerctx.NewContext(ctx, wLog), wLog, worker)
+			if err != nil {
+				wLog.Error("failed-to-warm-cache", err)
+			}
+		}(worker)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (warmer *CacheWarmer) warm(ctx context.Context, logger lager.Logger, worker Worker) error {
+	caches, err := warmer.resourceCacheFactory.FindResourceCachesToWarm(worker.Name(), warmer.budget.MaxCaches)
+	if err != nil {
+		return err
+	}
+
+	if len(caches) == 0 {
+		return nil
+	}
+
+	warmable, found := warmer.nextCache(worker.Name(), caches)
+	if !found {
+		logger.Debug("all-caches-recently-failed")
+		return nil
+	}
+
+	logger = logger.WithData(lager.Data{
+		"resource-cache": warmable.ResourceCacheID,
+		"source-worker":  warmable.SourceWorkerName,
+		"source-volume":  warmable.SourceVolumeHandle,
+	})
+
+	resourceCache, found, err := warmer.resourceCacheFactory.FindResourceCacheByID(warmable.ResourceCacheID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		logger.Debug("resource-cache-disappeared")
+		return nil
+	}
+
+	source, found, err := warmer.volumeFinder.FindVolume(logger, 0, warmable.SourceVolumeHandle)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		logger.Debug("source-volume-disappeared")
+		return nil
+	}
+
+	err = warmer.stream(ctx, logger, worker, source, resourceCache)
+	if err != nil {
+		warmer.recordFailure(worker.Name(), warmable.ResourceCacheID)
+		return err
+	}
+
+	metric.Metrics.ResourceCachesWarmed.Inc()
+
+	logger.Info("warmed")
+
+	return nil
+}
+
+func (warmer *CacheWarmer) stream(
+	ctx context.Context,
+	logger lager.Logger,
+	worker Worker,
+	source Volume,
+	resourceCache db.UsedResourceCache,
+) error {
+	volume, err := worker.CreateVolume(
+		logger,
+		VolumeSpec{Strategy: baggageclaim.EmptyStrategy{}},
+		0,
+		db.VolumeTypeResource,
+	)
+	if err != nil {
+		return err
+	}
+
+	// hold on to the volume while it is being streamed; it would otherwise be
+	// garbage collected as an orphan before it becomes a resource cache
+	artifact, err := volume.InitializeArtifact("", 0)
+	if err != nil {
+		destroyWarmingVolume(logger, volume, nil)
+		return err
+	}
+
+	logger.Info("streaming")
+
+	err = NewStreamableArtifactSource(
+		runtime.GetArtifact{VolumeHandle: source.Handle()},
+		source,
+		warmer.compression,
+		warmer.enableP2PStreaming,
+		warmer.p2pStreamingTimeout,
+		warmer.chunking,
+	).StreamTo(ctx, volume)
+	if err != nil {
+		destroyWarmingVolume(logger, volume, artifact)
+		return err
+	}
+
+	err = volume.InitializeResourceCache(resourceCache)
+	if err != nil {
+		destroyWarmingVolume(logger, volume, artifact)
+		return err
+	}
+
+	return nil
+}
+
+// destroyWarmingVolume gets rid of a volume which failed to warm rather than
+// leaving it to take up space until its artifact expires.
+func destroyWarmingVolume(logger lager.Logger, volume Volume, artifact db.WorkerArtifact) {
+	if artifact != nil {
+		err := artifact.Destroy()
+		if err != nil {
+			logger.Error("failed-to-destroy-artifact", err)
+		}
+	}
+
+	err := volume.Destroy()
+	if err != nil {
+		logger.Error("failed-to-destroy-volume", err)
+	}
+}
+
+func (warmer *CacheWarmer) nextCache(workerName string, caches []db.WarmableResourceCache) (db.WarmableResourceCache, bool) {
+	warmer.failuresLock.Lock()
+	defer warmer.failuresLock.Unlock()
+
+	for _, cache := range caches {
+		_, failed := warmer.failures[cacheWarming{workerName, cache.ResourceCacheID}]
+		if !failed {
+			return cache, true
+		}
+	}
+
+	return db.WarmableResourceCache{}, false
+}
+
+func (warmer *CacheWarmer) recordFailure(workerName string, resourceCacheID int) {
+	warmer.failuresLock.Lock()
+	warmer.failures[cacheWarming{workerName, resourceCacheID}] = time.Now()
+	warmer.failuresLock.Unlock()
+}
+
+func (warmer *CacheWarmer) forgetFailures() {
+	warmer.failuresLock.Lock()
+	defer warmer.failuresLock.Unlock()
+
+	for warming, failedAt := range warmer.failures {
+		if time.Since(failedAt) >= cacheWarmingBackoff {
+			delete(warmer.failures, warming)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/compression/compressionfakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CacheWarmer", func() {
+	var (
+		fakeProvider             *workerfakes.FakeWorkerProvider
+		fakeVolumeFinder         *workerfakes.FakeVolumeFinder
+		fakeResourceCacheFactory *dbfakes.FakeResourceCacheFactory
+
+		newWorker    *workerfakes.FakeWorker
+		oldWorker    *workerfakes.FakeWorker
+		sourceVolume *workerfakes.FakeVolume
+		newVolume    *workerfakes.FakeVolume
+		cache        *dbfakes.FakeUsedResourceCache
+		artifact     *dbfakes.FakeWorkerArtifact
+
+		budget worker.CacheWarmingBudget
+
+		warmer *worker.CacheWarmer
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeProvider = new(workerfakes.FakeWorkerProvider)
+		fakeVolumeFinder = new(workerfakes.FakeVolumeFinder)
+		fakeResourceCacheFactory = new(dbfakes.FakeResourceCacheFactory)
+
+		newWorker = new(workerfakes.FakeWorker)
+		newWorker.NameReturns("new-worker")
+		newWorker.UptimeReturns(time.Minute)
+
+		oldWorker = new(workerfakes.FakeWorker)
+		oldWorker.NameReturns("old-worker")
+		oldWorker.UptimeReturns(time.Hour)
+
+		fakeProvider.RunningWorkersReturns([]worker.Worker{newWorker, oldWorker}, nil)
+
+		fakeResourceCacheFactory.FindResourceCachesToWarmReturns([]db.WarmableResourceCache{
+			{ResourceCacheID: 42, Uses: 3, SourceWorkerName: "old-worker", SourceVolumeHandle: "source-handle"},
+			{ResourceCacheID: 43, Uses: 1, SourceWorkerName: "old-worker", SourceVolumeHandle: "other-handle"},
+		}, nil)
+
+		cache = new(dbfakes.FakeUsedResourceCache)
+		cache.IDReturns(42)
+		fakeResourceCacheFactory.FindResourceCacheByIDReturns(cache, true, nil)
+
+		artifact = new(dbfakes.FakeWorkerArtifact)
+
+		sourceVolume = new(workerfakes.FakeVolume)
+		sourceVolume.HandleReturns("source-handle")
+		sourceVolume.StreamOutReturns(ioutil.NopCloser(strings.NewReader("some-cache")), nil)
+		fakeVolumeFinder.FindVolumeReturns(sourceVolume, true, nil)
+
+		newVolume = new(workerfakes.FakeVolume)
+		newVolume.InitializeArtifactReturns(artifact, nil)
+		newWorker.CreateVolumeReturns(newVolume, nil)
+
+		budget = worker.CacheWarmingBudget{
+			Window:              10 * time.Minute,
+			MaxCaches:           5,
+			MaxActiveContainers: 3,
+		}
+	})
+
+	JustBeforeEach(func() {
+		warmer = worker.NewCacheWarmer(
+			fakeProvider,
+			fakeVolumeFinder,
+			fakeResourceCacheFactory,
+			new(compressionfakes.FakeCompression),
+			false,
+			time.Minute,
+			worker.StreamChunking{},
+			budget,
+		)
+
+		runErr = warmer.Run(lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test")))
+	})
+
+	It("succeeds", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+	})
+
+	It("only warms workers which registered within the window", func() {
+		Expect(fakeResourceCacheFactory.FindResourceCachesToWarmCallCount()).To(Equal(1))
+
+		workerName, limit := fakeResourceCacheFactory.FindResourceCachesToWarmArgsForCall(0)
+		Expect(workerName).To(Equal("new-worker"))
+		Expect(limit).To(Equal(5))
+
+		Expect(oldWorker.CreateVolumeCallCount()).To(BeZero())
+	})
+
+	It("streams the hottest cache from its source volume", func() {
+		Expect(fakeResourceCacheFactory.FindResourceCacheByIDArgsForCall(0)).To(Equal(42))
+
+		_, teamID, handle := fakeVolumeFinder.FindVolumeArgsForCall(0)
+		Expect(teamID).To(Equal(0))
+		Expect(handle).To(Equal("source-handle"))
+
+		Expect(newWorker.CreateVolumeCallCount()).To(Equal(1))
+		_, spec, _, volumeType := newWorker.CreateVolumeArgsForCall(0)
+		Expect(spec.Strategy).To(Equal(baggageclaim.EmptyStrategy{}))
+		Expect(volumeType).To(Equal(db.VolumeTypeResource))
+
+		Expect(sourceVolume.StreamOutCallCount()).To(Equal(1))
+		Expect(newVolume.StreamInCallCount()).To(Equal(1))
+	})
+
+	It("holds on to the volume while streaming and then initializes the cache", func() {
+		Expect(newVolume.InitializeArtifactCallCount()).To(Equal(1))
+		Expect(newVolume.InitializeResourceCacheCallCount()).To(Equal(1))
+		Expect(newVolume.InitializeResourceCacheArgsForCall(0)).To(Equal(cache))
+	})
+
+	Context("when the new worker is busy with builds", func() {
+		BeforeEach(func() {
+			newWorker.ActiveContainersReturns(3)
+		})
+
+		It("does not warm it", func() {
+			Expect(fakeResourceCacheFactory.FindResourceCachesToWarmCallCount()).To(BeZero())
+			Expect(newWorker.CreateVolumeCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when there is nothing to warm", func() {
+		BeforeEach(func() {
+			fakeResourceCacheFactory.FindResourceCachesToWarmReturns(nil, nil)
+		})
+
+		It("does not create a volume", func() {
+			Expect(newWorker.CreateVolumeCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the source volume has disappeared", func() {
+		BeforeEach(func() {
+			fakeVolumeFinder.FindVolumeReturns(nil, false, nil)
+		})
+
+		It("does not create a volume", func() {
+			Expect(newWorker.CreateVolumeCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when streaming fails", func() {
+		BeforeEach(func() {
+			newVolume.StreamInReturns(errors.New("nope"))
+		})
+
+		It("does not initialize the cache", func() {
+			Expect(newVolume.InitializeResourceCacheCallCount()).To(BeZero())
+		})
+
+		It("destroys the volume and its artifact", func() {
+			Expect(artifact.DestroyCallCount()).To(Equal(1))
+			Expect(newVolume.DestroyCallCount()).To(Equal(1))
+		})
+
+		It("does not fail the component", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+		})
+
+		Context("when the warmer runs again", func() {
+			JustBeforeEach(func() {
+				sourceVolume.StreamOutReturns(ioutil.NopCloser(strings.NewReader("some-cache")), nil)
+
+				err := warmer.Run(lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test")))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("skips the cache which failed and warms the next one", func() {
+				Expect(fakeResourceCacheFactory.FindResourceCacheByIDCallCount()).To(Equal(2))
+				Expect(fakeResourceCacheFactory.FindResourceCacheByIDArgsForCall(1)).To(Equal(43))
+
+				_, _, handle := fakeVolumeFinder.FindVolumeArgsForCall(1)
+				Expect(handle).To(Equal("other-handle"))
+			})
+
+			Context("when every cache has failed", func() {
+				BeforeEach(func() {
+					fakeResourceCacheFactory.FindResourceCachesToWarmReturns([]db.WarmableResourceCache{
+						{ResourceCacheID: 42, Uses: 3, SourceWorkerName: "old-worker", SourceVolumeHandle: "source-handle"},
+					}, nil)
+				})
+
+				It("does not create another volume", func() {
+					Expect(newWorker.CreateVolumeCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	Context("when initializing the cache fails", func() {
+		BeforeEach(func() {
+			newVolume.InitializeResourceCacheReturns(errors.New("nope"))
+		})
+
+		It("destroys the volume and its artifact", func() {
+			Expect(artifact.DestroyCallCount()).To(Equal(1))
+			Expect(newVolume.DestroyCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when holding on to the volume fails", func() {
+		BeforeEach(func() {
+			newVolume.InitializeArtifactReturns(nil, errors.New("nope"))
+		})
+
+		It("destroys the volume", func() {
+			Expect(newVolume.DestroyCallCount()).To(Equal(1))
+			Expect(newVolume.StreamInCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when listing the workers fails", func() {
+		BeforeEach(func() {
+			fakeProvider.RunningWorkersReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(HaveOccurred())
+		})
+	})
+})
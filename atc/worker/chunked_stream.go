@@ -0,0 +1,400 @@
+package worker
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+// ErrChunkNotConsumed is returned when the destination of a chunk returns
+// before consuming all of it, e.g. because the stream was cut short.
+var ErrChunkNotConsumed = errors.New("destination did not consume the whole chunk")
+
+// StreamChunking configures relaying volumes through the ATC in chunks rather
+// than as one stream.
+//
+// The tarball streamed out of the source volume is split at file boundaries
+// into chunks of roughly ChunkSize bytes. Each chunk is buffered on disk and
+// streamed into the destination, and only counts as delivered once the
+// destination has accepted it after consuming every byte of it. A failed chunk
+// is retried on its own, and a failed source is streamed out again, skipping
+// the files of the chunks already delivered.
+//
+// Every hop is checksummed by the compression: each chunk carries the checksum
+// of its content, which the destination verifies as it decompresses the chunk,
+// rejecting it on a mismatch so that it is resent. Likewise the stream out of
+// the source carries the checksum computed by the source, which is verified
+// once the whole stream has been read. As files may have been delivered by
+// then, a mismatch there fails the stream rather than resuming it.
+//
+// Chunking is off by default as every chunk in flight is buffered on the
+// ATC's disk, which has to be sized for it. P2P streams go straight from one
+// worker to the other, so they are never chunked; the destination verifies
+// the checksum of the source's stream itself.
+type StreamChunking struct {
+	// ChunkSize is the number of uncompressed bytes after which a chunk is
+	// delivered. 0 disables chunking.
+	ChunkSize int64
+
+	// MaxRetries is the number of times a stream is resumed before giving up.
+	MaxRetries int
+}
+
+func (chunking StreamChunking) Enabled() bool {
+	return chunking.ChunkSize > 0
+}
+
+// StreamStats describes a volume streamed through the ATC.
+type StreamStats struct {
+	SourceWorker string
+
+	// Bytes is the compressed size of the stream delivered to the destination.
+	Bytes int64
+
+	Duration time.Duration
+
+	// Retries is the number of chunks resent and source streams resumed.
+	Retries int
+}
+
+// Throughput returns the bytes streamed per second.
+func (stats StreamStats) Throughput() float64 {
+	if stats.Duration <= 0 {
+		return 0
+	}
+
+	return float64(stats.Bytes) / stats.Duration.Seconds()
+}
+
+func (stats StreamStats) String() string {
+	summary := fmt.Sprintf(
+		"streamed %s from %s in %s (%s/s)",
+		humanBytes(float64(stats.Bytes)),
+		stats.SourceWorker,
+		stats.Duration.Round(time.Millisecond),
+		humanBytes(stats.Throughput()),
+	)
+
+	if stats.Retries > 0 {
+		summary += fmt.Sprintf(", %d retries", stats.Retries)
+	}
+
+	return summary
+}
+
+func humanBytes(bytes float64) string {
+	const unit = 1024
+
+	if bytes < unit {
+		return fmt.Sprintf("%.0fB", bytes)
+	}
+
+	div, exp := float64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", bytes/div, "KMGTPE"[exp])
+}
+
+// StreamReporter is told about every volume streamed on behalf of a step.
+type StreamReporter interface {
+	ReportStream(StreamStats)
+}
+
+type streamReporterKey struct{}
+
+// WithStreamReporter returns a context which reports the stats of the volumes
+// streamed with it.
+func WithStreamReporter(ctx context.Context, reporter StreamReporter) context.Context {
+	return context.WithValue(ctx, streamReporterKey{}, reporter)
+}
+
+func reportStream(ctx context.Context, stats StreamStats) {
+	metric.Metrics.VolumesStreamedBytes.IncDelta(int(stats.Bytes))
+
+	reporter, ok := ctx.Value(streamReporterKey{}).(StreamReporter)
+	if ok {
+		reporter.ReportStream(stats)
+	}
+}
+
+// errDeliveryFailed marks a chunk which could not be delivered within the
+// retry budget, so that the source isn't streamed out again.
+type errDeliveryFailed struct {
+	err error
+}
+
+func (err errDeliveryFailed) Error() string { return err.err.Error() }
+func (err errDeliveryFailed) Unwrap() error { return err.err }
+
+type chunkedRelay struct {
+	logger      lager.Logger
+	source      Volume
+	destination ArtifactDestination
+	compression compression.Compression
+	chunking    StreamChunking
+
+	// delivered is the number of tar entries delivered to the destination
+	delivered int
+
+	stats StreamStats
+}
+
+func (relay *chunkedRelay) run(ctx context.Context) error {
+	for {
+		err := relay.pass(ctx)
+		if err == nil {
+			return nil
+		}
+
+		var deliveryErr errDeliveryFailed
+		if errors.As(err, &deliveryErr) {
+			return deliveryErr.err
+		}
+
+		if errors.Is(err, compression.ErrChecksumMismatch) {
+			return err
+		}
+
+		if ctx.Err() != nil || relay.stats.Retries >= relay.chunking.MaxRetries {
+			return err
+		}
+
+		relay.retried()
+
+		relay.logger.Info("resuming-stream", lager.Data{
+			"error":     err.Error(),
+			"delivered": relay.delivered,
+		})
+	}
+}
+
+func (relay *chunkedRelay) retried() {
+	relay.stats.Retries++
+	metric.Metrics.VolumeStreamRetries.Inc()
+}
+
+// pass streams out of the source, skipping the entries which have already
+// been delivered, and delivers the rest in chunks.
+func (relay *chunkedRelay) pass(ctx context.Context) error {
+	out, err := relay.source.StreamOut(ctx, ".", relay.compression.Encoding())
+	if err != nil {
+		return err
+	}
+
+	defer out.Close()
+
+	decompressed, err := relay.compression.NewReader(out)
+	if err != nil {
+		return err
+	}
+
+	defer decompressed.Close()
+
+	tarReader := tar.NewReader(decompressed)
+
+	for skipped := 0; skipped < relay.delivered; skipped++ {
+		_, err := tarReader.Next()
+		if err != nil {
+			return fmt.Errorf("skip delivered entries: %w", err)
+		}
+	}
+
+	for {
+		chunk, err := relay.nextChunk(tarReader)
+		if err != nil {
+			return err
+		}
+
+		if chunk.entries == 0 {
+			chunk.remove()
+			return verifySourceChecksum(decompressed)
+		}
+
+		err = relay.deliver(ctx, chunk)
+		chunk.remove()
+		if err != nil {
+			return errDeliveryFailed{err}
+		}
+	}
+}
+
+// verifySourceChecksum reads what is left of the source's stream after the
+// end of its tarball, which makes the decompression verify the checksum of
+// the whole stream.
+func verifySourceChecksum(decompressed io.Reader) error {
+	_, err := io.Copy(ioutil.Discard, decompressed)
+	if err != nil {
+		return fmt.Errorf("verify source stream: %w", err)
+	}
+
+	return nil
+}
+
+func (relay *chunkedRelay) nextChunk(tarReader *tar.Reader) (*streamChunk, error) {
+	chunk, err := newStreamChunk(relay.compression)
+	if err != nil {
+		return nil, err
+	}
+
+	for chunk.size < relay.chunking.ChunkSize {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			chunk.remove()
+			return nil, err
+		}
+
+		err = chunk.add(header, tarReader)
+		if err != nil {
+			chunk.remove()
+			return nil, err
+		}
+	}
+
+	err = chunk.seal()
+	if err != nil {
+		chunk.remove()
+		return nil, err
+	}
+
+	return chunk, nil
+}
+
+func (relay *chunkedRelay) deliver(ctx context.Context, chunk *streamChunk) error {
+	for {
+		err := relay.send(ctx, chunk)
+		if err == nil {
+			break
+		}
+
+		if ctx.Err() != nil || relay.stats.Retries >= relay.chunking.MaxRetries {
+			return err
+		}
+
+		relay.retried()
+
+		relay.logger.Info("retrying-chunk", lager.Data{
+			"error":     err.Error(),
+			"delivered": relay.delivered,
+			"entries":   chunk.entries,
+		})
+	}
+
+	relay.delivered += chunk.entries
+	relay.stats.Bytes += chunk.compressedSize
+
+	return nil
+}
+
+func (relay *chunkedRelay) send(ctx context.Context, chunk *streamChunk) error {
+	_, err := chunk.file.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	counter := &countingReader{reader: chunk.file}
+
+	err = relay.destination.StreamIn(ctx, ".", relay.compression.Encoding(), counter)
+	if err != nil {
+		return err
+	}
+
+	if counter.count != chunk.compressedSize {
+		return ErrChunkNotConsumed
+	}
+
+	return nil
+}
+
+// streamChunk is a tarball of some of the entries of a volume, compressed and
+// buffered on disk.
+type streamChunk struct {
+	file *os.File
+
+	compressed io.WriteCloser
+	tarWriter  *tar.Writer
+
+	entries int
+	size    int64
+
+	compressedSize int64
+}
+
+func newStreamChunk(comp compression.Compression) (*streamChunk, error) {
+	file, err := ioutil.TempFile("", "stream-chunk")
+	if err != nil {
+		return nil, err
+	}
+
+	chunk := &streamChunk{
+		file: file,
+	}
+
+	chunk.compressed, err = comp.NewWriter(file)
+	if err != nil {
+		chunk.remove()
+		return nil, err
+	}
+
+	chunk.tarWriter = tar.NewWriter(chunk.compressed)
+
+	return chunk, nil
+}
+
+func (chunk *streamChunk) add(header *tar.Header, content io.Reader) error {
+	err := chunk.tarWriter.WriteHeader(header)
+	if err != nil {
+		return err
+	}
+
+	n, err := io.Copy(chunk.tarWriter, content)
+	if err != nil {
+		return err
+	}
+
+	chunk.entries++
+	chunk.size += n
+
+	return nil
+}
+
+func (chunk *streamChunk) seal() error {
+	err := chunk.tarWriter.Close()
+	if err != nil {
+		return err
+	}
+
+	err = chunk.compressed.Close()
+	if err != nil {
+		return err
+	}
+
+	info, err := chunk.file.Stat()
+	if err != nil {
+		return err
+	}
+
+	chunk.compressedSize = info.Size()
+
+	return nil
+}
+
+func (chunk *streamChunk) remove() {
+	_ = chunk.file.Close()
+	_ = os.Remove(chunk.file.Name())
+}
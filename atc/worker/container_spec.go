@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -13,6 +14,12 @@ type WorkerSpec struct {
 	ResourceType string
 	Tags         []string
 	TeamID       int
+
+	// Label expressions the worker must satisfy.
+	Selector *atc.WorkerSelector
+
+	// Runtime class the worker must support.
+	RuntimeClass string
 }
 
 type ContainerSpec struct {
@@ -21,6 +28,20 @@ type ContainerSpec struct {
 	Env       []string
 	Type      db.ContainerType
 
+	// Where the container is being created for and the worker tags it
+	// requires. Only used to describe the container to placement strategies.
+	TeamName     string
+	PipelineName string
+	JobName      string
+	Tags         []string
+
+	// Label expressions that workers satisfying the most of are preferred.
+	PreferredLabels []atc.WorkerSelectorExpression
+
+	// Runtime class to run the container with. Empty uses the worker's
+	// default runtime.
+	RuntimeClass string
+
 	// Working directory for processes run in the container.
 	Dir string
 
@@ -140,5 +161,15 @@ func (spec WorkerSpec) Description() string {
 		attrs = append(attrs, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	if spec.Selector != nil {
+		for _, expr := range spec.Selector.Required {
+			attrs = append(attrs, fmt.Sprintf("label '%s'", expr))
+		}
+	}
+
+	if spec.RuntimeClass != "" {
+		attrs = append(attrs, fmt.Sprintf("runtime class '%s'", spec.RuntimeClass))
+	}
+
 	return strings.Join(attrs, ", ")
 }
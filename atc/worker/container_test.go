@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/runtime"
@@ -102,6 +103,7 @@ var _ = Describe("RunScript", func() {
 			fakeDBTeamFactory,
 			fakeDBWorker,
 			fakeResourceCacheFactory,
+			atc.EgressPolicies{},
 			0,
 		)
 
@@ -181,6 +181,7 @@ var _ = Describe("DBProvider", func() {
 			wantWorkerVersion,
 			baggageclaimResponseHeaderTimeout,
 			gardenRequestTimeout,
+			atc.EgressPolicies{},
 		)
 		baggageclaimURL = baggageclaimServer.URL()
 	})
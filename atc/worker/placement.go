@@ -3,19 +3,25 @@ package worker
 import (
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"strings"
 	"time"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
 type ContainerPlacementStrategyOptions struct {
-	ContainerPlacementStrategy   []string `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"limit-active-containers" choice:"limit-active-volumes" description:"Method by which a worker is selected during container placement. If multiple methods are specified, they will be applied in order. Random strategy should only be used alone."`
+	ContainerPlacementStrategy   []string `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"limit-active-containers" choice:"limit-active-volumes" choice:"most-available-resources" choice:"preferred-labels" choice:"external" description:"Method by which a worker is selected during container placement. If multiple methods are specified, they will be applied in order. Random strategy should only be used alone."`
 	MaxActiveTasksPerWorker      int      `long:"max-active-tasks-per-worker" default:"0" description:"Maximum allowed number of active build tasks per worker. Has effect only when used with limit-active-tasks placement strategy. 0 means no limit."`
 	MaxActiveContainersPerWorker int      `long:"max-active-containers-per-worker" default:"0" description:"Maximum allowed number of active containers per worker. Has effect only when used with limit-active-containers placement strategy. 0 means no limit."`
 	MaxActiveVolumesPerWorker    int      `long:"max-active-volumes-per-worker" default:"0" description:"Maximum allowed number of active volumes per worker. Has effect only when used with limit-active-volumes placement strategy. 0 means no limit."`
+
+	ExternalPlacementURL      string        `long:"external-placement-url" description:"Endpoint the candidate workers are POSTed to. Required by the external placement strategy."`
+	ExternalPlacementTimeout  time.Duration `long:"external-placement-timeout" default:"5s" description:"Timeout for requests to the external placement endpoint."`
+	ExternalPlacementFallback string        `long:"external-placement-fallback" default:"random" choice:"fail" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"limit-active-containers" choice:"limit-active-volumes" choice:"most-available-resources" choice:"preferred-labels" description:"Placement strategy used in place of the external strategy when the endpoint cannot be reached or returns an invalid response. 'fail' fails the step instead."`
 }
 
 type NoWorkerFitContainerPlacementStrategyError struct {
@@ -39,6 +45,13 @@ type ContainerPlacementStrategyChainNode interface {
 	StrategyName() string
 }
 
+// rankingPlacementStrategyNode is a chain node which may order the workers it
+// chooses from most to least preferred, and reports whether it did.
+type rankingPlacementStrategyNode interface {
+	ContainerPlacementStrategyChainNode
+	ChooseRanked(lager.Logger, []Worker, ContainerSpec) ([]Worker, bool, error)
+}
+
 type containerPlacementStrategy struct {
 	nodes []ContainerPlacementStrategyChainNode
 }
@@ -47,39 +60,71 @@ func NewContainerPlacementStrategy(opts ContainerPlacementStrategyOptions) (*con
 	cps := &containerPlacementStrategy{nodes: []ContainerPlacementStrategyChainNode{}}
 	for _, strategy := range opts.ContainerPlacementStrategy {
 		strategy := strings.TrimSpace(strategy)
-		switch strategy {
-		case "random":
-			// Add nothing. Because an empty strategy chain equals to random strategy.
-		case "fewest-build-containers":
-			cps.nodes = append(cps.nodes, newFewestBuildContainersPlacementStrategy(strategy))
-		case "limit-active-tasks":
-			if opts.MaxActiveTasksPerWorker < 0 {
-				return nil, errors.New("max-active-tasks-per-worker must be greater or equal than 0")
-			}
-			cps.nodes = append(cps.nodes, newLimitActiveTasksPlacementStrategy(strategy, opts.MaxActiveTasksPerWorker))
-		case "limit-active-containers":
-			if opts.MaxActiveContainersPerWorker < 0 {
-				return nil, errors.New("max-active-containers-per-worker must be greater or equal than 0")
-			}
-			cps.nodes = append(cps.nodes, newLimitActiveContainersPlacementStrategy(strategy, opts.MaxActiveContainersPerWorker))
-		case "limit-active-volumes":
-			if opts.MaxActiveVolumesPerWorker < 0 {
-				return nil, errors.New("max-active-volumes-per-worker must be greater or equal than 0")
+		if strategy == "external" {
+			node, err := newExternalPlacementStrategy(strategy, opts)
+			if err != nil {
+				return nil, err
 			}
-			cps.nodes = append(cps.nodes, newLimitActiveVolumesPlacementStrategy(strategy, opts.MaxActiveVolumesPerWorker))
-		case "volume-locality":
-			cps.nodes = append(cps.nodes, newVolumeLocalityPlacementStrategyNode(strategy))
-		default:
-			return nil, fmt.Errorf("invalid container placement strategy %s", strategy)
+			cps.nodes = append(cps.nodes, node)
+			continue
+		}
+
+		node, err := newContainerPlacementStrategyNode(strategy, opts)
+		if err != nil {
+			return nil, err
+		}
+		if node != nil {
+			cps.nodes = append(cps.nodes, node)
 		}
 	}
 	return cps, nil
 }
 
+// newContainerPlacementStrategyNode returns the built-in node for the given
+// strategy, or nil for the random strategy.
+func newContainerPlacementStrategyNode(strategy string, opts ContainerPlacementStrategyOptions) (ContainerPlacementStrategyChainNode, error) {
+	switch strategy {
+	case "random":
+		// Add nothing. Because an empty strategy chain equals to random strategy.
+		return nil, nil
+	case "fewest-build-containers":
+		return newFewestBuildContainersPlacementStrategy(strategy), nil
+	case "limit-active-tasks":
+		if opts.MaxActiveTasksPerWorker < 0 {
+			return nil, errors.New("max-active-tasks-per-worker must be greater or equal than 0")
+		}
+		return newLimitActiveTasksPlacementStrategy(strategy, opts.MaxActiveTasksPerWorker), nil
+	case "limit-active-containers":
+		if opts.MaxActiveContainersPerWorker < 0 {
+			return nil, errors.New("max-active-containers-per-worker must be greater or equal than 0")
+		}
+		return newLimitActiveContainersPlacementStrategy(strategy, opts.MaxActiveContainersPerWorker), nil
+	case "limit-active-volumes":
+		if opts.MaxActiveVolumesPerWorker < 0 {
+			return nil, errors.New("max-active-volumes-per-worker must be greater or equal than 0")
+		}
+		return newLimitActiveVolumesPlacementStrategy(strategy, opts.MaxActiveVolumesPerWorker), nil
+	case "volume-locality":
+		return newVolumeLocalityPlacementStrategyNode(strategy), nil
+	case "most-available-resources":
+		return newMostAvailableResourcesPlacementStrategy(strategy), nil
+	case "preferred-labels":
+		return newPreferredLabelsPlacementStrategy(strategy), nil
+	default:
+		return nil, fmt.Errorf("invalid container placement strategy %s", strategy)
+	}
+}
+
 func (strategy *containerPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
 	var err error
+	var ranked bool
 	for _, node := range strategy.nodes {
-		workers, err = node.Choose(logger, workers, spec)
+		if ranking, ok := node.(rankingPlacementStrategyNode); ok {
+			workers, ranked, err = ranking.ChooseRanked(logger, workers, spec)
+		} else {
+			workers, err = node.Choose(logger, workers, spec)
+			ranked = false
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -91,6 +136,12 @@ func (strategy *containerPlacementStrategy) Choose(logger lager.Logger, workers
 		return workers[0], nil
 	}
 
+	// Respect the ordering of the workers when the last strategy actually
+	// ranked them.
+	if ranked {
+		return workers[0], nil
+	}
+
 	// If there are still multiple candidate, choose a random one.
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	return workers[r.Intn(len(workers))], nil
@@ -293,3 +344,163 @@ func (strategy *LimitActiveVolumesPlacementStrategyNode) ModifiesActiveTasks() b
 func (strategy *LimitActiveVolumesPlacementStrategyNode) StrategyName() string {
 	return strategy.GivenName
 }
+
+type MostAvailableResourcesPlacementStrategyNode struct {
+	GivenName string
+}
+
+func newMostAvailableResourcesPlacementStrategy(name string) ContainerPlacementStrategyChainNode {
+	return &MostAvailableResourcesPlacementStrategyNode{name}
+}
+
+// Choose filters out workers that cannot fit the container's memory limit and
+// picks one of the remaining workers at random, weighted by the headroom they
+// last reported. Workers that have not reported their resources yet are
+// weighted by the average headroom of the other workers, unless the container
+// has a memory limit, in which case they are filtered out as they may not be
+// able to fit it.
+func (strategy *MostAvailableResourcesPlacementStrategyNode) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	candidates := []Worker{}
+	scores := []float64{}
+
+	var knownTotal float64
+	var knownCount int
+
+	hasMemoryLimit := spec.Limits.Memory != nil && *spec.Limits.Memory > 0
+
+	for _, w := range workers {
+		resources := w.Resources()
+		if resources == nil {
+			if hasMemoryLimit {
+				logger.Info("worker-resources-unknown", lager.Data{
+					"worker":       w.Name(),
+					"memory-limit": *spec.Limits.Memory,
+				})
+				continue
+			}
+
+			candidates = append(candidates, w)
+			scores = append(scores, -1)
+			continue
+		}
+
+		if hasMemoryLimit && resources.MemoryFree < *spec.Limits.Memory {
+			logger.Info("worker-lacks-memory", lager.Data{
+				"worker":       w.Name(),
+				"memory-free":  resources.MemoryFree,
+				"memory-limit": *spec.Limits.Memory,
+			})
+			continue
+		}
+
+		score := headroom(*resources)
+		knownTotal += score
+		knownCount++
+
+		candidates = append(candidates, w)
+		scores = append(scores, score)
+	}
+
+	if len(candidates) <= 1 {
+		return candidates, nil
+	}
+
+	unknownScore := 0.5
+	if knownCount > 0 {
+		unknownScore = knownTotal / float64(knownCount)
+	}
+
+	var total float64
+	for i, score := range scores {
+		if score < 0 {
+			scores[i] = unknownScore
+		}
+
+		total += scores[i]
+	}
+
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	if total <= 0 {
+		return []Worker{candidates[r.Intn(len(candidates))]}, nil
+	}
+
+	pick := r.Float64() * total
+	for i, score := range scores {
+		pick -= score
+		if pick < 0 {
+			return []Worker{candidates[i]}, nil
+		}
+	}
+
+	return []Worker{candidates[len(candidates)-1]}, nil
+}
+
+func (strategy *MostAvailableResourcesPlacementStrategyNode) ModifiesActiveTasks() bool {
+	return false
+}
+
+func (strategy *MostAvailableResourcesPlacementStrategyNode) StrategyName() string {
+	return strategy.GivenName
+}
+
+type PreferredLabelsPlacementStrategyNode struct {
+	GivenName string
+}
+
+func newPreferredLabelsPlacementStrategy(name string) ContainerPlacementStrategyChainNode {
+	return &PreferredLabelsPlacementStrategyNode{name}
+}
+
+// Choose returns the workers whose labels satisfy the most of the
+// container's preferred label expressions.
+func (strategy *PreferredLabelsPlacementStrategyNode) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	if len(spec.PreferredLabels) == 0 {
+		return workers, nil
+	}
+
+	workersByMatches := map[int][]Worker{}
+	var mostMatches int
+
+	for _, w := range workers {
+		labels := w.Labels()
+
+		matches := 0
+		for _, expr := range spec.PreferredLabels {
+			if expr.Matches(labels) {
+				matches++
+			}
+		}
+
+		workersByMatches[matches] = append(workersByMatches[matches], w)
+		if matches > mostMatches {
+			mostMatches = matches
+		}
+	}
+
+	return workersByMatches[mostMatches], nil
+}
+
+func (strategy *PreferredLabelsPlacementStrategyNode) ModifiesActiveTasks() bool {
+	return false
+}
+
+func (strategy *PreferredLabelsPlacementStrategyNode) StrategyName() string {
+	return strategy.GivenName
+}
+
+// headroom returns a score between 0 and 1 averaging the free memory, free
+// disk and idle CPU of a worker.
+func headroom(resources atc.WorkerResources) float64 {
+	var memory, disk float64
+	if resources.MemoryTotal > 0 {
+		memory = float64(resources.MemoryFree) / float64(resources.MemoryTotal)
+	}
+
+	if resources.DiskTotal > 0 {
+		disk = float64(resources.DiskFree) / float64(resources.DiskTotal)
+	}
+
+	cpu := 1 - math.Min(resources.CPULoad, 1)
+
+	return (memory + disk + cpu) / 3
+}
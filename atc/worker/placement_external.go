@@ -0,0 +1,216 @@
+package worker
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/metric"
+)
+
+type externalPlacementInput struct {
+	Container externalPlacementContainer `json:"container"`
+	Workers   []externalPlacementWorker  `json:"workers"`
+}
+
+type externalPlacementContainer struct {
+	TeamID       int      `json:"team_id"`
+	TeamName     string   `json:"team_name,omitempty"`
+	PipelineName string   `json:"pipeline_name,omitempty"`
+	JobName      string   `json:"job_name,omitempty"`
+	Type         string   `json:"type,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	CPULimit     *uint64  `json:"cpu_limit,omitempty"`
+	MemoryLimit  *uint64  `json:"memory_limit,omitempty"`
+}
+
+type externalPlacementWorker struct {
+	Name             string               `json:"name"`
+	Tags             []string             `json:"tags,omitempty"`
+	Labels           map[string]string    `json:"labels,omitempty"`
+	TeamOwned        bool                 `json:"team_owned,omitempty"`
+	Ephemeral        bool                 `json:"ephemeral,omitempty"`
+	ActiveContainers int                  `json:"active_containers"`
+	ActiveVolumes    int                  `json:"active_volumes"`
+	BuildContainers  int                  `json:"build_containers"`
+	Resources        *atc.WorkerResources `json:"resources,omitempty"`
+}
+
+type externalPlacementOutput struct {
+	Workers *[]string `json:"workers,omitempty"`
+}
+
+type ExternalPlacementStrategyNode struct {
+	GivenName string
+
+	url      string
+	timeout  time.Duration
+	fallback ContainerPlacementStrategyChainNode
+	fail     bool
+}
+
+func newExternalPlacementStrategy(name string, opts ContainerPlacementStrategyOptions) (ContainerPlacementStrategyChainNode, error) {
+	if opts.ExternalPlacementURL == "" {
+		return nil, errors.New("external-placement-url must be set to use the external placement strategy")
+	}
+
+	strategy := &ExternalPlacementStrategyNode{
+		GivenName: name,
+		url:       opts.ExternalPlacementURL,
+		timeout:   opts.ExternalPlacementTimeout,
+	}
+
+	switch opts.ExternalPlacementFallback {
+	case "fail":
+		strategy.fail = true
+	case "":
+	default:
+		fallback, err := newContainerPlacementStrategyNode(opts.ExternalPlacementFallback, opts)
+		if err != nil {
+			return nil, err
+		}
+		strategy.fallback = fallback
+	}
+
+	return strategy, nil
+}
+
+// Choose POSTs the candidate workers and a summary of the container to the
+// configured endpoint, which responds with the names of the workers the
+// container may be placed on, most preferred first. If the endpoint fails,
+// the fallback strategy is used instead.
+func (strategy *ExternalPlacementStrategyNode) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	candidates, _, err := strategy.ChooseRanked(logger, workers, spec)
+	return candidates, err
+}
+
+// ChooseRanked is like Choose, but also reports whether the workers are
+// ranked by the endpoint, which is not the case when falling back.
+func (strategy *ExternalPlacementStrategyNode) ChooseRanked(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, bool, error) {
+	logger = logger.Session("external-placement")
+
+	start := time.Now()
+	candidates, err := strategy.request(logger, workers, spec)
+	if err != nil {
+		metric.Metrics.ExternalPlacementsFailed.Inc()
+		logger.Error("failed-to-place-container", err, lager.Data{"duration": time.Since(start).String()})
+
+		if strategy.fail {
+			return nil, false, err
+		}
+
+		if strategy.fallback == nil {
+			return workers, false, nil
+		}
+
+		candidates, err := strategy.fallback.Choose(logger, workers, spec)
+		return candidates, false, err
+	}
+
+	metric.Metrics.ExternalPlacementsSucceeded.Inc()
+	logger.Debug("placed-container", lager.Data{
+		"duration":   time.Since(start).String(),
+		"candidates": len(candidates),
+	})
+
+	return candidates, true, nil
+}
+
+func (strategy *ExternalPlacementStrategyNode) request(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	input := externalPlacementInput{
+		Container: externalPlacementContainer{
+			TeamID:       spec.TeamID,
+			TeamName:     spec.TeamName,
+			PipelineName: spec.PipelineName,
+			JobName:      spec.JobName,
+			Type:         string(spec.Type),
+			Tags:         spec.Tags,
+			CPULimit:     spec.Limits.CPU,
+			MemoryLimit:  spec.Limits.Memory,
+		},
+		Workers: []externalPlacementWorker{},
+	}
+
+	workersByName := map[string]Worker{}
+	for _, w := range workers {
+		workersByName[w.Name()] = w
+
+		input.Workers = append(input.Workers, externalPlacementWorker{
+			Name:             w.Name(),
+			Tags:             w.Tags(),
+			Labels:           w.Labels(),
+			TeamOwned:        w.IsOwnedByTeam(),
+			Ephemeral:        w.Ephemeral(),
+			ActiveContainers: w.ActiveContainers(),
+			ActiveVolumes:    w.ActiveVolumes(),
+			BuildContainers:  w.BuildContainers(),
+			Resources:        w.Resources(),
+		})
+	}
+
+	jsonBytes, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", strategy.url, bytes.NewBuffer(jsonBytes))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := &http.Client{}
+	client.Timeout = strategy.timeout
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("external placement returned status: %d", resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("external placement returned no response: %s", err.Error())
+	}
+
+	var output externalPlacementOutput
+	err = json.Unmarshal(body, &output)
+	if err != nil {
+		return nil, fmt.Errorf("external placement returned bad response: %s", err.Error())
+	}
+
+	if output.Workers == nil {
+		return nil, fmt.Errorf("external placement returned invalid response: %s", body)
+	}
+
+	candidates := []Worker{}
+	for _, name := range *output.Workers {
+		w, found := workersByName[name]
+		if !found {
+			logger.Info("ignoring-unknown-worker", lager.Data{"worker": name})
+			continue
+		}
+
+		candidates = append(candidates, w)
+		delete(workersByName, name)
+	}
+
+	return candidates, nil
+}
+
+func (strategy *ExternalPlacementStrategyNode) ModifiesActiveTasks() bool {
+	return strategy.fallback != nil && strategy.fallback.ModifiesActiveTasks()
+}
+
+func (strategy *ExternalPlacementStrategyNode) StrategyName() string {
+	return strategy.GivenName
+}
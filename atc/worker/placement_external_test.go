@@ -0,0 +1,222 @@
+package worker_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ExternalPlacementStrategyNode", func() {
+	var (
+		server *ghttp.Server
+		opts   ContainerPlacementStrategyOptions
+
+		worker1 *workerfakes.FakeWorker
+		worker2 *workerfakes.FakeWorker
+		worker3 *workerfakes.FakeWorker
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("external-placement-test")
+		server = ghttp.NewServer()
+
+		worker1 = new(workerfakes.FakeWorker)
+		worker1.NameReturns("worker1")
+		worker1.ActiveContainersReturns(5)
+		worker2 = new(workerfakes.FakeWorker)
+		worker2.NameReturns("worker2")
+		worker2.TagsReturns([]string{"gpu"})
+		worker3 = new(workerfakes.FakeWorker)
+		worker3.NameReturns("worker3")
+		worker3.BuildContainersReturns(1)
+
+		workers = []Worker{worker1, worker2, worker3}
+
+		memory := uint64(1024)
+		spec = ContainerSpec{
+			TeamID:       1,
+			TeamName:     "some-team",
+			PipelineName: "some-pipeline",
+			JobName:      "some-job",
+			Type:         db.ContainerTypeTask,
+			Tags:         []string{"gpu"},
+			Limits:       ContainerLimits{Memory: &memory},
+		}
+
+		opts = ContainerPlacementStrategyOptions{
+			ContainerPlacementStrategy: []string{"external"},
+			ExternalPlacementURL:       server.URL(),
+			ExternalPlacementTimeout:   time.Second,
+			ExternalPlacementFallback:  "random",
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		strategy, newStrategyError = NewContainerPlacementStrategy(opts)
+	})
+
+	Context("when the url is not set", func() {
+		BeforeEach(func() {
+			opts.ExternalPlacementURL = ""
+		})
+
+		It("errors", func() {
+			Expect(newStrategyError).To(HaveOccurred())
+		})
+	})
+
+	Context("when the endpoint responds with workers", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/"),
+					ghttp.VerifyJSON(`{
+						"container": {
+							"team_id": 1,
+							"team_name": "some-team",
+							"pipeline_name": "some-pipeline",
+							"job_name": "some-job",
+							"type": "task",
+							"tags": ["gpu"],
+							"memory_limit": 1024
+						},
+						"workers": [
+							{"name": "worker1", "active_containers": 5, "active_volumes": 0, "build_containers": 0},
+							{"name": "worker2", "tags": ["gpu"], "active_containers": 0, "active_volumes": 0, "build_containers": 0},
+							{"name": "worker3", "active_containers": 0, "active_volumes": 0, "build_containers": 1}
+						]
+					}`),
+					ghttp.RespondWith(http.StatusOK, `{"workers": ["worker3", "some-unknown-worker", "worker1"]}`),
+				),
+			)
+		})
+
+		It("picks the most preferred worker", func() {
+			Expect(newStrategyError).ToNot(HaveOccurred())
+
+			chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+			Expect(chooseErr).ToNot(HaveOccurred())
+			Expect(chosenWorker).To(Equal(worker3))
+		})
+
+		Context("when followed by another strategy", func() {
+			BeforeEach(func() {
+				opts.ContainerPlacementStrategy = []string{"external", "fewest-build-containers"}
+			})
+
+			It("only considers the returned workers", func() {
+				chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(worker1))
+			})
+		})
+	})
+
+	Context("when the endpoint filters out every worker", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusOK, `{"workers": []}`),
+			)
+		})
+
+		It("returns no worker", func() {
+			chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+			Expect(chooseErr).To(Equal(NoWorkerFitContainerPlacementStrategyError{Strategy: "external"}))
+			Expect(chosenWorker).To(BeNil())
+		})
+	})
+
+	Context("when the endpoint fails", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusInternalServerError, ""),
+			)
+		})
+
+		Context("when falling back to the random strategy", func() {
+			BeforeEach(func() {
+				server.RouteToHandler("POST", "/", ghttp.RespondWith(http.StatusInternalServerError, ""))
+			})
+
+			It("picks a random worker rather than the first one", func() {
+				chosen := map[string]bool{}
+				for i := 0; i < 100; i++ {
+					chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					chosen[chosenWorker.Name()] = true
+				}
+
+				Expect(len(chosen)).To(BeNumerically(">", 1))
+			})
+		})
+
+		Context("when falling back to another strategy", func() {
+			BeforeEach(func() {
+				opts.ExternalPlacementFallback = "fewest-build-containers"
+			})
+
+			It("picks a worker using the fallback strategy", func() {
+				chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Or(Equal(worker1), Equal(worker2)))
+			})
+		})
+
+		Context("when configured to fail", func() {
+			BeforeEach(func() {
+				opts.ExternalPlacementFallback = "fail"
+			})
+
+			It("errors", func() {
+				chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+				Expect(chooseErr).To(MatchError("external placement returned status: 500"))
+				Expect(chosenWorker).To(BeNil())
+			})
+		})
+	})
+
+	Context("when the endpoint times out", func() {
+		BeforeEach(func() {
+			opts.ExternalPlacementTimeout = 10 * time.Millisecond
+			opts.ExternalPlacementFallback = "fail"
+
+			server.AppendHandlers(
+				func(w http.ResponseWriter, r *http.Request) {
+					time.Sleep(100 * time.Millisecond)
+				},
+			)
+		})
+
+		It("errors", func() {
+			_, chooseErr = strategy.Choose(logger, workers, spec)
+			Expect(chooseErr).To(HaveOccurred())
+		})
+	})
+
+	Context("when the endpoint returns an invalid response", func() {
+		BeforeEach(func() {
+			opts.ExternalPlacementFallback = "fail"
+
+			server.AppendHandlers(
+				ghttp.RespondWith(http.StatusOK, `{}`),
+			)
+		})
+
+		It("errors", func() {
+			_, chooseErr = strategy.Choose(logger, workers, spec)
+			Expect(chooseErr).To(MatchError(ContainSubstring("external placement returned invalid response")))
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
@@ -713,6 +714,192 @@ var _ = Describe("LimitActiveVolumesPlacementStrategyNode", func() {
 	})
 })
 
+var _ = Describe("MostAvailableResourcesPlacementStrategyNode", func() {
+	Describe("Choose", func() {
+		var idleWorker *workerfakes.FakeWorker
+		var busyWorker *workerfakes.FakeWorker
+		var unknownWorker *workerfakes.FakeWorker
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("most-available-resources-placement-test")
+			idleWorker = new(workerfakes.FakeWorker)
+			idleWorker.NameReturns("idleWorker")
+			idleWorker.ResourcesReturns(&atc.WorkerResources{
+				MemoryFree:  4096,
+				MemoryTotal: 4096,
+				CPULoad:     0,
+				DiskFree:    1000,
+				DiskTotal:   1000,
+			})
+			busyWorker = new(workerfakes.FakeWorker)
+			busyWorker.NameReturns("busyWorker")
+			busyWorker.ResourcesReturns(&atc.WorkerResources{
+				MemoryFree:  0,
+				MemoryTotal: 4096,
+				CPULoad:     2,
+				DiskFree:    0,
+				DiskTotal:   1000,
+			})
+			unknownWorker = new(workerfakes.FakeWorker)
+			unknownWorker.NameReturns("unknownWorker")
+
+			workers = []Worker{idleWorker, busyWorker}
+
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+				TeamID:    4567,
+				Inputs:    []InputSource{},
+			}
+		})
+
+		JustBeforeEach(func() {
+			strategy, newStrategyError = NewContainerPlacementStrategy(ContainerPlacementStrategyOptions{
+				ContainerPlacementStrategy: []string{"most-available-resources"},
+			})
+			Expect(newStrategyError).ToNot(HaveOccurred())
+		})
+
+		It("never picks a worker without any headroom", func() {
+			Consistently(func() Worker {
+				chosenWorker, chooseErr = strategy.Choose(
+					logger,
+					workers,
+					spec,
+				)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				return chosenWorker
+			}).Should(Equal(idleWorker))
+		})
+
+		Context("when a worker has not reported its resources", func() {
+			BeforeEach(func() {
+				workers = []Worker{busyWorker, unknownWorker}
+			})
+
+			It("weights it by the average headroom of the other workers", func() {
+				Consistently(func() Worker {
+					chosenWorker, chooseErr = strategy.Choose(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					return chosenWorker
+				}).Should(Or(Equal(busyWorker), Equal(unknownWorker)))
+			})
+		})
+
+		Context("when the container has a memory limit", func() {
+			BeforeEach(func() {
+				memoryLimit := uint64(8192)
+				spec.Limits = ContainerLimits{Memory: &memoryLimit}
+			})
+
+			Context("when no worker has enough free memory", func() {
+				It("returns no worker", func() {
+					chosenWorker, chooseErr = strategy.Choose(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(chooseErr).To(Equal(NoWorkerFitContainerPlacementStrategyError{Strategy: "most-available-resources"}))
+					Expect(chosenWorker).To(BeNil())
+				})
+			})
+
+			Context("when a worker has not reported its resources", func() {
+				BeforeEach(func() {
+					workers = []Worker{idleWorker, unknownWorker}
+				})
+
+				It("is not considered", func() {
+					chosenWorker, chooseErr = strategy.Choose(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(chooseErr).To(Equal(NoWorkerFitContainerPlacementStrategyError{Strategy: "most-available-resources"}))
+					Expect(chosenWorker).To(BeNil())
+				})
+			})
+		})
+	})
+})
+
+var _ = Describe("PreferredLabelsPlacementStrategyNode", func() {
+	Describe("Choose", func() {
+		var armSSDWorker *workerfakes.FakeWorker
+		var armWorker *workerfakes.FakeWorker
+		var unlabelledWorker *workerfakes.FakeWorker
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("preferred-labels-placement-test")
+			armSSDWorker = new(workerfakes.FakeWorker)
+			armSSDWorker.NameReturns("armSSDWorker")
+			armSSDWorker.LabelsReturns(map[string]string{"arch": "arm64", "disk": "ssd"})
+			armWorker = new(workerfakes.FakeWorker)
+			armWorker.NameReturns("armWorker")
+			armWorker.LabelsReturns(map[string]string{"arch": "arm64"})
+			unlabelledWorker = new(workerfakes.FakeWorker)
+			unlabelledWorker.NameReturns("unlabelledWorker")
+
+			workers = []Worker{armSSDWorker, armWorker, unlabelledWorker}
+
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+				TeamID:    4567,
+				Inputs:    []InputSource{},
+			}
+		})
+
+		JustBeforeEach(func() {
+			strategy, newStrategyError = NewContainerPlacementStrategy(ContainerPlacementStrategyOptions{
+				ContainerPlacementStrategy: []string{"preferred-labels"},
+			})
+			Expect(newStrategyError).ToNot(HaveOccurred())
+		})
+
+		Context("when the container has no preferences", func() {
+			It("picks any worker", func() {
+				Consistently(func() Worker {
+					chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					return chosenWorker
+				}).Should(Or(Equal(armSSDWorker), Equal(armWorker), Equal(unlabelledWorker)))
+			})
+		})
+
+		Context("when the container has preferences", func() {
+			BeforeEach(func() {
+				spec.PreferredLabels = []atc.WorkerSelectorExpression{
+					{Key: "arch", Operator: atc.WorkerSelectorOperatorIn, Values: []string{"arm64"}},
+					{Key: "disk", Operator: atc.WorkerSelectorOperatorIn, Values: []string{"ssd"}},
+				}
+			})
+
+			It("picks the worker satisfying the most of them", func() {
+				Consistently(func() Worker {
+					chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					return chosenWorker
+				}).Should(Equal(armSSDWorker))
+			})
+
+			Context("when no worker satisfies any of them", func() {
+				BeforeEach(func() {
+					workers = []Worker{unlabelledWorker}
+				})
+
+				It("still picks a worker", func() {
+					chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					Expect(chosenWorker).To(Equal(unlabelledWorker))
+				})
+			})
+		})
+	})
+})
+
 var _ = Describe("ChainedPlacementStrategy #Choose", func() {
 
 	var someWorker1 *workerfakes.FakeWorker
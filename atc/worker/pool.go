@@ -26,6 +26,16 @@ func (err NoCompatibleWorkersError) Error() string {
 	return fmt.Sprintf("no workers satisfying: %s", err.Spec.Description())
 }
 
+// NoEgressPolicyWorkersError is returned when an egress policy applies to the
+// container but none of the workers satisfying the spec enforce it.
+type NoEgressPolicyWorkersError struct {
+	Spec WorkerSpec
+}
+
+func (err NoEgressPolicyWorkersError) Error() string {
+	return fmt.Sprintf("no workers enforcing egress policies satisfying: %s", err.Spec.Description())
+}
+
 //go:generate counterfeiter . Pool
 
 type Pool interface {
@@ -190,6 +200,11 @@ func (pool *pool) SelectWorker(
 		return nil, err
 	}
 
+	compatibleWorkers = enforcingEgressPolicy(compatibleWorkers, containerSpec)
+	if len(compatibleWorkers) == 0 {
+		return nil, NoEgressPolicyWorkersError{Spec: workerSpec}
+	}
+
 	var worker Worker
 dance:
 	for _, w := range workersWithContainer {
@@ -210,6 +225,17 @@ dance:
 	return NewClient(worker), nil
 }
 
+func enforcingEgressPolicy(workers []Worker, containerSpec ContainerSpec) []Worker {
+	enforcing := []Worker{}
+	for _, worker := range workers {
+		if worker.EnforcesEgressPolicy(containerSpec) {
+			enforcing = append(enforcing, worker)
+		}
+	}
+
+	return enforcing
+}
+
 func (pool *pool) chooseRandomWorkerForVolume(
 	logger lager.Logger,
 	workerSpec WorkerSpec,
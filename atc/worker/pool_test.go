@@ -235,9 +235,11 @@ var _ = Describe("Pool", func() {
 
 			incompatibleWorker = new(workerfakes.FakeWorker)
 			incompatibleWorker.SatisfiesReturns(false)
+			incompatibleWorker.EnforcesEgressPolicyReturns(true)
 
 			compatibleWorker = new(workerfakes.FakeWorker)
 			compatibleWorker.SatisfiesReturns(true)
+			compatibleWorker.EnforcesEgressPolicyReturns(true)
 		})
 
 		JustBeforeEach(func() {
@@ -260,10 +262,13 @@ var _ = Describe("Pool", func() {
 			BeforeEach(func() {
 				workerA = new(workerfakes.FakeWorker)
 				workerA.NameReturns("workerA")
+				workerA.EnforcesEgressPolicyReturns(true)
 				workerB = new(workerfakes.FakeWorker)
 				workerB.NameReturns("workerB")
+				workerB.EnforcesEgressPolicyReturns(true)
 				workerC = new(workerfakes.FakeWorker)
 				workerC.NameReturns("workerC")
+				workerC.EnforcesEgressPolicyReturns(true)
 
 				fakeProvider.FindWorkersForContainerByOwnerReturns([]Worker{workerA, workerB, workerC}, nil)
 				fakeProvider.RunningWorkersReturns([]Worker{workerA, workerB, workerC}, nil)
@@ -336,6 +341,10 @@ var _ = Describe("Pool", func() {
 					workerC = new(workerfakes.FakeWorker)
 					workerA.NameReturns("workerA")
 
+					workerA.EnforcesEgressPolicyReturns(true)
+					workerB.EnforcesEgressPolicyReturns(true)
+					workerC.EnforcesEgressPolicyReturns(true)
+
 					workerA.SatisfiesReturns(true)
 					workerB.SatisfiesReturns(true)
 					workerC.SatisfiesReturns(false)
@@ -375,14 +384,17 @@ var _ = Describe("Pool", func() {
 				BeforeEach(func() {
 					teamWorker1 = new(workerfakes.FakeWorker)
 					teamWorker1.SatisfiesReturns(true)
+					teamWorker1.EnforcesEgressPolicyReturns(true)
 					teamWorker1.IsOwnedByTeamReturns(true)
 					teamWorker2 = new(workerfakes.FakeWorker)
 					teamWorker2.SatisfiesReturns(true)
+					teamWorker2.EnforcesEgressPolicyReturns(true)
 					teamWorker2.IsOwnedByTeamReturns(true)
 					teamWorker3 = new(workerfakes.FakeWorker)
 					teamWorker3.SatisfiesReturns(false)
 					generalWorker = new(workerfakes.FakeWorker)
 					generalWorker.SatisfiesReturns(true)
+					generalWorker.EnforcesEgressPolicyReturns(true)
 					generalWorker.IsOwnedByTeamReturns(false)
 					fakeProvider.RunningWorkersReturns([]Worker{generalWorker, teamWorker1, teamWorker2, teamWorker3}, nil)
 					fakeStrategy.ChooseReturns(teamWorker1, nil)
@@ -406,6 +418,7 @@ var _ = Describe("Pool", func() {
 					teamWorker.SatisfiesReturns(false)
 					generalWorker1 = new(workerfakes.FakeWorker)
 					generalWorker1.SatisfiesReturns(true)
+					generalWorker1.EnforcesEgressPolicyReturns(true)
 					generalWorker1.IsOwnedByTeamReturns(false)
 					generalWorker2 = new(workerfakes.FakeWorker)
 					generalWorker2.SatisfiesReturns(false)
@@ -453,6 +466,24 @@ var _ = Describe("Pool", func() {
 				})
 			})
 
+			Context("with compatible workers which do not enforce the container's egress policy", func() {
+				BeforeEach(func() {
+					compatibleWorker.EnforcesEgressPolicyReturns(false)
+					fakeProvider.RunningWorkersReturns([]Worker{compatibleWorker}, nil)
+				})
+
+				It("checks the workers against the container spec", func() {
+					Expect(compatibleWorker.EnforcesEgressPolicyCallCount()).To(Equal(1))
+					Expect(compatibleWorker.EnforcesEgressPolicyArgsForCall(0)).To(Equal(spec))
+				})
+
+				It("returns NoEgressPolicyWorkersError", func() {
+					Expect(chooseErr).To(Equal(NoEgressPolicyWorkersError{
+						Spec: workerSpec,
+					}))
+				})
+			})
+
 			Context("with compatible workers available", func() {
 				BeforeEach(func() {
 					fakeProvider.RunningWorkersReturns([]Worker{
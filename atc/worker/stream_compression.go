@@ -0,0 +1,96 @@
+package worker
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/compression"
+)
+
+// StreamedSizeProperty is the volume property recording how many bytes were
+// streamed the last time the volume was streamed to another worker.
+const StreamedSizeProperty = "concourse:streamed-size"
+
+//go:generate counterfeiter . CompressionSelector
+
+// CompressionSelector is a Compression which picks the compression of each
+// volume streamed between two workers. It behaves as its fallback compression
+// everywhere else, e.g. when streaming to and from fly.
+type CompressionSelector interface {
+	compression.Compression
+
+	ForStream(lager.Logger, Volume, ArtifactDestination) compression.Compression
+	Streamed(lager.Logger, Volume, StreamStats)
+}
+
+// AutoCompressionConfig configures picking the compression of each stream
+// based on the size of the volume.
+//
+// The size of a volume is only known once it has been streamed, so the first
+// stream of every volume uses the fallback compression.
+type AutoCompressionConfig struct {
+	// LargeVolumeSize is the streamed size from which a volume is streamed
+	// with zstd rather than the fallback compression.
+	LargeVolumeSize int64
+}
+
+type compressionSelector struct {
+	compression.Compression
+
+	auto AutoCompressionConfig
+}
+
+// NewAutoCompression streams small volumes with the fallback compression. Large
+// volumes are streamed between workers with zstd, which every worker's
+// baggageclaim supports, as it compresses much faster than gzip.
+func NewAutoCompression(
+	fallback compression.Compression,
+	config AutoCompressionConfig,
+) CompressionSelector {
+	return &compressionSelector{
+		Compression: fallback,
+		auto:        config,
+	}
+}
+
+func (selector *compressionSelector) ForStream(logger lager.Logger, source Volume, destination ArtifactDestination) compression.Compression {
+	// only streams into a volume are between workers
+	_, ok := destination.(Volume)
+	if !ok {
+		return selector.Compression
+	}
+
+	if streamedSize(logger, source) < selector.auto.LargeVolumeSize {
+		return selector.Compression
+	}
+
+	return compression.NewZstdCompression()
+}
+
+// Streamed records the size of the stream on the volume, so that the next
+// stream of a large volume knows it is large.
+func (selector *compressionSelector) Streamed(logger lager.Logger, source Volume, stats StreamStats) {
+	if stats.Bytes == 0 {
+		return
+	}
+
+	err := source.SetProperty(StreamedSizeProperty, strconv.FormatInt(stats.Bytes, 10))
+	if err != nil {
+		logger.Error("failed-to-record-streamed-size", err)
+	}
+}
+
+func streamedSize(logger lager.Logger, volume Volume) int64 {
+	properties, err := volume.Properties()
+	if err != nil {
+		logger.Error("failed-to-get-volume-properties", err)
+		return 0
+	}
+
+	size, err := strconv.ParseInt(properties[StreamedSizeProperty], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return size
+}
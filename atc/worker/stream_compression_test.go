@@ -0,0 +1,98 @@
+package worker_test
+
+import (
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CompressionSelector", func() {
+	var (
+		logger *lagertest.TestLogger
+
+		source      *workerfakes.FakeVolume
+		destination *workerfakes.FakeVolume
+
+		selector worker.CompressionSelector
+		selected compression.Compression
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+
+		source = new(workerfakes.FakeVolume)
+		source.WorkerNameReturns("source-worker")
+		source.PropertiesReturns(baggageclaim.VolumeProperties{
+			worker.StreamedSizeProperty: "2048",
+		}, nil)
+
+		destination = new(workerfakes.FakeVolume)
+		destination.WorkerNameReturns("destination-worker")
+	})
+
+	JustBeforeEach(func() {
+		selected = selector.ForStream(logger, source, destination)
+	})
+
+	Describe("auto", func() {
+		BeforeEach(func() {
+			selector = worker.NewAutoCompression(
+				compression.NewGzipCompression(),
+				worker.AutoCompressionConfig{
+					LargeVolumeSize: 1024,
+				},
+			)
+		})
+
+		It("streams large volumes with zstd", func() {
+			Expect(selected.Encoding()).To(Equal(baggageclaim.ZstdEncoding))
+		})
+
+		It("behaves as the fallback outside of streams between workers", func() {
+			Expect(selector.Encoding()).To(Equal(baggageclaim.GzipEncoding))
+		})
+
+		It("uses the fallback when streaming to something other than a volume", func() {
+			selected := selector.ForStream(logger, source, new(workerfakes.FakeArtifactDestination))
+			Expect(selected.Encoding()).To(Equal(baggageclaim.GzipEncoding))
+		})
+
+		Context("when the volume is small", func() {
+			BeforeEach(func() {
+				source.PropertiesReturns(baggageclaim.VolumeProperties{
+					worker.StreamedSizeProperty: "512",
+				}, nil)
+			})
+
+			It("uses the fallback", func() {
+				Expect(selected.Encoding()).To(Equal(baggageclaim.GzipEncoding))
+			})
+		})
+
+		Context("when the volume has never been streamed", func() {
+			BeforeEach(func() {
+				source.PropertiesReturns(baggageclaim.VolumeProperties{}, nil)
+			})
+
+			It("uses the fallback", func() {
+				Expect(selected.Encoding()).To(Equal(baggageclaim.GzipEncoding))
+			})
+		})
+
+		Describe("Streamed", func() {
+			It("records the streamed size on the volume", func() {
+				selector.Streamed(logger, source, worker.StreamStats{Bytes: 4096})
+
+				Expect(source.SetPropertyCallCount()).To(Equal(1))
+				key, value := source.SetPropertyArgsForCall(0)
+				Expect(key).To(Equal(worker.StreamedSizeProperty))
+				Expect(value).To(Equal("4096"))
+			})
+		})
+	})
+})
@@ -30,8 +30,20 @@ import (
 
 const userPropertyName = "user"
 
+// egressPolicyPropertyName is the container property through which the
+// containerd runtime learns the egress policy to enforce on the container.
+const egressPolicyPropertyName = "concourse:egress-policy"
+
+// runtimeClassPropertyName is the container property through which the
+// containerd runtime learns the runtime class to run the container with.
+const runtimeClassPropertyName = "concourse:runtime-class"
+
 var ResourceConfigCheckSessionExpiredError = errors.New("no db container was found for owner")
 
+// ErrEgressPolicyNotEnforced is returned when creating a container to which an
+// egress policy applies on a worker whose runtime does not enforce it.
+var ErrEgressPolicyNotEnforced = errors.New("worker does not enforce egress policies")
+
 //go:generate counterfeiter . Worker
 
 type Worker interface {
@@ -41,11 +53,13 @@ type Worker interface {
 	Name() string
 	ResourceTypes() []atc.WorkerResourceType
 	Tags() atc.Tags
+	Labels() map[string]string
 	Uptime() time.Duration
 	IsOwnedByTeam() bool
 	Ephemeral() bool
 	IsVersionCompatible(lager.Logger, version.Version) bool
 	Satisfies(lager.Logger, WorkerSpec) bool
+	EnforcesEgressPolicy(ContainerSpec) bool
 	FindContainerByHandle(lager.Logger, int, string) (Container, bool, error)
 
 	FindOrCreateContainer(
@@ -81,6 +95,7 @@ type Worker interface {
 
 	ActiveContainers() int
 	ActiveVolumes() int
+	Resources() *atc.WorkerResources
 }
 
 type gardenWorker struct {
@@ -106,17 +121,19 @@ func NewGardenWorker(
 	dbTeamFactory db.TeamFactory,
 	dbWorker db.Worker,
 	resourceCacheFactory db.ResourceCacheFactory,
+	egressPolicies atc.EgressPolicies,
 	numBuildContainers int,
 	// TODO: numBuildContainers is only needed for placement strategy but this
 	// method is called in ContainerProvider.FindOrCreateContainer as well and
 	// hence we pass in 0 values for numBuildContainers everywhere.
 ) Worker {
 	workerHelper := workerHelper{
-		gardenClient:  gardenClient,
-		volumeClient:  volumeClient,
-		volumeRepo:    volumeRepository,
-		dbTeamFactory: dbTeamFactory,
-		dbWorker:      dbWorker,
+		gardenClient:   gardenClient,
+		volumeClient:   volumeClient,
+		volumeRepo:     volumeRepository,
+		dbTeamFactory:  dbTeamFactory,
+		dbWorker:       dbWorker,
+		egressPolicies: egressPolicies,
 	}
 
 	return &gardenWorker{
@@ -696,6 +713,10 @@ func (worker *gardenWorker) Tags() atc.Tags {
 	return worker.dbWorker.Tags()
 }
 
+func (worker *gardenWorker) Labels() map[string]string {
+	return worker.dbWorker.Labels()
+}
+
 func (worker *gardenWorker) Ephemeral() bool {
 	return worker.dbWorker.Ephemeral()
 }
@@ -736,6 +757,18 @@ func (worker *gardenWorker) Satisfies(logger lager.Logger, spec WorkerSpec) bool
 		return false
 	}
 
+	if spec.Selector != nil && !spec.Selector.Matches(worker.dbWorker.Labels()) {
+		return false
+	}
+
+	if spec.RuntimeClass != "" && !worker.supportsRuntimeClass(spec.RuntimeClass) {
+		return false
+	}
+
+	if maintenance := worker.dbWorker.Maintenance(); maintenance != nil && maintenance.Draining(time.Now()) {
+		return false
+	}
+
 	return true
 }
 
@@ -748,6 +781,10 @@ func (worker *gardenWorker) Description() string {
 		messages = append(messages, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	if labels := worker.dbWorker.Labels(); len(labels) > 0 {
+		messages = append(messages, fmt.Sprintf("labels '%s'", atc.FormatWorkerLabels(labels)))
+	}
+
 	return strings.Join(messages, ", ")
 }
 
@@ -779,6 +816,26 @@ insert_coin:
 	return true
 }
 
+// EnforcesEgressPolicy returns false if an egress policy applies to the
+// container but the worker's runtime does not enforce egress policies.
+func (worker *gardenWorker) EnforcesEgressPolicy(spec ContainerSpec) bool {
+	if worker.helper.egressPolicies.For(spec.TeamName, spec.PipelineName) == nil {
+		return true
+	}
+
+	return worker.dbWorker.EnforcesEgressPolicies()
+}
+
+func (worker *gardenWorker) supportsRuntimeClass(class string) bool {
+	for _, c := range worker.dbWorker.RuntimeClasses() {
+		if c == class {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (worker *gardenWorker) ActiveTasks() (int, error) {
 	return worker.dbWorker.ActiveTasks()
 }
@@ -796,3 +853,7 @@ func (worker *gardenWorker) ActiveContainers() int {
 func (worker *gardenWorker) ActiveVolumes() int {
 	return worker.dbWorker.ActiveVolumes()
 }
+
+func (worker *gardenWorker) Resources() *atc.WorkerResources {
+	return worker.dbWorker.Resources()
+}
@@ -1,11 +1,13 @@
 package worker
 
 import (
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/worker/gclient"
 )
@@ -16,6 +18,8 @@ type workerHelper struct {
 	volumeRepo    db.VolumeRepository
 	dbTeamFactory db.TeamFactory
 	dbWorker      db.Worker
+
+	egressPolicies atc.EgressPolicies
 }
 
 func (w workerHelper) createGardenContainer(
@@ -33,6 +37,23 @@ func (w workerHelper) createGardenContainer(
 		gardenProperties[userPropertyName] = fetchedImage.Metadata.User
 	}
 
+	if containerSpec.RuntimeClass != "" {
+		gardenProperties[runtimeClassPropertyName] = containerSpec.RuntimeClass
+	}
+
+	if policy := w.egressPolicies.For(containerSpec.TeamName, containerSpec.PipelineName); policy != nil {
+		if !w.dbWorker.EnforcesEgressPolicies() {
+			return nil, ErrEgressPolicyNotEnforced
+		}
+
+		payload, err := json.Marshal(policy)
+		if err != nil {
+			return nil, err
+		}
+
+		gardenProperties[egressPolicyPropertyName] = string(payload)
+	}
+
 	env := append(fetchedImage.Metadata.Env, containerSpec.Env...)
 
 	if w.dbWorker.HTTPProxyURL() != "" {
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/lager"
@@ -43,6 +44,7 @@ var _ = Describe("Worker", func() {
 		fakeDBWorker             *dbfakes.FakeWorker
 		fakeDBVolumeRepository   *dbfakes.FakeVolumeRepository
 		fakeResourceCacheFactory *dbfakes.FakeResourceCacheFactory
+		egressPolicies           atc.EgressPolicies
 		fakeDBTeamFactory        *dbfakes.FakeTeamFactory
 		fakeDBTeam               *dbfakes.FakeTeam
 		fakeCreatingContainer    *dbfakes.FakeCreatingContainer
@@ -105,6 +107,7 @@ var _ = Describe("Worker", func() {
 
 		fakeDBVolumeRepository = new(dbfakes.FakeVolumeRepository)
 		fakeResourceCacheFactory = new(dbfakes.FakeResourceCacheFactory)
+		egressPolicies = atc.EgressPolicies{}
 
 		fakeDBTeamFactory = new(dbfakes.FakeTeamFactory)
 		fakeDBTeam = new(dbfakes.FakeTeam)
@@ -224,6 +227,7 @@ var _ = Describe("Worker", func() {
 			fakeDBTeamFactory,
 			fakeDBWorker,
 			fakeResourceCacheFactory,
+			egressPolicies,
 			0,
 		)
 	})
@@ -603,6 +607,129 @@ var _ = Describe("Worker", func() {
 					Expect(satisfies).To(BeFalse())
 				})
 			})
+
+			Context("when the worker is being drained for maintenance", func() {
+				BeforeEach(func() {
+					spec.Tags = []string{"some"}
+					fakeDBWorker.MaintenanceReturns(&db.MaintenanceWindow{
+						DrainStartsAt: time.Now().Add(-time.Minute),
+						StartsAt:      time.Now().Add(time.Minute),
+						EndsAt:        time.Now().Add(time.Hour),
+					})
+				})
+
+				It("returns false", func() {
+					Expect(satisfies).To(BeFalse())
+				})
+			})
+
+			Context("when the worker's maintenance window has started", func() {
+				BeforeEach(func() {
+					spec.Tags = []string{"some"}
+					fakeDBWorker.MaintenanceReturns(&db.MaintenanceWindow{
+						DrainStartsAt: time.Now().Add(-time.Hour),
+						StartsAt:      time.Now().Add(-time.Minute),
+						EndsAt:        time.Now().Add(time.Hour),
+					})
+				})
+
+				It("returns false", func() {
+					Expect(satisfies).To(BeFalse())
+				})
+			})
+
+			Context("when the worker's maintenance window has ended", func() {
+				BeforeEach(func() {
+					spec.Tags = []string{"some"}
+					fakeDBWorker.MaintenanceReturns(&db.MaintenanceWindow{
+						DrainStartsAt: time.Now().Add(-2 * time.Hour),
+						StartsAt:      time.Now().Add(-2 * time.Hour),
+						EndsAt:        time.Now().Add(-time.Minute),
+					})
+				})
+
+				It("returns true", func() {
+					Expect(satisfies).To(BeTrue())
+				})
+			})
+
+			Context("when the worker has maintenance scheduled later", func() {
+				BeforeEach(func() {
+					spec.Tags = []string{"some"}
+					fakeDBWorker.MaintenanceReturns(&db.MaintenanceWindow{
+						DrainStartsAt: time.Now().Add(time.Minute),
+						StartsAt:      time.Now().Add(time.Hour),
+						EndsAt:        time.Now().Add(2 * time.Hour),
+					})
+				})
+
+				It("returns true", func() {
+					Expect(satisfies).To(BeTrue())
+				})
+			})
+
+			Context("when a worker selector is specified", func() {
+				BeforeEach(func() {
+					fakeDBWorker.LabelsReturns(map[string]string{"arch": "arm64"})
+				})
+
+				Context("when the worker labels satisfy the required expressions", func() {
+					BeforeEach(func() {
+						spec.Selector = &atc.WorkerSelector{
+							Required: []atc.WorkerSelectorExpression{
+								{Key: "arch", Operator: atc.WorkerSelectorOperatorIn, Values: []string{"arm64"}},
+							},
+							Preferred: []atc.WorkerSelectorExpression{
+								{Key: "disk", Operator: atc.WorkerSelectorOperatorExists},
+							},
+						}
+					})
+
+					It("returns true", func() {
+						Expect(satisfies).To(BeTrue())
+					})
+				})
+
+				Context("when the worker labels do not satisfy the required expressions", func() {
+					BeforeEach(func() {
+						spec.Selector = &atc.WorkerSelector{
+							Required: []atc.WorkerSelectorExpression{
+								{Key: "arch", Operator: atc.WorkerSelectorOperatorNotIn, Values: []string{"arm64"}},
+							},
+						}
+					})
+
+					It("returns false", func() {
+						Expect(satisfies).To(BeFalse())
+					})
+				})
+			})
+
+			Context("when a runtime class is specified", func() {
+				BeforeEach(func() {
+					spec.RuntimeClass = "gvisor"
+				})
+
+				Context("when the worker supports the runtime class", func() {
+					BeforeEach(func() {
+						fakeDBWorker.RuntimeClassesReturns([]string{"kata", "gvisor"})
+					})
+
+					It("returns true", func() {
+						Expect(satisfies).To(BeTrue())
+					})
+				})
+
+				Context("when the worker does not support the runtime class", func() {
+					BeforeEach(func() {
+						fakeDBWorker.RuntimeClassesReturns([]string{"kata"})
+					})
+
+					It("returns false", func() {
+						Expect(satisfies).To(BeFalse())
+					})
+				})
+			})
 		})
 
 		Context("when the platform is incompatible", func() {
@@ -717,6 +844,55 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("EnforcesEgressPolicy", func() {
+		var (
+			spec ContainerSpec
+
+			enforces bool
+		)
+
+		BeforeEach(func() {
+			spec = ContainerSpec{
+				TeamName:     "some-team",
+				PipelineName: "some-pipeline",
+			}
+		})
+
+		JustBeforeEach(func() {
+			enforces = gardenWorker.EnforcesEgressPolicy(spec)
+		})
+
+		Context("when no egress policy applies to the container", func() {
+			It("returns true", func() {
+				Expect(enforces).To(BeTrue())
+			})
+		})
+
+		Context("when an egress policy applies to the container", func() {
+			BeforeEach(func() {
+				egressPolicies = atc.EgressPolicies{
+					Default: &atc.EgressPolicy{BlockMetadata: true},
+				}
+			})
+
+			Context("when the worker enforces egress policies", func() {
+				BeforeEach(func() {
+					fakeDBWorker.EnforcesEgressPoliciesReturns(true)
+				})
+
+				It("returns true", func() {
+					Expect(enforces).To(BeTrue())
+				})
+			})
+
+			Context("when the worker does not enforce egress policies", func() {
+				It("returns false", func() {
+					Expect(enforces).To(BeFalse())
+				})
+			})
+		})
+	})
+
 	Describe("FindOrCreateContainer", func() {
 		CertsVolumeExists := func() {
 			fakeCertsVolume := new(baggageclaimfakes.FakeVolume)
@@ -865,6 +1041,55 @@ var _ = Describe("Worker", func() {
 					}))
 				})
 
+				Context("when a runtime class is requested", func() {
+					BeforeEach(func() {
+						containerSpec.RuntimeClass = "gvisor"
+					})
+
+					It("passes it in the container's properties", func() {
+						actualSpec := fakeGardenClient.CreateArgsForCall(0)
+						Expect(actualSpec.Properties).To(HaveKeyWithValue("concourse:runtime-class", "gvisor"))
+					})
+				})
+
+				Context("when an egress policy is enforced on the container's pipeline", func() {
+					BeforeEach(func() {
+						containerSpec.TeamName = "some-team"
+						containerSpec.PipelineName = "some-pipeline"
+
+						egressPolicies = atc.EgressPolicies{
+							Default: &atc.EgressPolicy{BlockMetadata: true},
+							Teams: map[string]atc.TeamEgressPolicy{
+								"some-team": {
+									Pipelines: map[string]atc.EgressPolicy{
+										"some-pipeline": {
+											Allow: []atc.EgressRule{{Network: "10.0.0.0/8"}},
+										},
+									},
+								},
+							},
+						}
+
+						fakeDBWorker.EnforcesEgressPoliciesReturns(true)
+					})
+
+					It("passes it in the container's properties", func() {
+						actualSpec := fakeGardenClient.CreateArgsForCall(0)
+						Expect(actualSpec.Properties).To(HaveKeyWithValue("concourse:egress-policy", `{"allow":[{"network":"10.0.0.0/8"}]}`))
+					})
+
+					Context("when the worker does not enforce egress policies", func() {
+						BeforeEach(func() {
+							fakeDBWorker.EnforcesEgressPoliciesReturns(false)
+						})
+
+						It("does not create the container", func() {
+							Expect(errors.Is(findOrCreateErr, ErrEgressPolicyNotEnforced)).To(BeTrue())
+							Expect(fakeGardenClient.CreateCallCount()).To(BeZero())
+						})
+					})
+				})
+
 				Context("when the input and output destination paths overlap", func() {
 					var (
 						fakeRemoteInputUnderInput    *workerfakes.FakeInputSource
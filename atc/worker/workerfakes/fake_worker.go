@@ -100,6 +100,17 @@ type FakeWorker struct {
 	descriptionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	EnforcesEgressPolicyStub        func(worker.ContainerSpec) bool
+	enforcesEgressPolicyMutex       sync.RWMutex
+	enforcesEgressPolicyArgsForCall []struct {
+		arg1 worker.ContainerSpec
+	}
+	enforcesEgressPolicyReturns struct {
+		result1 bool
+	}
+	enforcesEgressPolicyReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	EphemeralStub        func() bool
 	ephemeralMutex       sync.RWMutex
 	ephemeralArgsForCall []struct {
@@ -250,6 +261,16 @@ type FakeWorker struct {
 	isVersionCompatibleReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	LabelsStub        func() map[string]string
+	labelsMutex       sync.RWMutex
+	labelsArgsForCall []struct {
+	}
+	labelsReturns struct {
+		result1 map[string]string
+	}
+	labelsReturnsOnCall map[int]struct {
+		result1 map[string]string
+	}
 	LookupVolumeStub        func(lager.Logger, string) (worker.Volume, bool, error)
 	lookupVolumeMutex       sync.RWMutex
 	lookupVolumeArgsForCall []struct {
@@ -286,6 +307,16 @@ type FakeWorker struct {
 	resourceTypesReturnsOnCall map[int]struct {
 		result1 []atc.WorkerResourceType
 	}
+	ResourcesStub        func() *atc.WorkerResources
+	resourcesMutex       sync.RWMutex
+	resourcesArgsForCall []struct {
+	}
+	resourcesReturns struct {
+		result1 *atc.WorkerResources
+	}
+	resourcesReturnsOnCall map[int]struct {
+		result1 *atc.WorkerResources
+	}
 	SatisfiesStub        func(lager.Logger, worker.WorkerSpec) bool
 	satisfiesMutex       sync.RWMutex
 	satisfiesArgsForCall []struct {
@@ -717,6 +748,66 @@ func (fake *FakeWorker) DescriptionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) EnforcesEgressPolicy(arg1 worker.ContainerSpec) bool {
+	fake.enforcesEgressPolicyMutex.Lock()
+	ret, specificReturn := fake.enforcesEgressPolicyReturnsOnCall[len(fake.enforcesEgressPolicyArgsForCall)]
+	fake.enforcesEgressPolicyArgsForCall = append(fake.enforcesEgressPolicyArgsForCall, struct {
+		arg1 worker.ContainerSpec
+	}{arg1})
+	fake.recordInvocation("EnforcesEgressPolicy", []interface{}{arg1})
+	fake.enforcesEgressPolicyMutex.Unlock()
+	if fake.EnforcesEgressPolicyStub != nil {
+		return fake.EnforcesEgressPolicyStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.enforcesEgressPolicyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) EnforcesEgressPolicyCallCount() int {
+	fake.enforcesEgressPolicyMutex.RLock()
+	defer fake.enforcesEgressPolicyMutex.RUnlock()
+	return len(fake.enforcesEgressPolicyArgsForCall)
+}
+
+func (fake *FakeWorker) EnforcesEgressPolicyCalls(stub func(worker.ContainerSpec) bool) {
+	fake.enforcesEgressPolicyMutex.Lock()
+	defer fake.enforcesEgressPolicyMutex.Unlock()
+	fake.EnforcesEgressPolicyStub = stub
+}
+
+func (fake *FakeWorker) EnforcesEgressPolicyArgsForCall(i int) worker.ContainerSpec {
+	fake.enforcesEgressPolicyMutex.RLock()
+	defer fake.enforcesEgressPolicyMutex.RUnlock()
+	argsForCall := fake.enforcesEgressPolicyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) EnforcesEgressPolicyReturns(result1 bool) {
+	fake.enforcesEgressPolicyMutex.Lock()
+	defer fake.enforcesEgressPolicyMutex.Unlock()
+	fake.EnforcesEgressPolicyStub = nil
+	fake.enforcesEgressPolicyReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorker) EnforcesEgressPolicyReturnsOnCall(i int, result1 bool) {
+	fake.enforcesEgressPolicyMutex.Lock()
+	defer fake.enforcesEgressPolicyMutex.Unlock()
+	fake.EnforcesEgressPolicyStub = nil
+	if fake.enforcesEgressPolicyReturnsOnCall == nil {
+		fake.enforcesEgressPolicyReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.enforcesEgressPolicyReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeWorker) Ephemeral() bool {
 	fake.ephemeralMutex.Lock()
 	ret, specificReturn := fake.ephemeralReturnsOnCall[len(fake.ephemeralArgsForCall)]
@@ -1347,6 +1438,58 @@ func (fake *FakeWorker) IsVersionCompatibleReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Labels() map[string]string {
+	fake.labelsMutex.Lock()
+	ret, specificReturn := fake.labelsReturnsOnCall[len(fake.labelsArgsForCall)]
+	fake.labelsArgsForCall = append(fake.labelsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Labels", []interface{}{})
+	fake.labelsMutex.Unlock()
+	if fake.LabelsStub != nil {
+		return fake.LabelsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.labelsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) LabelsCallCount() int {
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
+	return len(fake.labelsArgsForCall)
+}
+
+func (fake *FakeWorker) LabelsCalls(stub func() map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = stub
+}
+
+func (fake *FakeWorker) LabelsReturns(result1 map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	fake.labelsReturns = struct {
+		result1 map[string]string
+	}{result1}
+}
+
+func (fake *FakeWorker) LabelsReturnsOnCall(i int, result1 map[string]string) {
+	fake.labelsMutex.Lock()
+	defer fake.labelsMutex.Unlock()
+	fake.LabelsStub = nil
+	if fake.labelsReturnsOnCall == nil {
+		fake.labelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]string
+		})
+	}
+	fake.labelsReturnsOnCall[i] = struct {
+		result1 map[string]string
+	}{result1}
+}
+
 func (fake *FakeWorker) LookupVolume(arg1 lager.Logger, arg2 string) (worker.Volume, bool, error) {
 	fake.lookupVolumeMutex.Lock()
 	ret, specificReturn := fake.lookupVolumeReturnsOnCall[len(fake.lookupVolumeArgsForCall)]
@@ -1518,6 +1661,58 @@ func (fake *FakeWorker) ResourceTypesReturnsOnCall(i int, result1 []atc.WorkerRe
 	}{result1}
 }
 
+func (fake *FakeWorker) Resources() *atc.WorkerResources {
+	fake.resourcesMutex.Lock()
+	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
+	fake.resourcesArgsForCall = append(fake.resourcesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Resources", []interface{}{})
+	fake.resourcesMutex.Unlock()
+	if fake.ResourcesStub != nil {
+		return fake.ResourcesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourcesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ResourcesCallCount() int {
+	fake.resourcesMutex.RLock()
+	defer fake.resourcesMutex.RUnlock()
+	return len(fake.resourcesArgsForCall)
+}
+
+func (fake *FakeWorker) ResourcesCalls(stub func() *atc.WorkerResources) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = stub
+}
+
+func (fake *FakeWorker) ResourcesReturns(result1 *atc.WorkerResources) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = nil
+	fake.resourcesReturns = struct {
+		result1 *atc.WorkerResources
+	}{result1}
+}
+
+func (fake *FakeWorker) ResourcesReturnsOnCall(i int, result1 *atc.WorkerResources) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = nil
+	if fake.resourcesReturnsOnCall == nil {
+		fake.resourcesReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerResources
+		})
+	}
+	fake.resourcesReturnsOnCall[i] = struct {
+		result1 *atc.WorkerResources
+	}{result1}
+}
+
 func (fake *FakeWorker) Satisfies(arg1 lager.Logger, arg2 worker.WorkerSpec) bool {
 	fake.satisfiesMutex.Lock()
 	ret, specificReturn := fake.satisfiesReturnsOnCall[len(fake.satisfiesArgsForCall)]
@@ -1700,6 +1895,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.createVolumeMutex.RUnlock()
 	fake.descriptionMutex.RLock()
 	defer fake.descriptionMutex.RUnlock()
+	fake.enforcesEgressPolicyMutex.RLock()
+	defer fake.enforcesEgressPolicyMutex.RUnlock()
 	fake.ephemeralMutex.RLock()
 	defer fake.ephemeralMutex.RUnlock()
 	fake.fetchMutex.RLock()
@@ -1720,12 +1917,16 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.isOwnedByTeamMutex.RUnlock()
 	fake.isVersionCompatibleMutex.RLock()
 	defer fake.isVersionCompatibleMutex.RUnlock()
+	fake.labelsMutex.RLock()
+	defer fake.labelsMutex.RUnlock()
 	fake.lookupVolumeMutex.RLock()
 	defer fake.lookupVolumeMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
 	defer fake.resourceTypesMutex.RUnlock()
+	fake.resourcesMutex.RLock()
+	defer fake.resourcesMutex.RUnlock()
 	fake.satisfiesMutex.RLock()
 	defer fake.satisfiesMutex.RUnlock()
 	fake.tagsMutex.RLock()
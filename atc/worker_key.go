@@ -0,0 +1,101 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// WorkerKey is a public key with which the workers of a team register
+// through the TSA.
+type WorkerKey struct {
+	TeamName    string `json:"team_name"`
+	Name        string `json:"name"`
+	PublicKey   string `json:"public_key"`
+	Fingerprint string `json:"fingerprint"`
+
+	// WorkerName and Tags are set for keys registered by a worker with a
+	// registration token. Such keys can only register that worker, with
+	// those tags.
+	WorkerName string   `json:"worker_name,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+
+	CreatedAt int64 `json:"created_at"`
+
+	// ExpiresAt is when the TSA stops accepting the key. Zero means never.
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+
+	LastUsedAt int64 `json:"last_used_at,omitempty"`
+}
+
+// Expired returns whether the TSA no longer accepts the key.
+func (key WorkerKey) Expired(now time.Time) bool {
+	return key.ExpiresAt != 0 && key.ExpiresAt <= now.Unix()
+}
+
+// SetWorkerKeyRequest sets a worker key of a team.
+type SetWorkerKeyRequest struct {
+	// PublicKey is in SSH authorized_keys format.
+	PublicKey string `json:"public_key"`
+
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+
+	// Replaces names another key of the team which is being rotated out. It
+	// keeps being accepted until ReplacedKeyExpiresAt, so that workers can be
+	// moved over to the new key.
+	Replaces             string `json:"replaces,omitempty"`
+	ReplacedKeyExpiresAt int64  `json:"replaced_key_expires_at,omitempty"`
+}
+
+// WorkerKeyUse records when the TSA last accepted a worker key.
+type WorkerKeyUse struct {
+	TeamName string `json:"team_name"`
+	Name     string `json:"name"`
+	UsedAt   int64  `json:"used_at"`
+}
+
+var (
+	ErrWorkerKeyExpiry         = errors.New("worker key must expire in the future")
+	ErrWorkerKeyReplacesItself = errors.New("worker key cannot replace itself")
+	ErrWorkerKeyReplacedExpiry = errors.New("replaced worker key must expire in the future")
+)
+
+func (request SetWorkerKeyRequest) Validate(name string, now time.Time) error {
+	_, err := ParseWorkerKey(request.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	if request.ExpiresAt != 0 && request.ExpiresAt <= now.Unix() {
+		return ErrWorkerKeyExpiry
+	}
+
+	if request.Replaces != "" {
+		if request.Replaces == name {
+			return ErrWorkerKeyReplacesItself
+		}
+
+		if request.ReplacedKeyExpiresAt <= now.Unix() {
+			return ErrWorkerKeyReplacedExpiry
+		}
+	}
+
+	return nil
+}
+
+// ParseWorkerKey parses a single public key in SSH authorized_keys format.
+func ParseWorkerKey(publicKey string) (ssh.PublicKey, error) {
+	key, _, _, rest, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+
+	if strings.TrimSpace(string(rest)) != "" {
+		return nil, errors.New("invalid public key: only one key can be given")
+	}
+
+	return key, nil
+}
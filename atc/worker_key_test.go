@@ -0,0 +1,73 @@
+package atc_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SetWorkerKeyRequest", func() {
+	const somePublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFdKVCFpynKib6/W5IGS6qXrd/yxmYbgJKdH/zOv51fo some-comment"
+
+	var (
+		now     time.Time
+		request atc.SetWorkerKeyRequest
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		request = atc.SetWorkerKeyRequest{PublicKey: somePublicKey}
+	})
+
+	It("accepts a public key", func() {
+		Expect(request.Validate("some-key", now)).To(Succeed())
+	})
+
+	It("rejects an invalid public key", func() {
+		request.PublicKey = "bogus"
+		Expect(request.Validate("some-key", now)).To(MatchError(ContainSubstring("invalid public key")))
+	})
+
+	It("rejects more than one public key", func() {
+		request.PublicKey = somePublicKey + "\n" + somePublicKey
+		Expect(request.Validate("some-key", now)).To(MatchError("invalid public key: only one key can be given"))
+	})
+
+	It("rejects an expiry in the past", func() {
+		request.ExpiresAt = now.Add(-time.Minute).Unix()
+		Expect(request.Validate("some-key", now)).To(Equal(atc.ErrWorkerKeyExpiry))
+	})
+
+	Context("when replacing another key", func() {
+		BeforeEach(func() {
+			request.Replaces = "some-old-key"
+			request.ReplacedKeyExpiresAt = now.Add(time.Hour).Unix()
+		})
+
+		It("accepts the replacement", func() {
+			Expect(request.Validate("some-key", now)).To(Succeed())
+		})
+
+		It("rejects replacing itself", func() {
+			Expect(request.Validate("some-old-key", now)).To(Equal(atc.ErrWorkerKeyReplacesItself))
+		})
+
+		It("requires the replaced key to expire in the future", func() {
+			request.ReplacedKeyExpiresAt = 0
+			Expect(request.Validate("some-key", now)).To(Equal(atc.ErrWorkerKeyReplacedExpiry))
+		})
+	})
+})
+
+var _ = Describe("WorkerKey", func() {
+	It("expires at its expiry", func() {
+		now := time.Now()
+
+		Expect(atc.WorkerKey{}.Expired(now)).To(BeFalse())
+		Expect(atc.WorkerKey{ExpiresAt: now.Add(time.Minute).Unix()}.Expired(now)).To(BeFalse())
+		Expect(atc.WorkerKey{ExpiresAt: now.Unix()}.Expired(now)).To(BeTrue())
+	})
+})
@@ -0,0 +1,86 @@
+package atc
+
+import (
+	"errors"
+	"time"
+)
+
+type WorkerMaintenanceState string
+
+const (
+	// The window has not started draining the worker yet.
+	WorkerMaintenanceScheduled WorkerMaintenanceState = "scheduled"
+
+	// No new containers are placed on the worker, and running builds are
+	// given until the window starts to finish.
+	WorkerMaintenanceDraining WorkerMaintenanceState = "draining"
+
+	// Builds still running on the worker have been aborted, and it takes no
+	// work until the window ends.
+	WorkerMaintenanceInProgress WorkerMaintenanceState = "in-progress"
+)
+
+// MaintenanceWindow is a period during which a worker, or every worker with
+// a tag, is taken out of the pool.
+type MaintenanceWindow struct {
+	ID     int    `json:"id,omitempty"`
+	Worker string `json:"worker,omitempty"`
+	Tag    string `json:"tag,omitempty"`
+
+	// DrainStartsAt is when the pool stops placing new containers on the
+	// matching workers. It defaults to StartsAt.
+	DrainStartsAt int64 `json:"drain_starts_at,omitempty"`
+
+	StartsAt int64 `json:"starts_at"`
+	EndsAt   int64 `json:"ends_at"`
+
+	State WorkerMaintenanceState `json:"state,omitempty"`
+}
+
+// WorkerMaintenance describes the next maintenance window of a worker.
+type WorkerMaintenance struct {
+	WindowID      int                    `json:"window_id"`
+	DrainStartsAt int64                  `json:"drain_starts_at"`
+	StartsAt      int64                  `json:"starts_at"`
+	EndsAt        int64                  `json:"ends_at"`
+	State         WorkerMaintenanceState `json:"state"`
+}
+
+var (
+	ErrMaintenanceWindowTarget   = errors.New("exactly one of worker or tag must be specified")
+	ErrMaintenanceWindowEnd      = errors.New("maintenance window must end after it starts")
+	ErrMaintenanceWindowInPast   = errors.New("maintenance window has already ended")
+	ErrMaintenanceWindowDraining = errors.New("drain must start before the maintenance window starts")
+)
+
+func (window MaintenanceWindow) Validate(now time.Time) error {
+	if (window.Worker == "") == (window.Tag == "") {
+		return ErrMaintenanceWindowTarget
+	}
+
+	if window.EndsAt <= window.StartsAt {
+		return ErrMaintenanceWindowEnd
+	}
+
+	if window.EndsAt <= now.Unix() {
+		return ErrMaintenanceWindowInPast
+	}
+
+	if window.DrainStartsAt > window.StartsAt {
+		return ErrMaintenanceWindowDraining
+	}
+
+	return nil
+}
+
+// MaintenanceState returns the state of a window spanning the given times.
+func MaintenanceState(now, drainStartsAt, startsAt time.Time) WorkerMaintenanceState {
+	switch {
+	case now.Before(drainStartsAt):
+		return WorkerMaintenanceScheduled
+	case now.Before(startsAt):
+		return WorkerMaintenanceDraining
+	default:
+		return WorkerMaintenanceInProgress
+	}
+}
@@ -0,0 +1,102 @@
+package atc_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MaintenanceWindow", func() {
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Unix(1000, 0)
+	})
+
+	Describe("Validate", func() {
+		var window atc.MaintenanceWindow
+
+		BeforeEach(func() {
+			window = atc.MaintenanceWindow{
+				Worker:        "some-worker",
+				DrainStartsAt: 1500,
+				StartsAt:      2000,
+				EndsAt:        3000,
+			}
+		})
+
+		It("succeeds", func() {
+			Expect(window.Validate(now)).To(Succeed())
+		})
+
+		Context("when both a worker and a tag are given", func() {
+			BeforeEach(func() {
+				window.Tag = "some-tag"
+			})
+
+			It("errors", func() {
+				Expect(window.Validate(now)).To(Equal(atc.ErrMaintenanceWindowTarget))
+			})
+		})
+
+		Context("when neither a worker nor a tag is given", func() {
+			BeforeEach(func() {
+				window.Worker = ""
+			})
+
+			It("errors", func() {
+				Expect(window.Validate(now)).To(Equal(atc.ErrMaintenanceWindowTarget))
+			})
+		})
+
+		Context("when the window ends before it starts", func() {
+			BeforeEach(func() {
+				window.EndsAt = 2000
+			})
+
+			It("errors", func() {
+				Expect(window.Validate(now)).To(Equal(atc.ErrMaintenanceWindowEnd))
+			})
+		})
+
+		Context("when the window has already ended", func() {
+			BeforeEach(func() {
+				now = time.Unix(3000, 0)
+			})
+
+			It("errors", func() {
+				Expect(window.Validate(now)).To(Equal(atc.ErrMaintenanceWindowInPast))
+			})
+		})
+
+		Context("when draining starts after the window", func() {
+			BeforeEach(func() {
+				window.DrainStartsAt = 2500
+			})
+
+			It("errors", func() {
+				Expect(window.Validate(now)).To(Equal(atc.ErrMaintenanceWindowDraining))
+			})
+		})
+	})
+
+	Describe("MaintenanceState", func() {
+		drainStartsAt := time.Unix(1500, 0)
+		startsAt := time.Unix(2000, 0)
+
+		It("is scheduled before draining starts", func() {
+			Expect(atc.MaintenanceState(now, drainStartsAt, startsAt)).To(Equal(atc.WorkerMaintenanceScheduled))
+		})
+
+		It("is draining until the window starts", func() {
+			Expect(atc.MaintenanceState(time.Unix(1500, 0), drainStartsAt, startsAt)).To(Equal(atc.WorkerMaintenanceDraining))
+		})
+
+		It("is in progress once the window starts", func() {
+			Expect(atc.MaintenanceState(time.Unix(2000, 0), drainStartsAt, startsAt)).To(Equal(atc.WorkerMaintenanceInProgress))
+		})
+	})
+})
@@ -0,0 +1,92 @@
+package atc
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// WorkerRegistrationToken lets workers register a key of their own through
+// the TSA when they first connect, so that they do not need to be given a
+// private key shared by every worker.
+type WorkerRegistrationToken struct {
+	ID int `json:"id,omitempty"`
+
+	// TeamName and Tags, if set, must match the workers registering with the
+	// token.
+	TeamName string   `json:"team_name,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+
+	CreatedAt int64 `json:"created_at,omitempty"`
+	ExpiresAt int64 `json:"expires_at"`
+
+	// MaxUses is how many workers can register with the token. Zero means any
+	// number of workers until the token expires.
+	MaxUses int `json:"max_uses,omitempty"`
+	Uses    int `json:"uses,omitempty"`
+
+	// Token is the secret given to the workers. It is only returned when the
+	// token is created.
+	Token string `json:"token,omitempty"`
+}
+
+// RedeemWorkerRegistrationTokenRequest is sent by the TSA to exchange a
+// registration token for a key of the worker's own.
+type RedeemWorkerRegistrationTokenRequest struct {
+	Token string `json:"token"`
+
+	WorkerName string   `json:"worker_name"`
+	TeamName   string   `json:"team_name,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+
+	// PublicKey is in SSH authorized_keys format.
+	PublicKey string `json:"public_key"`
+}
+
+var (
+	ErrWorkerRegistrationTokenExpiry  = errors.New("registration token must expire in the future")
+	ErrWorkerRegistrationTokenMaxUses = errors.New("registration token max uses cannot be negative")
+	ErrWorkerRegistrationTokenWorker  = errors.New("worker name must be specified")
+)
+
+func (token WorkerRegistrationToken) Validate(now time.Time) error {
+	if token.ExpiresAt <= now.Unix() {
+		return ErrWorkerRegistrationTokenExpiry
+	}
+
+	if token.MaxUses < 0 {
+		return ErrWorkerRegistrationTokenMaxUses
+	}
+
+	return nil
+}
+
+func (request RedeemWorkerRegistrationTokenRequest) Validate() error {
+	if request.WorkerName == "" {
+		return ErrWorkerRegistrationTokenWorker
+	}
+
+	_, err := ParseWorkerKey(request.PublicKey)
+	return err
+}
+
+// SameTags reports whether the two sets of tags are equal, regardless of
+// their order.
+func SameTags(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
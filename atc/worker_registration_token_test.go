@@ -0,0 +1,81 @@
+package atc_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerRegistrationToken", func() {
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Unix(1000, 0)
+	})
+
+	Describe("Validate", func() {
+		var token atc.WorkerRegistrationToken
+
+		BeforeEach(func() {
+			token = atc.WorkerRegistrationToken{
+				TeamName:  "some-team",
+				ExpiresAt: 2000,
+				MaxUses:   1,
+			}
+		})
+
+		It("accepts a token expiring in the future", func() {
+			Expect(token.Validate(now)).To(Succeed())
+		})
+
+		It("rejects a token which has already expired", func() {
+			token.ExpiresAt = 1000
+			Expect(token.Validate(now)).To(Equal(atc.ErrWorkerRegistrationTokenExpiry))
+		})
+
+		It("rejects negative max uses", func() {
+			token.MaxUses = -1
+			Expect(token.Validate(now)).To(Equal(atc.ErrWorkerRegistrationTokenMaxUses))
+		})
+	})
+})
+
+var _ = Describe("RedeemWorkerRegistrationTokenRequest", func() {
+	var request atc.RedeemWorkerRegistrationTokenRequest
+
+	BeforeEach(func() {
+		request = atc.RedeemWorkerRegistrationTokenRequest{
+			Token:      "some-token",
+			WorkerName: "some-worker",
+			PublicKey:  "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFdKVCFpynKib6/W5IGS6qXrd/yxmYbgJKdH/zOv51fo",
+		}
+	})
+
+	It("accepts a worker and its public key", func() {
+		Expect(request.Validate()).To(Succeed())
+	})
+
+	It("requires a worker name", func() {
+		request.WorkerName = ""
+		Expect(request.Validate()).To(Equal(atc.ErrWorkerRegistrationTokenWorker))
+	})
+
+	It("rejects an invalid public key", func() {
+		request.PublicKey = "bogus"
+		Expect(request.Validate()).To(MatchError(ContainSubstring("invalid public key")))
+	})
+})
+
+var _ = Describe("SameTags", func() {
+	It("ignores the order of the tags", func() {
+		Expect(atc.SameTags([]string{"a", "b"}, []string{"b", "a"})).To(BeTrue())
+	})
+
+	It("rejects different tags", func() {
+		Expect(atc.SameTags([]string{"a", "b"}, []string{"a", "c"})).To(BeFalse())
+		Expect(atc.SameTags([]string{"a"}, []string{"a", "b"})).To(BeFalse())
+	})
+})
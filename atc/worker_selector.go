@@ -0,0 +1,138 @@
+package atc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+type WorkerSelectorOperator string
+
+const (
+	WorkerSelectorOperatorIn     WorkerSelectorOperator = "In"
+	WorkerSelectorOperatorNotIn  WorkerSelectorOperator = "NotIn"
+	WorkerSelectorOperatorExists WorkerSelectorOperator = "Exists"
+)
+
+// WorkerSelector selects the workers a step may run on by the labels they
+// registered with.
+type WorkerSelector struct {
+	// Expressions that every candidate worker must satisfy.
+	Required []WorkerSelectorExpression `json:"required,omitempty"`
+
+	// Expressions that workers satisfying the most of are preferred.
+	Preferred []WorkerSelectorExpression `json:"preferred,omitempty"`
+}
+
+type WorkerSelectorExpression struct {
+	Key      string                 `json:"key"`
+	Operator WorkerSelectorOperator `json:"operator"`
+	Values   []string               `json:"values,omitempty"`
+}
+
+// Matches returns whether the labels satisfy every required expression.
+func (selector WorkerSelector) Matches(labels map[string]string) bool {
+	for _, expr := range selector.Required {
+		if !expr.Matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PreferredExpressions returns the preferred expressions of the selector, if
+// any.
+func (selector *WorkerSelector) PreferredExpressions() []WorkerSelectorExpression {
+	if selector == nil {
+		return nil
+	}
+
+	return selector.Preferred
+}
+
+// Validate returns a message for each invalid expression.
+func (selector WorkerSelector) Validate() []string {
+	var errs []string
+
+	for i, expr := range selector.Required {
+		if err := expr.Validate(); err != nil {
+			errs = append(errs, fmt.Sprintf("required[%d]: %s", i, err))
+		}
+	}
+
+	for i, expr := range selector.Preferred {
+		if err := expr.Validate(); err != nil {
+			errs = append(errs, fmt.Sprintf("preferred[%d]: %s", i, err))
+		}
+	}
+
+	return errs
+}
+
+func (expr WorkerSelectorExpression) Matches(labels map[string]string) bool {
+	value, found := labels[expr.Key]
+
+	switch expr.Operator {
+	case WorkerSelectorOperatorExists:
+		return found
+	case WorkerSelectorOperatorIn:
+		return found && expr.hasValue(value)
+	case WorkerSelectorOperatorNotIn:
+		return !found || !expr.hasValue(value)
+	default:
+		return false
+	}
+}
+
+func (expr WorkerSelectorExpression) Validate() error {
+	if expr.Key == "" {
+		return fmt.Errorf("missing key")
+	}
+
+	switch expr.Operator {
+	case WorkerSelectorOperatorExists:
+		if len(expr.Values) != 0 {
+			return fmt.Errorf("operator '%s' does not take values", expr.Operator)
+		}
+	case WorkerSelectorOperatorIn, WorkerSelectorOperatorNotIn:
+		if len(expr.Values) == 0 {
+			return fmt.Errorf("operator '%s' requires at least one value", expr.Operator)
+		}
+	default:
+		return fmt.Errorf("unknown operator '%s' (must be one of In, NotIn, Exists)", expr.Operator)
+	}
+
+	return nil
+}
+
+func (expr WorkerSelectorExpression) String() string {
+	if expr.Operator == WorkerSelectorOperatorExists {
+		return expr.Key
+	}
+
+	return fmt.Sprintf("%s %s (%s)", expr.Key, expr.Operator, strings.Join(expr.Values, ","))
+}
+
+func (expr WorkerSelectorExpression) hasValue(value string) bool {
+	for _, v := range expr.Values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// FormatWorkerLabels renders labels as a sorted, comma-separated list of
+// key=value pairs.
+func FormatWorkerLabels(labels map[string]string) string {
+	pairs := []string{}
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
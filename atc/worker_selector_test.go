@@ -0,0 +1,56 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WorkerSelector", func() {
+	labels := map[string]string{
+		"arch": "arm64",
+		"disk": "ssd",
+	}
+
+	DescribeTable("expressions",
+		func(expr atc.WorkerSelectorExpression, matches bool) {
+			Expect(expr.Matches(labels)).To(Equal(matches))
+		},
+		Entry("In with a matching value", atc.WorkerSelectorExpression{Key: "arch", Operator: "In", Values: []string{"amd64", "arm64"}}, true),
+		Entry("In without a matching value", atc.WorkerSelectorExpression{Key: "arch", Operator: "In", Values: []string{"amd64"}}, false),
+		Entry("In with a missing label", atc.WorkerSelectorExpression{Key: "zone", Operator: "In", Values: []string{"b"}}, false),
+		Entry("NotIn with a matching value", atc.WorkerSelectorExpression{Key: "disk", Operator: "NotIn", Values: []string{"ssd"}}, false),
+		Entry("NotIn without a matching value", atc.WorkerSelectorExpression{Key: "disk", Operator: "NotIn", Values: []string{"hdd"}}, true),
+		Entry("NotIn with a missing label", atc.WorkerSelectorExpression{Key: "zone", Operator: "NotIn", Values: []string{"b"}}, true),
+		Entry("Exists with a label", atc.WorkerSelectorExpression{Key: "disk", Operator: "Exists"}, true),
+		Entry("Exists without a label", atc.WorkerSelectorExpression{Key: "zone", Operator: "Exists"}, false),
+		Entry("an unknown operator", atc.WorkerSelectorExpression{Key: "arch", Operator: "Near", Values: []string{"arm64"}}, false),
+	)
+
+	Describe("Matches", func() {
+		It("requires every required expression to match", func() {
+			selector := atc.WorkerSelector{
+				Required: []atc.WorkerSelectorExpression{
+					{Key: "arch", Operator: "In", Values: []string{"arm64"}},
+					{Key: "zone", Operator: "Exists"},
+				},
+				Preferred: []atc.WorkerSelectorExpression{
+					{Key: "gpu", Operator: "Exists"},
+				},
+			}
+
+			Expect(selector.Matches(labels)).To(BeFalse())
+
+			selector.Required = selector.Required[:1]
+			Expect(selector.Matches(labels)).To(BeTrue())
+		})
+	})
+
+	Describe("FormatWorkerLabels", func() {
+		It("renders the labels sorted", func() {
+			Expect(atc.FormatWorkerLabels(labels)).To(Equal("arch=arm64,disk=ssd"))
+		})
+	})
+})
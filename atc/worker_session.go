@@ -0,0 +1,42 @@
+package atc
+
+// WorkerSession is a connection to a TSA, as last reported by the TSA.
+type WorkerSession struct {
+	ID  string `json:"id"`
+	TSA string `json:"tsa"`
+
+	// WorkerName and TeamName are set once the session has started
+	// registering a worker.
+	WorkerName string `json:"worker_name,omitempty"`
+	TeamName   string `json:"team_name,omitempty"`
+
+	RemoteAddr  string `json:"remote_addr"`
+	Transport   string `json:"transport"`
+	ConnectedAt int64  `json:"connected_at"`
+
+	ForwardedPorts []WorkerSessionForward `json:"forwarded_ports,omitempty"`
+
+	// LastHeartbeatStatus is one of "healthy", "unhealthy", "landed" or
+	// "gone-away".
+	LastHeartbeatAt     int64  `json:"last_heartbeat_at,omitempty"`
+	LastHeartbeatStatus string `json:"last_heartbeat_status,omitempty"`
+
+	BytesProxied int64 `json:"bytes_proxied"`
+
+	LastKeepaliveAt  int64 `json:"last_keepalive_at,omitempty"`
+	MissedKeepalives int   `json:"missed_keepalives"`
+}
+
+// WorkerSessionForward is a port on the TSA's host which is forwarded to the
+// worker through the session.
+type WorkerSessionForward struct {
+	BindAddr  string `json:"bind_addr"`
+	BoundPort uint32 `json:"bound_port"`
+}
+
+// WorkerSessionsReport is sent by a TSA with all of its sessions, replacing
+// the ones it reported before.
+type WorkerSessionsReport struct {
+	TSA      string          `json:"tsa"`
+	Sessions []WorkerSession `json:"sessions"`
+}
@@ -51,7 +51,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
 			// resource belongs to authorized team
-		case atc.AbortBuild:
+		case atc.AbortBuild,
+			atc.GetBuildSecretAccesses:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
@@ -88,6 +89,10 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.RegisterWorker,
 			atc.HeartbeatWorker,
 			atc.DeleteWorker,
+			atc.ListWorkerKeys,
+			atc.MarkWorkerKeysUsed,
+			atc.RedeemWorkerRegistrationToken,
+			atc.ReportWorkerSessions,
 			atc.ListTeamBuilds,
 			atc.GetUser:
 			newHandler = auth.CheckAuthenticationHandler(handler, rejector)
@@ -109,11 +114,22 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// admin
 		case atc.GetLogLevel,
 			atc.DestroyTeam,
+			atc.GetTeamCheckLimits,
+			atc.SetTeamCheckLimits,
 			atc.ListActiveUsersSince,
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.SetWall,
-			atc.ClearWall:
+			atc.ClearWall,
+			atc.ListMaintenanceWindows,
+			atc.CreateMaintenanceWindow,
+			atc.DeleteMaintenanceWindow,
+			atc.ListWorkerRegistrationTokens,
+			atc.CreateWorkerRegistrationToken,
+			atc.DeleteWorkerRegistrationToken,
+			atc.ListWorkerSessions,
+			atc.ListSecretAccesses,
+			atc.ClearSecretCache:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
 
 		// authorized (requested team matches resource team and has required role, or is admin)
@@ -133,8 +149,13 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.DeletePipeline,
 			atc.DisableResourceVersion,
 			atc.EnableResourceVersion,
+			atc.AddResourceVersionLabel,
+			atc.RemoveResourceVersionLabel,
 			atc.PinResourceVersion,
 			atc.UnpinResource,
+			atc.PinResourceTypeVersion,
+			atc.UnpinResourceType,
+			atc.PromoteResourceTypeCandidate,
 			atc.SetPinCommentOnResource,
 			atc.GetConfig,
 			atc.GetCC,
@@ -153,6 +174,13 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
 			atc.ScheduleJob,
+			atc.ListTeamWorkerKeys,
+			atc.SetTeamWorkerKey,
+			atc.DeleteTeamWorkerKey,
+			atc.ListTeamSecrets,
+			atc.SetTeamSecret,
+			atc.DeleteTeamSecret,
+			atc.ClearTeamSecretCache,
 			atc.GetArtifact:
 			newHandler = auth.CheckAuthorizationHandler(handler, rejector)
 
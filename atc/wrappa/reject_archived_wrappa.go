@@ -32,8 +32,13 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.CheckResourceType,
 			atc.DisableResourceVersion,
 			atc.EnableResourceVersion,
+			atc.AddResourceVersionLabel,
+			atc.RemoveResourceVersionLabel,
 			atc.PinResourceVersion,
 			atc.UnpinResource,
+			atc.PinResourceTypeVersion,
+			atc.UnpinResourceType,
+			atc.PromoteResourceTypeCandidate,
 			atc.SetPinCommentOnResource,
 			atc.RerunJobBuild:
 
@@ -48,6 +53,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListBuildArtifacts,
 			atc.GetBuildPreparation,
 			atc.GetBuildPlan,
+			atc.GetBuildSecretAccesses,
 			atc.AbortBuild,
 			atc.PruneWorker,
 			atc.LandWorker,
@@ -82,10 +88,27 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.RegisterWorker,
 			atc.HeartbeatWorker,
 			atc.DeleteWorker,
+			atc.ListWorkerKeys,
+			atc.MarkWorkerKeysUsed,
+			atc.ListWorkerRegistrationTokens,
+			atc.CreateWorkerRegistrationToken,
+			atc.DeleteWorkerRegistrationToken,
+			atc.RedeemWorkerRegistrationToken,
+			atc.ListWorkerSessions,
+			atc.ReportWorkerSessions,
 			atc.GetTeam,
 			atc.SetTeam,
 			atc.RenameTeam,
 			atc.DestroyTeam,
+			atc.GetTeamCheckLimits,
+			atc.SetTeamCheckLimits,
+			atc.ListTeamWorkerKeys,
+			atc.SetTeamWorkerKey,
+			atc.DeleteTeamWorkerKey,
+			atc.ListTeamSecrets,
+			atc.SetTeamSecret,
+			atc.DeleteTeamSecret,
+			atc.ClearTeamSecretCache,
 			atc.GetUser,
 			atc.GetInfo,
 			atc.DownloadCLI,
@@ -102,7 +125,12 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.ListActiveUsersSince,
+			atc.ListSecretAccesses,
+			atc.ClearSecretCache,
 			atc.SetWall,
+			atc.ListMaintenanceWindows,
+			atc.CreateMaintenanceWindow,
+			atc.DeleteMaintenanceWindow,
 			atc.ClearWall,
 			atc.DeletePipeline,
 			atc.GetCC,
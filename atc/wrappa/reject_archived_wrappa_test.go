@@ -39,8 +39,13 @@ var _ = Describe("RejectArchivedWrappa", func() {
 			atc.CheckResourceType,
 			atc.DisableResourceVersion,
 			atc.EnableResourceVersion,
+			atc.AddResourceVersionLabel,
+			atc.RemoveResourceVersionLabel,
 			atc.PinResourceVersion,
 			atc.UnpinResource,
+			atc.PinResourceTypeVersion,
+			atc.UnpinResourceType,
+			atc.PromoteResourceTypeCandidate,
 			atc.SetPinCommentOnResource,
 			atc.RerunJobBuild,
 		}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ClearSecretCacheCommand struct {
+	Team       string `long:"team" description:"Name of the team to clear the cached credentials of, if different from the target default"`
+	Pipeline   string `short:"p" long:"pipeline" description:"Only clear the credentials looked up for this pipeline, leaving out those shared by the team"`
+	PathPrefix string `long:"path-prefix" description:"Only clear the credentials whose path in the credential manager starts with this prefix"`
+	AllTeams   bool   `short:"a" long:"all-teams" description:"Clear the cached credentials of every team (admin only)"`
+}
+
+func (command *ClearSecretCacheCommand) Execute([]string) error {
+	if command.AllTeams {
+		if command.Team != "" || command.Pipeline != "" {
+			return errors.New("--all-teams cannot be used with --team or --pipeline")
+		}
+
+		target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+		if err != nil {
+			return err
+		}
+
+		err = target.Validate()
+		if err != nil {
+			return err
+		}
+
+		err = target.Client().ClearSecretCache(command.PathPrefix)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("cleared cached credentials of all teams%s\n", command.pathPrefixScope())
+
+		return nil
+	}
+
+	team, err := secretsTeam(command.Team)
+	if err != nil {
+		return err
+	}
+
+	err = team.ClearSecretCache(command.Pipeline, command.PathPrefix)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("cleared cached credentials of %s%s\n", secretScope(team.Name(), command.Pipeline), command.pathPrefixScope())
+
+	return nil
+}
+
+func (command *ClearSecretCacheCommand) pathPrefixScope() string {
+	if command.PathPrefix == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(" under '%s'", command.PathPrefix)
+}
@@ -32,6 +32,16 @@ type FlyCommand struct {
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
 
+	SetTeamCheckLimits SetTeamCheckLimitsCommand `command:"set-team-check-limits" alias:"stcl" description:"Override the check rate and check container budget of a team"`
+
+	WorkerKeys      WorkerKeysCommand      `command:"worker-keys" alias:"wks" description:"List the keys with which the workers of a team register"`
+	SetWorkerKey    SetWorkerKeyCommand    `command:"set-worker-key" alias:"swk" description:"Add or rotate a key with which the workers of a team register"`
+	DeleteWorkerKey DeleteWorkerKeyCommand `command:"delete-worker-key" alias:"dwk" description:"Delete a key with which the workers of a team register"`
+
+	Secrets      SecretsCommand      `command:"secrets" alias:"ss" description:"List the secrets stored by the built-in credential manager"`
+	SetSecret    SetSecretCommand    `command:"set-secret" alias:"sse" description:"Set a secret in the built-in credential manager"`
+	DeleteSecret DeleteSecretCommand `command:"delete-secret" alias:"dse" description:"Delete a secret from the built-in credential manager"`
+
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
 	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
@@ -66,11 +76,17 @@ type FlyCommand struct {
 	UnpinResource          UnpinResourceCommand          `command:"unpin-resource"             alias:"ur"   description:"Unpin a resource"`
 	EnableResourceVersion  EnableResourceVersionCommand  `command:"enable-resource-version"    alias:"erv"  description:"Enable a version of a resource"`
 	DisableResourceVersion DisableResourceVersionCommand `command:"disable-resource-version"   alias:"drv"  description:"Disable a version of a resource"`
+	LabelResourceVersion   LabelResourceVersionCommand   `command:"label-resource-version"     alias:"lrv"  description:"Add a label to a version of a resource"`
+	UnlabelResourceVersion UnlabelResourceVersionCommand `command:"unlabel-resource-version"   alias:"ulrv" description:"Remove a label from a version of a resource"`
 
 	CheckResourceType CheckResourceTypeCommand `command:"check-resource-type" alias:"crt"  description:"Check a resource-type"`
+	PinResourceType   PinResourceTypeCommand   `command:"pin-resource-type"   alias:"prt"  description:"Pin a version to a resource-type"`
+	UnpinResourceType UnpinResourceTypeCommand `command:"unpin-resource-type" alias:"urt"  description:"Unpin a resource-type"`
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
+	ClearSecretCache ClearSecretCacheCommand `command:"clear-secret-cache" alias:"csc" description:"Make every web node re-fetch its cached credentials, e.g. once they have been rotated"`
+
 	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
 	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
 	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
@@ -83,6 +99,16 @@ type FlyCommand struct {
 	LandWorker  LandWorkerCommand  `command:"land-worker" alias:"lw" description:"Land a worker"`
 	PruneWorker PruneWorkerCommand `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
 
+	ScheduleMaintenance ScheduleMaintenanceCommand `command:"schedule-maintenance" alias:"sm" description:"Schedule a maintenance window for a worker or every worker with a tag"`
+	MaintenanceWindows  MaintenanceWindowsCommand  `command:"maintenance-windows" alias:"mws" description:"List the upcoming and ongoing maintenance windows"`
+	CancelMaintenance   CancelMaintenanceCommand   `command:"cancel-maintenance" alias:"cm" description:"Cancel a maintenance window"`
+
+	WorkerRegistrationTokens      WorkerRegistrationTokensCommand      `command:"worker-registration-tokens" alias:"wrts" description:"List the tokens with which workers can register their own keys"`
+	CreateWorkerRegistrationToken CreateWorkerRegistrationTokenCommand `command:"create-worker-registration-token" alias:"cwrt" description:"Create a token with which workers can register their own keys"`
+	DeleteWorkerRegistrationToken DeleteWorkerRegistrationTokenCommand `command:"delete-worker-registration-token" alias:"dwrt" description:"Delete a worker registration token"`
+
+	WorkerSessions WorkerSessionsCommand `command:"worker-sessions" alias:"wss" description:"List the workers' connections to each web node"`
+
 	Curl CurlCommand `command:"curl" alias:"c" description:"curl the api"`
 
 	Completion CompletionCommand `command:"completion" description:"generate shell completion code"`
@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type LabelResourceVersionCommand struct {
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource"`
+	Version  *atc.Version             `short:"v" long:"version" required:"true" value-name:"KEY:VALUE" description:"Version of the resource to label. The given key value pair(s) has to be an exact match but not all fields are needed. In the case of multiple resource versions matched, it will label the latest one."`
+	Label    string                   `short:"l" long:"label" required:"true" description:"Label to add to the version"`
+}
+
+func (command *LabelResourceVersionCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+
+	latestResourceVer, err := GetLatestResourceVersion(team, command.Resource, *command.Version)
+	if err != nil {
+		return err
+	}
+
+	labeled, err := team.AddResourceVersionLabel(command.Resource.PipelineRef, command.Resource.ResourceName, latestResourceVer.ID, command.Label)
+	if err != nil {
+		return err
+	}
+
+	if labeled {
+		versionBytes, err := json.Marshal(latestResourceVer.Version)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("labeled '%s/%s' with version %s as '%s'\n", command.Resource.PipelineRef.String(), command.Resource.ResourceName, string(versionBytes), command.Label)
+	} else {
+		displayhelpers.Failf("could not label '%s/%s', make sure the resource version exists\n", command.Resource.PipelineRef.String(), command.Resource.ResourceName)
+	}
+
+	return nil
+}
+
+type UnlabelResourceVersionCommand struct {
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of the resource"`
+	Version  *atc.Version             `short:"v" long:"version" required:"true" value-name:"KEY:VALUE" description:"Version of the resource to unlabel. The given key value pair(s) has to be an exact match but not all fields are needed. In the case of multiple resource versions matched, it will unlabel the latest one."`
+	Label    string                   `short:"l" long:"label" required:"true" description:"Label to remove from the version"`
+}
+
+func (command *UnlabelResourceVersionCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+
+	latestResourceVer, err := GetLatestResourceVersion(team, command.Resource, *command.Version)
+	if err != nil {
+		return err
+	}
+
+	unlabeled, err := team.RemoveResourceVersionLabel(command.Resource.PipelineRef, command.Resource.ResourceName, latestResourceVer.ID, command.Label)
+	if err != nil {
+		return err
+	}
+
+	if unlabeled {
+		versionBytes, err := json.Marshal(latestResourceVer.Version)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("removed label '%s' from '%s/%s' with version %s\n", command.Label, command.Resource.PipelineRef.String(), command.Resource.ResourceName, string(versionBytes))
+	} else {
+		displayhelpers.Failf("could not unlabel '%s/%s', make sure the resource version exists\n", command.Resource.PipelineRef.String(), command.Resource.ResourceName)
+	}
+
+	return nil
+}
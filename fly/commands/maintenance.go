@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type MaintenanceWindowsCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *MaintenanceWindowsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	windows, err := target.Client().ListMaintenanceWindows()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(windows)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "id", Color: color.New(color.Bold)},
+		{Contents: "worker", Color: color.New(color.Bold)},
+		{Contents: "tag", Color: color.New(color.Bold)},
+		{Contents: "state", Color: color.New(color.Bold)},
+		{Contents: "drain", Color: color.New(color.Bold)},
+		{Contents: "start", Color: color.New(color.Bold)},
+		{Contents: "end", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, window := range windows {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(window.ID)},
+			stringOrDefault(window.Worker),
+			stringOrDefault(window.Tag),
+			{Contents: string(window.State)},
+			{Contents: time.Unix(window.DrainStartsAt, 0).Format(timeDateLayout)},
+			{Contents: time.Unix(window.StartsAt, 0).Format(timeDateLayout)},
+			{Contents: time.Unix(window.EndsAt, 0).Format(timeDateLayout)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+type CancelMaintenanceCommand struct {
+	ID int `long:"id" required:"true" description:"ID of the maintenance window to cancel, as shown by maintenance-windows"`
+}
+
+func (command *CancelMaintenanceCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	deleted, err := target.Client().DeleteMaintenanceWindow(command.ID)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return fmt.Errorf("maintenance window %d does not exist", command.ID)
+	}
+
+	fmt.Printf("cancelled maintenance %d\n", command.ID)
+
+	return nil
+}
+
+func maintenanceCell(maintenance *atc.WorkerMaintenance) ui.TableCell {
+	if maintenance == nil {
+		return stringOrDefault("")
+	}
+
+	switch maintenance.State {
+	case atc.WorkerMaintenanceInProgress:
+		return ui.TableCell{
+			Contents: fmt.Sprintf("%s until %s", maintenance.State, time.Unix(maintenance.EndsAt, 0).Format(timeDateLayout)),
+			Color:    color.New(color.FgYellow),
+		}
+	case atc.WorkerMaintenanceDraining:
+		return ui.TableCell{
+			Contents: fmt.Sprintf("%s until %s", maintenance.State, time.Unix(maintenance.StartsAt, 0).Format(timeDateLayout)),
+			Color:    color.New(color.FgYellow),
+		}
+	default:
+		return ui.TableCell{
+			Contents: fmt.Sprintf("%s at %s", maintenance.State, time.Unix(maintenance.DrainStartsAt, 0).Format(timeDateLayout)),
+		}
+	}
+}
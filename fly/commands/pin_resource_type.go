@@ -0,0 +1,99 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type PinResourceTypeCommand struct {
+	ResourceType flaghelpers.ResourceFlag `short:"r" long:"resource-type" required:"true" value-name:"PIPELINE/RESOURCE-TYPE" description:"Name of the resource-type"`
+	Version      *atc.Version             `short:"v" long:"version"                       value-name:"VERSION"                description:"Version of the resource-type to pin, e.g. digest:sha256@... The version must match exactly."`
+	Candidate    bool                     `long:"candidate"                                                                   description:"Promote the latest version found by the check of the pinned resource-type"`
+}
+
+func (command *PinResourceTypeCommand) Execute([]string) error {
+	if command.Version == nil && !command.Candidate {
+		return errors.New("either --version or --candidate must be specified")
+	}
+
+	if command.Version != nil && command.Candidate {
+		return errors.New("--version and --candidate cannot be specified together")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+
+	pipelineRef := command.ResourceType.PipelineRef
+	resourceTypeName := command.ResourceType.ResourceName
+
+	if command.Candidate {
+		resourceTypes, found, err := team.VersionedResourceTypes(pipelineRef)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
+		}
+
+		resourceType, found := resourceTypes.Lookup(resourceTypeName)
+		if !found {
+			displayhelpers.Failf("resource-type '%s/%s' not found\n", pipelineRef.String(), resourceTypeName)
+		}
+
+		if resourceType.CandidateVersion == nil {
+			displayhelpers.Failf("'%s/%s' has no candidate version to promote\n", pipelineRef.String(), resourceTypeName)
+		}
+
+		promoted, err := team.PromoteResourceTypeCandidate(pipelineRef, resourceTypeName)
+		if err != nil {
+			return err
+		}
+
+		if !promoted {
+			displayhelpers.Failf("could not promote the candidate version of '%s/%s'\n", pipelineRef.String(), resourceTypeName)
+		}
+
+		versionBytes, err := json.Marshal(resourceType.CandidateVersion)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("pinned '%s/%s' with candidate version %s\n", pipelineRef.String(), resourceTypeName, string(versionBytes))
+
+		return nil
+	}
+
+	pinned, err := team.PinResourceTypeVersion(pipelineRef, resourceTypeName, *command.Version)
+	if err != nil {
+		return err
+	}
+
+	if !pinned {
+		displayhelpers.Failf("could not pin '%s/%s', make sure the resource-type and version exist\n", pipelineRef.String(), resourceTypeName)
+	}
+
+	versionBytes, err := json.Marshal(command.Version)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("pinned '%s/%s' with version %s\n", pipelineRef.String(), resourceTypeName, string(versionBytes))
+
+	return nil
+}
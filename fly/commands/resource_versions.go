@@ -1,10 +1,13 @@
 package commands
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -19,6 +22,12 @@ type ResourceVersionsCommand struct {
 	Count    int                      `short:"c" long:"count" default:"50" description:"Number of versions you want to limit the return to"`
 	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to get versions for"`
 	Json     bool                     `long:"json" description:"Print command result as JSON"`
+
+	Filter   []string `long:"filter" value-name:"KEY:VALUE" description:"Only show versions whose version contains the given field (can be specified multiple times)"`
+	Metadata []string `long:"metadata" value-name:"NAME:VALUE|NAME~VALUE" description:"Only show versions whose metadata matches the given value, glob pattern (with '*') or substring (with '~') (can be specified multiple times)"`
+	Labels   []string `long:"label" description:"Only show versions with the given label (can be specified multiple times)"`
+	Since    string   `long:"since" description:"Only show versions first checked after this time"`
+	Until    string   `long:"until" description:"Only show versions first checked before this time"`
 }
 
 func (command *ResourceVersionsCommand) Execute([]string) error {
@@ -32,11 +41,16 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 		return err
 	}
 
+	filter, err := command.versionFilter()
+	if err != nil {
+		return err
+	}
+
 	page := concourse.Page{Limit: command.Count}
 
 	team := target.Team()
 
-	versions, _, _, err := team.ResourceVersions(command.Resource.PipelineRef, command.Resource.ResourceName, page, atc.Version{})
+	versions, _, _, err := team.ResourceVersions(command.Resource.PipelineRef, command.Resource.ResourceName, page, filter)
 	if err != nil {
 		return err
 	}
@@ -54,6 +68,7 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 			{Contents: "id", Color: color.New(color.Bold)},
 			{Contents: "version", Color: color.New(color.Bold)},
 			{Contents: "enabled", Color: color.New(color.Bold)},
+			{Contents: "labels", Color: color.New(color.Bold)},
 		},
 	}
 
@@ -84,8 +99,55 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 			{Contents: strconv.Itoa(version.ID)},
 			{Contents: strings.Join(fields, ",")},
 			enabledCell,
+			{Contents: strings.Join(version.Labels, ",")},
 		})
 	}
 
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
+
+func (command *ResourceVersionsCommand) versionFilter() (atc.ResourceVersionFilter, error) {
+	filter := atc.ResourceVersionFilter{
+		Version: atc.Version{},
+		Labels:  command.Labels,
+	}
+
+	for _, field := range command.Filter {
+		vs := strings.SplitN(field, ":", 2)
+		if len(vs) != 2 {
+			return atc.ResourceVersionFilter{}, fmt.Errorf("invalid filter '%s': expected 'key:value'", field)
+		}
+
+		filter.Version[vs[0]] = vs[1]
+	}
+
+	for _, field := range command.Metadata {
+		metadataFilter, err := atc.ParseMetadataFilter(field)
+		if err != nil {
+			return atc.ResourceVersionFilter{}, err
+		}
+
+		filter.Metadata = append(filter.Metadata, metadataFilter)
+	}
+
+	var err error
+	if command.Since != "" {
+		filter.Since, err = time.ParseInLocation(inputTimeLayout, command.Since, time.Now().Location())
+		if err != nil {
+			return atc.ResourceVersionFilter{}, errors.New("Since time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Until != "" {
+		filter.Until, err = time.ParseInLocation(inputTimeLayout, command.Until, time.Now().Location())
+		if err != nil {
+			return atc.ResourceVersionFilter{}, errors.New("Until time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && filter.Since.After(filter.Until) {
+		return atc.ResourceVersionFilter{}, errors.New("Cannot have --since after --until")
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ScheduleMaintenanceCommand struct {
+	Worker flaghelpers.WorkerFlag `short:"w" long:"worker" description:"Worker to take out of the pool"`
+	Tag    string                 `long:"tag" description:"Take every worker with this tag out of the pool"`
+
+	Start string        `long:"start" required:"true" description:"When builds still running on the workers are aborted, in the format: 2006-01-02 15:04:05"`
+	End   string        `long:"end" required:"true" description:"When the workers may take work again, in the format: 2006-01-02 15:04:05"`
+	Drain time.Duration `long:"drain" default:"1h" description:"How long before the start to stop placing containers on the workers so that running builds can finish"`
+}
+
+func (command *ScheduleMaintenanceCommand) Execute(args []string) error {
+	if (command.Worker == "") == (command.Tag == "") {
+		return errors.New("exactly one of --worker or --tag must be specified")
+	}
+
+	start, err := time.ParseInLocation(inputTimeLayout, command.Start, time.Now().Location())
+	if err != nil {
+		return errors.New("start time should be in the format: " + inputTimeLayout)
+	}
+
+	end, err := time.ParseInLocation(inputTimeLayout, command.End, time.Now().Location())
+	if err != nil {
+		return errors.New("end time should be in the format: " + inputTimeLayout)
+	}
+
+	if command.Drain < 0 {
+		return errors.New("drain can't be negative")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	window, err := target.Client().CreateMaintenanceWindow(atc.MaintenanceWindow{
+		Worker:        command.Worker.Name(),
+		Tag:           command.Tag,
+		DrainStartsAt: start.Add(-command.Drain).Unix(),
+		StartsAt:      start.Unix(),
+		EndsAt:        end.Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	subject := fmt.Sprintf("worker '%s'", window.Worker)
+	if window.Tag != "" {
+		subject = fmt.Sprintf("workers tagged '%s'", window.Tag)
+	}
+
+	fmt.Printf("scheduled maintenance %d for %s from %s to %s\n",
+		window.ID,
+		subject,
+		time.Unix(window.StartsAt, 0).Format(timeDateLayout),
+		time.Unix(window.EndsAt, 0).Format(timeDateLayout),
+	)
+
+	return nil
+}
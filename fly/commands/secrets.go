@@ -0,0 +1,186 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+	"sigs.k8s.io/yaml"
+)
+
+type SecretsCommand struct {
+	Team     string `long:"team" description:"Name of the team to list the secrets of, if different from the target default"`
+	Pipeline string `short:"p" long:"pipeline" description:"Only list the secrets of this pipeline"`
+	Json     bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *SecretsCommand) Execute([]string) error {
+	team, err := secretsTeam(command.Team)
+	if err != nil {
+		return err
+	}
+
+	secrets, err := team.Secrets(command.Pipeline)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(secrets)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "name", Color: color.New(color.Bold)},
+		{Contents: "pipeline", Color: color.New(color.Bold)},
+		{Contents: "updated", Color: color.New(color.Bold)},
+		{Contents: "updated by", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, secret := range secrets {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: secret.Name},
+			stringOrDefault(secret.PipelineName, "all"),
+			{Contents: formatUnix(secret.UpdatedAt)},
+			stringOrDefault(secret.UpdatedBy),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+type SetSecretCommand struct {
+	Team     string `long:"team" description:"Name of the team to set the secret for, if different from the target default"`
+	Pipeline string `short:"p" long:"pipeline" description:"Only make the secret available to this pipeline. If not specified, every pipeline of the team can use it."`
+	Secret   string `short:"s" long:"secret" required:"true" description:"Name of the secret, as used in ((vars))"`
+
+	Value     string       `short:"v" long:"value" description:"Value of the secret"`
+	ValueFile atc.PathFlag `long:"value-file" description:"Path to a file containing the value of the secret, e.g. a private key"`
+	YAMLFile  atc.PathFlag `long:"yaml-file" description:"Path to a YAML file containing the value of the secret, whose fields can be used as ((secret.field))"`
+}
+
+func (command *SetSecretCommand) Execute([]string) error {
+	value, err := command.value()
+	if err != nil {
+		return err
+	}
+
+	team, err := secretsTeam(command.Team)
+	if err != nil {
+		return err
+	}
+
+	err = team.SetSecret(command.Pipeline, command.Secret, atc.SetSecretRequest{Value: value})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("secret '%s' set for %s\n", command.Secret, secretScope(team.Name(), command.Pipeline))
+	fmt.Println("builds will see the new value once credentials cached by the web nodes expire")
+
+	return nil
+}
+
+func (command *SetSecretCommand) value() (interface{}, error) {
+	given := 0
+	for _, set := range []bool{command.Value != "", command.ValueFile != "", command.YAMLFile != ""} {
+		if set {
+			given++
+		}
+	}
+
+	if given != 1 {
+		return nil, errors.New("exactly one of --value, --value-file, or --yaml-file must be specified")
+	}
+
+	switch {
+	case command.ValueFile != "":
+		contents, err := ioutil.ReadFile(string(command.ValueFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read value file: %s", err)
+		}
+
+		return string(contents), nil
+
+	case command.YAMLFile != "":
+		contents, err := ioutil.ReadFile(string(command.YAMLFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read yaml file: %s", err)
+		}
+
+		var value interface{}
+		err = yaml.Unmarshal(contents, &value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse yaml file: %s", err)
+		}
+
+		return value, nil
+
+	default:
+		return command.Value, nil
+	}
+}
+
+type DeleteSecretCommand struct {
+	Team     string `long:"team" description:"Name of the team to delete the secret of, if different from the target default"`
+	Pipeline string `short:"p" long:"pipeline" description:"Pipeline the secret was set for, if any"`
+	Secret   string `short:"s" long:"secret" required:"true" description:"Name of the secret"`
+}
+
+func (command *DeleteSecretCommand) Execute([]string) error {
+	team, err := secretsTeam(command.Team)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := team.DeleteSecret(command.Pipeline, command.Secret)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return fmt.Errorf("secret '%s' does not exist for %s", command.Secret, secretScope(team.Name(), command.Pipeline))
+	}
+
+	fmt.Printf("deleted secret '%s' of %s\n", command.Secret, secretScope(team.Name(), command.Pipeline))
+
+	return nil
+}
+
+func secretsTeam(teamName string) (concourse.Team, error) {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return nil, err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if teamName != "" {
+		return target.FindTeam(teamName)
+	}
+
+	return target.Team(), nil
+}
+
+func secretScope(teamName string, pipelineName string) string {
+	if pipelineName == "" {
+		return fmt.Sprintf("team '%s'", teamName)
+	}
+
+	return fmt.Sprintf("pipeline '%s' of team '%s'", pipelineName, teamName)
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type SetTeamCheckLimitsCommand struct {
+	Team               flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to set the check limits of"`
+	ChecksPerSecond    *float64             `long:"checks-per-second" description:"Maximum number of checks that can be started per second for the team. A value of 0 removes the limit. If not specified, the web node's default is used."`
+	MaxCheckContainers *int                 `long:"max-check-containers" description:"Maximum number of checks that can run at the same time for the team. A value of 0 removes the limit. If not specified, the web node's default is used."`
+}
+
+func (command *SetTeamCheckLimitsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	teamName := command.Team.Name()
+	team, err := target.FindTeam(teamName)
+	if err != nil {
+		return err
+	}
+
+	err = team.SetCheckLimits(atc.TeamCheckLimits{
+		ChecksPerSecond:    command.ChecksPerSecond,
+		MaxCheckContainers: command.MaxCheckContainers,
+	})
+	if err != nil {
+		return err
+	}
+
+	checksPerSecond := "default"
+	if command.ChecksPerSecond != nil {
+		checksPerSecond = strconv.FormatFloat(*command.ChecksPerSecond, 'f', -1, 64)
+	}
+
+	maxCheckContainers := "default"
+	if command.MaxCheckContainers != nil {
+		maxCheckContainers = strconv.Itoa(*command.MaxCheckContainers)
+	}
+
+	fmt.Printf("check limits of team '%s' set\n", teamName)
+	fmt.Printf("  checks per second:    %s\n", checksPerSecond)
+	fmt.Printf("  max check containers: %s\n", maxCheckContainers)
+
+	return nil
+}
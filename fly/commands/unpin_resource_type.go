@@ -0,0 +1,40 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type UnpinResourceTypeCommand struct {
+	ResourceType flaghelpers.ResourceFlag `short:"r" long:"resource-type" required:"true" value-name:"PIPELINE/RESOURCE-TYPE" description:"Name of the resource-type"`
+}
+
+func (command *UnpinResourceTypeCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+
+	unpinned, err := team.UnpinResourceType(command.ResourceType.PipelineRef, command.ResourceType.ResourceName)
+	if err != nil {
+		return err
+	}
+
+	if unpinned {
+		fmt.Printf("unpinned '%s/%s'\n", command.ResourceType.PipelineRef.String(), command.ResourceType.ResourceName)
+	} else {
+		displayhelpers.Failf("could not find resource-type '%s/%s'\n", command.ResourceType.PipelineRef.String(), command.ResourceType.ResourceName)
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package commands
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type WorkerKeysCommand struct {
+	Team flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to list the worker keys of"`
+	Json bool                 `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *WorkerKeysCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team, err := target.FindTeam(command.Team.Name())
+	if err != nil {
+		return err
+	}
+
+	keys, err := team.WorkerKeys()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(keys)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "name", Color: color.New(color.Bold)},
+		{Contents: "fingerprint", Color: color.New(color.Bold)},
+		{Contents: "created", Color: color.New(color.Bold)},
+		{Contents: "expires", Color: color.New(color.Bold)},
+		{Contents: "last used", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	now := time.Now()
+	for _, key := range keys {
+		expires := stringOrDefault(formatUnix(key.ExpiresAt), "never")
+		if key.Expired(now) {
+			expires = ui.TableCell{Contents: "expired", Color: color.New(color.FgRed)}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: key.Name},
+			{Contents: key.Fingerprint},
+			{Contents: formatUnix(key.CreatedAt)},
+			expires,
+			stringOrDefault(formatUnix(key.LastUsedAt), "never"),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+type SetWorkerKeyCommand struct {
+	Team      flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team whose workers register with the key"`
+	Name      string               `short:"k" long:"key-name" required:"true" description:"Name of the key"`
+	PublicKey atc.PathFlag         `long:"public-key" required:"true" description:"Path to the public key, in SSH authorized_keys format"`
+	ExpiresIn time.Duration        `long:"expires-in" description:"How long the key is accepted for. If not specified, the key never expires."`
+
+	Replaces string        `long:"replaces" description:"Name of a key of the team being rotated out in favour of this one"`
+	Overlap  time.Duration `long:"overlap" default:"24h" description:"How long the replaced key keeps being accepted, so that workers can be moved over to the new key"`
+}
+
+func (command *SetWorkerKeyCommand) Execute([]string) error {
+	publicKey, err := ioutil.ReadFile(string(command.PublicKey))
+	if err != nil {
+		return fmt.Errorf("failed to read public key: %s", err)
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	teamName := command.Team.Name()
+	team, err := target.FindTeam(teamName)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	request := atc.SetWorkerKeyRequest{
+		PublicKey: string(publicKey),
+		Replaces:  command.Replaces,
+	}
+
+	if command.ExpiresIn != 0 {
+		request.ExpiresAt = now.Add(command.ExpiresIn).Unix()
+	}
+
+	if command.Replaces != "" {
+		request.ReplacedKeyExpiresAt = now.Add(command.Overlap).Unix()
+	}
+
+	err = team.SetWorkerKey(command.Name, request)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("worker key '%s' set for team '%s'\n", command.Name, teamName)
+
+	if command.Replaces != "" {
+		fmt.Printf("worker key '%s' will expire at %s\n", command.Replaces, formatUnix(request.ReplacedKeyExpiresAt))
+	}
+
+	return nil
+}
+
+type DeleteWorkerKeyCommand struct {
+	Team flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to delete the worker key of"`
+	Name string               `short:"k" long:"key-name" required:"true" description:"Name of the key"`
+}
+
+func (command *DeleteWorkerKeyCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	teamName := command.Team.Name()
+	team, err := target.FindTeam(teamName)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := team.DeleteWorkerKey(command.Name)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return fmt.Errorf("worker key '%s' does not exist", command.Name)
+	}
+
+	fmt.Printf("deleted worker key '%s' of team '%s'\n", command.Name, teamName)
+
+	return nil
+}
+
+func formatUnix(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+
+	return time.Unix(unix, 0).Format(timeDateLayout)
+}
@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type WorkerRegistrationTokensCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *WorkerRegistrationTokensCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	tokens, err := target.Client().ListWorkerRegistrationTokens()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(tokens)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "id", Color: color.New(color.Bold)},
+		{Contents: "team", Color: color.New(color.Bold)},
+		{Contents: "tags", Color: color.New(color.Bold)},
+		{Contents: "uses", Color: color.New(color.Bold)},
+		{Contents: "expires", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, token := range tokens {
+		uses := strconv.Itoa(token.Uses)
+		if token.MaxUses != 0 {
+			uses = fmt.Sprintf("%d/%d", token.Uses, token.MaxUses)
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(token.ID)},
+			stringOrDefault(token.TeamName),
+			stringOrDefault(strings.Join(token.Tags, ", ")),
+			{Contents: uses},
+			{Contents: time.Unix(token.ExpiresAt, 0).Format(timeDateLayout)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+type CreateWorkerRegistrationTokenCommand struct {
+	Team      string        `long:"team" description:"Team which the registering workers must belong to"`
+	Tags      []string      `long:"tag" description:"Tags which the registering workers must have. Can be specified multiple times."`
+	ExpiresIn time.Duration `long:"expires-in" default:"1h" description:"How long the token can be used for"`
+	MaxUses   int           `long:"max-uses" default:"1" description:"How many workers can register with the token, or 0 for no limit"`
+	Json      bool          `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *CreateWorkerRegistrationTokenCommand) Execute([]string) error {
+	if command.ExpiresIn <= 0 {
+		return errors.New("--expires-in must be positive")
+	}
+
+	if command.MaxUses < 0 {
+		return errors.New("--max-uses can't be negative")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	token, err := target.Client().CreateWorkerRegistrationToken(atc.WorkerRegistrationToken{
+		TeamName:  command.Team,
+		Tags:      command.Tags,
+		ExpiresAt: time.Now().Add(command.ExpiresIn).Unix(),
+		MaxUses:   command.MaxUses,
+	})
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(token)
+	}
+
+	fmt.Fprintf(os.Stderr, "created worker registration token %d, expiring at %s\n",
+		token.ID,
+		time.Unix(token.ExpiresAt, 0).Format(timeDateLayout),
+	)
+
+	fmt.Println(token.Token)
+
+	return nil
+}
+
+type DeleteWorkerRegistrationTokenCommand struct {
+	ID int `long:"id" required:"true" description:"ID of the token to delete, as shown by worker-registration-tokens"`
+}
+
+func (command *DeleteWorkerRegistrationTokenCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	deleted, err := target.Client().DeleteWorkerRegistrationToken(command.ID)
+	if err != nil {
+		return err
+	}
+
+	if !deleted {
+		return fmt.Errorf("worker registration token %d does not exist", command.ID)
+	}
+
+	fmt.Printf("deleted worker registration token %d\n", command.ID)
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type WorkerSessionsCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *WorkerSessionsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	sessions, err := target.Client().ListWorkerSessions()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(sessions)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	headers := ui.TableRow{
+		{Contents: "worker", Color: color.New(color.Bold)},
+		{Contents: "team", Color: color.New(color.Bold)},
+		{Contents: "tsa", Color: color.New(color.Bold)},
+		{Contents: "remote address", Color: color.New(color.Bold)},
+		{Contents: "transport", Color: color.New(color.Bold)},
+		{Contents: "connected", Color: color.New(color.Bold)},
+		{Contents: "forwarded ports", Color: color.New(color.Bold)},
+		{Contents: "heartbeat", Color: color.New(color.Bold)},
+		{Contents: "bytes proxied", Color: color.New(color.Bold)},
+		{Contents: "missed keepalives", Color: color.New(color.Bold)},
+	}
+
+	table := ui.Table{Headers: headers}
+
+	for _, session := range sessions {
+		var ports []string
+		for _, forward := range session.ForwardedPorts {
+			ports = append(ports, strconv.Itoa(int(forward.BoundPort)))
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			stringOrDefault(session.WorkerName),
+			stringOrDefault(session.TeamName),
+			{Contents: session.TSA},
+			{Contents: session.RemoteAddr},
+			{Contents: session.Transport},
+			{Contents: time.Unix(session.ConnectedAt, 0).Format(timeDateLayout)},
+			stringOrDefault(strings.Join(ports, ", ")),
+			heartbeatCell(session),
+			{Contents: strconv.FormatInt(session.BytesProxied, 10)},
+			missedKeepalivesCell(session),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func heartbeatCell(session atc.WorkerSession) ui.TableCell {
+	if session.LastHeartbeatStatus == "" {
+		return ui.TableCell{Contents: "none", Color: color.New(color.Faint)}
+	}
+
+	cell := ui.TableCell{
+		Contents: session.LastHeartbeatStatus + " at " + time.Unix(session.LastHeartbeatAt, 0).Format(timeDateLayout),
+	}
+
+	if session.LastHeartbeatStatus == "unhealthy" {
+		cell.Color = color.New(color.FgRed)
+	}
+
+	return cell
+}
+
+func missedKeepalivesCell(session atc.WorkerSession) ui.TableCell {
+	cell := ui.TableCell{Contents: strconv.Itoa(session.MissedKeepalives)}
+	if session.MissedKeepalives > 0 {
+		cell.Color = color.New(color.FgRed)
+	}
+
+	return cell
+}
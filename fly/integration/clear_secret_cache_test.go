@@ -0,0 +1,100 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("clear-secret-cache", func() {
+		It("clears the cached credentials of the team", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/secrets-cache", ""),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "clear-secret-cache")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("cleared cached credentials of team 'main'"))
+		})
+
+		It("clears the cached credentials of a pipeline under a path prefix", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/secrets-cache", "path_prefix=%2Fconcourse%2Fmain%2F&pipeline=some-pipeline"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "clear-secret-cache", "-p", "some-pipeline", "--path-prefix", "/concourse/main/")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("cleared cached credentials of pipeline 'some-pipeline' of team 'main' under '/concourse/main/'"))
+		})
+
+		Context("with --all-teams", func() {
+			It("clears the cached credentials of every team", func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/secrets-cache", ""),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+
+				flyCmd := exec.Command(flyPath, "-t", targetName, "clear-secret-cache", "--all-teams")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("cleared cached credentials of all teams"))
+			})
+
+			It("errors when not an admin", func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/secrets-cache", ""),
+						ghttp.RespondWith(http.StatusForbidden, nil),
+					),
+				)
+
+				flyCmd := exec.Command(flyPath, "-t", targetName, "clear-secret-cache", "--all-teams")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+
+			It("errors when given a pipeline", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "clear-secret-cache", "--all-teams", "-p", "some-pipeline")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("--all-teams cannot be used with --team or --pipeline"))
+			})
+		})
+	})
+})
@@ -0,0 +1,140 @@
+package integration_test
+
+import (
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var (
+		teamName          = "main"
+		pipelineName      = "pipeline"
+		resourceName      = "resource"
+		resourceVersionID = "42"
+		version           = "some:value"
+		pipelineRef       = atc.PipelineRef{Name: pipelineName, InstanceVars: atc.InstanceVars{"branch": "master"}}
+		pipelineResource  = fmt.Sprintf("%s/%s", pipelineRef.String(), resourceName)
+		expectedVersion   = atc.ResourceVersion{
+			ID:      42,
+			Version: atc.Version{"some": "value"},
+			Enabled: true,
+		}
+	)
+
+	for _, command := range []struct {
+		name    string
+		method  string
+		route   string
+		success string
+		failure string
+	}{
+		{
+			name:    "label-resource-version",
+			method:  "PUT",
+			route:   atc.AddResourceVersionLabel,
+			success: fmt.Sprintf("labeled '%s' with version {\"some\":\"value\"} as 'qa-approved'\n", pipelineResource),
+			failure: fmt.Sprintf("could not label '%s', make sure the resource version exists", pipelineResource),
+		},
+		{
+			name:    "unlabel-resource-version",
+			method:  "DELETE",
+			route:   atc.RemoveResourceVersionLabel,
+			success: fmt.Sprintf("removed label 'qa-approved' from '%s' with version {\"some\":\"value\"}\n", pipelineResource),
+			failure: fmt.Sprintf("could not unlabel '%s', make sure the resource version exists", pipelineResource),
+		},
+	} {
+		command := command
+
+		Describe(command.name, func() {
+			var (
+				expectedGetStatus int
+				expectedStatus    int
+				getPath, path     string
+			)
+
+			BeforeEach(func() {
+				var err error
+				getPath, err = atc.Routes.CreatePathForRoute(atc.ListResourceVersions, rata.Params{
+					"pipeline_name": pipelineName,
+					"team_name":     teamName,
+					"resource_name": resourceName,
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				path, err = atc.Routes.CreatePathForRoute(command.route, rata.Params{
+					"pipeline_name":              pipelineName,
+					"team_name":                  teamName,
+					"resource_name":              resourceName,
+					"resource_config_version_id": resourceVersionID,
+					"label":                      "qa-approved",
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", getPath, strings.Join([]string{"vars.branch=%22master%22", "filter=some:value"}, "&")),
+						ghttp.RespondWithJSONEncoded(expectedGetStatus, []atc.ResourceVersion{expectedVersion}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(command.method, path, "vars.branch=%22master%22"),
+						ghttp.RespondWith(expectedStatus, nil),
+					),
+				)
+			})
+
+			Context("when the resource version exists", func() {
+				BeforeEach(func() {
+					expectedGetStatus = http.StatusOK
+					expectedStatus = http.StatusOK
+				})
+
+				It("updates the labels of the resource version", func() {
+					Expect(func() {
+						flyCmd := exec.Command(flyPath, "-t", targetName, command.name, "-r", pipelineResource, "-v", version, "-l", "qa-approved")
+
+						sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+						Expect(err).NotTo(HaveOccurred())
+
+						Eventually(sess.Out).Should(gbytes.Say(command.success))
+
+						<-sess.Exited
+						Expect(sess.ExitCode()).To(Equal(0))
+					}).To(Change(func() int {
+						return len(atcServer.ReceivedRequests())
+					}).By(3))
+				})
+			})
+
+			Context("when the resource version does not exist", func() {
+				BeforeEach(func() {
+					expectedGetStatus = http.StatusOK
+					expectedStatus = http.StatusNotFound
+				})
+
+				It("fails", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, command.name, "-r", pipelineResource, "-v", version, "-l", "qa-approved")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say(command.failure))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+		})
+	}
+})
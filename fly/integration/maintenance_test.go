@@ -0,0 +1,245 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var (
+		startsAt time.Time
+		endsAt   time.Time
+	)
+
+	BeforeEach(func() {
+		var err error
+		startsAt, err = time.ParseInLocation("2006-01-02 15:04:05", "2030-01-02 10:00:00", time.Now().Location())
+		Expect(err).NotTo(HaveOccurred())
+
+		endsAt, err = time.ParseInLocation("2006-01-02 15:04:05", "2030-01-02 12:00:00", time.Now().Location())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("schedule-maintenance", func() {
+		Context("when neither a worker nor a tag is given", func() {
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "schedule-maintenance", "--start", "2030-01-02 10:00:00", "--end", "2030-01-02 12:00:00")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("exactly one of --worker or --tag must be specified"))
+			})
+		})
+
+		Context("when the start time is malformed", func() {
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "schedule-maintenance", "-w", "some-worker", "--start", "tomorrow", "--end", "2030-01-02 12:00:00")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("start time should be in the format: 2006-01-02 15:04:05"))
+			})
+		})
+
+		Context("when scheduling maintenance for a worker", func() {
+			var status int
+
+			BeforeEach(func() {
+				status = http.StatusCreated
+			})
+
+			JustBeforeEach(func() {
+				window := atc.MaintenanceWindow{
+					Worker:        "some-worker",
+					DrainStartsAt: startsAt.Add(-30 * time.Minute).Unix(),
+					StartsAt:      startsAt.Unix(),
+					EndsAt:        endsAt.Unix(),
+				}
+
+				created := window
+				created.ID = 42
+				created.State = atc.WorkerMaintenanceScheduled
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/maintenance-windows"),
+						ghttp.VerifyJSONRepresenting(window),
+						ghttp.RespondWithJSONEncoded(status, created),
+					),
+				)
+			})
+
+			It("schedules the window", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "schedule-maintenance", "-w", "some-worker", "--start", "2030-01-02 10:00:00", "--end", "2030-01-02 12:00:00", "--drain", "30m")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("scheduled maintenance 42 for worker 'some-worker'"))
+			})
+
+			Context("when the user is not an admin", func() {
+				BeforeEach(func() {
+					status = http.StatusForbidden
+				})
+
+				It("fails", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "schedule-maintenance", "-w", "some-worker", "--start", "2030-01-02 10:00:00", "--end", "2030-01-02 12:00:00", "--drain", "30m")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+					Expect(sess.Err).To(gbytes.Say("forbidden"))
+				})
+			})
+		})
+
+		Context("when scheduling maintenance for a tag", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/maintenance-windows"),
+						ghttp.VerifyJSONRepresenting(atc.MaintenanceWindow{
+							Tag:           "gpu",
+							DrainStartsAt: startsAt.Add(-time.Hour).Unix(),
+							StartsAt:      startsAt.Unix(),
+							EndsAt:        endsAt.Unix(),
+						}),
+						ghttp.RespondWith(http.StatusBadRequest, "maintenance window has already ended"),
+					),
+				)
+			})
+
+			It("drains for an hour by default and reports rejections", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "schedule-maintenance", "--tag", "gpu", "--start", "2030-01-02 10:00:00", "--end", "2030-01-02 12:00:00")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("maintenance window has already ended"))
+			})
+		})
+	})
+
+	Describe("maintenance-windows", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/maintenance-windows"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.MaintenanceWindow{
+						{
+							ID:            1,
+							Worker:        "some-worker",
+							DrainStartsAt: startsAt.Add(-time.Hour).Unix(),
+							StartsAt:      startsAt.Unix(),
+							EndsAt:        endsAt.Unix(),
+							State:         atc.WorkerMaintenanceScheduled,
+						},
+						{
+							ID:            2,
+							Tag:           "gpu",
+							DrainStartsAt: startsAt.Unix(),
+							StartsAt:      startsAt.Unix(),
+							EndsAt:        endsAt.Unix(),
+							State:         atc.WorkerMaintenanceInProgress,
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the windows", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "maintenance-windows")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			layout := "2006-01-02@15:04:05-0700"
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "id", Color: color.New(color.Bold)},
+					{Contents: "worker", Color: color.New(color.Bold)},
+					{Contents: "tag", Color: color.New(color.Bold)},
+					{Contents: "state", Color: color.New(color.Bold)},
+					{Contents: "drain", Color: color.New(color.Bold)},
+					{Contents: "start", Color: color.New(color.Bold)},
+					{Contents: "end", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{{Contents: "1"}, {Contents: "some-worker"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "scheduled"}, {Contents: startsAt.Add(-time.Hour).Format(layout)}, {Contents: startsAt.Format(layout)}, {Contents: endsAt.Format(layout)}},
+					{{Contents: "2"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "gpu"}, {Contents: "in-progress"}, {Contents: startsAt.Format(layout)}, {Contents: startsAt.Format(layout)}, {Contents: endsAt.Format(layout)}},
+				},
+			}))
+		})
+	})
+
+	Describe("cancel-maintenance", func() {
+		var status int
+
+		BeforeEach(func() {
+			status = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/maintenance-windows/42"),
+					ghttp.RespondWith(status, nil),
+				),
+			)
+		})
+
+		It("cancels the window", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "cancel-maintenance", "--id", "42")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("cancelled maintenance 42"))
+		})
+
+		Context("when the window does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cancel-maintenance", "--id", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("maintenance window 42 does not exist"))
+			})
+		})
+	})
+})
@@ -0,0 +1,242 @@
+package integration_test
+
+import (
+	"fmt"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var (
+		teamName             = "main"
+		pipelineName         = "pipeline"
+		resourceTypeName     = "some-type"
+		pipelineRef          = atc.PipelineRef{Name: pipelineName, InstanceVars: atc.InstanceVars{"branch": "master"}}
+		pipelineResourceType = fmt.Sprintf("%s/%s", pipelineRef.String(), resourceTypeName)
+		expectedQueryParams  = "vars.branch=%22master%22"
+		routeParams          = rata.Params{
+			"pipeline_name":      pipelineName,
+			"team_name":          teamName,
+			"resource_type_name": resourceTypeName,
+		}
+	)
+
+	Describe("pin-resource-type", func() {
+		It("requires either a version or the candidate", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "pin-resource-type", "-r", pipelineResourceType)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Err).Should(gbytes.Say("either --version or --candidate must be specified"))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+		})
+
+		It("does not allow both a version and the candidate", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "pin-resource-type", "-r", pipelineResourceType, "-v", "tag:1.2.3", "--candidate")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Err).Should(gbytes.Say("--version and --candidate cannot be specified together"))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+		})
+
+		Context("when pinning a version", func() {
+			var expectedStatus int
+
+			BeforeEach(func() {
+				path, err := atc.Routes.CreatePathForRoute(atc.PinResourceTypeVersion, routeParams)
+				Expect(err).NotTo(HaveOccurred())
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", path, expectedQueryParams),
+						ghttp.VerifyJSON(`{"version":{"tag":"1.2.3"}}`),
+						func(w http.ResponseWriter, r *http.Request) {
+							w.WriteHeader(expectedStatus)
+						},
+					),
+				)
+			})
+
+			Context("when the version exists", func() {
+				BeforeEach(func() {
+					expectedStatus = http.StatusOK
+				})
+
+				It("pins the resource type", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "pin-resource-type", "-r", pipelineResourceType, "-v", "tag:1.2.3")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say(fmt.Sprintf(`pinned '%s' with version {"tag":"1.2.3"}`, pipelineResourceType)))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				})
+			})
+
+			Context("when the version does not exist", func() {
+				BeforeEach(func() {
+					expectedStatus = http.StatusNotFound
+				})
+
+				It("fails to pin", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "pin-resource-type", "-r", pipelineResourceType, "-v", "tag:1.2.3")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say(fmt.Sprintf("could not pin '%s', make sure the resource-type and version exist", pipelineResourceType)))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when promoting the candidate", func() {
+			var resourceTypes atc.VersionedResourceTypes
+
+			BeforeEach(func() {
+				listPath, err := atc.Routes.CreatePathForRoute(atc.ListResourceTypes, routeParams)
+				Expect(err).NotTo(HaveOccurred())
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", listPath, expectedQueryParams),
+						func(w http.ResponseWriter, r *http.Request) {
+							ghttp.RespondWithJSONEncoded(http.StatusOK, resourceTypes)(w, r)
+						},
+					),
+				)
+			})
+
+			Context("when the resource type has a candidate", func() {
+				BeforeEach(func() {
+					resourceTypes = atc.VersionedResourceTypes{
+						{
+							ResourceType:     atc.ResourceType{Name: resourceTypeName, Type: "registry-image"},
+							Version:          atc.Version{"tag": "1.2.3"},
+							Pinned:           true,
+							CandidateVersion: atc.Version{"tag": "1.3.0"},
+						},
+					}
+
+					promotePath, err := atc.Routes.CreatePathForRoute(atc.PromoteResourceTypeCandidate, routeParams)
+					Expect(err).NotTo(HaveOccurred())
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", promotePath, expectedQueryParams),
+							ghttp.RespondWith(http.StatusOK, nil),
+						),
+					)
+				})
+
+				It("pins the candidate version", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "pin-resource-type", "-r", pipelineResourceType, "--candidate")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say(fmt.Sprintf(`pinned '%s' with candidate version {"tag":"1.3.0"}`, pipelineResourceType)))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(0))
+				})
+			})
+
+			Context("when the resource type has no candidate", func() {
+				BeforeEach(func() {
+					resourceTypes = atc.VersionedResourceTypes{
+						{
+							ResourceType: atc.ResourceType{Name: resourceTypeName, Type: "registry-image"},
+							Version:      atc.Version{"tag": "1.2.3"},
+						},
+					}
+				})
+
+				It("fails without promoting anything", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "pin-resource-type", "-r", pipelineResourceType, "--candidate")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say(fmt.Sprintf("'%s' has no candidate version to promote", pipelineResourceType)))
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	Describe("unpin-resource-type", func() {
+		var expectedStatus int
+
+		BeforeEach(func() {
+			path, err := atc.Routes.CreatePathForRoute(atc.UnpinResourceType, routeParams)
+			Expect(err).NotTo(HaveOccurred())
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", path, expectedQueryParams),
+					func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(expectedStatus)
+					},
+				),
+			)
+		})
+
+		Context("when the resource type exists", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("unpins the resource type", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "unpin-resource-type", "-r", pipelineResourceType)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say(fmt.Sprintf("unpinned '%s'\n", pipelineResourceType)))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+			})
+		})
+
+		Context("when the resource type does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("fails to unpin", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "unpin-resource-type", "-r", pipelineResourceType)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say(fmt.Sprintf("could not find resource-type '%s'", pipelineResourceType)))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+			})
+		})
+	})
+})
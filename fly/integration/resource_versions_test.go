@@ -32,7 +32,7 @@ var _ = Describe("Fly CLI", func() {
 						ghttp.CombineHandlers(
 							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/versions", strings.Join(queryParams, "&")),
 							ghttp.RespondWithJSONEncoded(200, []atc.ResourceVersion{
-								{ID: 3, Version: atc.Version{"version": "3", "another": "field"}, Enabled: true},
+								{ID: 3, Version: atc.Version{"version": "3", "another": "field"}, Enabled: true, Labels: []string{"qa-approved", "signed"}},
 								{ID: 2, Version: atc.Version{"version": "2", "another": "field"}, Enabled: false},
 								{ID: 1, Version: atc.Version{"version": "1", "another": "field"}, Enabled: true},
 							}),
@@ -54,7 +54,8 @@ var _ = Describe("Fly CLI", func() {
                 {
                   "id": 3,
 									"version": {"version":"3","another":"field"},
-									"enabled": true
+									"enabled": true,
+									"labels": ["qa-approved", "signed"]
                 },
                 {
                   "id": 2,
@@ -80,17 +81,62 @@ var _ = Describe("Fly CLI", func() {
 							{Contents: "id", Color: color.New(color.Bold)},
 							{Contents: "version", Color: color.New(color.Bold)},
 							{Contents: "enabled", Color: color.New(color.Bold)},
+							{Contents: "labels", Color: color.New(color.Bold)},
 						},
 						Data: []ui.TableRow{
-							{{Contents: "3"}, {Contents: "another:field,version:3"}, {Contents: "yes"}},
-							{{Contents: "2"}, {Contents: "another:field,version:2"}, {Contents: "no"}},
-							{{Contents: "1"}, {Contents: "another:field,version:1"}, {Contents: "yes"}},
+							{{Contents: "3"}, {Contents: "another:field,version:3"}, {Contents: "yes"}, {Contents: "qa-approved,signed"}},
+							{{Contents: "2"}, {Contents: "another:field,version:2"}, {Contents: "no"}, {Contents: ""}},
+							{{Contents: "1"}, {Contents: "another:field,version:1"}, {Contents: "yes"}, {Contents: ""}},
 						},
 					}))
 				})
 			})
 		})
 
+		Context("when filters are given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args,
+					"--filter", "ref:abc",
+					"--metadata", "author:alice",
+					"--metadata", "message~fix bug",
+				)
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/pipeline/resources/foo/versions", strings.Join(append(queryParams,
+							"filter=ref:abc",
+							"metadata=author:alice",
+							"metadata=message~fix%20bug",
+						), "&")),
+						ghttp.RespondWithJSONEncoded(200, []atc.ResourceVersion{
+							{ID: 3, Version: atc.Version{"ref": "abc"}, Enabled: true},
+						}),
+					),
+				)
+			})
+
+			It("sends the filters to the API", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("ref:abc"))
+			})
+		})
+
+		Context("when the metadata filter is invalid", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--metadata", "author")
+			})
+
+			It("errors without calling the API", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("invalid metadata filter 'author'"))
+			})
+		})
+
 		Context("and the api returns an internal server error", func() {
 			BeforeEach(func() {
 				atcServer.AppendHandlers(
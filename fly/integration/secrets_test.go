@@ -0,0 +1,272 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("secrets", func() {
+		var (
+			flyCmd    *exec.Cmd
+			updatedAt time.Time
+		)
+
+		BeforeEach(func() {
+			updatedAt = time.Now().Add(-time.Hour)
+
+			flyCmd = exec.Command(flyPath, "-t", targetName, "secrets")
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/secrets"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Secret{
+						{
+							TeamName:  "main",
+							Name:      "some-secret",
+							CreatedAt: updatedAt.Unix(),
+							UpdatedAt: updatedAt.Unix(),
+							UpdatedBy: "some-user",
+						},
+						{
+							TeamName:     "main",
+							PipelineName: "some-pipeline",
+							Name:         "some-other-secret",
+							CreatedAt:    updatedAt.Unix(),
+							UpdatedAt:    updatedAt.Unix(),
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the team's secrets without their values", func() {
+			sess, err := gexec.Start(flyCmd, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "pipeline", Color: color.New(color.Bold)},
+					{Contents: "updated", Color: color.New(color.Bold)},
+					{Contents: "updated by", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "some-secret"},
+						{Contents: "all"},
+						{Contents: updatedAt.Format("2006-01-02@15:04:05-0700")},
+						{Contents: "some-user"},
+					},
+					{
+						{Contents: "some-other-secret"},
+						{Contents: "some-pipeline"},
+						{Contents: updatedAt.Format("2006-01-02@15:04:05-0700")},
+						{Contents: "none", Color: color.New(color.Faint)},
+					},
+				},
+			}))
+		})
+
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--json")
+			})
+
+			It("prints the secrets as JSON", func() {
+				sess, err := gexec.Start(flyCmd, nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				var secrets []atc.Secret
+				Expect(json.Unmarshal(sess.Out.Contents(), &secrets)).To(Succeed())
+				Expect(secrets).To(HaveLen(2))
+				Expect(secrets[1].PipelineName).To(Equal("some-pipeline"))
+			})
+		})
+	})
+
+	Describe("set-secret", func() {
+		var tmpDir string
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "fly-secret")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpDir)
+		})
+
+		It("requires a secret name", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-secret", "-v", "some-value")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("s", "secret") + "' was not specified"))
+		})
+
+		It("requires exactly one value", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-secret", "-s", "some-secret")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("exactly one of --value, --value-file, or --yaml-file must be specified"))
+		})
+
+		It("sets the secret for the whole team", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/secrets/some-secret", ""),
+					ghttp.VerifyJSONRepresenting(atc.SetSecretRequest{Value: "some-value"}),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-secret", "-s", "some-secret", "-v", "some-value")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("secret 'some-secret' set for team 'main'"))
+		})
+
+		It("sets a structured secret for a pipeline of another team", func() {
+			yamlPath := filepath.Join(tmpDir, "secret.yml")
+			err := ioutil.WriteFile(yamlPath, []byte("username: some-user\npassword: some-password\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{ID: 2, Name: "some-team"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/secrets/some-secret", "pipeline=some-pipeline"),
+					ghttp.VerifyJSONRepresenting(atc.SetSecretRequest{
+						Value: map[string]interface{}{
+							"username": "some-user",
+							"password": "some-password",
+						},
+					}),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-secret", "--team", "some-team", "-p", "some-pipeline", "-s", "some-secret", "--yaml-file", yamlPath)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("secret 'some-secret' set for pipeline 'some-pipeline' of team 'some-team'"))
+		})
+
+		It("sets the contents of a file as is", func() {
+			valuePath := filepath.Join(tmpDir, "key")
+			err := ioutil.WriteFile(valuePath, []byte("-----BEGIN KEY-----\nabc\n-----END KEY-----\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/secrets/some-key"),
+					ghttp.VerifyJSONRepresenting(atc.SetSecretRequest{Value: "-----BEGIN KEY-----\nabc\n-----END KEY-----\n"}),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-secret", "-s", "some-key", "--value-file", valuePath)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+		})
+
+		Context("when the secret is rejected", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/secrets/some-secret"),
+						ghttp.RespondWith(http.StatusBadRequest, "pipeline name cannot contain '/'"),
+					),
+				)
+			})
+
+			It("prints the reason", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-secret", "-p", "some/pipeline", "-s", "some-secret", "-v", "some-value")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("pipeline name cannot contain '/'"))
+			})
+		})
+	})
+
+	Describe("delete-secret", func() {
+		It("deletes the secret", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/secrets/some-secret", "pipeline=some-pipeline"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "delete-secret", "-p", "some-pipeline", "-s", "some-secret")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("deleted secret 'some-secret' of pipeline 'some-pipeline' of team 'main'"))
+		})
+
+		It("errors when the secret does not exist", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/secrets/some-secret"),
+					ghttp.RespondWith(http.StatusNotFound, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "delete-secret", "-s", "some-secret")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("secret 'some-secret' does not exist for team 'main'"))
+		})
+	})
+})
@@ -0,0 +1,82 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("set-team-check-limits", func() {
+		Context("when not specifying a team name", func() {
+			It("fails and says you should give a team name", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-team-check-limits")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("n", "team-name") + "' was not specified"))
+			})
+		})
+
+		Context("when specifying a team name", func() {
+			var setStatus int
+
+			BeforeEach(func() {
+				setStatus = http.StatusNoContent
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{ID: 2, Name: "some-team"}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/check-limits"),
+						ghttp.VerifyJSON(`{"checks_per_second":2.5}`),
+						ghttp.RespondWith(setStatus, nil),
+					),
+				)
+			})
+
+			It("sets the check limits of the team", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-team-check-limits", "-n", "some-team", "--checks-per-second", "2.5")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+				Expect(sess.Out).To(gbytes.Say("check limits of team 'some-team' set"))
+				Expect(sess.Out).To(gbytes.Say(`checks per second:    2\.5`))
+				Expect(sess.Out).To(gbytes.Say("max check containers: default"))
+			})
+
+			Context("when the user is not an admin", func() {
+				BeforeEach(func() {
+					setStatus = http.StatusForbidden
+				})
+
+				It("fails", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "set-team-check-limits", "-n", "some-team", "--checks-per-second", "2.5")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					<-sess.Exited
+					Expect(sess.ExitCode()).To(Equal(1))
+					Expect(sess.Err).To(gbytes.Say("forbidden"))
+				})
+			})
+		})
+	})
+})
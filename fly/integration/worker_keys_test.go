@@ -0,0 +1,266 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	const somePublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFdKVCFpynKib6/W5IGS6qXrd/yxmYbgJKdH/zOv51fo"
+
+	findTeam := func() http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/v1/teams/some-team"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{ID: 2, Name: "some-team"}),
+		)
+	}
+
+	Describe("worker-keys", func() {
+		var (
+			flyCmd    *exec.Cmd
+			createdAt time.Time
+			usedAt    time.Time
+		)
+
+		BeforeEach(func() {
+			createdAt = time.Now().Add(-48 * time.Hour)
+			usedAt = time.Now().Add(-time.Minute)
+
+			flyCmd = exec.Command(flyPath, "-t", targetName, "worker-keys", "-n", "some-team")
+
+			atcServer.AppendHandlers(
+				findTeam(),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/worker-keys"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.WorkerKey{
+						{
+							TeamName:    "some-team",
+							Name:        "new-key",
+							PublicKey:   somePublicKey,
+							Fingerprint: "SHA256:new",
+							CreatedAt:   createdAt.Unix(),
+							LastUsedAt:  usedAt.Unix(),
+						},
+						{
+							TeamName:    "some-team",
+							Name:        "old-key",
+							PublicKey:   somePublicKey,
+							Fingerprint: "SHA256:old",
+							CreatedAt:   createdAt.Unix(),
+							ExpiresAt:   time.Now().Add(-time.Hour).Unix(),
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the team's worker keys", func() {
+			sess, err := gexec.Start(flyCmd, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(sess).Should(gexec.Exit(0))
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "fingerprint", Color: color.New(color.Bold)},
+					{Contents: "created", Color: color.New(color.Bold)},
+					{Contents: "expires", Color: color.New(color.Bold)},
+					{Contents: "last used", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "new-key"},
+						{Contents: "SHA256:new"},
+						{Contents: createdAt.Format("2006-01-02@15:04:05-0700")},
+						{Contents: "never"},
+						{Contents: usedAt.Format("2006-01-02@15:04:05-0700")},
+					},
+					{
+						{Contents: "old-key"},
+						{Contents: "SHA256:old"},
+						{Contents: createdAt.Format("2006-01-02@15:04:05-0700")},
+						{Contents: "expired", Color: color.New(color.FgRed)},
+						{Contents: "never"},
+					},
+				},
+			}))
+		})
+
+		Context("when --json is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--json")
+			})
+
+			It("prints the keys as JSON", func() {
+				sess, err := gexec.Start(flyCmd, nil, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				var keys []atc.WorkerKey
+				Expect(json.Unmarshal(sess.Out.Contents(), &keys)).To(Succeed())
+				Expect(keys).To(HaveLen(2))
+				Expect(keys[0].Name).To(Equal("new-key"))
+			})
+		})
+	})
+
+	Describe("set-worker-key", func() {
+		var (
+			tmpDir        string
+			publicKeyPath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "fly-worker-key")
+			Expect(err).NotTo(HaveOccurred())
+
+			publicKeyPath = filepath.Join(tmpDir, "key.pub")
+			err = ioutil.WriteFile(publicKeyPath, []byte(somePublicKey+"\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpDir)
+		})
+
+		It("requires a key name", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-worker-key", "-n", "some-team", "--public-key", publicKeyPath)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("k", "key-name") + "' was not specified"))
+		})
+
+		It("sets the key", func() {
+			atcServer.AppendHandlers(
+				findTeam(),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/worker-keys/some-key"),
+					ghttp.VerifyJSONRepresenting(atc.SetWorkerKeyRequest{PublicKey: somePublicKey + "\n"}),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-worker-key", "-n", "some-team", "-k", "some-key", "--public-key", publicKeyPath)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("worker key 'some-key' set for team 'some-team'"))
+		})
+
+		It("rotates out the replaced key after the overlap", func() {
+			var request atc.SetWorkerKeyRequest
+			atcServer.AppendHandlers(
+				findTeam(),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/worker-keys/new-key"),
+					func(w http.ResponseWriter, r *http.Request) {
+						Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+					},
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-worker-key", "-n", "some-team", "-k", "new-key", "--public-key", publicKeyPath, "--replaces", "old-key", "--overlap", "1h")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("worker key 'old-key' will expire at"))
+
+			Expect(request.Replaces).To(Equal("old-key"))
+			Expect(request.ReplacedKeyExpiresAt).To(BeNumerically("~", time.Now().Add(time.Hour).Unix(), 60))
+			Expect(request.ExpiresAt).To(BeZero())
+		})
+
+		Context("when the key is rejected", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					findTeam(),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/worker-keys/some-key"),
+						ghttp.RespondWith(http.StatusConflict, "public key is already in use by another worker key"),
+					),
+				)
+			})
+
+			It("prints the reason", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-worker-key", "-n", "some-team", "-k", "some-key", "--public-key", publicKeyPath)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("public key is already in use by another worker key"))
+			})
+		})
+	})
+
+	Describe("delete-worker-key", func() {
+		It("deletes the key", func() {
+			atcServer.AppendHandlers(
+				findTeam(),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/worker-keys/some-key"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "delete-worker-key", "-n", "some-team", "-k", "some-key")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("deleted worker key 'some-key' of team 'some-team'"))
+		})
+
+		Context("when the key does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					findTeam(),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/worker-keys/some-key"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "delete-worker-key", "-n", "some-team", "-k", "some-key")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("worker key 'some-key' does not exist"))
+			})
+		})
+	})
+})
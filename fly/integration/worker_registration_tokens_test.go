@@ -0,0 +1,197 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("create-worker-registration-token", func() {
+		var (
+			status   int
+			received atc.WorkerRegistrationToken
+		)
+
+		BeforeEach(func() {
+			status = http.StatusCreated
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/worker-registration-tokens"),
+					func(w http.ResponseWriter, r *http.Request) {
+						err := json.NewDecoder(r.Body).Decode(&received)
+						Expect(err).NotTo(HaveOccurred())
+					},
+					ghttp.RespondWithJSONEncoded(status, atc.WorkerRegistrationToken{
+						ID:        42,
+						TeamName:  "some-team",
+						ExpiresAt: time.Now().Add(time.Hour).Unix(),
+						MaxUses:   1,
+						Token:     "some-secret",
+					}),
+				),
+			)
+		})
+
+		It("creates a single-use token for an hour and prints its secret", func() {
+			before := time.Now()
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "create-worker-registration-token", "--team", "some-team", "--tag", "some-tag")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Err).To(gbytes.Say("created worker registration token 42"))
+			Expect(sess.Out).To(gbytes.Say("some-secret"))
+
+			Expect(received.TeamName).To(Equal("some-team"))
+			Expect(received.Tags).To(Equal([]string{"some-tag"}))
+			Expect(received.MaxUses).To(Equal(1))
+			Expect(received.ExpiresAt).To(BeNumerically("~", before.Add(time.Hour).Unix(), 5))
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				status = http.StatusForbidden
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-worker-registration-token")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("forbidden"))
+			})
+		})
+
+		Context("when max uses is negative", func() {
+			It("fails without creating the token", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-worker-registration-token", "--max-uses", "-1")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("--max-uses can't be negative"))
+			})
+		})
+	})
+
+	Describe("worker-registration-tokens", func() {
+		var expiresAt time.Time
+
+		BeforeEach(func() {
+			expiresAt = time.Now().Add(time.Hour).Truncate(time.Second)
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/worker-registration-tokens"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.WorkerRegistrationToken{
+						{
+							ID:        1,
+							TeamName:  "some-team",
+							Tags:      []string{"some-tag", "some-other-tag"},
+							ExpiresAt: expiresAt.Unix(),
+							MaxUses:   2,
+							Uses:      1,
+						},
+						{
+							ID:        2,
+							ExpiresAt: expiresAt.Unix(),
+							Uses:      3,
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the tokens", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "worker-registration-tokens")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			layout := "2006-01-02@15:04:05-0700"
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "id", Color: color.New(color.Bold)},
+					{Contents: "team", Color: color.New(color.Bold)},
+					{Contents: "tags", Color: color.New(color.Bold)},
+					{Contents: "uses", Color: color.New(color.Bold)},
+					{Contents: "expires", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{{Contents: "1"}, {Contents: "some-team"}, {Contents: "some-tag, some-other-tag"}, {Contents: "1/2"}, {Contents: expiresAt.Format(layout)}},
+					{{Contents: "2"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "3"}, {Contents: expiresAt.Format(layout)}},
+				},
+			}))
+		})
+	})
+
+	Describe("delete-worker-registration-token", func() {
+		var status int
+
+		BeforeEach(func() {
+			status = http.StatusNoContent
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/worker-registration-tokens/42"),
+					ghttp.RespondWith(status, nil),
+				),
+			)
+		})
+
+		It("deletes the token", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "delete-worker-registration-token", "--id", "42")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+			Expect(sess.Out).To(gbytes.Say("deleted worker registration token 42"))
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "delete-worker-registration-token", "--id", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("worker registration token 42 does not exist"))
+			})
+		})
+	})
+})
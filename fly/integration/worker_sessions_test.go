@@ -0,0 +1,135 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("worker-sessions", func() {
+		var (
+			status      int
+			connectedAt time.Time
+			heartbeatAt time.Time
+		)
+
+		BeforeEach(func() {
+			status = http.StatusOK
+			connectedAt = time.Now().Add(-time.Hour).Truncate(time.Second)
+			heartbeatAt = time.Now().Truncate(time.Second)
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/worker-sessions"),
+					ghttp.RespondWithJSONEncoded(status, []atc.WorkerSession{
+						{
+							ID:          "some-session",
+							TSA:         "10.0.0.1:2222",
+							WorkerName:  "some-worker",
+							TeamName:    "some-team",
+							RemoteAddr:  "1.2.3.4:5678",
+							Transport:   "ssh",
+							ConnectedAt: connectedAt.Unix(),
+							ForwardedPorts: []atc.WorkerSessionForward{
+								{BindAddr: "0.0.0.0:7777", BoundPort: 40001},
+								{BindAddr: "0.0.0.0:7788", BoundPort: 40002},
+							},
+							LastHeartbeatAt:     heartbeatAt.Unix(),
+							LastHeartbeatStatus: "healthy",
+							BytesProxied:        1024,
+						},
+						{
+							ID:               "some-other-session",
+							TSA:              "10.0.0.2:2222",
+							RemoteAddr:       "1.2.3.5:5678",
+							Transport:        "websocket",
+							ConnectedAt:      connectedAt.Unix(),
+							MissedKeepalives: 2,
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the sessions of every web node", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "worker-sessions")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+
+			layout := "2006-01-02@15:04:05-0700"
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "worker", Color: color.New(color.Bold)},
+					{Contents: "team", Color: color.New(color.Bold)},
+					{Contents: "tsa", Color: color.New(color.Bold)},
+					{Contents: "remote address", Color: color.New(color.Bold)},
+					{Contents: "transport", Color: color.New(color.Bold)},
+					{Contents: "connected", Color: color.New(color.Bold)},
+					{Contents: "forwarded ports", Color: color.New(color.Bold)},
+					{Contents: "heartbeat", Color: color.New(color.Bold)},
+					{Contents: "bytes proxied", Color: color.New(color.Bold)},
+					{Contents: "missed keepalives", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "some-worker"},
+						{Contents: "some-team"},
+						{Contents: "10.0.0.1:2222"},
+						{Contents: "1.2.3.4:5678"},
+						{Contents: "ssh"},
+						{Contents: connectedAt.Format(layout)},
+						{Contents: "40001, 40002"},
+						{Contents: "healthy at " + heartbeatAt.Format(layout)},
+						{Contents: "1024"},
+						{Contents: "0"},
+					},
+					{
+						{Contents: "none", Color: color.New(color.Faint)},
+						{Contents: "none", Color: color.New(color.Faint)},
+						{Contents: "10.0.0.2:2222"},
+						{Contents: "1.2.3.5:5678"},
+						{Contents: "websocket"},
+						{Contents: connectedAt.Format(layout)},
+						{Contents: "none", Color: color.New(color.Faint)},
+						{Contents: "none", Color: color.New(color.Faint)},
+						{Contents: "0"},
+						{Contents: "2", Color: color.New(color.FgRed)},
+					},
+				},
+			}))
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				status = http.StatusForbidden
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "worker-sessions")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(1))
+				Expect(sess.Err).To(gbytes.Say("forbidden"))
+			})
+		})
+	})
+})
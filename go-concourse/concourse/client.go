@@ -25,6 +25,14 @@ type Client interface {
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
 	LandWorker(workerName string) error
+	ListMaintenanceWindows() ([]atc.MaintenanceWindow, error)
+	CreateMaintenanceWindow(atc.MaintenanceWindow) (atc.MaintenanceWindow, error)
+	DeleteMaintenanceWindow(id int) (bool, error)
+	ListWorkerRegistrationTokens() ([]atc.WorkerRegistrationToken, error)
+	CreateWorkerRegistrationToken(atc.WorkerRegistrationToken) (atc.WorkerRegistrationToken, error)
+	DeleteWorkerRegistrationToken(id int) (bool, error)
+	ListWorkerSessions() ([]atc.WorkerSession, error)
+	ClearSecretCache(pathPrefix string) error
 	GetInfo() (atc.Info, error)
 	GetCLIReader(arch, platform string) (io.ReadCloser, http.Header, error)
 	ListPipelines() ([]atc.Pipeline, error)
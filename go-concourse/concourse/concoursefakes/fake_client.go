@@ -96,6 +96,69 @@ type FakeClient struct {
 		result2 concourse.Pagination
 		result3 error
 	}
+	ClearSecretCacheStub        func(string) error
+	clearSecretCacheMutex       sync.RWMutex
+	clearSecretCacheArgsForCall []struct {
+		arg1 string
+	}
+	clearSecretCacheReturns struct {
+		result1 error
+	}
+	clearSecretCacheReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CreateMaintenanceWindowStub        func(atc.MaintenanceWindow) (atc.MaintenanceWindow, error)
+	createMaintenanceWindowMutex       sync.RWMutex
+	createMaintenanceWindowArgsForCall []struct {
+		arg1 atc.MaintenanceWindow
+	}
+	createMaintenanceWindowReturns struct {
+		result1 atc.MaintenanceWindow
+		result2 error
+	}
+	createMaintenanceWindowReturnsOnCall map[int]struct {
+		result1 atc.MaintenanceWindow
+		result2 error
+	}
+	CreateWorkerRegistrationTokenStub        func(atc.WorkerRegistrationToken) (atc.WorkerRegistrationToken, error)
+	createWorkerRegistrationTokenMutex       sync.RWMutex
+	createWorkerRegistrationTokenArgsForCall []struct {
+		arg1 atc.WorkerRegistrationToken
+	}
+	createWorkerRegistrationTokenReturns struct {
+		result1 atc.WorkerRegistrationToken
+		result2 error
+	}
+	createWorkerRegistrationTokenReturnsOnCall map[int]struct {
+		result1 atc.WorkerRegistrationToken
+		result2 error
+	}
+	DeleteMaintenanceWindowStub        func(int) (bool, error)
+	deleteMaintenanceWindowMutex       sync.RWMutex
+	deleteMaintenanceWindowArgsForCall []struct {
+		arg1 int
+	}
+	deleteMaintenanceWindowReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteMaintenanceWindowReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	DeleteWorkerRegistrationTokenStub        func(int) (bool, error)
+	deleteWorkerRegistrationTokenMutex       sync.RWMutex
+	deleteWorkerRegistrationTokenArgsForCall []struct {
+		arg1 int
+	}
+	deleteWorkerRegistrationTokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteWorkerRegistrationTokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FindTeamStub        func(string) (concourse.Team, error)
 	findTeamMutex       sync.RWMutex
 	findTeamArgsForCall []struct {
@@ -196,6 +259,18 @@ type FakeClient struct {
 		result1 []atc.WorkerArtifact
 		result2 error
 	}
+	ListMaintenanceWindowsStub        func() ([]atc.MaintenanceWindow, error)
+	listMaintenanceWindowsMutex       sync.RWMutex
+	listMaintenanceWindowsArgsForCall []struct {
+	}
+	listMaintenanceWindowsReturns struct {
+		result1 []atc.MaintenanceWindow
+		result2 error
+	}
+	listMaintenanceWindowsReturnsOnCall map[int]struct {
+		result1 []atc.MaintenanceWindow
+		result2 error
+	}
 	ListPipelinesStub        func() ([]atc.Pipeline, error)
 	listPipelinesMutex       sync.RWMutex
 	listPipelinesArgsForCall []struct {
@@ -220,6 +295,30 @@ type FakeClient struct {
 		result1 []atc.Team
 		result2 error
 	}
+	ListWorkerRegistrationTokensStub        func() ([]atc.WorkerRegistrationToken, error)
+	listWorkerRegistrationTokensMutex       sync.RWMutex
+	listWorkerRegistrationTokensArgsForCall []struct {
+	}
+	listWorkerRegistrationTokensReturns struct {
+		result1 []atc.WorkerRegistrationToken
+		result2 error
+	}
+	listWorkerRegistrationTokensReturnsOnCall map[int]struct {
+		result1 []atc.WorkerRegistrationToken
+		result2 error
+	}
+	ListWorkerSessionsStub        func() ([]atc.WorkerSession, error)
+	listWorkerSessionsMutex       sync.RWMutex
+	listWorkerSessionsArgsForCall []struct {
+	}
+	listWorkerSessionsReturns struct {
+		result1 []atc.WorkerSession
+		result2 error
+	}
+	listWorkerSessionsReturnsOnCall map[int]struct {
+		result1 []atc.WorkerSession
+		result2 error
+	}
 	ListWorkersStub        func() ([]atc.Worker, error)
 	listWorkersMutex       sync.RWMutex
 	listWorkersArgsForCall []struct {
@@ -681,6 +780,318 @@ func (fake *FakeClient) BuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) ClearSecretCache(arg1 string) error {
+	fake.clearSecretCacheMutex.Lock()
+	ret, specificReturn := fake.clearSecretCacheReturnsOnCall[len(fake.clearSecretCacheArgsForCall)]
+	fake.clearSecretCacheArgsForCall = append(fake.clearSecretCacheArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ClearSecretCache", []interface{}{arg1})
+	fake.clearSecretCacheMutex.Unlock()
+	if fake.ClearSecretCacheStub != nil {
+		return fake.ClearSecretCacheStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.clearSecretCacheReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ClearSecretCacheCallCount() int {
+	fake.clearSecretCacheMutex.RLock()
+	defer fake.clearSecretCacheMutex.RUnlock()
+	return len(fake.clearSecretCacheArgsForCall)
+}
+
+func (fake *FakeClient) ClearSecretCacheCalls(stub func(string) error) {
+	fake.clearSecretCacheMutex.Lock()
+	defer fake.clearSecretCacheMutex.Unlock()
+	fake.ClearSecretCacheStub = stub
+}
+
+func (fake *FakeClient) ClearSecretCacheArgsForCall(i int) string {
+	fake.clearSecretCacheMutex.RLock()
+	defer fake.clearSecretCacheMutex.RUnlock()
+	argsForCall := fake.clearSecretCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ClearSecretCacheReturns(result1 error) {
+	fake.clearSecretCacheMutex.Lock()
+	defer fake.clearSecretCacheMutex.Unlock()
+	fake.ClearSecretCacheStub = nil
+	fake.clearSecretCacheReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ClearSecretCacheReturnsOnCall(i int, result1 error) {
+	fake.clearSecretCacheMutex.Lock()
+	defer fake.clearSecretCacheMutex.Unlock()
+	fake.ClearSecretCacheStub = nil
+	if fake.clearSecretCacheReturnsOnCall == nil {
+		fake.clearSecretCacheReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clearSecretCacheReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CreateMaintenanceWindow(arg1 atc.MaintenanceWindow) (atc.MaintenanceWindow, error) {
+	fake.createMaintenanceWindowMutex.Lock()
+	ret, specificReturn := fake.createMaintenanceWindowReturnsOnCall[len(fake.createMaintenanceWindowArgsForCall)]
+	fake.createMaintenanceWindowArgsForCall = append(fake.createMaintenanceWindowArgsForCall, struct {
+		arg1 atc.MaintenanceWindow
+	}{arg1})
+	fake.recordInvocation("CreateMaintenanceWindow", []interface{}{arg1})
+	fake.createMaintenanceWindowMutex.Unlock()
+	if fake.CreateMaintenanceWindowStub != nil {
+		return fake.CreateMaintenanceWindowStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createMaintenanceWindowReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CreateMaintenanceWindowCallCount() int {
+	fake.createMaintenanceWindowMutex.RLock()
+	defer fake.createMaintenanceWindowMutex.RUnlock()
+	return len(fake.createMaintenanceWindowArgsForCall)
+}
+
+func (fake *FakeClient) CreateMaintenanceWindowCalls(stub func(atc.MaintenanceWindow) (atc.MaintenanceWindow, error)) {
+	fake.createMaintenanceWindowMutex.Lock()
+	defer fake.createMaintenanceWindowMutex.Unlock()
+	fake.CreateMaintenanceWindowStub = stub
+}
+
+func (fake *FakeClient) CreateMaintenanceWindowArgsForCall(i int) atc.MaintenanceWindow {
+	fake.createMaintenanceWindowMutex.RLock()
+	defer fake.createMaintenanceWindowMutex.RUnlock()
+	argsForCall := fake.createMaintenanceWindowArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CreateMaintenanceWindowReturns(result1 atc.MaintenanceWindow, result2 error) {
+	fake.createMaintenanceWindowMutex.Lock()
+	defer fake.createMaintenanceWindowMutex.Unlock()
+	fake.CreateMaintenanceWindowStub = nil
+	fake.createMaintenanceWindowReturns = struct {
+		result1 atc.MaintenanceWindow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateMaintenanceWindowReturnsOnCall(i int, result1 atc.MaintenanceWindow, result2 error) {
+	fake.createMaintenanceWindowMutex.Lock()
+	defer fake.createMaintenanceWindowMutex.Unlock()
+	fake.CreateMaintenanceWindowStub = nil
+	if fake.createMaintenanceWindowReturnsOnCall == nil {
+		fake.createMaintenanceWindowReturnsOnCall = make(map[int]struct {
+			result1 atc.MaintenanceWindow
+			result2 error
+		})
+	}
+	fake.createMaintenanceWindowReturnsOnCall[i] = struct {
+		result1 atc.MaintenanceWindow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateWorkerRegistrationToken(arg1 atc.WorkerRegistrationToken) (atc.WorkerRegistrationToken, error) {
+	fake.createWorkerRegistrationTokenMutex.Lock()
+	ret, specificReturn := fake.createWorkerRegistrationTokenReturnsOnCall[len(fake.createWorkerRegistrationTokenArgsForCall)]
+	fake.createWorkerRegistrationTokenArgsForCall = append(fake.createWorkerRegistrationTokenArgsForCall, struct {
+		arg1 atc.WorkerRegistrationToken
+	}{arg1})
+	fake.recordInvocation("CreateWorkerRegistrationToken", []interface{}{arg1})
+	fake.createWorkerRegistrationTokenMutex.Unlock()
+	if fake.CreateWorkerRegistrationTokenStub != nil {
+		return fake.CreateWorkerRegistrationTokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createWorkerRegistrationTokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CreateWorkerRegistrationTokenCallCount() int {
+	fake.createWorkerRegistrationTokenMutex.RLock()
+	defer fake.createWorkerRegistrationTokenMutex.RUnlock()
+	return len(fake.createWorkerRegistrationTokenArgsForCall)
+}
+
+func (fake *FakeClient) CreateWorkerRegistrationTokenCalls(stub func(atc.WorkerRegistrationToken) (atc.WorkerRegistrationToken, error)) {
+	fake.createWorkerRegistrationTokenMutex.Lock()
+	defer fake.createWorkerRegistrationTokenMutex.Unlock()
+	fake.CreateWorkerRegistrationTokenStub = stub
+}
+
+func (fake *FakeClient) CreateWorkerRegistrationTokenArgsForCall(i int) atc.WorkerRegistrationToken {
+	fake.createWorkerRegistrationTokenMutex.RLock()
+	defer fake.createWorkerRegistrationTokenMutex.RUnlock()
+	argsForCall := fake.createWorkerRegistrationTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CreateWorkerRegistrationTokenReturns(result1 atc.WorkerRegistrationToken, result2 error) {
+	fake.createWorkerRegistrationTokenMutex.Lock()
+	defer fake.createWorkerRegistrationTokenMutex.Unlock()
+	fake.CreateWorkerRegistrationTokenStub = nil
+	fake.createWorkerRegistrationTokenReturns = struct {
+		result1 atc.WorkerRegistrationToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateWorkerRegistrationTokenReturnsOnCall(i int, result1 atc.WorkerRegistrationToken, result2 error) {
+	fake.createWorkerRegistrationTokenMutex.Lock()
+	defer fake.createWorkerRegistrationTokenMutex.Unlock()
+	fake.CreateWorkerRegistrationTokenStub = nil
+	if fake.createWorkerRegistrationTokenReturnsOnCall == nil {
+		fake.createWorkerRegistrationTokenReturnsOnCall = make(map[int]struct {
+			result1 atc.WorkerRegistrationToken
+			result2 error
+		})
+	}
+	fake.createWorkerRegistrationTokenReturnsOnCall[i] = struct {
+		result1 atc.WorkerRegistrationToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteMaintenanceWindow(arg1 int) (bool, error) {
+	fake.deleteMaintenanceWindowMutex.Lock()
+	ret, specificReturn := fake.deleteMaintenanceWindowReturnsOnCall[len(fake.deleteMaintenanceWindowArgsForCall)]
+	fake.deleteMaintenanceWindowArgsForCall = append(fake.deleteMaintenanceWindowArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeleteMaintenanceWindow", []interface{}{arg1})
+	fake.deleteMaintenanceWindowMutex.Unlock()
+	if fake.DeleteMaintenanceWindowStub != nil {
+		return fake.DeleteMaintenanceWindowStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteMaintenanceWindowReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DeleteMaintenanceWindowCallCount() int {
+	fake.deleteMaintenanceWindowMutex.RLock()
+	defer fake.deleteMaintenanceWindowMutex.RUnlock()
+	return len(fake.deleteMaintenanceWindowArgsForCall)
+}
+
+func (fake *FakeClient) DeleteMaintenanceWindowCalls(stub func(int) (bool, error)) {
+	fake.deleteMaintenanceWindowMutex.Lock()
+	defer fake.deleteMaintenanceWindowMutex.Unlock()
+	fake.DeleteMaintenanceWindowStub = stub
+}
+
+func (fake *FakeClient) DeleteMaintenanceWindowArgsForCall(i int) int {
+	fake.deleteMaintenanceWindowMutex.RLock()
+	defer fake.deleteMaintenanceWindowMutex.RUnlock()
+	argsForCall := fake.deleteMaintenanceWindowArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) DeleteMaintenanceWindowReturns(result1 bool, result2 error) {
+	fake.deleteMaintenanceWindowMutex.Lock()
+	defer fake.deleteMaintenanceWindowMutex.Unlock()
+	fake.DeleteMaintenanceWindowStub = nil
+	fake.deleteMaintenanceWindowReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteMaintenanceWindowReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteMaintenanceWindowMutex.Lock()
+	defer fake.deleteMaintenanceWindowMutex.Unlock()
+	fake.DeleteMaintenanceWindowStub = nil
+	if fake.deleteMaintenanceWindowReturnsOnCall == nil {
+		fake.deleteMaintenanceWindowReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteMaintenanceWindowReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteWorkerRegistrationToken(arg1 int) (bool, error) {
+	fake.deleteWorkerRegistrationTokenMutex.Lock()
+	ret, specificReturn := fake.deleteWorkerRegistrationTokenReturnsOnCall[len(fake.deleteWorkerRegistrationTokenArgsForCall)]
+	fake.deleteWorkerRegistrationTokenArgsForCall = append(fake.deleteWorkerRegistrationTokenArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DeleteWorkerRegistrationToken", []interface{}{arg1})
+	fake.deleteWorkerRegistrationTokenMutex.Unlock()
+	if fake.DeleteWorkerRegistrationTokenStub != nil {
+		return fake.DeleteWorkerRegistrationTokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteWorkerRegistrationTokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DeleteWorkerRegistrationTokenCallCount() int {
+	fake.deleteWorkerRegistrationTokenMutex.RLock()
+	defer fake.deleteWorkerRegistrationTokenMutex.RUnlock()
+	return len(fake.deleteWorkerRegistrationTokenArgsForCall)
+}
+
+func (fake *FakeClient) DeleteWorkerRegistrationTokenCalls(stub func(int) (bool, error)) {
+	fake.deleteWorkerRegistrationTokenMutex.Lock()
+	defer fake.deleteWorkerRegistrationTokenMutex.Unlock()
+	fake.DeleteWorkerRegistrationTokenStub = stub
+}
+
+func (fake *FakeClient) DeleteWorkerRegistrationTokenArgsForCall(i int) int {
+	fake.deleteWorkerRegistrationTokenMutex.RLock()
+	defer fake.deleteWorkerRegistrationTokenMutex.RUnlock()
+	argsForCall := fake.deleteWorkerRegistrationTokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) DeleteWorkerRegistrationTokenReturns(result1 bool, result2 error) {
+	fake.deleteWorkerRegistrationTokenMutex.Lock()
+	defer fake.deleteWorkerRegistrationTokenMutex.Unlock()
+	fake.DeleteWorkerRegistrationTokenStub = nil
+	fake.deleteWorkerRegistrationTokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteWorkerRegistrationTokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteWorkerRegistrationTokenMutex.Lock()
+	defer fake.deleteWorkerRegistrationTokenMutex.Unlock()
+	fake.DeleteWorkerRegistrationTokenStub = nil
+	if fake.deleteWorkerRegistrationTokenReturnsOnCall == nil {
+		fake.deleteWorkerRegistrationTokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteWorkerRegistrationTokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) FindTeam(arg1 string) (concourse.Team, error) {
 	fake.findTeamMutex.Lock()
 	ret, specificReturn := fake.findTeamReturnsOnCall[len(fake.findTeamArgsForCall)]
@@ -1159,6 +1570,61 @@ func (fake *FakeClient) ListBuildArtifactsReturnsOnCall(i int, result1 []atc.Wor
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListMaintenanceWindows() ([]atc.MaintenanceWindow, error) {
+	fake.listMaintenanceWindowsMutex.Lock()
+	ret, specificReturn := fake.listMaintenanceWindowsReturnsOnCall[len(fake.listMaintenanceWindowsArgsForCall)]
+	fake.listMaintenanceWindowsArgsForCall = append(fake.listMaintenanceWindowsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListMaintenanceWindows", []interface{}{})
+	fake.listMaintenanceWindowsMutex.Unlock()
+	if fake.ListMaintenanceWindowsStub != nil {
+		return fake.ListMaintenanceWindowsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listMaintenanceWindowsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListMaintenanceWindowsCallCount() int {
+	fake.listMaintenanceWindowsMutex.RLock()
+	defer fake.listMaintenanceWindowsMutex.RUnlock()
+	return len(fake.listMaintenanceWindowsArgsForCall)
+}
+
+func (fake *FakeClient) ListMaintenanceWindowsCalls(stub func() ([]atc.MaintenanceWindow, error)) {
+	fake.listMaintenanceWindowsMutex.Lock()
+	defer fake.listMaintenanceWindowsMutex.Unlock()
+	fake.ListMaintenanceWindowsStub = stub
+}
+
+func (fake *FakeClient) ListMaintenanceWindowsReturns(result1 []atc.MaintenanceWindow, result2 error) {
+	fake.listMaintenanceWindowsMutex.Lock()
+	defer fake.listMaintenanceWindowsMutex.Unlock()
+	fake.ListMaintenanceWindowsStub = nil
+	fake.listMaintenanceWindowsReturns = struct {
+		result1 []atc.MaintenanceWindow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListMaintenanceWindowsReturnsOnCall(i int, result1 []atc.MaintenanceWindow, result2 error) {
+	fake.listMaintenanceWindowsMutex.Lock()
+	defer fake.listMaintenanceWindowsMutex.Unlock()
+	fake.ListMaintenanceWindowsStub = nil
+	if fake.listMaintenanceWindowsReturnsOnCall == nil {
+		fake.listMaintenanceWindowsReturnsOnCall = make(map[int]struct {
+			result1 []atc.MaintenanceWindow
+			result2 error
+		})
+	}
+	fake.listMaintenanceWindowsReturnsOnCall[i] = struct {
+		result1 []atc.MaintenanceWindow
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListPipelines() ([]atc.Pipeline, error) {
 	fake.listPipelinesMutex.Lock()
 	ret, specificReturn := fake.listPipelinesReturnsOnCall[len(fake.listPipelinesArgsForCall)]
@@ -1269,6 +1735,116 @@ func (fake *FakeClient) ListTeamsReturnsOnCall(i int, result1 []atc.Team, result
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListWorkerRegistrationTokens() ([]atc.WorkerRegistrationToken, error) {
+	fake.listWorkerRegistrationTokensMutex.Lock()
+	ret, specificReturn := fake.listWorkerRegistrationTokensReturnsOnCall[len(fake.listWorkerRegistrationTokensArgsForCall)]
+	fake.listWorkerRegistrationTokensArgsForCall = append(fake.listWorkerRegistrationTokensArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListWorkerRegistrationTokens", []interface{}{})
+	fake.listWorkerRegistrationTokensMutex.Unlock()
+	if fake.ListWorkerRegistrationTokensStub != nil {
+		return fake.ListWorkerRegistrationTokensStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listWorkerRegistrationTokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListWorkerRegistrationTokensCallCount() int {
+	fake.listWorkerRegistrationTokensMutex.RLock()
+	defer fake.listWorkerRegistrationTokensMutex.RUnlock()
+	return len(fake.listWorkerRegistrationTokensArgsForCall)
+}
+
+func (fake *FakeClient) ListWorkerRegistrationTokensCalls(stub func() ([]atc.WorkerRegistrationToken, error)) {
+	fake.listWorkerRegistrationTokensMutex.Lock()
+	defer fake.listWorkerRegistrationTokensMutex.Unlock()
+	fake.ListWorkerRegistrationTokensStub = stub
+}
+
+func (fake *FakeClient) ListWorkerRegistrationTokensReturns(result1 []atc.WorkerRegistrationToken, result2 error) {
+	fake.listWorkerRegistrationTokensMutex.Lock()
+	defer fake.listWorkerRegistrationTokensMutex.Unlock()
+	fake.ListWorkerRegistrationTokensStub = nil
+	fake.listWorkerRegistrationTokensReturns = struct {
+		result1 []atc.WorkerRegistrationToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListWorkerRegistrationTokensReturnsOnCall(i int, result1 []atc.WorkerRegistrationToken, result2 error) {
+	fake.listWorkerRegistrationTokensMutex.Lock()
+	defer fake.listWorkerRegistrationTokensMutex.Unlock()
+	fake.ListWorkerRegistrationTokensStub = nil
+	if fake.listWorkerRegistrationTokensReturnsOnCall == nil {
+		fake.listWorkerRegistrationTokensReturnsOnCall = make(map[int]struct {
+			result1 []atc.WorkerRegistrationToken
+			result2 error
+		})
+	}
+	fake.listWorkerRegistrationTokensReturnsOnCall[i] = struct {
+		result1 []atc.WorkerRegistrationToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListWorkerSessions() ([]atc.WorkerSession, error) {
+	fake.listWorkerSessionsMutex.Lock()
+	ret, specificReturn := fake.listWorkerSessionsReturnsOnCall[len(fake.listWorkerSessionsArgsForCall)]
+	fake.listWorkerSessionsArgsForCall = append(fake.listWorkerSessionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListWorkerSessions", []interface{}{})
+	fake.listWorkerSessionsMutex.Unlock()
+	if fake.ListWorkerSessionsStub != nil {
+		return fake.ListWorkerSessionsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listWorkerSessionsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListWorkerSessionsCallCount() int {
+	fake.listWorkerSessionsMutex.RLock()
+	defer fake.listWorkerSessionsMutex.RUnlock()
+	return len(fake.listWorkerSessionsArgsForCall)
+}
+
+func (fake *FakeClient) ListWorkerSessionsCalls(stub func() ([]atc.WorkerSession, error)) {
+	fake.listWorkerSessionsMutex.Lock()
+	defer fake.listWorkerSessionsMutex.Unlock()
+	fake.ListWorkerSessionsStub = stub
+}
+
+func (fake *FakeClient) ListWorkerSessionsReturns(result1 []atc.WorkerSession, result2 error) {
+	fake.listWorkerSessionsMutex.Lock()
+	defer fake.listWorkerSessionsMutex.Unlock()
+	fake.ListWorkerSessionsStub = nil
+	fake.listWorkerSessionsReturns = struct {
+		result1 []atc.WorkerSession
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListWorkerSessionsReturnsOnCall(i int, result1 []atc.WorkerSession, result2 error) {
+	fake.listWorkerSessionsMutex.Lock()
+	defer fake.listWorkerSessionsMutex.Unlock()
+	fake.ListWorkerSessionsStub = nil
+	if fake.listWorkerSessionsReturnsOnCall == nil {
+		fake.listWorkerSessionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.WorkerSession
+			result2 error
+		})
+	}
+	fake.listWorkerSessionsReturnsOnCall[i] = struct {
+		result1 []atc.WorkerSession
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListWorkers() ([]atc.Worker, error) {
 	fake.listWorkersMutex.Lock()
 	ret, specificReturn := fake.listWorkersReturnsOnCall[len(fake.listWorkersArgsForCall)]
@@ -1630,6 +2206,16 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
+	fake.clearSecretCacheMutex.RLock()
+	defer fake.clearSecretCacheMutex.RUnlock()
+	fake.createMaintenanceWindowMutex.RLock()
+	defer fake.createMaintenanceWindowMutex.RUnlock()
+	fake.createWorkerRegistrationTokenMutex.RLock()
+	defer fake.createWorkerRegistrationTokenMutex.RUnlock()
+	fake.deleteMaintenanceWindowMutex.RLock()
+	defer fake.deleteMaintenanceWindowMutex.RUnlock()
+	fake.deleteWorkerRegistrationTokenMutex.RLock()
+	defer fake.deleteWorkerRegistrationTokenMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
@@ -1646,10 +2232,16 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listAllJobsMutex.RUnlock()
 	fake.listBuildArtifactsMutex.RLock()
 	defer fake.listBuildArtifactsMutex.RUnlock()
+	fake.listMaintenanceWindowsMutex.RLock()
+	defer fake.listMaintenanceWindowsMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
 	defer fake.listPipelinesMutex.RUnlock()
 	fake.listTeamsMutex.RLock()
 	defer fake.listTeamsMutex.RUnlock()
+	fake.listWorkerRegistrationTokensMutex.RLock()
+	defer fake.listWorkerRegistrationTokensMutex.RUnlock()
+	fake.listWorkerSessionsMutex.RLock()
+	defer fake.listWorkerSessionsMutex.RUnlock()
 	fake.listWorkersMutex.RLock()
 	defer fake.listWorkersMutex.RUnlock()
 	fake.pruneWorkerMutex.RLock()
@@ -20,6 +20,22 @@ type FakeTeam struct {
 	aTCTeamReturnsOnCall map[int]struct {
 		result1 atc.Team
 	}
+	AddResourceVersionLabelStub        func(atc.PipelineRef, string, int, string) (bool, error)
+	addResourceVersionLabelMutex       sync.RWMutex
+	addResourceVersionLabelArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+		arg4 string
+	}
+	addResourceVersionLabelReturns struct {
+		result1 bool
+		result2 error
+	}
+	addResourceVersionLabelReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ArchivePipelineStub        func(atc.PipelineRef) (bool, error)
 	archivePipelineMutex       sync.RWMutex
 	archivePipelineArgsForCall []struct {
@@ -108,6 +124,18 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	CheckLimitsStub        func() (atc.TeamCheckLimits, error)
+	checkLimitsMutex       sync.RWMutex
+	checkLimitsArgsForCall []struct {
+	}
+	checkLimitsReturns struct {
+		result1 atc.TeamCheckLimits
+		result2 error
+	}
+	checkLimitsReturnsOnCall map[int]struct {
+		result1 atc.TeamCheckLimits
+		result2 error
+	}
 	CheckResourceStub        func(atc.PipelineRef, string, atc.Version) (atc.Build, bool, error)
 	checkResourceMutex       sync.RWMutex
 	checkResourceArgsForCall []struct {
@@ -142,6 +170,18 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	ClearSecretCacheStub        func(string, string) error
+	clearSecretCacheMutex       sync.RWMutex
+	clearSecretCacheArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	clearSecretCacheReturns struct {
+		result1 error
+	}
+	clearSecretCacheReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ClearTaskCacheStub        func(atc.PipelineRef, string, string, string) (int64, error)
 	clearTaskCacheMutex       sync.RWMutex
 	clearTaskCacheArgsForCall []struct {
@@ -266,6 +306,33 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	DeleteSecretStub        func(string, string) (bool, error)
+	deleteSecretMutex       sync.RWMutex
+	deleteSecretArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteSecretReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteSecretReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	DeleteWorkerKeyStub        func(string) (bool, error)
+	deleteWorkerKeyMutex       sync.RWMutex
+	deleteWorkerKeyArgsForCall []struct {
+		arg1 string
+	}
+	deleteWorkerKeyReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteWorkerKeyReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DestroyTeamStub        func(string) error
 	destroyTeamMutex       sync.RWMutex
 	destroyTeamArgsForCall []struct {
@@ -532,6 +599,21 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	PinResourceTypeVersionStub        func(atc.PipelineRef, string, atc.Version) (bool, error)
+	pinResourceTypeVersionMutex       sync.RWMutex
+	pinResourceTypeVersionArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 atc.Version
+	}
+	pinResourceTypeVersionReturns struct {
+		result1 bool
+		result2 error
+	}
+	pinResourceTypeVersionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	PinResourceVersionStub        func(atc.PipelineRef, string, int) (bool, error)
 	pinResourceVersionMutex       sync.RWMutex
 	pinResourceVersionArgsForCall []struct {
@@ -597,6 +679,36 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	PromoteResourceTypeCandidateStub        func(atc.PipelineRef, string) (bool, error)
+	promoteResourceTypeCandidateMutex       sync.RWMutex
+	promoteResourceTypeCandidateArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+	}
+	promoteResourceTypeCandidateReturns struct {
+		result1 bool
+		result2 error
+	}
+	promoteResourceTypeCandidateReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RemoveResourceVersionLabelStub        func(atc.PipelineRef, string, int, string) (bool, error)
+	removeResourceVersionLabelMutex       sync.RWMutex
+	removeResourceVersionLabelArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+		arg4 string
+	}
+	removeResourceVersionLabelReturns struct {
+		result1 bool
+		result2 error
+	}
+	removeResourceVersionLabelReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	RenamePipelineStub        func(string, string) (bool, []concourse.ConfigWarning, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
@@ -660,13 +772,13 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
-	ResourceVersionsStub        func(atc.PipelineRef, string, concourse.Page, atc.Version) ([]atc.ResourceVersion, concourse.Pagination, bool, error)
+	ResourceVersionsStub        func(atc.PipelineRef, string, concourse.Page, atc.ResourceVersionFilter) ([]atc.ResourceVersion, concourse.Pagination, bool, error)
 	resourceVersionsMutex       sync.RWMutex
 	resourceVersionsArgsForCall []struct {
 		arg1 atc.PipelineRef
 		arg2 string
 		arg3 concourse.Page
-		arg4 atc.Version
+		arg4 atc.ResourceVersionFilter
 	}
 	resourceVersionsReturns struct {
 		result1 []atc.ResourceVersion
@@ -694,6 +806,30 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SecretsStub        func(string) ([]atc.Secret, error)
+	secretsMutex       sync.RWMutex
+	secretsArgsForCall []struct {
+		arg1 string
+	}
+	secretsReturns struct {
+		result1 []atc.Secret
+		result2 error
+	}
+	secretsReturnsOnCall map[int]struct {
+		result1 []atc.Secret
+		result2 error
+	}
+	SetCheckLimitsStub        func(atc.TeamCheckLimits) error
+	setCheckLimitsMutex       sync.RWMutex
+	setCheckLimitsArgsForCall []struct {
+		arg1 atc.TeamCheckLimits
+	}
+	setCheckLimitsReturns struct {
+		result1 error
+	}
+	setCheckLimitsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetPinCommentStub        func(atc.PipelineRef, string, string) (bool, error)
 	setPinCommentMutex       sync.RWMutex
 	setPinCommentArgsForCall []struct {
@@ -709,6 +845,31 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SetSecretStub        func(string, string, atc.SetSecretRequest) error
+	setSecretMutex       sync.RWMutex
+	setSecretArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 atc.SetSecretRequest
+	}
+	setSecretReturns struct {
+		result1 error
+	}
+	setSecretReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetWorkerKeyStub        func(string, atc.SetWorkerKeyRequest) error
+	setWorkerKeyMutex       sync.RWMutex
+	setWorkerKeyArgsForCall []struct {
+		arg1 string
+		arg2 atc.SetWorkerKeyRequest
+	}
+	setWorkerKeyReturns struct {
+		result1 error
+	}
+	setWorkerKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UnpauseJobStub        func(atc.PipelineRef, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
@@ -750,6 +911,20 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	UnpinResourceTypeStub        func(atc.PipelineRef, string) (bool, error)
+	unpinResourceTypeMutex       sync.RWMutex
+	unpinResourceTypeArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+	}
+	unpinResourceTypeReturns struct {
+		result1 bool
+		result2 error
+	}
+	unpinResourceTypeReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	VersionedResourceTypesStub        func(atc.PipelineRef) (atc.VersionedResourceTypes, bool, error)
 	versionedResourceTypesMutex       sync.RWMutex
 	versionedResourceTypesArgsForCall []struct {
@@ -765,6 +940,18 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	WorkerKeysStub        func() ([]atc.WorkerKey, error)
+	workerKeysMutex       sync.RWMutex
+	workerKeysArgsForCall []struct {
+	}
+	workerKeysReturns struct {
+		result1 []atc.WorkerKey
+		result2 error
+	}
+	workerKeysReturnsOnCall map[int]struct {
+		result1 []atc.WorkerKey
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -821,6 +1008,72 @@ func (fake *FakeTeam) ATCTeamReturnsOnCall(i int, result1 atc.Team) {
 	}{result1}
 }
 
+func (fake *FakeTeam) AddResourceVersionLabel(arg1 atc.PipelineRef, arg2 string, arg3 int, arg4 string) (bool, error) {
+	fake.addResourceVersionLabelMutex.Lock()
+	ret, specificReturn := fake.addResourceVersionLabelReturnsOnCall[len(fake.addResourceVersionLabelArgsForCall)]
+	fake.addResourceVersionLabelArgsForCall = append(fake.addResourceVersionLabelArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("AddResourceVersionLabel", []interface{}{arg1, arg2, arg3, arg4})
+	fake.addResourceVersionLabelMutex.Unlock()
+	if fake.AddResourceVersionLabelStub != nil {
+		return fake.AddResourceVersionLabelStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.addResourceVersionLabelReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) AddResourceVersionLabelCallCount() int {
+	fake.addResourceVersionLabelMutex.RLock()
+	defer fake.addResourceVersionLabelMutex.RUnlock()
+	return len(fake.addResourceVersionLabelArgsForCall)
+}
+
+func (fake *FakeTeam) AddResourceVersionLabelCalls(stub func(atc.PipelineRef, string, int, string) (bool, error)) {
+	fake.addResourceVersionLabelMutex.Lock()
+	defer fake.addResourceVersionLabelMutex.Unlock()
+	fake.AddResourceVersionLabelStub = stub
+}
+
+func (fake *FakeTeam) AddResourceVersionLabelArgsForCall(i int) (atc.PipelineRef, string, int, string) {
+	fake.addResourceVersionLabelMutex.RLock()
+	defer fake.addResourceVersionLabelMutex.RUnlock()
+	argsForCall := fake.addResourceVersionLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) AddResourceVersionLabelReturns(result1 bool, result2 error) {
+	fake.addResourceVersionLabelMutex.Lock()
+	defer fake.addResourceVersionLabelMutex.Unlock()
+	fake.AddResourceVersionLabelStub = nil
+	fake.addResourceVersionLabelReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) AddResourceVersionLabelReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.addResourceVersionLabelMutex.Lock()
+	defer fake.addResourceVersionLabelMutex.Unlock()
+	fake.AddResourceVersionLabelStub = nil
+	if fake.addResourceVersionLabelReturnsOnCall == nil {
+		fake.addResourceVersionLabelReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.addResourceVersionLabelReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ArchivePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.archivePipelineMutex.Lock()
 	ret, specificReturn := fake.archivePipelineReturnsOnCall[len(fake.archivePipelineArgsForCall)]
@@ -1205,6 +1458,61 @@ func (fake *FakeTeam) BuildsWithVersionAsOutputReturnsOnCall(i int, result1 []at
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) CheckLimits() (atc.TeamCheckLimits, error) {
+	fake.checkLimitsMutex.Lock()
+	ret, specificReturn := fake.checkLimitsReturnsOnCall[len(fake.checkLimitsArgsForCall)]
+	fake.checkLimitsArgsForCall = append(fake.checkLimitsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CheckLimits", []interface{}{})
+	fake.checkLimitsMutex.Unlock()
+	if fake.CheckLimitsStub != nil {
+		return fake.CheckLimitsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.checkLimitsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CheckLimitsCallCount() int {
+	fake.checkLimitsMutex.RLock()
+	defer fake.checkLimitsMutex.RUnlock()
+	return len(fake.checkLimitsArgsForCall)
+}
+
+func (fake *FakeTeam) CheckLimitsCalls(stub func() (atc.TeamCheckLimits, error)) {
+	fake.checkLimitsMutex.Lock()
+	defer fake.checkLimitsMutex.Unlock()
+	fake.CheckLimitsStub = stub
+}
+
+func (fake *FakeTeam) CheckLimitsReturns(result1 atc.TeamCheckLimits, result2 error) {
+	fake.checkLimitsMutex.Lock()
+	defer fake.checkLimitsMutex.Unlock()
+	fake.CheckLimitsStub = nil
+	fake.checkLimitsReturns = struct {
+		result1 atc.TeamCheckLimits
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CheckLimitsReturnsOnCall(i int, result1 atc.TeamCheckLimits, result2 error) {
+	fake.checkLimitsMutex.Lock()
+	defer fake.checkLimitsMutex.Unlock()
+	fake.CheckLimitsStub = nil
+	if fake.checkLimitsReturnsOnCall == nil {
+		fake.checkLimitsReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamCheckLimits
+			result2 error
+		})
+	}
+	fake.checkLimitsReturnsOnCall[i] = struct {
+		result1 atc.TeamCheckLimits
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CheckResource(arg1 atc.PipelineRef, arg2 string, arg3 atc.Version) (atc.Build, bool, error) {
 	fake.checkResourceMutex.Lock()
 	ret, specificReturn := fake.checkResourceReturnsOnCall[len(fake.checkResourceArgsForCall)]
@@ -1341,6 +1649,67 @@ func (fake *FakeTeam) CheckResourceTypeReturnsOnCall(i int, result1 atc.Build, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) ClearSecretCache(arg1 string, arg2 string) error {
+	fake.clearSecretCacheMutex.Lock()
+	ret, specificReturn := fake.clearSecretCacheReturnsOnCall[len(fake.clearSecretCacheArgsForCall)]
+	fake.clearSecretCacheArgsForCall = append(fake.clearSecretCacheArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ClearSecretCache", []interface{}{arg1, arg2})
+	fake.clearSecretCacheMutex.Unlock()
+	if fake.ClearSecretCacheStub != nil {
+		return fake.ClearSecretCacheStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.clearSecretCacheReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) ClearSecretCacheCallCount() int {
+	fake.clearSecretCacheMutex.RLock()
+	defer fake.clearSecretCacheMutex.RUnlock()
+	return len(fake.clearSecretCacheArgsForCall)
+}
+
+func (fake *FakeTeam) ClearSecretCacheCalls(stub func(string, string) error) {
+	fake.clearSecretCacheMutex.Lock()
+	defer fake.clearSecretCacheMutex.Unlock()
+	fake.ClearSecretCacheStub = stub
+}
+
+func (fake *FakeTeam) ClearSecretCacheArgsForCall(i int) (string, string) {
+	fake.clearSecretCacheMutex.RLock()
+	defer fake.clearSecretCacheMutex.RUnlock()
+	argsForCall := fake.clearSecretCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) ClearSecretCacheReturns(result1 error) {
+	fake.clearSecretCacheMutex.Lock()
+	defer fake.clearSecretCacheMutex.Unlock()
+	fake.ClearSecretCacheStub = nil
+	fake.clearSecretCacheReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) ClearSecretCacheReturnsOnCall(i int, result1 error) {
+	fake.clearSecretCacheMutex.Lock()
+	defer fake.clearSecretCacheMutex.Unlock()
+	fake.ClearSecretCacheStub = nil
+	if fake.clearSecretCacheReturnsOnCall == nil {
+		fake.clearSecretCacheReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clearSecretCacheReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) ClearTaskCache(arg1 atc.PipelineRef, arg2 string, arg3 string, arg4 string) (int64, error) {
 	fake.clearTaskCacheMutex.Lock()
 	ret, specificReturn := fake.clearTaskCacheReturnsOnCall[len(fake.clearTaskCacheArgsForCall)]
@@ -1880,6 +2249,133 @@ func (fake *FakeTeam) DeletePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) DeleteSecret(arg1 string, arg2 string) (bool, error) {
+	fake.deleteSecretMutex.Lock()
+	ret, specificReturn := fake.deleteSecretReturnsOnCall[len(fake.deleteSecretArgsForCall)]
+	fake.deleteSecretArgsForCall = append(fake.deleteSecretArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteSecret", []interface{}{arg1, arg2})
+	fake.deleteSecretMutex.Unlock()
+	if fake.DeleteSecretStub != nil {
+		return fake.DeleteSecretStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteSecretReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DeleteSecretCallCount() int {
+	fake.deleteSecretMutex.RLock()
+	defer fake.deleteSecretMutex.RUnlock()
+	return len(fake.deleteSecretArgsForCall)
+}
+
+func (fake *FakeTeam) DeleteSecretCalls(stub func(string, string) (bool, error)) {
+	fake.deleteSecretMutex.Lock()
+	defer fake.deleteSecretMutex.Unlock()
+	fake.DeleteSecretStub = stub
+}
+
+func (fake *FakeTeam) DeleteSecretArgsForCall(i int) (string, string) {
+	fake.deleteSecretMutex.RLock()
+	defer fake.deleteSecretMutex.RUnlock()
+	argsForCall := fake.deleteSecretArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) DeleteSecretReturns(result1 bool, result2 error) {
+	fake.deleteSecretMutex.Lock()
+	defer fake.deleteSecretMutex.Unlock()
+	fake.DeleteSecretStub = nil
+	fake.deleteSecretReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeleteSecretReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteSecretMutex.Lock()
+	defer fake.deleteSecretMutex.Unlock()
+	fake.DeleteSecretStub = nil
+	if fake.deleteSecretReturnsOnCall == nil {
+		fake.deleteSecretReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteSecretReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeleteWorkerKey(arg1 string) (bool, error) {
+	fake.deleteWorkerKeyMutex.Lock()
+	ret, specificReturn := fake.deleteWorkerKeyReturnsOnCall[len(fake.deleteWorkerKeyArgsForCall)]
+	fake.deleteWorkerKeyArgsForCall = append(fake.deleteWorkerKeyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteWorkerKey", []interface{}{arg1})
+	fake.deleteWorkerKeyMutex.Unlock()
+	if fake.DeleteWorkerKeyStub != nil {
+		return fake.DeleteWorkerKeyStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteWorkerKeyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DeleteWorkerKeyCallCount() int {
+	fake.deleteWorkerKeyMutex.RLock()
+	defer fake.deleteWorkerKeyMutex.RUnlock()
+	return len(fake.deleteWorkerKeyArgsForCall)
+}
+
+func (fake *FakeTeam) DeleteWorkerKeyCalls(stub func(string) (bool, error)) {
+	fake.deleteWorkerKeyMutex.Lock()
+	defer fake.deleteWorkerKeyMutex.Unlock()
+	fake.DeleteWorkerKeyStub = stub
+}
+
+func (fake *FakeTeam) DeleteWorkerKeyArgsForCall(i int) string {
+	fake.deleteWorkerKeyMutex.RLock()
+	defer fake.deleteWorkerKeyMutex.RUnlock()
+	argsForCall := fake.deleteWorkerKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DeleteWorkerKeyReturns(result1 bool, result2 error) {
+	fake.deleteWorkerKeyMutex.Lock()
+	defer fake.deleteWorkerKeyMutex.Unlock()
+	fake.DeleteWorkerKeyStub = nil
+	fake.deleteWorkerKeyReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeleteWorkerKeyReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteWorkerKeyMutex.Lock()
+	defer fake.deleteWorkerKeyMutex.Unlock()
+	fake.DeleteWorkerKeyStub = nil
+	if fake.deleteWorkerKeyReturnsOnCall == nil {
+		fake.deleteWorkerKeyReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteWorkerKeyReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) DestroyTeam(arg1 string) error {
 	fake.destroyTeamMutex.Lock()
 	ret, specificReturn := fake.destroyTeamReturnsOnCall[len(fake.destroyTeamArgsForCall)]
@@ -3123,6 +3619,71 @@ func (fake *FakeTeam) PausePipelineReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) PinResourceTypeVersion(arg1 atc.PipelineRef, arg2 string, arg3 atc.Version) (bool, error) {
+	fake.pinResourceTypeVersionMutex.Lock()
+	ret, specificReturn := fake.pinResourceTypeVersionReturnsOnCall[len(fake.pinResourceTypeVersionArgsForCall)]
+	fake.pinResourceTypeVersionArgsForCall = append(fake.pinResourceTypeVersionArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 atc.Version
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("PinResourceTypeVersion", []interface{}{arg1, arg2, arg3})
+	fake.pinResourceTypeVersionMutex.Unlock()
+	if fake.PinResourceTypeVersionStub != nil {
+		return fake.PinResourceTypeVersionStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.pinResourceTypeVersionReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) PinResourceTypeVersionCallCount() int {
+	fake.pinResourceTypeVersionMutex.RLock()
+	defer fake.pinResourceTypeVersionMutex.RUnlock()
+	return len(fake.pinResourceTypeVersionArgsForCall)
+}
+
+func (fake *FakeTeam) PinResourceTypeVersionCalls(stub func(atc.PipelineRef, string, atc.Version) (bool, error)) {
+	fake.pinResourceTypeVersionMutex.Lock()
+	defer fake.pinResourceTypeVersionMutex.Unlock()
+	fake.PinResourceTypeVersionStub = stub
+}
+
+func (fake *FakeTeam) PinResourceTypeVersionArgsForCall(i int) (atc.PipelineRef, string, atc.Version) {
+	fake.pinResourceTypeVersionMutex.RLock()
+	defer fake.pinResourceTypeVersionMutex.RUnlock()
+	argsForCall := fake.pinResourceTypeVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) PinResourceTypeVersionReturns(result1 bool, result2 error) {
+	fake.pinResourceTypeVersionMutex.Lock()
+	defer fake.pinResourceTypeVersionMutex.Unlock()
+	fake.PinResourceTypeVersionStub = nil
+	fake.pinResourceTypeVersionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PinResourceTypeVersionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.pinResourceTypeVersionMutex.Lock()
+	defer fake.pinResourceTypeVersionMutex.Unlock()
+	fake.PinResourceTypeVersionStub = nil
+	if fake.pinResourceTypeVersionReturnsOnCall == nil {
+		fake.pinResourceTypeVersionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.pinResourceTypeVersionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) PinResourceVersion(arg1 atc.PipelineRef, arg2 string, arg3 int) (bool, error) {
 	fake.pinResourceVersionMutex.Lock()
 	ret, specificReturn := fake.pinResourceVersionReturnsOnCall[len(fake.pinResourceVersionArgsForCall)]
@@ -3393,6 +3954,136 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) PromoteResourceTypeCandidate(arg1 atc.PipelineRef, arg2 string) (bool, error) {
+	fake.promoteResourceTypeCandidateMutex.Lock()
+	ret, specificReturn := fake.promoteResourceTypeCandidateReturnsOnCall[len(fake.promoteResourceTypeCandidateArgsForCall)]
+	fake.promoteResourceTypeCandidateArgsForCall = append(fake.promoteResourceTypeCandidateArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("PromoteResourceTypeCandidate", []interface{}{arg1, arg2})
+	fake.promoteResourceTypeCandidateMutex.Unlock()
+	if fake.PromoteResourceTypeCandidateStub != nil {
+		return fake.PromoteResourceTypeCandidateStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.promoteResourceTypeCandidateReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) PromoteResourceTypeCandidateCallCount() int {
+	fake.promoteResourceTypeCandidateMutex.RLock()
+	defer fake.promoteResourceTypeCandidateMutex.RUnlock()
+	return len(fake.promoteResourceTypeCandidateArgsForCall)
+}
+
+func (fake *FakeTeam) PromoteResourceTypeCandidateCalls(stub func(atc.PipelineRef, string) (bool, error)) {
+	fake.promoteResourceTypeCandidateMutex.Lock()
+	defer fake.promoteResourceTypeCandidateMutex.Unlock()
+	fake.PromoteResourceTypeCandidateStub = stub
+}
+
+func (fake *FakeTeam) PromoteResourceTypeCandidateArgsForCall(i int) (atc.PipelineRef, string) {
+	fake.promoteResourceTypeCandidateMutex.RLock()
+	defer fake.promoteResourceTypeCandidateMutex.RUnlock()
+	argsForCall := fake.promoteResourceTypeCandidateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PromoteResourceTypeCandidateReturns(result1 bool, result2 error) {
+	fake.promoteResourceTypeCandidateMutex.Lock()
+	defer fake.promoteResourceTypeCandidateMutex.Unlock()
+	fake.PromoteResourceTypeCandidateStub = nil
+	fake.promoteResourceTypeCandidateReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) PromoteResourceTypeCandidateReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.promoteResourceTypeCandidateMutex.Lock()
+	defer fake.promoteResourceTypeCandidateMutex.Unlock()
+	fake.PromoteResourceTypeCandidateStub = nil
+	if fake.promoteResourceTypeCandidateReturnsOnCall == nil {
+		fake.promoteResourceTypeCandidateReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.promoteResourceTypeCandidateReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RemoveResourceVersionLabel(arg1 atc.PipelineRef, arg2 string, arg3 int, arg4 string) (bool, error) {
+	fake.removeResourceVersionLabelMutex.Lock()
+	ret, specificReturn := fake.removeResourceVersionLabelReturnsOnCall[len(fake.removeResourceVersionLabelArgsForCall)]
+	fake.removeResourceVersionLabelArgsForCall = append(fake.removeResourceVersionLabelArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 int
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RemoveResourceVersionLabel", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeResourceVersionLabelMutex.Unlock()
+	if fake.RemoveResourceVersionLabelStub != nil {
+		return fake.RemoveResourceVersionLabelStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeResourceVersionLabelReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RemoveResourceVersionLabelCallCount() int {
+	fake.removeResourceVersionLabelMutex.RLock()
+	defer fake.removeResourceVersionLabelMutex.RUnlock()
+	return len(fake.removeResourceVersionLabelArgsForCall)
+}
+
+func (fake *FakeTeam) RemoveResourceVersionLabelCalls(stub func(atc.PipelineRef, string, int, string) (bool, error)) {
+	fake.removeResourceVersionLabelMutex.Lock()
+	defer fake.removeResourceVersionLabelMutex.Unlock()
+	fake.RemoveResourceVersionLabelStub = stub
+}
+
+func (fake *FakeTeam) RemoveResourceVersionLabelArgsForCall(i int) (atc.PipelineRef, string, int, string) {
+	fake.removeResourceVersionLabelMutex.RLock()
+	defer fake.removeResourceVersionLabelMutex.RUnlock()
+	argsForCall := fake.removeResourceVersionLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTeam) RemoveResourceVersionLabelReturns(result1 bool, result2 error) {
+	fake.removeResourceVersionLabelMutex.Lock()
+	defer fake.removeResourceVersionLabelMutex.Unlock()
+	fake.RemoveResourceVersionLabelStub = nil
+	fake.removeResourceVersionLabelReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RemoveResourceVersionLabelReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.removeResourceVersionLabelMutex.Lock()
+	defer fake.removeResourceVersionLabelMutex.Unlock()
+	fake.RemoveResourceVersionLabelStub = nil
+	if fake.removeResourceVersionLabelReturnsOnCall == nil {
+		fake.removeResourceVersionLabelReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.removeResourceVersionLabelReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, []concourse.ConfigWarning, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
@@ -3659,14 +4350,14 @@ func (fake *FakeTeam) ResourceReturnsOnCall(i int, result1 atc.Resource, result2
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) ResourceVersions(arg1 atc.PipelineRef, arg2 string, arg3 concourse.Page, arg4 atc.Version) ([]atc.ResourceVersion, concourse.Pagination, bool, error) {
+func (fake *FakeTeam) ResourceVersions(arg1 atc.PipelineRef, arg2 string, arg3 concourse.Page, arg4 atc.ResourceVersionFilter) ([]atc.ResourceVersion, concourse.Pagination, bool, error) {
 	fake.resourceVersionsMutex.Lock()
 	ret, specificReturn := fake.resourceVersionsReturnsOnCall[len(fake.resourceVersionsArgsForCall)]
 	fake.resourceVersionsArgsForCall = append(fake.resourceVersionsArgsForCall, struct {
 		arg1 atc.PipelineRef
 		arg2 string
 		arg3 concourse.Page
-		arg4 atc.Version
+		arg4 atc.ResourceVersionFilter
 	}{arg1, arg2, arg3, arg4})
 	fake.recordInvocation("ResourceVersions", []interface{}{arg1, arg2, arg3, arg4})
 	fake.resourceVersionsMutex.Unlock()
@@ -3686,13 +4377,13 @@ func (fake *FakeTeam) ResourceVersionsCallCount() int {
 	return len(fake.resourceVersionsArgsForCall)
 }
 
-func (fake *FakeTeam) ResourceVersionsCalls(stub func(atc.PipelineRef, string, concourse.Page, atc.Version) ([]atc.ResourceVersion, concourse.Pagination, bool, error)) {
+func (fake *FakeTeam) ResourceVersionsCalls(stub func(atc.PipelineRef, string, concourse.Page, atc.ResourceVersionFilter) ([]atc.ResourceVersion, concourse.Pagination, bool, error)) {
 	fake.resourceVersionsMutex.Lock()
 	defer fake.resourceVersionsMutex.Unlock()
 	fake.ResourceVersionsStub = stub
 }
 
-func (fake *FakeTeam) ResourceVersionsArgsForCall(i int) (atc.PipelineRef, string, concourse.Page, atc.Version) {
+func (fake *FakeTeam) ResourceVersionsArgsForCall(i int) (atc.PipelineRef, string, concourse.Page, atc.ResourceVersionFilter) {
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
 	argsForCall := fake.resourceVersionsArgsForCall[i]
@@ -3795,6 +4486,129 @@ func (fake *FakeTeam) ScheduleJobReturnsOnCall(i int, result1 bool, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) Secrets(arg1 string) ([]atc.Secret, error) {
+	fake.secretsMutex.Lock()
+	ret, specificReturn := fake.secretsReturnsOnCall[len(fake.secretsArgsForCall)]
+	fake.secretsArgsForCall = append(fake.secretsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("Secrets", []interface{}{arg1})
+	fake.secretsMutex.Unlock()
+	if fake.SecretsStub != nil {
+		return fake.SecretsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.secretsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) SecretsCallCount() int {
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
+	return len(fake.secretsArgsForCall)
+}
+
+func (fake *FakeTeam) SecretsCalls(stub func(string) ([]atc.Secret, error)) {
+	fake.secretsMutex.Lock()
+	defer fake.secretsMutex.Unlock()
+	fake.SecretsStub = stub
+}
+
+func (fake *FakeTeam) SecretsArgsForCall(i int) string {
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
+	argsForCall := fake.secretsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SecretsReturns(result1 []atc.Secret, result2 error) {
+	fake.secretsMutex.Lock()
+	defer fake.secretsMutex.Unlock()
+	fake.SecretsStub = nil
+	fake.secretsReturns = struct {
+		result1 []atc.Secret
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SecretsReturnsOnCall(i int, result1 []atc.Secret, result2 error) {
+	fake.secretsMutex.Lock()
+	defer fake.secretsMutex.Unlock()
+	fake.SecretsStub = nil
+	if fake.secretsReturnsOnCall == nil {
+		fake.secretsReturnsOnCall = make(map[int]struct {
+			result1 []atc.Secret
+			result2 error
+		})
+	}
+	fake.secretsReturnsOnCall[i] = struct {
+		result1 []atc.Secret
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SetCheckLimits(arg1 atc.TeamCheckLimits) error {
+	fake.setCheckLimitsMutex.Lock()
+	ret, specificReturn := fake.setCheckLimitsReturnsOnCall[len(fake.setCheckLimitsArgsForCall)]
+	fake.setCheckLimitsArgsForCall = append(fake.setCheckLimitsArgsForCall, struct {
+		arg1 atc.TeamCheckLimits
+	}{arg1})
+	fake.recordInvocation("SetCheckLimits", []interface{}{arg1})
+	fake.setCheckLimitsMutex.Unlock()
+	if fake.SetCheckLimitsStub != nil {
+		return fake.SetCheckLimitsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setCheckLimitsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetCheckLimitsCallCount() int {
+	fake.setCheckLimitsMutex.RLock()
+	defer fake.setCheckLimitsMutex.RUnlock()
+	return len(fake.setCheckLimitsArgsForCall)
+}
+
+func (fake *FakeTeam) SetCheckLimitsCalls(stub func(atc.TeamCheckLimits) error) {
+	fake.setCheckLimitsMutex.Lock()
+	defer fake.setCheckLimitsMutex.Unlock()
+	fake.SetCheckLimitsStub = stub
+}
+
+func (fake *FakeTeam) SetCheckLimitsArgsForCall(i int) atc.TeamCheckLimits {
+	fake.setCheckLimitsMutex.RLock()
+	defer fake.setCheckLimitsMutex.RUnlock()
+	argsForCall := fake.setCheckLimitsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetCheckLimitsReturns(result1 error) {
+	fake.setCheckLimitsMutex.Lock()
+	defer fake.setCheckLimitsMutex.Unlock()
+	fake.SetCheckLimitsStub = nil
+	fake.setCheckLimitsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetCheckLimitsReturnsOnCall(i int, result1 error) {
+	fake.setCheckLimitsMutex.Lock()
+	defer fake.setCheckLimitsMutex.Unlock()
+	fake.SetCheckLimitsStub = nil
+	if fake.setCheckLimitsReturnsOnCall == nil {
+		fake.setCheckLimitsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setCheckLimitsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) SetPinComment(arg1 atc.PipelineRef, arg2 string, arg3 string) (bool, error) {
 	fake.setPinCommentMutex.Lock()
 	ret, specificReturn := fake.setPinCommentReturnsOnCall[len(fake.setPinCommentArgsForCall)]
@@ -3860,6 +4674,129 @@ func (fake *FakeTeam) SetPinCommentReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetSecret(arg1 string, arg2 string, arg3 atc.SetSecretRequest) error {
+	fake.setSecretMutex.Lock()
+	ret, specificReturn := fake.setSecretReturnsOnCall[len(fake.setSecretArgsForCall)]
+	fake.setSecretArgsForCall = append(fake.setSecretArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 atc.SetSecretRequest
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("SetSecret", []interface{}{arg1, arg2, arg3})
+	fake.setSecretMutex.Unlock()
+	if fake.SetSecretStub != nil {
+		return fake.SetSecretStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setSecretReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetSecretCallCount() int {
+	fake.setSecretMutex.RLock()
+	defer fake.setSecretMutex.RUnlock()
+	return len(fake.setSecretArgsForCall)
+}
+
+func (fake *FakeTeam) SetSecretCalls(stub func(string, string, atc.SetSecretRequest) error) {
+	fake.setSecretMutex.Lock()
+	defer fake.setSecretMutex.Unlock()
+	fake.SetSecretStub = stub
+}
+
+func (fake *FakeTeam) SetSecretArgsForCall(i int) (string, string, atc.SetSecretRequest) {
+	fake.setSecretMutex.RLock()
+	defer fake.setSecretMutex.RUnlock()
+	argsForCall := fake.setSecretArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) SetSecretReturns(result1 error) {
+	fake.setSecretMutex.Lock()
+	defer fake.setSecretMutex.Unlock()
+	fake.SetSecretStub = nil
+	fake.setSecretReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetSecretReturnsOnCall(i int, result1 error) {
+	fake.setSecretMutex.Lock()
+	defer fake.setSecretMutex.Unlock()
+	fake.SetSecretStub = nil
+	if fake.setSecretReturnsOnCall == nil {
+		fake.setSecretReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setSecretReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetWorkerKey(arg1 string, arg2 atc.SetWorkerKeyRequest) error {
+	fake.setWorkerKeyMutex.Lock()
+	ret, specificReturn := fake.setWorkerKeyReturnsOnCall[len(fake.setWorkerKeyArgsForCall)]
+	fake.setWorkerKeyArgsForCall = append(fake.setWorkerKeyArgsForCall, struct {
+		arg1 string
+		arg2 atc.SetWorkerKeyRequest
+	}{arg1, arg2})
+	fake.recordInvocation("SetWorkerKey", []interface{}{arg1, arg2})
+	fake.setWorkerKeyMutex.Unlock()
+	if fake.SetWorkerKeyStub != nil {
+		return fake.SetWorkerKeyStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setWorkerKeyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetWorkerKeyCallCount() int {
+	fake.setWorkerKeyMutex.RLock()
+	defer fake.setWorkerKeyMutex.RUnlock()
+	return len(fake.setWorkerKeyArgsForCall)
+}
+
+func (fake *FakeTeam) SetWorkerKeyCalls(stub func(string, atc.SetWorkerKeyRequest) error) {
+	fake.setWorkerKeyMutex.Lock()
+	defer fake.setWorkerKeyMutex.Unlock()
+	fake.SetWorkerKeyStub = stub
+}
+
+func (fake *FakeTeam) SetWorkerKeyArgsForCall(i int) (string, atc.SetWorkerKeyRequest) {
+	fake.setWorkerKeyMutex.RLock()
+	defer fake.setWorkerKeyMutex.RUnlock()
+	argsForCall := fake.setWorkerKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) SetWorkerKeyReturns(result1 error) {
+	fake.setWorkerKeyMutex.Lock()
+	defer fake.setWorkerKeyMutex.Unlock()
+	fake.SetWorkerKeyStub = nil
+	fake.setWorkerKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetWorkerKeyReturnsOnCall(i int, result1 error) {
+	fake.setWorkerKeyMutex.Lock()
+	defer fake.setWorkerKeyMutex.Unlock()
+	fake.SetWorkerKeyStub = nil
+	if fake.setWorkerKeyReturnsOnCall == nil {
+		fake.setWorkerKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setWorkerKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UnpauseJob(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
@@ -4051,6 +4988,70 @@ func (fake *FakeTeam) UnpinResourceReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) UnpinResourceType(arg1 atc.PipelineRef, arg2 string) (bool, error) {
+	fake.unpinResourceTypeMutex.Lock()
+	ret, specificReturn := fake.unpinResourceTypeReturnsOnCall[len(fake.unpinResourceTypeArgsForCall)]
+	fake.unpinResourceTypeArgsForCall = append(fake.unpinResourceTypeArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("UnpinResourceType", []interface{}{arg1, arg2})
+	fake.unpinResourceTypeMutex.Unlock()
+	if fake.UnpinResourceTypeStub != nil {
+		return fake.UnpinResourceTypeStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unpinResourceTypeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) UnpinResourceTypeCallCount() int {
+	fake.unpinResourceTypeMutex.RLock()
+	defer fake.unpinResourceTypeMutex.RUnlock()
+	return len(fake.unpinResourceTypeArgsForCall)
+}
+
+func (fake *FakeTeam) UnpinResourceTypeCalls(stub func(atc.PipelineRef, string) (bool, error)) {
+	fake.unpinResourceTypeMutex.Lock()
+	defer fake.unpinResourceTypeMutex.Unlock()
+	fake.UnpinResourceTypeStub = stub
+}
+
+func (fake *FakeTeam) UnpinResourceTypeArgsForCall(i int) (atc.PipelineRef, string) {
+	fake.unpinResourceTypeMutex.RLock()
+	defer fake.unpinResourceTypeMutex.RUnlock()
+	argsForCall := fake.unpinResourceTypeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) UnpinResourceTypeReturns(result1 bool, result2 error) {
+	fake.unpinResourceTypeMutex.Lock()
+	defer fake.unpinResourceTypeMutex.Unlock()
+	fake.UnpinResourceTypeStub = nil
+	fake.unpinResourceTypeReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) UnpinResourceTypeReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.unpinResourceTypeMutex.Lock()
+	defer fake.unpinResourceTypeMutex.Unlock()
+	fake.UnpinResourceTypeStub = nil
+	if fake.unpinResourceTypeReturnsOnCall == nil {
+		fake.unpinResourceTypeReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.unpinResourceTypeReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) VersionedResourceTypes(arg1 atc.PipelineRef) (atc.VersionedResourceTypes, bool, error) {
 	fake.versionedResourceTypesMutex.Lock()
 	ret, specificReturn := fake.versionedResourceTypesReturnsOnCall[len(fake.versionedResourceTypesArgsForCall)]
@@ -4117,11 +5118,68 @@ func (fake *FakeTeam) VersionedResourceTypesReturnsOnCall(i int, result1 atc.Ver
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) WorkerKeys() ([]atc.WorkerKey, error) {
+	fake.workerKeysMutex.Lock()
+	ret, specificReturn := fake.workerKeysReturnsOnCall[len(fake.workerKeysArgsForCall)]
+	fake.workerKeysArgsForCall = append(fake.workerKeysArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WorkerKeys", []interface{}{})
+	fake.workerKeysMutex.Unlock()
+	if fake.WorkerKeysStub != nil {
+		return fake.WorkerKeysStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.workerKeysReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) WorkerKeysCallCount() int {
+	fake.workerKeysMutex.RLock()
+	defer fake.workerKeysMutex.RUnlock()
+	return len(fake.workerKeysArgsForCall)
+}
+
+func (fake *FakeTeam) WorkerKeysCalls(stub func() ([]atc.WorkerKey, error)) {
+	fake.workerKeysMutex.Lock()
+	defer fake.workerKeysMutex.Unlock()
+	fake.WorkerKeysStub = stub
+}
+
+func (fake *FakeTeam) WorkerKeysReturns(result1 []atc.WorkerKey, result2 error) {
+	fake.workerKeysMutex.Lock()
+	defer fake.workerKeysMutex.Unlock()
+	fake.WorkerKeysStub = nil
+	fake.workerKeysReturns = struct {
+		result1 []atc.WorkerKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) WorkerKeysReturnsOnCall(i int, result1 []atc.WorkerKey, result2 error) {
+	fake.workerKeysMutex.Lock()
+	defer fake.workerKeysMutex.Unlock()
+	fake.WorkerKeysStub = nil
+	if fake.workerKeysReturnsOnCall == nil {
+		fake.workerKeysReturnsOnCall = make(map[int]struct {
+			result1 []atc.WorkerKey
+			result2 error
+		})
+	}
+	fake.workerKeysReturnsOnCall[i] = struct {
+		result1 []atc.WorkerKey
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.aTCTeamMutex.RLock()
 	defer fake.aTCTeamMutex.RUnlock()
+	fake.addResourceVersionLabelMutex.RLock()
+	defer fake.addResourceVersionLabelMutex.RUnlock()
 	fake.archivePipelineMutex.RLock()
 	defer fake.archivePipelineMutex.RUnlock()
 	fake.authMutex.RLock()
@@ -4134,10 +5192,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.buildsWithVersionAsInputMutex.RUnlock()
 	fake.buildsWithVersionAsOutputMutex.RLock()
 	defer fake.buildsWithVersionAsOutputMutex.RUnlock()
+	fake.checkLimitsMutex.RLock()
+	defer fake.checkLimitsMutex.RUnlock()
 	fake.checkResourceMutex.RLock()
 	defer fake.checkResourceMutex.RUnlock()
 	fake.checkResourceTypeMutex.RLock()
 	defer fake.checkResourceTypeMutex.RUnlock()
+	fake.clearSecretCacheMutex.RLock()
+	defer fake.clearSecretCacheMutex.RUnlock()
 	fake.clearTaskCacheMutex.RLock()
 	defer fake.clearTaskCacheMutex.RUnlock()
 	fake.createArtifactMutex.RLock()
@@ -4154,6 +5216,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createPipelineBuildMutex.RUnlock()
 	fake.deletePipelineMutex.RLock()
 	defer fake.deletePipelineMutex.RUnlock()
+	fake.deleteSecretMutex.RLock()
+	defer fake.deleteSecretMutex.RUnlock()
+	fake.deleteWorkerKeyMutex.RLock()
+	defer fake.deleteWorkerKeyMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
 	defer fake.destroyTeamMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
@@ -4194,6 +5260,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pauseJobMutex.RUnlock()
 	fake.pausePipelineMutex.RLock()
 	defer fake.pausePipelineMutex.RUnlock()
+	fake.pinResourceTypeVersionMutex.RLock()
+	defer fake.pinResourceTypeVersionMutex.RUnlock()
 	fake.pinResourceVersionMutex.RLock()
 	defer fake.pinResourceVersionMutex.RUnlock()
 	fake.pipelineMutex.RLock()
@@ -4202,6 +5270,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.promoteResourceTypeCandidateMutex.RLock()
+	defer fake.promoteResourceTypeCandidateMutex.RUnlock()
+	fake.removeResourceVersionLabelMutex.RLock()
+	defer fake.removeResourceVersionLabelMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
@@ -4214,16 +5286,28 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.resourceVersionsMutex.RUnlock()
 	fake.scheduleJobMutex.RLock()
 	defer fake.scheduleJobMutex.RUnlock()
+	fake.secretsMutex.RLock()
+	defer fake.secretsMutex.RUnlock()
+	fake.setCheckLimitsMutex.RLock()
+	defer fake.setCheckLimitsMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
+	fake.setSecretMutex.RLock()
+	defer fake.setSecretMutex.RUnlock()
+	fake.setWorkerKeyMutex.RLock()
+	defer fake.setWorkerKeyMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	fake.unpausePipelineMutex.RLock()
 	defer fake.unpausePipelineMutex.RUnlock()
 	fake.unpinResourceMutex.RLock()
 	defer fake.unpinResourceMutex.RUnlock()
+	fake.unpinResourceTypeMutex.RLock()
+	defer fake.unpinResourceTypeMutex.RUnlock()
 	fake.versionedResourceTypesMutex.RLock()
 	defer fake.versionedResourceTypesMutex.RUnlock()
+	fake.workerKeysMutex.RLock()
+	defer fake.workerKeysMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,64 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) ListMaintenanceWindows() ([]atc.MaintenanceWindow, error) {
+	var windows []atc.MaintenanceWindow
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListMaintenanceWindows,
+	}, &internal.Response{
+		Result: &windows,
+	})
+
+	return windows, err
+}
+
+func (client *client) CreateMaintenanceWindow(window atc.MaintenanceWindow) (atc.MaintenanceWindow, error) {
+	jsonBytes, err := json.Marshal(window)
+	if err != nil {
+		return atc.MaintenanceWindow{}, err
+	}
+
+	var created atc.MaintenanceWindow
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.CreateMaintenanceWindow,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &created,
+	})
+
+	if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+		if unexpectedResponseError.StatusCode == http.StatusBadRequest {
+			return atc.MaintenanceWindow{}, errors.New(unexpectedResponseError.Body)
+		}
+	}
+
+	return created, err
+}
+
+func (client *client) DeleteMaintenanceWindow(id int) (bool, error) {
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.DeleteMaintenanceWindow,
+		Params:      rata.Params{"window_id": strconv.Itoa(id)},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,125 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Maintenance Windows", func() {
+	Describe("ListMaintenanceWindows", func() {
+		var expectedWindows []atc.MaintenanceWindow
+
+		BeforeEach(func() {
+			expectedWindows = []atc.MaintenanceWindow{
+				{ID: 1, Worker: "some-worker", StartsAt: 100, EndsAt: 200, State: atc.WorkerMaintenanceScheduled},
+				{ID: 2, Tag: "some-tag", StartsAt: 100, EndsAt: 200, State: atc.WorkerMaintenanceInProgress},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/maintenance-windows"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedWindows),
+				),
+			)
+		})
+
+		It("returns the windows", func() {
+			windows, err := client.ListMaintenanceWindows()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(windows).To(Equal(expectedWindows))
+		})
+	})
+
+	Describe("CreateMaintenanceWindow", func() {
+		var window atc.MaintenanceWindow
+
+		BeforeEach(func() {
+			window = atc.MaintenanceWindow{
+				Worker:        "some-worker",
+				DrainStartsAt: 50,
+				StartsAt:      100,
+				EndsAt:        200,
+			}
+		})
+
+		Context("when the window is created", func() {
+			BeforeEach(func() {
+				created := window
+				created.ID = 42
+				created.State = atc.WorkerMaintenanceScheduled
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/maintenance-windows"),
+						ghttp.VerifyJSONRepresenting(window),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, created),
+					),
+				)
+			})
+
+			It("returns the created window", func() {
+				created, err := client.CreateMaintenanceWindow(window)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created.ID).To(Equal(42))
+				Expect(created.State).To(Equal(atc.WorkerMaintenanceScheduled))
+			})
+		})
+
+		Context("when the window is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/maintenance-windows"),
+						ghttp.RespondWith(http.StatusBadRequest, "maintenance window has already ended"),
+					),
+				)
+			})
+
+			It("returns the reason", func() {
+				_, err := client.CreateMaintenanceWindow(window)
+				Expect(err).To(MatchError("maintenance window has already ended"))
+			})
+		})
+	})
+
+	Describe("DeleteMaintenanceWindow", func() {
+		Context("when the window exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/maintenance-windows/42"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("returns true", func() {
+				deleted, err := client.DeleteMaintenanceWindow(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted).To(BeTrue())
+			})
+		})
+
+		Context("when the window does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/maintenance-windows/42"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				deleted, err := client.DeleteMaintenanceWindow(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) PinResourceTypeVersion(pipelineRef atc.PipelineRef, resourceTypeName string, version atc.Version) (bool, error) {
+	params := rata.Params{
+		"pipeline_name":      pipelineRef.Name,
+		"resource_type_name": resourceTypeName,
+		"team_name":          team.Name(),
+	}
+
+	jsonBytes, err := json.Marshal(atc.PinResourceTypeRequestBody{Version: version})
+	if err != nil {
+		return false, err
+	}
+
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.PinResourceTypeVersion,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+
+	return resourceTypePinResult(err)
+}
+
+func (team *team) UnpinResourceType(pipelineRef atc.PipelineRef, resourceTypeName string) (bool, error) {
+	params := rata.Params{
+		"pipeline_name":      pipelineRef.Name,
+		"resource_type_name": resourceTypeName,
+		"team_name":          team.Name(),
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.UnpinResourceType,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, nil)
+
+	return resourceTypePinResult(err)
+}
+
+func (team *team) PromoteResourceTypeCandidate(pipelineRef atc.PipelineRef, resourceTypeName string) (bool, error) {
+	params := rata.Params{
+		"pipeline_name":      pipelineRef.Name,
+		"resource_type_name": resourceTypeName,
+		"team_name":          team.Name(),
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.PromoteResourceTypeCandidate,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, nil)
+
+	return resourceTypePinResult(err)
+}
+
+func resourceTypePinResult(err error) (bool, error) {
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
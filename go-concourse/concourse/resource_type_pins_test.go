@@ -0,0 +1,144 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Resource Type Pins", func() {
+	var (
+		expectedStatus int
+		expectedQuery  = "vars.branch=%22master%22"
+		pipelineRef    = atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+	)
+
+	Describe("PinResourceTypeVersion", func() {
+		var (
+			pinned bool
+			err    error
+		)
+
+		BeforeEach(func() {
+			expectedStatus = http.StatusOK
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/pipelines/mypipeline/resource-types/mytype/pin", expectedQuery),
+					ghttp.VerifyJSON(`{"version":{"tag":"1.2.3"}}`),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+
+			pinned, err = team.PinResourceTypeVersion(pipelineRef, "mytype", atc.Version{"tag": "1.2.3"})
+		})
+
+		It("pins the version", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pinned).To(BeTrue())
+		})
+
+		Context("when the resource type or version does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pinned).To(BeFalse())
+			})
+		})
+
+		Context("when the request fails", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusInternalServerError
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(pinned).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("UnpinResourceType", func() {
+		var (
+			unpinned bool
+			err      error
+		)
+
+		BeforeEach(func() {
+			expectedStatus = http.StatusOK
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/pipelines/mypipeline/resource-types/mytype/unpin", expectedQuery),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+
+			unpinned, err = team.UnpinResourceType(pipelineRef, "mytype")
+		})
+
+		It("unpins the resource type", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unpinned).To(BeTrue())
+		})
+
+		Context("when the resource type does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(unpinned).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("PromoteResourceTypeCandidate", func() {
+		var (
+			promoted bool
+			err      error
+		)
+
+		BeforeEach(func() {
+			expectedStatus = http.StatusOK
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/pipelines/mypipeline/resource-types/mytype/promote", expectedQuery),
+					ghttp.RespondWith(expectedStatus, nil),
+				),
+			)
+
+			promoted, err = team.PromoteResourceTypeCandidate(pipelineRef, "mytype")
+		})
+
+		It("promotes the candidate", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(promoted).To(BeTrue())
+		})
+
+		Context("when there is no candidate", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(promoted).To(BeFalse())
+			})
+		})
+	})
+})
@@ -12,7 +12,7 @@ import (
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) ResourceVersions(pipelineRef atc.PipelineRef, resourceName string, page Page, filter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error) {
+func (team *team) ResourceVersions(pipelineRef atc.PipelineRef, resourceName string, page Page, filter atc.ResourceVersionFilter) ([]atc.ResourceVersion, Pagination, bool, error) {
 	params := rata.Params{
 		"pipeline_name": pipelineRef.Name,
 		"resource_name": resourceName,
@@ -23,8 +23,24 @@ func (team *team) ResourceVersions(pipelineRef atc.PipelineRef, resourceName str
 	headers := http.Header{}
 
 	queryParams := page.QueryParams()
-	for k, v := range filter {
-		queryParams.Add("filter", fmt.Sprintf("%s:%s", k, v))
+	for k, v := range filter.Version {
+		queryParams.Add(atc.ResourceVersionQueryFilter, fmt.Sprintf("%s:%s", k, v))
+	}
+
+	for _, metadataFilter := range filter.Metadata {
+		queryParams.Add(atc.ResourceVersionQueryMetadata, metadataFilter.String())
+	}
+
+	for _, label := range filter.Labels {
+		queryParams.Add(atc.ResourceVersionQueryLabel, label)
+	}
+
+	if !filter.Since.IsZero() {
+		queryParams.Add(atc.ResourceVersionQuerySince, strconv.FormatInt(filter.Since.Unix(), 10))
+	}
+
+	if !filter.Until.IsZero() {
+		queryParams.Add(atc.ResourceVersionQueryUntil, strconv.FormatInt(filter.Until.Unix(), 10))
 	}
 
 	err := team.connection.Send(internal.Request{
@@ -58,6 +74,14 @@ func (team *team) EnableResourceVersion(pipelineRef atc.PipelineRef, resourceNam
 	return team.sendResourceVersion(pipelineRef, resourceName, resourceVersionID, atc.EnableResourceVersion)
 }
 
+func (team *team) AddResourceVersionLabel(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, label string) (bool, error) {
+	return team.sendResourceVersionLabel(pipelineRef, resourceName, resourceVersionID, label, atc.AddResourceVersionLabel)
+}
+
+func (team *team) RemoveResourceVersionLabel(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, label string) (bool, error) {
+	return team.sendResourceVersionLabel(pipelineRef, resourceName, resourceVersionID, label, atc.RemoveResourceVersionLabel)
+}
+
 func (team *team) PinResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) (bool, error) {
 	return team.sendResourceVersion(pipelineRef, resourceName, resourceVersionID, atc.PinResourceVersion)
 }
@@ -146,3 +170,28 @@ func (team *team) sendResourceVersion(pipelineRef atc.PipelineRef, resourceName
 		return false, err
 	}
 }
+
+func (team *team) sendResourceVersionLabel(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, label string, resourceVersionReq string) (bool, error) {
+	params := rata.Params{
+		"pipeline_name":              pipelineRef.Name,
+		"resource_name":              resourceName,
+		"resource_config_version_id": strconv.Itoa(resourceVersionID),
+		"label":                      label,
+		"team_name":                  team.Name(),
+	}
+
+	err := team.connection.Send(internal.Request{
+		RequestName: resourceVersionReq,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
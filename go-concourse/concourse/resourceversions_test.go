@@ -25,7 +25,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 		)
 
 		var page concourse.Page
-		var filter atc.Version
+		var filter atc.ResourceVersionFilter
 
 		var versions []atc.ResourceVersion
 		var pagination concourse.Pagination
@@ -34,7 +34,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 		BeforeEach(func() {
 			page = concourse.Page{}
-			filter = atc.Version{}
+			filter = atc.ResourceVersionFilter{}
 			expectedQuery = []string{"vars.branch=%22master%22"}
 
 			expectedVersions = []atc.ResourceVersion{
@@ -135,7 +135,7 @@ var _ = Describe("ATC Handler Resource Versions", func() {
 
 		Context("when filter is specified", func() {
 			BeforeEach(func() {
-				filter = atc.Version{"some": "value"}
+				filter = atc.ResourceVersionFilter{Version: atc.Version{"some": "value"}}
 				expectedQuery = append(expectedQuery, "filter=some:value")
 			})
 
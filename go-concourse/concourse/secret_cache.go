@@ -0,0 +1,42 @@
+package concourse
+
+import (
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// ClearSecretCache makes every web node evict the credentials it has cached
+// for any team, limited to those under the path prefix if one is given.
+func (client *client) ClearSecretCache(pathPrefix string) error {
+	return client.connection.Send(internal.Request{
+		RequestName: atc.ClearSecretCache,
+		Query:       secretCacheQuery("", pathPrefix),
+	}, nil)
+}
+
+// ClearSecretCache makes every web node evict the credentials it has cached
+// for the team. A pipeline name limits them to those looked up only for the
+// pipeline.
+func (team *team) ClearSecretCache(pipelineName string, pathPrefix string) error {
+	return team.connection.Send(internal.Request{
+		RequestName: atc.ClearTeamSecretCache,
+		Params:      rata.Params{"team_name": team.Name()},
+		Query:       secretCacheQuery(pipelineName, pathPrefix),
+	}, nil)
+}
+
+func secretCacheQuery(pipelineName string, pathPrefix string) url.Values {
+	query := url.Values{}
+	if pipelineName != "" {
+		query.Set(atc.ClearSecretCacheQueryPipeline, pipelineName)
+	}
+
+	if pathPrefix != "" {
+		query.Set(atc.ClearSecretCacheQueryPathPrefix, pathPrefix)
+	}
+
+	return query
+}
@@ -0,0 +1,58 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Secret Cache", func() {
+	Describe("Client.ClearSecretCache", func() {
+		Context("when the eviction is requested", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/secrets-cache", "path_prefix=%2Fconcourse%2Fshared%2F"),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("succeeds", func() {
+				Expect(client.ClearSecretCache("/concourse/shared/")).To(Succeed())
+			})
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/secrets-cache", ""),
+						ghttp.RespondWith(http.StatusForbidden, nil),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				Expect(client.ClearSecretCache("")).ToNot(Succeed())
+			})
+		})
+	})
+
+	Describe("Team.ClearSecretCache", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/secrets-cache", "path_prefix=%2Fconcourse%2F&pipeline=some-pipeline"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("succeeds", func() {
+			Expect(team.ClearSecretCache("some-pipeline", "/concourse/")).To(Succeed())
+		})
+	})
+})
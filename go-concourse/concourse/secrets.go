@@ -0,0 +1,83 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// Secrets returns the team's secrets in the built-in credential manager,
+// without their values. A pipeline name limits them to the pipeline's.
+func (team *team) Secrets(pipelineName string) ([]atc.Secret, error) {
+	var secrets []atc.Secret
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListTeamSecrets,
+		Params:      rata.Params{"team_name": team.Name()},
+		Query:       secretQuery(pipelineName),
+	}, &internal.Response{
+		Result: &secrets,
+	})
+
+	return secrets, err
+}
+
+// SetSecret creates or replaces a secret. An empty pipeline name makes it
+// available to every pipeline of the team.
+func (team *team) SetSecret(pipelineName string, name string, request atc.SetSecretRequest) error {
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.SetTeamSecret,
+		Params: rata.Params{
+			"team_name":   team.Name(),
+			"secret_name": name,
+		},
+		Query:  secretQuery(pipelineName),
+		Body:   bytes.NewBuffer(jsonBytes),
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+
+	if e, ok := err.(internal.UnexpectedResponseError); ok && e.StatusCode == http.StatusBadRequest {
+		return errors.New(e.Body)
+	}
+
+	return err
+}
+
+func (team *team) DeleteSecret(pipelineName string, name string) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DeleteTeamSecret,
+		Params: rata.Params{
+			"team_name":   team.Name(),
+			"secret_name": name,
+		},
+		Query: secretQuery(pipelineName),
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func secretQuery(pipelineName string) url.Values {
+	query := url.Values{}
+	if pipelineName != "" {
+		query.Set("pipeline", pipelineName)
+	}
+
+	return query
+}
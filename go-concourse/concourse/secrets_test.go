@@ -0,0 +1,112 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Secrets", func() {
+	Describe("Secrets", func() {
+		var expectedSecrets []atc.Secret
+
+		BeforeEach(func() {
+			expectedSecrets = []atc.Secret{
+				{TeamName: "some-team", PipelineName: "some-pipeline", Name: "some-secret", CreatedAt: 100, UpdatedAt: 200, UpdatedBy: "some-user"},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/secrets", "pipeline=some-pipeline"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedSecrets),
+				),
+			)
+		})
+
+		It("returns the secrets", func() {
+			secrets, err := team.Secrets("some-pipeline")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secrets).To(Equal(expectedSecrets))
+		})
+	})
+
+	Describe("SetSecret", func() {
+		var request atc.SetSecretRequest
+
+		BeforeEach(func() {
+			request = atc.SetSecretRequest{Value: "some-value"}
+		})
+
+		Context("when the secret is set", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/secrets/some-secret", ""),
+						ghttp.VerifyJSONRepresenting(request),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("succeeds", func() {
+				Expect(team.SetSecret("", "some-secret", request)).To(Succeed())
+			})
+		})
+
+		Context("when the secret is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/secrets/some-secret", "pipeline=some%2Fpipeline"),
+						ghttp.RespondWith(http.StatusBadRequest, "pipeline name cannot contain '/'"),
+					),
+				)
+			})
+
+			It("returns the reason", func() {
+				err := team.SetSecret("some/pipeline", "some-secret", request)
+				Expect(err).To(MatchError("pipeline name cannot contain '/'"))
+			})
+		})
+	})
+
+	Describe("DeleteSecret", func() {
+		Context("when the secret exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/secrets/some-secret", "pipeline=some-pipeline"),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("deletes it", func() {
+				deleted, err := team.DeleteSecret("some-pipeline", "some-secret")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted).To(BeTrue())
+			})
+		})
+
+		Context("when the secret does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/secrets/some-secret"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				deleted, err := team.DeleteSecret("", "some-secret")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted).To(BeFalse())
+			})
+		})
+	})
+})
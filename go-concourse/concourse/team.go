@@ -19,6 +19,19 @@ type Team interface {
 	RenameTeam(teamName, name string) (bool, []ConfigWarning, error)
 	DestroyTeam(teamName string) error
 
+	CheckLimits() (atc.TeamCheckLimits, error)
+	SetCheckLimits(limits atc.TeamCheckLimits) error
+
+	WorkerKeys() ([]atc.WorkerKey, error)
+	SetWorkerKey(name string, request atc.SetWorkerKeyRequest) error
+	DeleteWorkerKey(name string) (bool, error)
+
+	Secrets(pipelineName string) ([]atc.Secret, error)
+	SetSecret(pipelineName string, name string, request atc.SetSecretRequest) error
+	DeleteSecret(pipelineName string, name string) (bool, error)
+
+	ClearSecretCache(pipelineName string, pathPrefix string) error
+
 	Pipeline(pipelineRef atc.PipelineRef) (atc.Pipeline, bool, error)
 	PipelineBuilds(pipelineRef atc.PipelineRef, page Page) ([]atc.Build, Pagination, bool, error)
 	DeletePipeline(pipelineRef atc.PipelineRef) (bool, error)
@@ -52,16 +65,22 @@ type Team interface {
 	Resource(pipelineRef atc.PipelineRef, resourceName string) (atc.Resource, bool, error)
 	ListResources(pipelineRef atc.PipelineRef) ([]atc.Resource, error)
 	VersionedResourceTypes(pipelineRef atc.PipelineRef) (atc.VersionedResourceTypes, bool, error)
-	ResourceVersions(pipelineRef atc.PipelineRef, resourceName string, page Page, filter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error)
+	ResourceVersions(pipelineRef atc.PipelineRef, resourceName string, page Page, filter atc.ResourceVersionFilter) ([]atc.ResourceVersion, Pagination, bool, error)
 	CheckResource(pipelineRef atc.PipelineRef, resourceName string, version atc.Version) (atc.Build, bool, error)
 	CheckResourceType(pipelineRef atc.PipelineRef, resourceTypeName string, version atc.Version) (atc.Build, bool, error)
 	DisableResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) (bool, error)
 	EnableResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) (bool, error)
+	AddResourceVersionLabel(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, label string) (bool, error)
+	RemoveResourceVersionLabel(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int, label string) (bool, error)
 
 	PinResourceVersion(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) (bool, error)
 	UnpinResource(pipelineRef atc.PipelineRef, resourceName string) (bool, error)
 	SetPinComment(pipelineRef atc.PipelineRef, resourceName string, comment string) (bool, error)
 
+	PinResourceTypeVersion(pipelineRef atc.PipelineRef, resourceTypeName string, version atc.Version) (bool, error)
+	UnpinResourceType(pipelineRef atc.PipelineRef, resourceTypeName string) (bool, error)
+	PromoteResourceTypeCandidate(pipelineRef atc.PipelineRef, resourceTypeName string) (bool, error)
+
 	BuildsWithVersionAsInput(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) ([]atc.Build, bool, error)
 	BuildsWithVersionAsOutput(pipelineRef atc.PipelineRef, resourceName string, resourceVersionID int) ([]atc.Build, bool, error)
 
@@ -107,6 +107,34 @@ func (team *team) RenameTeam(teamName, name string) (bool, []ConfigWarning, erro
 	}
 }
 
+// CheckLimits returns the overrides of the check limits for the team.
+func (team *team) CheckLimits() (atc.TeamCheckLimits, error) {
+	var limits atc.TeamCheckLimits
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetTeamCheckLimits,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &limits,
+	})
+
+	return limits, err
+}
+
+// SetCheckLimits replaces the overrides of the check limits for the team.
+func (team *team) SetCheckLimits(limits atc.TeamCheckLimits) error {
+	jsonBytes, err := json.Marshal(limits)
+	if err != nil {
+		return err
+	}
+
+	return team.connection.Send(internal.Request{
+		RequestName: atc.SetTeamCheckLimits,
+		Params:      rata.Params{"team_name": team.Name()},
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+}
+
 func (client *client) ListTeams() ([]atc.Team, error) {
 	var teams []atc.Team
 	err := client.connection.Send(internal.Request{
@@ -252,6 +252,88 @@ var _ = Describe("ATC Handler Teams", func() {
 		})
 	})
 
+	Describe("CheckLimits", func() {
+		BeforeEach(func() {
+			team = client.Team("some-team")
+		})
+
+		Context("when the server returns the check limits", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/check-limits"),
+						ghttp.RespondWith(http.StatusOK, `{"checks_per_second":2.5}`),
+					),
+				)
+			})
+
+			It("returns the check limits", func() {
+				limits, err := team.CheckLimits()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*limits.ChecksPerSecond).To(Equal(2.5))
+				Expect(limits.MaxCheckContainers).To(BeNil())
+			})
+		})
+
+		Context("when the server is not permitting it", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/check-limits"),
+						ghttp.RespondWith(http.StatusForbidden, nil),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := team.CheckLimits()
+				Expect(err).To(Equal(concourse.ErrForbidden))
+			})
+		})
+	})
+
+	Describe("SetCheckLimits", func() {
+		var maxCheckContainers int
+
+		BeforeEach(func() {
+			team = client.Team("some-team")
+			maxCheckContainers = 5
+		})
+
+		Context("when the server sets the check limits", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/check-limits"),
+						ghttp.VerifyJSON(`{"max_check_containers":5}`),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("succeeds", func() {
+				err := team.SetCheckLimits(atc.TeamCheckLimits{MaxCheckContainers: &maxCheckContainers})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the server blows up", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/check-limits"),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				err := team.SetCheckLimits(atc.TeamCheckLimits{MaxCheckContainers: &maxCheckContainers})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("ListTeams", func() {
 		var expectedTeams []atc.Team
 
@@ -0,0 +1,77 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// WorkerKeys returns the keys with which the team's workers can register.
+func (team *team) WorkerKeys() ([]atc.WorkerKey, error) {
+	var keys []atc.WorkerKey
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListTeamWorkerKeys,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &keys,
+	})
+
+	return keys, err
+}
+
+// SetWorkerKey creates or replaces the team's worker key with the given name.
+func (team *team) SetWorkerKey(name string, request atc.SetWorkerKeyRequest) error {
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.SetTeamWorkerKey,
+		Params: rata.Params{
+			"team_name":       team.Name(),
+			"worker_key_name": name,
+		},
+		Body:   bytes.NewBuffer(jsonBytes),
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+
+	switch e := err.(type) {
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusConflict {
+			return errors.New(e.Body)
+		}
+	case internal.ResourceNotFoundError:
+		if request.Replaces != "" {
+			return fmt.Errorf("worker key '%s' not found", request.Replaces)
+		}
+	}
+
+	return err
+}
+
+// DeleteWorkerKey removes the team's worker key with the given name.
+func (team *team) DeleteWorkerKey(name string) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DeleteTeamWorkerKey,
+		Params: rata.Params{
+			"team_name":       team.Name(),
+			"worker_key_name": name,
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,148 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Worker Keys", func() {
+	Describe("WorkerKeys", func() {
+		var expectedKeys []atc.WorkerKey
+
+		BeforeEach(func() {
+			expectedKeys = []atc.WorkerKey{
+				{TeamName: "some-team", Name: "some-key", PublicKey: "ssh-ed25519 AAAA", Fingerprint: "SHA256:abc", CreatedAt: 100, LastUsedAt: 200},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/worker-keys"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedKeys),
+				),
+			)
+		})
+
+		It("returns the keys", func() {
+			keys, err := team.WorkerKeys()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(keys).To(Equal(expectedKeys))
+		})
+	})
+
+	Describe("SetWorkerKey", func() {
+		var request atc.SetWorkerKeyRequest
+
+		BeforeEach(func() {
+			request = atc.SetWorkerKeyRequest{
+				PublicKey:            "ssh-ed25519 AAAA",
+				Replaces:             "some-old-key",
+				ReplacedKeyExpiresAt: 100,
+			}
+		})
+
+		Context("when the key is set", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/worker-keys/some-key"),
+						ghttp.VerifyJSONRepresenting(request),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("succeeds", func() {
+				Expect(team.SetWorkerKey("some-key", request)).To(Succeed())
+			})
+		})
+
+		Context("when the key is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/worker-keys/some-key"),
+						ghttp.RespondWith(http.StatusBadRequest, "invalid public key: ssh: no key found"),
+					),
+				)
+			})
+
+			It("returns the reason", func() {
+				err := team.SetWorkerKey("some-key", request)
+				Expect(err).To(MatchError("invalid public key: ssh: no key found"))
+			})
+		})
+
+		Context("when the key is in use", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/worker-keys/some-key"),
+						ghttp.RespondWith(http.StatusConflict, "public key is already in use by another worker key"),
+					),
+				)
+			})
+
+			It("returns the reason", func() {
+				err := team.SetWorkerKey("some-key", request)
+				Expect(err).To(MatchError("public key is already in use by another worker key"))
+			})
+		})
+
+		Context("when the replaced key does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/worker-keys/some-key"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("names the replaced key", func() {
+				err := team.SetWorkerKey("some-key", request)
+				Expect(err).To(MatchError("worker key 'some-old-key' not found"))
+			})
+		})
+	})
+
+	Describe("DeleteWorkerKey", func() {
+		Context("when the key exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/worker-keys/some-key"),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("deletes it", func() {
+				deleted, err := team.DeleteWorkerKey("some-key")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted).To(BeTrue())
+			})
+		})
+
+		Context("when the key does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/worker-keys/some-key"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				deleted, err := team.DeleteWorkerKey("some-key")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted).To(BeFalse())
+			})
+		})
+	})
+})
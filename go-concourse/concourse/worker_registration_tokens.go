@@ -0,0 +1,66 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) ListWorkerRegistrationTokens() ([]atc.WorkerRegistrationToken, error) {
+	var tokens []atc.WorkerRegistrationToken
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListWorkerRegistrationTokens,
+	}, &internal.Response{
+		Result: &tokens,
+	})
+
+	return tokens, err
+}
+
+// CreateWorkerRegistrationToken returns the created token along with its
+// secret, which cannot be retrieved again afterwards.
+func (client *client) CreateWorkerRegistrationToken(token atc.WorkerRegistrationToken) (atc.WorkerRegistrationToken, error) {
+	jsonBytes, err := json.Marshal(token)
+	if err != nil {
+		return atc.WorkerRegistrationToken{}, err
+	}
+
+	var created atc.WorkerRegistrationToken
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.CreateWorkerRegistrationToken,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &created,
+	})
+
+	if unexpectedResponseError, ok := err.(internal.UnexpectedResponseError); ok {
+		if unexpectedResponseError.StatusCode == http.StatusBadRequest {
+			return atc.WorkerRegistrationToken{}, errors.New(unexpectedResponseError.Body)
+		}
+	}
+
+	return created, err
+}
+
+func (client *client) DeleteWorkerRegistrationToken(id int) (bool, error) {
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.DeleteWorkerRegistrationToken,
+		Params:      rata.Params{"worker_registration_token_id": strconv.Itoa(id)},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
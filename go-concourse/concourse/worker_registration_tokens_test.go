@@ -0,0 +1,125 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Worker Registration Tokens", func() {
+	Describe("ListWorkerRegistrationTokens", func() {
+		var expectedTokens []atc.WorkerRegistrationToken
+
+		BeforeEach(func() {
+			expectedTokens = []atc.WorkerRegistrationToken{
+				{ID: 1, TeamName: "some-team", CreatedAt: 100, ExpiresAt: 200, MaxUses: 1},
+				{ID: 2, Tags: []string{"some-tag"}, CreatedAt: 100, ExpiresAt: 200, Uses: 3},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/worker-registration-tokens"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedTokens),
+				),
+			)
+		})
+
+		It("returns the tokens", func() {
+			tokens, err := client.ListWorkerRegistrationTokens()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(Equal(expectedTokens))
+		})
+	})
+
+	Describe("CreateWorkerRegistrationToken", func() {
+		var token atc.WorkerRegistrationToken
+
+		BeforeEach(func() {
+			token = atc.WorkerRegistrationToken{
+				TeamName:  "some-team",
+				Tags:      []string{"some-tag"},
+				ExpiresAt: 200,
+				MaxUses:   1,
+			}
+		})
+
+		Context("when the token is created", func() {
+			BeforeEach(func() {
+				created := token
+				created.ID = 42
+				created.Token = "some-secret"
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/worker-registration-tokens"),
+						ghttp.VerifyJSONRepresenting(token),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, created),
+					),
+				)
+			})
+
+			It("returns the created token with its secret", func() {
+				created, err := client.CreateWorkerRegistrationToken(token)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created.ID).To(Equal(42))
+				Expect(created.Token).To(Equal("some-secret"))
+			})
+		})
+
+		Context("when the token is invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/worker-registration-tokens"),
+						ghttp.RespondWith(http.StatusBadRequest, "team 'some-team' not found"),
+					),
+				)
+			})
+
+			It("returns the reason", func() {
+				_, err := client.CreateWorkerRegistrationToken(token)
+				Expect(err).To(MatchError("team 'some-team' not found"))
+			})
+		})
+	})
+
+	Describe("DeleteWorkerRegistrationToken", func() {
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/worker-registration-tokens/42"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("returns true", func() {
+				deleted, err := client.DeleteWorkerRegistrationToken(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted).To(BeTrue())
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/worker-registration-tokens/42"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				deleted, err := client.DeleteWorkerRegistrationToken(42)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(deleted).To(BeFalse())
+			})
+		})
+	})
+})
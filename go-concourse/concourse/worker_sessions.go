@@ -0,0 +1,17 @@
+package concourse
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+)
+
+func (client *client) ListWorkerSessions() ([]atc.WorkerSession, error) {
+	var sessions []atc.WorkerSession
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListWorkerSessions,
+	}, &internal.Response{
+		Result: &sessions,
+	})
+
+	return sessions, err
+}
@@ -0,0 +1,37 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Worker Sessions", func() {
+	Describe("ListWorkerSessions", func() {
+		var expectedSessions []atc.WorkerSession
+
+		BeforeEach(func() {
+			expectedSessions = []atc.WorkerSession{
+				{ID: "some-session", TSA: "some-tsa:2222", WorkerName: "some-worker", Transport: "ssh", ConnectedAt: 100},
+				{ID: "some-other-session", TSA: "some-other-tsa:2222", Transport: "websocket", ConnectedAt: 200, MissedKeepalives: 1},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/worker-sessions"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedSessions),
+				),
+			)
+		})
+
+		It("returns the sessions", func() {
+			sessions, err := client.ListWorkerSessions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sessions).To(Equal(expectedSessions))
+		})
+	})
+})
@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"context"
 	"crypto/rsa"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"math/rand"
 	"net"
 	"os"
@@ -53,9 +55,23 @@ type Client struct {
 	Hosts    []string
 	HostKeys []ssh.PublicKey
 
+	// Transport is either TransportSSH, the default, or TransportWebSocket,
+	// in which case the gateways are reached through WebSocketURLs instead of
+	// Hosts.
+	Transport          string
+	WebSocketURLs      []string
+	WebSocketTLSConfig *tls.Config
+
 	PrivateKey *rsa.PrivateKey
 
+	// RegistrationToken, if set, is redeemed for registering PrivateKey with
+	// the SSH gateway when the gateway does not accept the key yet.
+	RegistrationToken string
+
 	Worker atc.Worker
+
+	registerLock  sync.Mutex
+	keyRegistered bool
 }
 
 // RegisterOptions contains required configuration for the registration.
@@ -86,6 +102,11 @@ type RegisterOptions struct {
 	// The function must be careful not to take too long or become deadlocked, or
 	// else the SSH connection can starve.
 	HeartbeatedFunc func()
+
+	// ResourcesFunc, if set, is called after the registration and after each
+	// heartbeat. The resources it returns are sent to the SSH gateway, which
+	// includes them in the next heartbeat. Nothing is sent if it returns false.
+	ResourcesFunc func() (atc.WorkerResources, bool)
 }
 
 // Register invokes the 'forward-worker' command, proxying traffic through the
@@ -108,9 +129,8 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 
 	defer sshClient.Close()
 
-	keepAliveInterval := time.Second * 5
 	keepAliveTimeout := time.Minute * 5
-	go KeepAlive(ctx, sshClient, tcpConn, keepAliveInterval, keepAliveTimeout)
+	go KeepAlive(ctx, sshClient, tcpConn, KeepAliveInterval, keepAliveTimeout)
 
 	gardenListener, err := sshClient.Listen("tcp", gardenForwardAddr)
 	if err != nil {
@@ -131,6 +151,10 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 	eventsR, eventsW := io.Pipe()
 	defer eventsW.Close()
 
+	// buffered so that a heartbeat never waits on sending the resources; a
+	// pending send already picks up the latest resources
+	sendResources := make(chan struct{}, 1)
+
 	events := NewEventReader(eventsR)
 	go func() {
 		for {
@@ -154,13 +178,45 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 					opts.HeartbeatedFunc()
 				}
 			}
+
+			select {
+			case sendResources <- struct{}{}:
+			default:
+			}
 		}
 	}()
 
-	err = client.run(
+	var input func(context.Context, io.Writer)
+	if opts.ResourcesFunc != nil {
+		input = func(ctx context.Context, stdin io.Writer) {
+			encoder := json.NewEncoder(stdin)
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+
+				case <-sendResources:
+					resources, ok := opts.ResourcesFunc()
+					if !ok {
+						continue
+					}
+
+					err := encoder.Encode(resources)
+					if err != nil {
+						logger.Error("failed-to-send-resources", err)
+						return
+					}
+				}
+			}
+		}
+	}
+
+	err = client.runWithInput(
 		ctx,
 		sshClient,
 		"forward-worker --garden "+gardenForwardAddr+" --baggageclaim "+baggageclaimForwardAddr,
+		input,
 		eventsW,
 	)
 	if err != nil {
@@ -326,14 +382,8 @@ func (client *Client) ReportVolumes(ctx context.Context, handles []string) error
 func (client *Client) dial(ctx context.Context, idleTimeout time.Duration) (*ssh.Client, *net.TCPConn, error) {
 	logger := lagerctx.WithSession(ctx, "dial")
 
-	var err error
-	tcpConn, tsaAddr, err := client.tryDialAll(ctx)
-	if err != nil {
-		logger.Error("failed-to-connect-to-any-tsa", err)
-		return nil, nil, err
-	}
-
 	var pk ssh.Signer
+	var err error
 	if client.PrivateKey != nil {
 		pk, err = ssh.NewSignerFromKey(client.PrivateKey)
 		if err != nil {
@@ -343,6 +393,67 @@ func (client *Client) dial(ctx context.Context, idleTimeout time.Duration) (*ssh
 		return nil, nil, fmt.Errorf("private key not provided")
 	}
 
+	sshClient, tcpConn, err := client.handshake(ctx, idleTimeout, ssh.PublicKeys(pk))
+	if _, ok := err.(*HandshakeError); ok && client.RegistrationToken != "" {
+		registered, registerErr := client.registerKey(ctx, pk.PublicKey())
+		if registerErr != nil {
+			logger.Error("failed-to-register-key", registerErr)
+			return nil, nil, err
+		}
+
+		if registered {
+			return client.handshake(ctx, idleTimeout, ssh.PublicKeys(pk))
+		}
+	}
+
+	return sshClient, tcpConn, err
+}
+
+// registerKey redeems the registration token for registering the public key,
+// unless that has already been done. Once registered, the key may take a
+// moment to be accepted by other gateways.
+func (client *Client) registerKey(ctx context.Context, publicKey ssh.PublicKey) (bool, error) {
+	logger := lagerctx.WithSession(ctx, "register-key")
+
+	client.registerLock.Lock()
+	defer client.registerLock.Unlock()
+
+	if client.keyRegistered {
+		return false, nil
+	}
+
+	sshClient, _, err := client.handshake(ctx, 0, ssh.Password(client.RegistrationToken))
+	if err != nil {
+		return false, err
+	}
+
+	defer sshClient.Close()
+
+	authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(publicKey)))
+
+	err = client.run(ctx, sshClient, "register-worker-key "+authorizedKey, ioutil.Discard)
+	if err != nil {
+		return false, err
+	}
+
+	logger.Info("registered", lager.Data{
+		"fingerprint": ssh.FingerprintSHA256(publicKey),
+	})
+
+	client.keyRegistered = true
+
+	return true, nil
+}
+
+func (client *Client) handshake(ctx context.Context, idleTimeout time.Duration, auth ssh.AuthMethod) (*ssh.Client, *net.TCPConn, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	conn, tcpConn, tsaAddr, err := client.tryDialAll(ctx)
+	if err != nil {
+		logger.Error("failed-to-connect-to-any-tsa", err)
+		return nil, nil, err
+	}
+
 	clientConfig := &ssh.ClientConfig{
 		Config: atc.DefaultSSHConfig(),
 
@@ -350,13 +461,13 @@ func (client *Client) dial(ctx context.Context, idleTimeout time.Duration) (*ssh
 
 		HostKeyCallback: client.checkHostKey,
 
-		Auth: []ssh.AuthMethod{ssh.PublicKeys(pk)},
+		Auth: []ssh.AuthMethod{auth},
 	}
 
-	tsaConn := tcpConn
+	tsaConn := conn
 	if idleTimeout != 0 {
 		tsaConn = &timeoutConn{
-			Conn:        tcpConn,
+			Conn:        conn,
 			IdleTimeout: idleTimeout,
 		}
 	}
@@ -366,10 +477,12 @@ func (client *Client) dial(ctx context.Context, idleTimeout time.Duration) (*ssh
 		return nil, nil, &HandshakeError{Err: err}
 	}
 
-	return ssh.NewClient(clientConn, chans, reqs), tcpConn.(*net.TCPConn), nil
+	return ssh.NewClient(clientConn, chans, reqs), tcpConn, nil
 }
 
-func (client *Client) tryDialAll(ctx context.Context) (net.Conn, string, error) {
+// tryDialAll returns the connection to the first gateway reached, along with
+// the TCP connection underlying it.
+func (client *Client) tryDialAll(ctx context.Context) (net.Conn, *net.TCPConn, string, error) {
 	logger := lagerctx.FromContext(ctx)
 
 	dialer := &net.Dialer{
@@ -377,21 +490,36 @@ func (client *Client) tryDialAll(ctx context.Context) (net.Conn, string, error)
 		KeepAlive: 15 * time.Second,
 	}
 
-	shuffled := make([]string, len(client.Hosts))
-	copy(shuffled, client.Hosts)
+	addrs := client.Hosts
+	if client.Transport == TransportWebSocket {
+		addrs = client.WebSocketURLs
+	}
+
+	shuffled := make([]string, len(addrs))
+	copy(shuffled, addrs)
 	shuffle(sort.StringSlice(shuffled))
 
-	for _, host := range shuffled {
-		conn, err := dialer.Dial("tcp", host)
+	for _, addr := range shuffled {
+		if client.Transport == TransportWebSocket {
+			conn, tcpConn, err := dialWebSocket(ctx, dialer, addr, client.WebSocketTLSConfig)
+			if err != nil {
+				logger.Error("failed-to-connect-to-tsa", err, lager.Data{"url": addr})
+				continue
+			}
+
+			return conn, tcpConn, addr, nil
+		}
+
+		conn, err := dialer.Dial("tcp", addr)
 		if err != nil {
 			logger.Error("failed-to-connect-to-tsa", err)
 			continue
 		}
 
-		return conn, host, nil
+		return conn, conn.(*net.TCPConn), addr, nil
 	}
 
-	return nil, "", ErrAllGatewaysUnreachable
+	return nil, nil, "", ErrAllGatewaysUnreachable
 }
 
 func (client *Client) checkHostKey(hostname string, remote net.Addr, remoteKey ssh.PublicKey) error {
@@ -409,6 +537,13 @@ func (client *Client) checkHostKey(hostname string, remote net.Addr, remoteKey s
 
 
 func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command string, stdout io.Writer) error {
+	return client.runWithInput(ctx, sshClient, command, nil, stdout)
+}
+
+// runWithInput runs the command like run, but rather than closing stdin once
+// the worker has been sent, it keeps it open for input to write to until the
+// command exits.
+func (client *Client) runWithInput(ctx context.Context, sshClient *ssh.Client, command string, input func(context.Context, io.Writer), stdout io.Writer) error {
 	argv := strings.Split(command, " ")
 	commandName := ""
 	if len(argv) > 0 {
@@ -432,7 +567,12 @@ func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command st
 		return err
 	}
 
-	sess.Stdin = bytes.NewBuffer(workerPayload)
+	stdin, err := sess.StdinPipe()
+	if err != nil {
+		logger.Error("failed-to-open-stdin", err)
+		return err
+	}
+
 	sess.Stdout = stdout
 	sess.Stderr = os.Stderr
 
@@ -442,6 +582,21 @@ func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command st
 		return err
 	}
 
+	_, err = stdin.Write(workerPayload)
+	if err != nil {
+		logger.Error("failed-to-write-worker", err)
+		return err
+	}
+
+	if input != nil {
+		inputCtx, stopInput := context.WithCancel(ctx)
+		defer stopInput()
+
+		go input(inputCtx, stdin)
+	} else {
+		stdin.Close()
+	}
+
 	errs := make(chan error, 1)
 	go func() {
 		errs <- sess.Wait()
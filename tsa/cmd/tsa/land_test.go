@@ -2,6 +2,9 @@ package main_test
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/concourse/concourse/tsa"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -157,4 +160,24 @@ var _ = Describe("Land", func() {
 			})
 		})
 	})
+
+	Context("when connecting over WebSocket", func() {
+		BeforeEach(func() {
+			tsaClient.Worker.Team = ""
+			tsaClient.PrivateKey = globalKey
+			tsaClient.Transport = tsa.TransportWebSocket
+			tsaClient.WebSocketURLs = []string{fmt.Sprintf("wss://127.0.0.1:%d", tsaWebSocketPort)}
+			tsaClient.WebSocketTLSConfig = webSocketTLSConfig
+
+			atcServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/land"),
+				ghttp.RespondWith(200, nil, nil),
+			))
+		})
+
+		It("sends a request to the ATC to land the worker", func() {
+			Expect(landErr).ToNot(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
 })
@@ -3,10 +3,14 @@ package main_test
 import (
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
 	"fmt"
 	"io/ioutil"
+	"math/big"
+	"net"
 	"os/exec"
 	"path/filepath"
 	"strconv"
@@ -51,6 +55,7 @@ var (
 	forwardHost string
 
 	tsaPort           int
+	tsaWebSocketPort  int
 	tsaDebugPort      int
 	heartbeatInterval = 1 * time.Second
 	tsaProcess        ifrit.Process
@@ -69,6 +74,8 @@ var (
 	hostPubKey     ssh.PublicKey
 	hostPubKeyFile string
 
+	webSocketTLSConfig *tls.Config
+
 	authorizedKeysFile string
 
 	globalKey           *rsa.PrivateKey
@@ -86,6 +93,7 @@ var (
 
 var _ = BeforeEach(func() {
 	tsaPort = 9800 + GinkgoParallelNode()
+	tsaWebSocketPort = 9700 + GinkgoParallelNode()
 	tsaDebugPort = 9900 + GinkgoParallelNode()
 
 	gardenPort := 9001 + GinkgoParallelNode()
@@ -123,6 +131,9 @@ var _ = BeforeEach(func() {
 
 	hostKeyFile, hostPubKeyFile, _, hostPubKey = generateSSHKeypair()
 
+	webSocketCertFile, webSocketKeyFile, webSocketCAs := generateTLSKeypair()
+	webSocketTLSConfig = &tls.Config{RootCAs: webSocketCAs}
+
 	globalKeyFile, _, globalKey, _ = generateSSHKeypair()
 
 	teamKeyFile, teamPubKeyFile, teamKey, _ = generateSSHKeypair()
@@ -150,6 +161,9 @@ var _ = BeforeEach(func() {
 	tsaCommand := exec.Command(
 		tsaPath,
 		"--bind-port", strconv.Itoa(tsaPort),
+		"--websocket-bind-port", strconv.Itoa(tsaWebSocketPort),
+		"--websocket-tls-cert", webSocketCertFile,
+		"--websocket-tls-key", webSocketKeyFile,
 		"--peer-address", forwardHost,
 		"--debug-bind-port", strconv.Itoa(tsaDebugPort),
 		"--host-key", hostKeyFile,
@@ -162,6 +176,8 @@ var _ = BeforeEach(func() {
 		"--atc-url", atcServer.URL(),
 		"--garden-request-timeout", gardenRequestTimeout.String(),
 		"--heartbeat-interval", heartbeatInterval.String(),
+		"--worker-keys-sync-interval", "1h",
+		"--worker-sessions-report-interval", "1h",
 	)
 
 	tsaRunner = ginkgomon.New(ginkgomon.Config{
@@ -188,7 +204,17 @@ var _ = BeforeEach(func() {
 		},
 	}
 
+	atcServer.RouteToHandler("GET", "/api/v1/worker-keys",
+		ghttp.RespondWithJSONEncoded(200, []atc.WorkerKey{}))
+	atcServer.RouteToHandler("PUT", "/api/v1/worker-sessions",
+		ghttp.RespondWith(204, nil))
+
 	tsaProcess = ginkgomon.Invoke(tsaRunner)
+
+	// the TSA syncs the worker keys and reports its sessions on start; forget
+	// about it so that the tests only see the requests they make
+	Eventually(atcServer.ReceivedRequests).Should(HaveLen(2))
+	atcServer.Reset()
 })
 
 var _ = AfterEach(func() {
@@ -228,3 +254,47 @@ func generateSSHKeypair() (string, string, *rsa.PrivateKey, ssh.PublicKey) {
 
 	return privateKeyPath, publicKeyPath, privateKey, publicKeyRsa
 }
+
+func generateTLSKeypair() (string, string, *x509.CertPool) {
+	path, err := ioutil.TempDir("", "tsa-tls")
+	Expect(err).NotTo(HaveOccurred())
+
+	certPath := filepath.Join(path, "tls.crt")
+	keyPath := filepath.Join(path, "tls.key")
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	Expect(err).NotTo(HaveOccurred())
+
+	cert, err := x509.ParseCertificate(certBytes)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: certBytes,
+	}), 0600)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	}), 0600)
+	Expect(err).NotTo(HaveOccurred())
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return certPath, keyPath, pool
+}
@@ -7,6 +7,8 @@ const (
 	RetireWorker = "retire-worker"
 	DeleteWorker = "delete-worker"
 
+	RegisterWorkerKey = "register-worker-key"
+
 	ReportContainers      = "report-containers"
 	ReportVolumes         = "report-volumes"
 	ResourceActionMissing = "resource-type-missing"
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/clock"
@@ -36,6 +37,12 @@ type Heartbeater struct {
 
 	registration atc.Worker
 	eventWriter  EventWriter
+
+	resourcesL sync.Mutex
+	resources  *atc.WorkerResources
+
+	// session, if set, records the result of each heartbeat.
+	session *WorkerSession
 }
 
 func NewHeartbeater(
@@ -48,6 +55,7 @@ func NewHeartbeater(
 	httpClient *http.Client,
 	worker atc.Worker,
 	eventWriter EventWriter,
+	session *WorkerSession,
 ) *Heartbeater {
 	return &Heartbeater{
 		clock:       clock,
@@ -62,9 +70,19 @@ func NewHeartbeater(
 
 		registration: worker,
 		eventWriter:  eventWriter,
+
+		session: session,
 	}
 }
 
+// SetResources records the resources most recently sent by the worker. They
+// are included in every registration and heartbeat from then on.
+func (heartbeater *Heartbeater) SetResources(resources atc.WorkerResources) {
+	heartbeater.resourcesL.Lock()
+	heartbeater.resources = &resources
+	heartbeater.resourcesL.Unlock()
+}
+
 func (heartbeater *Heartbeater) Heartbeat(ctx context.Context) error {
 	logger := lagerctx.FromContext(ctx)
 
@@ -72,6 +90,8 @@ func (heartbeater *Heartbeater) Heartbeat(ctx context.Context) error {
 	defer logger.Info("done")
 
 	for !heartbeater.register(logger.Session("register")) {
+		heartbeater.recordStatus(HeartbeatStatusUnhealthy)
+
 		select {
 		case <-heartbeater.clock.NewTimer(time.Second).C():
 		case <-ctx.Done():
@@ -79,6 +99,8 @@ func (heartbeater *Heartbeater) Heartbeat(ctx context.Context) error {
 		}
 	}
 
+	heartbeater.recordStatus(HeartbeatStatusHealthy)
+
 	currentInterval := heartbeater.interval
 
 	for {
@@ -88,6 +110,8 @@ func (heartbeater *Heartbeater) Heartbeat(ctx context.Context) error {
 
 		case <-heartbeater.clock.NewTimer(currentInterval).C():
 			status := heartbeater.heartbeat(logger.Session("heartbeat"))
+			heartbeater.recordStatus(status)
+
 			switch status {
 			case HeartbeatStatusGoneAway:
 				return nil
@@ -282,9 +306,19 @@ func (heartbeater *Heartbeater) pingWorker(logger lager.Logger) (atc.Worker, boo
 	registration.ActiveContainers = len(containers)
 	registration.ActiveVolumes = len(volumes)
 
+	heartbeater.resourcesL.Lock()
+	registration.Resources = heartbeater.resources
+	heartbeater.resourcesL.Unlock()
+
 	return registration, true
 }
 
+func (heartbeater *Heartbeater) recordStatus(status HeartbeatStatus) {
+	if heartbeater.session != nil {
+		heartbeater.session.Heartbeated(status)
+	}
+}
+
 func (heartbeater *Heartbeater) ttl() time.Duration {
 	return heartbeater.interval * 2
 }
@@ -49,6 +49,7 @@ var _ = Describe("Heartbeater", func() {
 		fakeATC2               *ghttp.Server
 		httpClient             *http.Client
 		atcEndpointPicker      *tsafakes.FakeEndpointPicker
+		heartbeater            *Heartbeater
 		heartbeatErr           <-chan error
 
 		verifyRegister  http.HandlerFunc
@@ -153,7 +154,7 @@ var _ = Describe("Heartbeater", func() {
 	})
 
 	JustBeforeEach(func() {
-		heartbeater := NewHeartbeater(
+		heartbeater = NewHeartbeater(
 			fakeClock,
 			interval,
 			cprInterval,
@@ -163,6 +164,7 @@ var _ = Describe("Heartbeater", func() {
 			httpClient,
 			worker,
 			NewEventWriter(clientWriter),
+			nil,
 		)
 
 		errs := make(chan error, 1)
@@ -262,6 +264,23 @@ var _ = Describe("Heartbeater", func() {
 					Eventually(heartbeats).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
 				})
 
+				It("heartbeats with the resources sent by the worker", func() {
+					Eventually(registrations).Should(Receive())
+
+					resources := atc.WorkerResources{
+						MemoryFree:  1024,
+						MemoryTotal: 4096,
+						CPULoad:     0.5,
+					}
+					heartbeater.SetResources(resources)
+
+					fakeClock.WaitForWatcherAndIncrement(interval)
+					expectedWorker.ActiveContainers = 5
+					expectedWorker.ActiveVolumes = 2
+					expectedWorker.Resources = &resources
+					Eventually(heartbeats).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+				})
+
 				It("emits events", func() {
 					Eventually(registrations).Should(Receive())
 
@@ -9,6 +9,10 @@ import (
 	"time"
 )
 
+// KeepAliveInterval is how often workers send a keepalive request to the SSH
+// gateway, which counts the ones which do not arrive in time as missed.
+const KeepAliveInterval = 5 * time.Second
+
 //
 func KeepAlive(ctx context.Context, sshClient *ssh.Client, tcpConn *net.TCPConn, interval time.Duration, timeout time.Duration){
 	logger := lagerctx.WithSession(ctx, "keepalive")
@@ -0,0 +1,85 @@
+package tsa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/tedsuo/rata"
+)
+
+// KeyRegistrar exchanges a worker's registration token for a key of the
+// worker's own.
+type KeyRegistrar struct {
+	ATCEndpoint *rata.RequestGenerator
+	HTTPClient  *http.Client
+}
+
+func (r *KeyRegistrar) Register(ctx context.Context, token string, worker atc.Worker, publicKey string) (atc.WorkerKey, error) {
+	logger := lagerctx.FromContext(ctx)
+
+	logger.Info("start")
+	defer logger.Info("end")
+
+	payload, err := json.Marshal(atc.RedeemWorkerRegistrationTokenRequest{
+		Token:      token,
+		WorkerName: worker.Name,
+		TeamName:   worker.Team,
+		Tags:       worker.Tags,
+		PublicKey:  publicKey,
+	})
+	if err != nil {
+		return atc.WorkerKey{}, err
+	}
+
+	request, err := r.ATCEndpoint.CreateRequest(atc.RedeemWorkerRegistrationToken, nil, bytes.NewBuffer(payload))
+	if err != nil {
+		logger.Error("failed-to-construct-request", err)
+		return atc.WorkerKey{}, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := r.HTTPClient.Do(request.WithContext(ctx))
+	if err != nil {
+		logger.Error("failed-to-redeem-token", err)
+		return atc.WorkerKey{}, err
+	}
+
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusCreated:
+	case http.StatusBadRequest, http.StatusForbidden, http.StatusConflict:
+		body, _ := ioutil.ReadAll(response.Body)
+
+		logger.Info("rejected", lager.Data{
+			"status-code": response.StatusCode,
+			"reason":      string(body),
+		})
+
+		return atc.WorkerKey{}, fmt.Errorf("registration token rejected: %s", strings.TrimSpace(string(body)))
+	default:
+		logger.Error("bad-response", nil, lager.Data{
+			"status-code": response.StatusCode,
+		})
+
+		return atc.WorkerKey{}, fmt.Errorf("bad-response (%d)", response.StatusCode)
+	}
+
+	var key atc.WorkerKey
+	err = json.NewDecoder(response.Body).Decode(&key)
+	if err != nil {
+		logger.Error("failed-to-decode-worker-key", err)
+		return atc.WorkerKey{}, err
+	}
+
+	return key, nil
+}
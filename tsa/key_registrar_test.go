@@ -0,0 +1,105 @@
+package tsa_test
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/tsa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+	"golang.org/x/oauth2"
+)
+
+var _ = Describe("KeyRegistrar", func() {
+	const somePublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFdKVCFpynKib6/W5IGS6qXrd/yxmYbgJKdH/zOv51fo"
+
+	var (
+		registrar *tsa.KeyRegistrar
+
+		ctx     context.Context
+		worker  atc.Worker
+		fakeATC *ghttp.Server
+	)
+
+	BeforeEach(func() {
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		worker = atc.Worker{
+			Name: "some-worker",
+			Team: "some-team",
+			Tags: []string{"some-tag"},
+		}
+		fakeATC = ghttp.NewServer()
+
+		token := &oauth2.Token{TokenType: "Bearer", AccessToken: "yo"}
+		httpClient := oauth2.NewClient(oauth2.NoContext, oauth2.StaticTokenSource(token))
+
+		registrar = &tsa.KeyRegistrar{
+			ATCEndpoint: rata.NewRequestGenerator(fakeATC.URL(), atc.Routes),
+			HTTPClient:  httpClient,
+		}
+	})
+
+	AfterEach(func() {
+		fakeATC.Close()
+	})
+
+	It("redeems the token for the worker's key", func() {
+		expectedKey := atc.WorkerKey{
+			TeamName:   "some-team",
+			Name:       "some-worker",
+			PublicKey:  somePublicKey,
+			WorkerName: "some-worker",
+			Tags:       []string{"some-tag"},
+		}
+
+		fakeATC.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("POST", "/api/v1/worker-registration-tokens/redeem"),
+			ghttp.VerifyHeaderKV("Authorization", "Bearer yo"),
+			ghttp.VerifyJSONRepresenting(atc.RedeemWorkerRegistrationTokenRequest{
+				Token:      "some-token",
+				WorkerName: "some-worker",
+				TeamName:   "some-team",
+				Tags:       []string{"some-tag"},
+				PublicKey:  somePublicKey,
+			}),
+			ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedKey),
+		))
+
+		key, err := registrar.Register(ctx, "some-token", worker, somePublicKey)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(key).To(Equal(expectedKey))
+	})
+
+	Context("when the token is rejected", func() {
+		BeforeEach(func() {
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/api/v1/worker-registration-tokens/redeem"),
+				ghttp.RespondWith(http.StatusForbidden, "registration token is invalid, expired or used up"),
+			))
+		})
+
+		It("returns the reason", func() {
+			_, err := registrar.Register(ctx, "some-token", worker, somePublicKey)
+			Expect(err).To(MatchError("registration token rejected: registration token is invalid, expired or used up"))
+		})
+	})
+
+	Context("when the ATC fails", func() {
+		BeforeEach(func() {
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/api/v1/worker-registration-tokens/redeem"),
+				ghttp.RespondWith(http.StatusInternalServerError, nil),
+			))
+		})
+
+		It("errors", func() {
+			_, err := registrar.Register(ctx, "some-token", worker, somePublicKey)
+			Expect(err).To(MatchError(ContainSubstring("500")))
+		})
+	})
+})
@@ -3,6 +3,8 @@ package tsacmd
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"net/http"
 	"os"
@@ -16,6 +18,7 @@ import (
 	"os/signal"
 	"syscall"
 
+	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
@@ -36,13 +39,25 @@ type TSACommand struct {
 	PeerAddress string  `long:"peer-address" default:"127.0.0.1" description:"Network address of this web node, reachable by other web nodes. Used for forwarded worker addresses."`
 	BindPort    uint16  `long:"bind-port" default:"2222"    description:"Port on which to listen for SSH."`
 
+	WebSocketBindIP   flag.IP   `long:"websocket-bind-ip"   default:"0.0.0.0" description:"IP address on which to listen for workers connecting over WebSocket."`
+	WebSocketBindPort uint16    `long:"websocket-bind-port" description:"Port on which to listen for workers connecting over WebSocket. Workers can only connect over WebSocket when this is set, which requires --websocket-tls-cert and --websocket-tls-key."`
+	WebSocketTLSCert  flag.File `long:"websocket-tls-cert"  description:"File containing an SSL certificate for the WebSocket listener. A load balancer in front of the TSA must pass TLS through or re-encrypt to it."`
+	WebSocketTLSKey   flag.File `long:"websocket-tls-key"   description:"File containing an RSA private key, used to encrypt WebSocket traffic."`
+
 	DebugBindIP   flag.IP `long:"debug-bind-ip"   default:"127.0.0.1" description:"IP address on which to listen for the pprof debugger endpoints."`
 	DebugBindPort uint16  `long:"debug-bind-port" default:"2221"      description:"Port on which to listen for the pprof debugger endpoints."`
 
 	HostKey                *flag.PrivateKey               `long:"host-key"        required:"true" description:"Path to private key to use for the SSH server."`
 	AuthorizedKeys         flag.AuthorizedKeys            `long:"authorized-keys" description:"Path to file containing keys to authorize, in SSH authorized_keys format (one public key per line)."`
-	TeamAuthorizedKeys     map[string]flag.AuthorizedKeys `long:"team-authorized-keys" value-name:"NAME:PATH" description:"Path to file containing keys to authorize, in SSH authorized_keys format (one public key per line)."`
-	TeamAuthorizedKeysFile flag.File                      `long:"team-authorized-keys-file" description:"Path to file containing a YAML array of teams and their authorized SSH keys, e.g. [{team:foo,ssh_keys:[key1,key2]}]."`
+	TeamAuthorizedKeys     map[string]flag.AuthorizedKeys `long:"team-authorized-keys" value-name:"NAME:PATH" description:"Path to file containing keys to authorize, in SSH authorized_keys format (one public key per line). Deprecated: use 'fly set-worker-key' instead."`
+	TeamAuthorizedKeysFile flag.File                      `long:"team-authorized-keys-file" description:"Path to file containing a YAML array of teams and their authorized SSH keys, e.g. [{team:foo,ssh_keys:[key1,key2]}]. Deprecated: use 'fly set-worker-key' instead."`
+
+	WorkerKeysSyncInterval time.Duration `long:"worker-keys-sync-interval" default:"10s" description:"Interval on which to fetch the team worker keys managed through the API."`
+
+	RegistrationTokenRate        float64 `long:"registration-token-rate"         default:"1"  description:"Maximum number of logins per second with a worker registration token. The tokens are only checked once the worker registers its key."`
+	MaxRegistrationTokenSessions int     `long:"max-registration-token-sessions" default:"16" description:"Maximum number of sessions authenticated with a worker registration token open at a time."`
+
+	WorkerSessionsReportInterval time.Duration `long:"worker-sessions-report-interval" default:"10s" description:"Interval on which to report the connected workers' sessions, listed with 'fly worker-sessions'."`
 
 	ATCURLs []flag.URL `long:"atc-url" required:"true" description:"ATC API endpoints to which workers will be registered."`
 
@@ -114,11 +129,6 @@ func (cmd *TSACommand) Runner(args []string) (ifrit.Runner, error) {
 		lock:         &sync.RWMutex{},
 	}
 
-	config, err := cmd.configureSSHServer(sessionAuthTeam, cmd.AuthorizedKeys.Keys, teamAuthorizedKeys)
-	if err != nil {
-		return nil, fmt.Errorf("failed to configure SSH server: %s", err)
-	}
-
 	listenAddr := fmt.Sprintf("%s:%d", cmd.BindIP, cmd.BindPort)
 
 	authConfig := clientcredentials.Config{
@@ -133,6 +143,23 @@ func (cmd *TSACommand) Runner(args []string) (ifrit.Runner, error) {
 	tokenSource := authConfig.TokenSource(ctx)
 	httpClient := oauth2.NewClient(ctx, tokenSource)
 
+	workerKeys := tsa.NewWorkerKeys(clock.NewClock(), cmd.WorkerKeysSyncInterval, atcEndpointPicker, httpClient)
+
+	workerSessions := tsa.NewWorkerSessions(
+		clock.NewClock(),
+		cmd.WorkerSessionsReportInterval,
+		fmt.Sprintf("%s:%d", cmd.PeerAddress, cmd.BindPort),
+		atcEndpointPicker,
+		httpClient,
+	)
+
+	tokenSessions := newTokenSessions(cmd.RegistrationTokenRate, cmd.MaxRegistrationTokenSessions)
+
+	config, err := cmd.configureSSHServer(sessionAuthTeam, cmd.AuthorizedKeys.Keys, teamAuthorizedKeys, workerKeys, tokenSessions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure SSH server: %s", err)
+	}
+
 	server := &server{
 		logger:               logger,
 		heartbeatInterval:    cmd.HeartbeatInterval,
@@ -142,6 +169,9 @@ func (cmd *TSACommand) Runner(args []string) (ifrit.Runner, error) {
 		config:               config,
 		httpClient:           httpClient,
 		sessionTeam:          sessionAuthTeam,
+		workerKeys:           workerKeys,
+		workerSessions:       workerSessions,
+		tokenSessions:        tokenSessions,
 		gardenRequestTimeout: cmd.GardenRequestTimeout,
 	}
 	// Starts a goroutine whose purpose is to listen to the
@@ -172,7 +202,7 @@ func (cmd *TSACommand) Runner(args []string) (ifrit.Runner, error) {
 			}
 
 			// Reconfigure the SSH server with the new keys
-			config, err := cmd.configureSSHServer(sessionAuthTeam, cmd.AuthorizedKeys.Keys, teamAuthorizedKeys)
+			config, err := cmd.configureSSHServer(sessionAuthTeam, cmd.AuthorizedKeys.Keys, teamAuthorizedKeys, workerKeys, tokenSessions)
 			if err != nil {
 				logger.Error("failed to configure SSH server: %s", err)
 				continue
@@ -182,7 +212,40 @@ func (cmd *TSACommand) Runner(args []string) (ifrit.Runner, error) {
 		}
 	}()
 
-	return serverRunner{logger, server, listenAddr}, nil
+	runner := serverRunner{
+		logger:         logger,
+		server:         server,
+		workerKeys:     workerKeys,
+		workerSessions: workerSessions,
+		listenAddr:     listenAddr,
+	}
+
+	if cmd.WebSocketBindPort != 0 {
+		runner.webSocketAddr = fmt.Sprintf("%s:%d", cmd.WebSocketBindIP, cmd.WebSocketBindPort)
+
+		runner.webSocketTLSConfig, err = cmd.webSocketTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return runner, nil
+}
+
+func (cmd *TSACommand) webSocketTLSConfig() (*tls.Config, error) {
+	if cmd.WebSocketTLSCert == "" || cmd.WebSocketTLSKey == "" {
+		return nil, errors.New("--websocket-bind-port requires --websocket-tls-cert and --websocket-tls-key")
+	}
+
+	cert, err := tls.LoadX509KeyPair(cmd.WebSocketTLSCert.Path(), cmd.WebSocketTLSKey.Path())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load websocket tls key pair: %s", err)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
 }
 
 func (cmd *TSACommand) constructLogger() (lager.Logger, *lager.ReconfigurableSink) {
@@ -238,7 +301,7 @@ func (cmd *TSACommand) loadTeamAuthorizedKeys() ([]TeamAuthKeys, error) {
 	return teamKeys, nil
 }
 
-func (cmd *TSACommand) configureSSHServer(sessionAuthTeam *sessionTeam, authorizedKeys []ssh.PublicKey, teamAuthorizedKeys []TeamAuthKeys) (*ssh.ServerConfig, error) {
+func (cmd *TSACommand) configureSSHServer(sessionAuthTeam *sessionTeam, authorizedKeys []ssh.PublicKey, teamAuthorizedKeys []TeamAuthKeys, workerKeys *tsa.WorkerKeys, tokenSessions *tokenSessions) (*ssh.ServerConfig, error) {
 	certChecker := &ssh.CertChecker{
 		IsUserAuthority: func(key ssh.PublicKey) bool {
 			return false
@@ -264,6 +327,14 @@ func (cmd *TSACommand) configureSSHServer(sessionAuthTeam *sessionTeam, authoriz
 				}
 			}
 
+			if workerKey, found := workerKeys.Authorize(key); found {
+				if workerKey.TeamName != "" {
+					sessionAuthTeam.AuthorizeTeam(string(conn.SessionID()), workerKey.TeamName)
+				}
+
+				return workerKeyPermissions(workerKey)
+			}
+
 			return nil, fmt.Errorf("unknown public key")
 		},
 	}
@@ -273,6 +344,26 @@ func (cmd *TSACommand) configureSSHServer(sessionAuthTeam *sessionTeam, authoriz
 		PublicKeyCallback: func(conn ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
 			return certChecker.Authenticate(conn, key)
 		},
+
+		// workers without a key of their own present a registration token as
+		// the password; the token is only checked by the ATC when the worker
+		// registers its key, which is all that such sessions are allowed to do,
+		// so the logins and sessions are limited
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if len(password) == 0 {
+				return nil, fmt.Errorf("empty registration token")
+			}
+
+			if !tokenSessions.Allow() {
+				return nil, fmt.Errorf("too many registration token logins")
+			}
+
+			return &ssh.Permissions{
+				Extensions: map[string]string{
+					registrationTokenExtension: string(password),
+				},
+			}, nil
+		},
 	}
 
 	signer, err := ssh.NewSignerFromKey(cmd.HostKey)
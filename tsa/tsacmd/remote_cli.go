@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -31,16 +32,22 @@ type forwardWorkerRequest struct {
 func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
 	logger := lagerctx.FromContext(ctx)
 
+	// the worker keeps sending its resources after the registration, so the
+	// decoder is kept around to read them
+	decoder := json.NewDecoder(channel)
+
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
 
-	if err := checkTeam(state, worker); err != nil {
+	if err := checkWorker(state, worker); err != nil {
 		return err
 	}
 
+	state.Session.Registering(worker.Name, worker.Team)
+
 	forwards := map[string]ForwardedTCPIP{}
 	for i := 0; i < 2; i++ {
 		select {
@@ -89,8 +96,11 @@ func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, cha
 		req.server.httpClient,
 		worker,
 		tsa.NewEventWriter(channel),
+		state.Session,
 	)
 
+	go readResources(logger.Session("read-resources"), decoder, heartbeater)
+
 	err = heartbeater.Heartbeat(ctx)
 	if err != nil {
 		logger.Error("failed-to-heartbeat", err)
@@ -121,6 +131,24 @@ func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, cha
 	return nil
 }
 
+// readResources hands the resources sent by the worker to the heartbeater
+// until the worker stops sending them.
+func readResources(logger lager.Logger, decoder *json.Decoder, heartbeater *tsa.Heartbeater) {
+	for {
+		var resources atc.WorkerResources
+		err := decoder.Decode(&resources)
+		if err != nil {
+			if err != io.EOF {
+				logger.Error("failed-to-decode-resources", err)
+			}
+
+			return
+		}
+
+		heartbeater.SetResources(resources)
+	}
+}
+
 func (r forwardWorkerRequest) expectedForwards() int {
 	expected := 0
 
@@ -139,6 +167,22 @@ type landWorkerRequest struct {
 	server *server
 }
 
+func checkWorker(state ConnState, worker atc.Worker) error {
+	if state.RegistrationToken != "" {
+		return fmt.Errorf("a registration token can only be used to %s", tsa.RegisterWorkerKey)
+	}
+
+	if state.WorkerName != "" && worker.Name != state.WorkerName {
+		return fmt.Errorf("key is authorized for worker %s, but worker is %s", state.WorkerName, worker.Name)
+	}
+
+	if len(state.Tags) > 0 && !atc.SameTags(state.Tags, worker.Tags) {
+		return fmt.Errorf("key is authorized for tags %v, but worker has tags %v", state.Tags, worker.Tags)
+	}
+
+	return checkTeam(state, worker)
+}
+
 func checkTeam(state ConnState, worker atc.Worker) error {
 	if state.Team == "" {
 		// global keys can be used for all teams
@@ -163,7 +207,7 @@ func (req landWorkerRequest) Handle(ctx context.Context, state ConnState, channe
 		return err
 	}
 
-	if err := checkTeam(state, worker); err != nil {
+	if err := checkWorker(state, worker); err != nil {
 		return err
 	}
 
@@ -184,7 +228,7 @@ func (req retireWorkerRequest) Handle(ctx context.Context, state ConnState, chan
 		return err
 	}
 
-	if err := checkTeam(state, worker); err != nil {
+	if err := checkWorker(state, worker); err != nil {
 		return err
 	}
 
@@ -205,7 +249,7 @@ func (req deleteWorkerRequest) Handle(ctx context.Context, state ConnState, chan
 		return err
 	}
 
-	if err := checkTeam(state, worker); err != nil {
+	if err := checkWorker(state, worker); err != nil {
 		return err
 	}
 
@@ -226,7 +270,7 @@ func (req sweepContainersRequest) Handle(ctx context.Context, state ConnState, c
 		return err
 	}
 
-	if err := checkTeam(state, worker); err != nil {
+	if err := checkWorker(state, worker); err != nil {
 		return err
 	}
 
@@ -260,7 +304,7 @@ func (req reportContainersRequest) Handle(ctx context.Context, state ConnState,
 		return err
 	}
 
-	if err := checkTeam(state, worker); err != nil {
+	if err := checkWorker(state, worker); err != nil {
 		return err
 	}
 
@@ -282,7 +326,7 @@ func (req sweepVolumesRequest) Handle(ctx context.Context, state ConnState, chan
 		return err
 	}
 
-	if err := checkTeam(state, worker); err != nil {
+	if err := checkWorker(state, worker); err != nil {
 		return err
 	}
 
@@ -316,7 +360,7 @@ func (req reportVolumesRequest) Handle(ctx context.Context, state ConnState, cha
 		return err
 	}
 
-	if err := checkTeam(state, worker); err != nil {
+	if err := checkWorker(state, worker); err != nil {
 		return err
 	}
 
@@ -327,6 +371,42 @@ func (req reportVolumesRequest) Handle(ctx context.Context, state ConnState, cha
 	}).WorkerStatus(ctx, worker, tsa.ReportVolumes)
 }
 
+type registerWorkerKeyRequest struct {
+	server    *server
+	publicKey string
+}
+
+func (req registerWorkerKeyRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+	logger := lagerctx.FromContext(ctx)
+
+	if state.RegistrationToken == "" {
+		return fmt.Errorf("%s requires a registration token", tsa.RegisterWorkerKey)
+	}
+
+	var worker atc.Worker
+	err := json.NewDecoder(channel).Decode(&worker)
+	if err != nil {
+		return err
+	}
+
+	key, err := (&tsa.KeyRegistrar{
+		ATCEndpoint: req.server.atcEndpointPicker.Pick(),
+		HTTPClient:  req.server.httpClient,
+	}).Register(ctx, state.RegistrationToken, worker, req.publicKey)
+	if err != nil {
+		fmt.Fprintln(channel.Stderr(), err)
+		return err
+	}
+
+	logger.Info("registered-worker-key", lager.Data{
+		"worker":      key.WorkerName,
+		"team":        key.TeamName,
+		"fingerprint": key.Fingerprint,
+	})
+
+	return req.server.workerKeys.Add(key)
+}
+
 func gardenURL(addr string) string {
 	return fmt.Sprintf("http://%s", addr)
 }
@@ -2,6 +2,8 @@ package tsacmd
 
 import (
 	"context"
+	"encoding/hex"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
@@ -13,12 +15,18 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/time/rate"
 )
 
 const maxForwards = 2
 
+// registrationTokenSessionTimeout is how long a session authenticated with a
+// registration token may take to register its key.
+const registrationTokenSessionTimeout = time.Minute
+
 type server struct {
 	logger               lager.Logger
 	atcEndpointPicker    tsa.EndpointPicker
@@ -29,6 +37,35 @@ type server struct {
 	config               *ssh.ServerConfig
 	httpClient           *http.Client
 	sessionTeam          *sessionTeam
+	workerKeys           *tsa.WorkerKeys
+	workerSessions       *tsa.WorkerSessions
+	tokenSessions        *tokenSessions
+}
+
+// These SSH permission extensions carry how the session authenticated from
+// the auth callbacks to the connection's state.
+const (
+	registrationTokenExtension = "concourse-registration-token"
+	workerNameExtension        = "concourse-worker-name"
+	workerTagsExtension        = "concourse-worker-tags"
+)
+
+func workerKeyPermissions(key atc.WorkerKey) (*ssh.Permissions, error) {
+	if key.WorkerName == "" {
+		return nil, nil
+	}
+
+	tags, err := json.Marshal(key.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ssh.Permissions{
+		Extensions: map[string]string{
+			workerNameExtension: key.WorkerName,
+			workerTagsExtension: string(tags),
+		},
+	}, nil
 }
 
 type sessionTeam struct {
@@ -59,9 +96,68 @@ func (s *sessionTeam) AuthorizedTeamFor(sessionID string) string {
 	return s.sessionTeams[sessionID]
 }
 
+// tokenSessions limits the sessions authenticated with a registration token.
+// The token is only checked by the ATC once the worker registers its key, so
+// anyone able to reach the TSA can open such a session.
+type tokenSessions struct {
+	limiter *rate.Limiter
+	max     int
+
+	lock   sync.Mutex
+	active int
+}
+
+func newTokenSessions(perSecond float64, max int) *tokenSessions {
+	return &tokenSessions{
+		limiter: rate.NewLimiter(rate.Limit(perSecond), max),
+		max:     max,
+	}
+}
+
+// Allow returns false if registration tokens are being presented faster than
+// allowed.
+func (s *tokenSessions) Allow() bool {
+	return s.limiter.Allow()
+}
+
+// Open reserves a session, returning false if the maximum number of sessions
+// are already open.
+func (s *tokenSessions) Open() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.active >= s.max {
+		return false
+	}
+
+	s.active++
+
+	return true
+}
+
+func (s *tokenSessions) Close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.active--
+}
+
 type ConnState struct {
 	Team string
 
+	// WorkerName and Tags are set when the session authenticated with a key
+	// registered by a worker, which can only be used by that worker.
+	WorkerName string
+	Tags       []string
+
+	// RegistrationToken is set when the session authenticated with a worker
+	// registration token. Such sessions can only register a worker key.
+	RegistrationToken string
+
+	// Session records what happens on the connection, for listing the
+	// connected workers.
+	Session *tsa.WorkerSession
+
 	ForwardedTCPIPs <-chan ForwardedTCPIP
 }
 
@@ -82,7 +178,7 @@ func (forward ForwardedTCPIP) Wait() {
 	forward.Logger.Debug("drained")
 }
 
-func (server *server) Serve(listener net.Listener) {
+func (server *server) Serve(listener net.Listener, transport string) {
 	for {
 		c, err := listener.Accept()
 		if err != nil {
@@ -97,11 +193,11 @@ func (server *server) Serve(listener net.Listener) {
 			"remote": c.RemoteAddr().String(),
 		})
 
-		go server.handshake(logger, c)
+		go server.handshake(logger, c, transport)
 	}
 }
 
-func (server *server) handshake(logger lager.Logger, netConn net.Conn) {
+func (server *server) handshake(logger lager.Logger, netConn net.Conn, transport string) {
 	conn, chans, reqs, err := ssh.NewServerConn(netConn, server.config)
 	if err != nil {
 		logger.Info("handshake-failed", lager.Data{"error": err.Error()})
@@ -115,15 +211,50 @@ func (server *server) handshake(logger lager.Logger, netConn net.Conn) {
 
 	sessionID := string(conn.SessionID())
 
-	forwardedTCPIPs := make(chan ForwardedTCPIP, maxForwards)
-	go server.handleForwardRequests(ctx, conn, reqs, forwardedTCPIPs)
+	// the session ID is 32 bytes; a prefix is enough to tell them apart
+	workerSessionID := hex.EncodeToString(conn.SessionID()[:8])
+
+	session := server.workerSessions.Open(workerSessionID, netConn.RemoteAddr().String(), transport)
+	defer server.workerSessions.Close(workerSessionID)
 
 	state := ConnState{
-		Team: server.sessionTeam.AuthorizedTeamFor(sessionID),
+		Team:    server.sessionTeam.AuthorizedTeamFor(sessionID),
+		Session: session,
+	}
 
-		ForwardedTCPIPs: forwardedTCPIPs,
+	if conn.Permissions != nil {
+		state.RegistrationToken = conn.Permissions.Extensions[registrationTokenExtension]
+		state.WorkerName = conn.Permissions.Extensions[workerNameExtension]
+
+		if state.RegistrationToken != "" {
+			if !server.tokenSessions.Open() {
+				logger.Info("too-many-registration-token-sessions")
+				return
+			}
+
+			defer server.tokenSessions.Close()
+
+			err := netConn.SetDeadline(time.Now().Add(registrationTokenSessionTimeout))
+			if err != nil {
+				logger.Error("failed-to-set-registration-token-session-deadline", err)
+				return
+			}
+		}
+
+		if tags, found := conn.Permissions.Extensions[workerTagsExtension]; found {
+			err := json.Unmarshal([]byte(tags), &state.Tags)
+			if err != nil {
+				logger.Error("failed-to-unmarshal-worker-tags", err)
+				return
+			}
+		}
 	}
 
+	forwardedTCPIPs := make(chan ForwardedTCPIP, maxForwards)
+	go server.handleForwardRequests(ctx, conn, reqs, forwardedTCPIPs, session, state.RegistrationToken != "")
+
+	state.ForwardedTCPIPs = forwardedTCPIPs
+
 	chansGroup := new(sync.WaitGroup)
 
 	for newChannel := range chans {
@@ -213,6 +344,12 @@ func (server *server) handleChannel(
 					continue
 				}
 
+				if state.RegistrationToken != "" && command != tsa.RegisterWorkerKey {
+					fmt.Fprintf(channel, "invalid command: a registration token can only be used to %s", tsa.RegisterWorkerKey)
+					req.Reply(false, nil)
+					continue
+				}
+
 				req.Reply(true, nil)
 
 				cmdLogger := logger.Session("command", lager.Data{
@@ -261,6 +398,8 @@ func (server *server) handleForwardRequests(
 	conn *ssh.ServerConn,
 	reqs <-chan *ssh.Request,
 	forwardedTCPIPs chan<- ForwardedTCPIP,
+	session *tsa.WorkerSession,
+	registeringKey bool,
 ) {
 	logger := lagerctx.FromContext(ctx)
 
@@ -273,6 +412,12 @@ func (server *server) handleForwardRequests(
 
 		switch r.Type {
 		case "tcpip-forward":
+			if registeringKey {
+				reqLog.Info("rejecting-forward-request-with-registration-token")
+				r.Reply(false, nil)
+				continue
+			}
+
 			forwardedThings++
 
 			if forwardedThings > maxForwards {
@@ -329,7 +474,7 @@ func (server *server) handleForwardRequests(
 			wait := new(sync.WaitGroup)
 
 			wait.Add(1)
-			go server.forwardTCPIP(lagerctx.NewContext(ctx, reqLog), drain, wait, conn, listener, req.BindIP, forPort)
+			go server.forwardTCPIP(lagerctx.NewContext(ctx, reqLog), drain, wait, conn, session, listener, req.BindIP, forPort)
 
 			forwardedTCPIPs <- ForwardedTCPIP{
 				Logger: reqLog,
@@ -342,6 +487,8 @@ func (server *server) handleForwardRequests(
 				wg: wait,
 			}
 
+			session.Forwarded(bindAddr, res.BoundPort)
+
 			r.Reply(true, ssh.Marshal(res))
 
 		default:
@@ -349,7 +496,13 @@ func (server *server) handleForwardRequests(
 			// just check for 'keepalive'
 			if strings.Contains(r.Type, "keepalive") {
 				reqLog.Debug("keepalive")
-				r.Reply(true, nil)
+
+				session.KeptAlive()
+
+				err := r.Reply(true, nil)
+				if err != nil {
+					reqLog.Error("failed-to-reply-to-keepalive", err)
+				}
 			} else {
 				reqLog.Info("ignoring")
 				r.Reply(false, nil)
@@ -363,6 +516,7 @@ func (server *server) forwardTCPIP(
 	drain <-chan struct{},
 	connsWg *sync.WaitGroup,
 	conn *ssh.ServerConn,
+	session *tsa.WorkerSession,
 	listener net.Listener,
 	forwardIP string,
 	forwardPort uint32,
@@ -405,6 +559,7 @@ func (server *server) forwardTCPIP(
 				lagerctx.NewContext(ctx, logger.Session("forward-conn")),
 				localConn,
 				conn,
+				session,
 				forwardIP,
 				forwardPort,
 			)
@@ -412,7 +567,7 @@ func (server *server) forwardTCPIP(
 	}
 }
 
-func forwardLocalConn(ctx context.Context, localConn net.Conn, conn *ssh.ServerConn, forwardIP string, forwardPort uint32) {
+func forwardLocalConn(ctx context.Context, localConn net.Conn, conn *ssh.ServerConn, session *tsa.WorkerSession, forwardIP string, forwardPort uint32) {
 	logger := lagerctx.FromContext(ctx)
 
 	defer localConn.Close()
@@ -458,7 +613,7 @@ func forwardLocalConn(ctx context.Context, localConn net.Conn, conn *ssh.ServerC
 			wait <- struct{}{}
 		}()
 
-		io.Copy(to, from)
+		io.Copy(to, session.CountingReader(from))
 	}
 
 	go pipe(localConn, channel)
@@ -519,6 +674,11 @@ func (server *server) parseRequest(cli string) (request, string, error) {
 		req = deleteWorkerRequest{
 			server: server,
 		}
+	case tsa.RegisterWorkerKey:
+		req = registerWorkerKeyRequest{
+			server:    server,
+			publicKey: strings.Join(args, " "),
+		}
 	case tsa.SweepContainers:
 		req = sweepContainersRequest{
 			server: server,
@@ -1,19 +1,37 @@
 package tsacmd
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
+	"time"
 
 	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/tsa"
 )
 
+// webSocketReadHeaderTimeout bounds how long a connection can take to send
+// the WebSocket upgrade request.
+const webSocketReadHeaderTimeout = 10 * time.Second
+
 type serverRunner struct {
 	logger lager.Logger
 
 	server *server
 
+	workerKeys     *tsa.WorkerKeys
+	workerSessions *tsa.WorkerSessions
+
 	listenAddr string
+
+	// webSocketAddr, if set, is listened on with TLS for workers which cannot
+	// reach the SSH port, tunneling the same SSH connection over WebSocket.
+	webSocketAddr      string
+	webSocketTLSConfig *tls.Config
 }
 
 func (runner serverRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
@@ -22,15 +40,46 @@ func (runner serverRunner) Run(signals <-chan os.Signal, ready chan<- struct{})
 		return fmt.Errorf("failed to listen on %s: %s", runner.listenAddr, err)
 	}
 
+	var webSocketListener *tsa.WebSocketListener
+	var webSocketServer *http.Server
+	if runner.webSocketAddr != "" {
+		httpListener, err := net.Listen("tcp", runner.webSocketAddr)
+		if err != nil {
+			listener.Close()
+			return fmt.Errorf("failed to listen on %s: %s", runner.webSocketAddr, err)
+		}
+
+		httpListener = tls.NewListener(httpListener, runner.webSocketTLSConfig)
+
+		webSocketListener = tsa.NewWebSocketListener(httpListener.Addr())
+		webSocketServer = &http.Server{
+			Handler:           webSocketListener,
+			ReadHeaderTimeout: webSocketReadHeaderTimeout,
+		}
+
+		go webSocketServer.Serve(httpListener)
+		go runner.server.Serve(webSocketListener, tsa.TransportWebSocket)
+
+		runner.logger.Info("listening-for-websocket", lager.Data{
+			"addr": runner.webSocketAddr,
+		})
+	}
+
 	runner.logger.Info("listening")
 
 	close(ready)
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go runner.workerKeys.Run(lagerctx.NewContext(ctx, runner.logger.Session("worker-keys")))
+	go runner.workerSessions.Run(lagerctx.NewContext(ctx, runner.logger.Session("worker-sessions")))
+
 	exited := make(chan struct{})
 
 	go func() {
 		defer close(exited)
-		runner.server.Serve(listener)
+		runner.server.Serve(listener, tsa.TransportSSH)
 	}()
 
 	for {
@@ -39,6 +88,11 @@ func (runner serverRunner) Run(signals <-chan os.Signal, ready chan<- struct{})
 			return nil
 		case <-signals:
 			listener.Close()
+
+			if webSocketServer != nil {
+				webSocketServer.Close()
+				webSocketListener.Close()
+			}
 		}
 	}
 }
@@ -0,0 +1,211 @@
+package tsa
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Transports with which the client can connect to the SSH gateways. Over
+// WebSocket, the SSH connection is carried in binary messages, so the
+// commands and reverse tunnels are the same for both.
+const (
+	TransportSSH       = "ssh"
+	TransportWebSocket = "websocket"
+)
+
+// errWebSocketListenerClosed matches the error returned by a closed
+// net.Listener, which the gateway does not log when it stops.
+var errWebSocketListenerClosed = errors.New("use of closed network connection")
+
+// WebSocketListener is a net.Listener accepting the connections which are
+// upgraded to WebSocket by its ServeHTTP, so that the SSH gateway can serve
+// them like any other connection.
+type WebSocketListener struct {
+	addr net.Addr
+
+	upgrader websocket.Upgrader
+
+	conns     chan net.Conn
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func NewWebSocketListener(addr net.Addr) *WebSocketListener {
+	return &WebSocketListener{
+		addr:   addr,
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+}
+
+func (listener *WebSocketListener) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ws, err := listener.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader has already responded with the error
+		return
+	}
+
+	conn := NewWebSocketConn(ws)
+
+	select {
+	case listener.conns <- conn:
+	case <-listener.closed:
+		conn.Close()
+	}
+}
+
+func (listener *WebSocketListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-listener.conns:
+		return conn, nil
+	case <-listener.closed:
+		return nil, errWebSocketListenerClosed
+	}
+}
+
+func (listener *WebSocketListener) Close() error {
+	listener.closeOnce.Do(func() {
+		close(listener.closed)
+	})
+
+	return nil
+}
+
+func (listener *WebSocketListener) Addr() net.Addr {
+	return listener.addr
+}
+
+type webSocketConn struct {
+	ws *websocket.Conn
+
+	reader io.Reader
+
+	writeLock sync.Mutex
+}
+
+// NewWebSocketConn adapts the WebSocket connection to a net.Conn, reading
+// and writing the stream in binary messages.
+func NewWebSocketConn(ws *websocket.Conn) net.Conn {
+	return &webSocketConn{ws: ws}
+}
+
+func (conn *webSocketConn) Read(p []byte) (int, error) {
+	for {
+		if conn.reader == nil {
+			messageType, reader, err := conn.ws.NextReader()
+			if err != nil {
+				if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+					return 0, io.EOF
+				}
+
+				return 0, err
+			}
+
+			if messageType != websocket.BinaryMessage {
+				continue
+			}
+
+			conn.reader = reader
+		}
+
+		n, err := conn.reader.Read(p)
+		if err == io.EOF {
+			conn.reader = nil
+
+			if n == 0 {
+				continue
+			}
+
+			err = nil
+		}
+
+		return n, err
+	}
+}
+
+func (conn *webSocketConn) Write(p []byte) (int, error) {
+	conn.writeLock.Lock()
+	defer conn.writeLock.Unlock()
+
+	err := conn.ws.WriteMessage(websocket.BinaryMessage, p)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+func (conn *webSocketConn) Close() error {
+	// best effort; the peer may already be gone
+	_ = conn.ws.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+		time.Now().Add(time.Second),
+	)
+
+	return conn.ws.Close()
+}
+
+func (conn *webSocketConn) LocalAddr() net.Addr {
+	return conn.ws.LocalAddr()
+}
+
+func (conn *webSocketConn) RemoteAddr() net.Addr {
+	return conn.ws.RemoteAddr()
+}
+
+func (conn *webSocketConn) SetDeadline(t time.Time) error {
+	err := conn.ws.SetReadDeadline(t)
+	if err != nil {
+		return err
+	}
+
+	return conn.ws.SetWriteDeadline(t)
+}
+
+func (conn *webSocketConn) SetReadDeadline(t time.Time) error {
+	return conn.ws.SetReadDeadline(t)
+}
+
+func (conn *webSocketConn) SetWriteDeadline(t time.Time) error {
+	return conn.ws.SetWriteDeadline(t)
+}
+
+// dialWebSocket connects to the gateway's WebSocket endpoint, going through
+// the proxy configured in the environment, if any. The TCP connection
+// underlying the WebSocket is returned so that its keepalive can be managed.
+func dialWebSocket(ctx context.Context, dialer *net.Dialer, url string, tlsConfig *tls.Config) (net.Conn, *net.TCPConn, error) {
+	var tcpConn *net.TCPConn
+
+	wsDialer := &websocket.Dialer{
+		NetDialContext: func(ctx context.Context, network string, addr string) (net.Conn, error) {
+			conn, err := dialer.DialContext(ctx, network, addr)
+			if err != nil {
+				return nil, err
+			}
+
+			tcpConn = conn.(*net.TCPConn)
+
+			return conn, nil
+		},
+
+		Proxy:            http.ProxyFromEnvironment,
+		TLSClientConfig:  tlsConfig,
+		HandshakeTimeout: dialer.Timeout,
+	}
+
+	ws, _, err := wsDialer.DialContext(ctx, url, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return NewWebSocketConn(ws), tcpConn, nil
+}
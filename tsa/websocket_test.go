@@ -0,0 +1,86 @@
+package tsa_test
+
+import (
+	"io"
+	"net"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/concourse/concourse/tsa"
+	"github.com/gorilla/websocket"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WebSocketListener", func() {
+	var (
+		listener *tsa.WebSocketListener
+		server   *httptest.Server
+
+		clientConn net.Conn
+	)
+
+	BeforeEach(func() {
+		listener = tsa.NewWebSocketListener(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)})
+		server = httptest.NewServer(listener)
+
+		ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		clientConn = tsa.NewWebSocketConn(ws)
+	})
+
+	AfterEach(func() {
+		clientConn.Close()
+		listener.Close()
+		server.Close()
+	})
+
+	It("accepts the upgraded connections as streams", func() {
+		serverConn, err := listener.Accept()
+		Expect(err).NotTo(HaveOccurred())
+
+		defer serverConn.Close()
+
+		_, err = clientConn.Write([]byte("hello "))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = clientConn.Write([]byte("world"))
+		Expect(err).NotTo(HaveOccurred())
+
+		received := make([]byte, 11)
+		_, err = io.ReadFull(serverConn, received)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(received)).To(Equal("hello world"))
+
+		_, err = serverConn.Write([]byte("hi"))
+		Expect(err).NotTo(HaveOccurred())
+
+		short := make([]byte, 1)
+		_, err = io.ReadFull(clientConn, short)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(short)).To(Equal("h"))
+
+		_, err = io.ReadFull(clientConn, short)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(short)).To(Equal("i"))
+	})
+
+	It("reads EOF once the peer closes the connection", func() {
+		serverConn, err := listener.Accept()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(clientConn.Close()).To(Succeed())
+
+		_, err = serverConn.Read(make([]byte, 1))
+		Expect(err).To(Equal(io.EOF))
+	})
+
+	It("stops accepting once closed", func() {
+		Expect(listener.Close()).To(Succeed())
+
+		_, err := listener.Accept()
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,246 @@
+package tsa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"golang.org/x/crypto/ssh"
+)
+
+// WorkerKeys are the team worker keys managed through the ATC API. They are
+// synced on an interval so that keys can be added and rotated without
+// restarting the TSA.
+type WorkerKeys struct {
+	clock    clock.Clock
+	interval time.Duration
+
+	atcEndpointPicker EndpointPicker
+	httpClient        *http.Client
+
+	lock sync.Mutex
+	keys []workerKey
+	uses map[workerKeyID]time.Time
+}
+
+type workerKey struct {
+	atc.WorkerKey
+
+	marshaled []byte
+}
+
+type workerKeyID struct {
+	teamName string
+	name     string
+}
+
+func NewWorkerKeys(
+	clock clock.Clock,
+	interval time.Duration,
+	atcEndpointPicker EndpointPicker,
+	httpClient *http.Client,
+) *WorkerKeys {
+	return &WorkerKeys{
+		clock:    clock,
+		interval: interval,
+
+		atcEndpointPicker: atcEndpointPicker,
+		httpClient:        httpClient,
+
+		uses: map[workerKeyID]time.Time{},
+	}
+}
+
+// Run syncs the keys until the context is done. The last synced keys keep
+// being used while the ATC cannot be reached.
+func (keys *WorkerKeys) Run(ctx context.Context) {
+	logger := lagerctx.FromContext(ctx)
+
+	ticker := keys.clock.NewTicker(keys.interval)
+	defer ticker.Stop()
+
+	for {
+		err := keys.Sync(lagerctx.NewContext(ctx, logger.Session("sync")))
+		if err != nil {
+			logger.Error("failed-to-sync-worker-keys", err)
+		}
+
+		select {
+		case <-ticker.C():
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Sync fetches the current keys and reports when they were last used.
+func (keys *WorkerKeys) Sync(ctx context.Context) error {
+	err := keys.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	return keys.reportUses(ctx)
+}
+
+// Authorize returns the worker key matching the public key, and records that
+// it was used.
+func (keys *WorkerKeys) Authorize(key ssh.PublicKey) (atc.WorkerKey, bool) {
+	keys.lock.Lock()
+	defer keys.lock.Unlock()
+
+	now := keys.clock.Now()
+	marshaled := key.Marshal()
+
+	for _, k := range keys.keys {
+		if k.Expired(now) || !bytes.Equal(k.marshaled, marshaled) {
+			continue
+		}
+
+		keys.uses[workerKeyID{k.TeamName, k.Name}] = now
+
+		return k.WorkerKey, true
+	}
+
+	return atc.WorkerKey{}, false
+}
+
+// Add accepts a key registered by a worker right away, rather than after the
+// next sync, so that the worker can reconnect with it.
+func (keys *WorkerKeys) Add(key atc.WorkerKey) error {
+	parsed, err := parseWorkerKey(key)
+	if err != nil {
+		return err
+	}
+
+	keys.lock.Lock()
+	keys.keys = append(keys.keys, parsed)
+	keys.lock.Unlock()
+
+	return nil
+}
+
+func (keys *WorkerKeys) fetch(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx)
+
+	request, err := keys.atcEndpointPicker.Pick().CreateRequest(atc.ListWorkerKeys, nil, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := keys.httpClient.Do(request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("bad-response (%d)", response.StatusCode)
+	}
+
+	var workerKeys []atc.WorkerKey
+	err = json.NewDecoder(response.Body).Decode(&workerKeys)
+	if err != nil {
+		return err
+	}
+
+	parsed := make([]workerKey, 0, len(workerKeys))
+	for _, k := range workerKeys {
+		key, err := parseWorkerKey(k)
+		if err != nil {
+			logger.Error("failed-to-parse-worker-key", err, lager.Data{"team": k.TeamName, "key": k.Name})
+			continue
+		}
+
+		parsed = append(parsed, key)
+	}
+
+	keys.lock.Lock()
+	keys.keys = parsed
+	keys.lock.Unlock()
+
+	return nil
+}
+
+func (keys *WorkerKeys) reportUses(ctx context.Context) error {
+	keys.lock.Lock()
+	uses := keys.uses
+	keys.uses = map[workerKeyID]time.Time{}
+	keys.lock.Unlock()
+
+	if len(uses) == 0 {
+		return nil
+	}
+
+	err := keys.markUsed(ctx, uses)
+	if err != nil {
+		keys.lock.Lock()
+		for id, usedAt := range uses {
+			if keys.uses[id].Before(usedAt) {
+				keys.uses[id] = usedAt
+			}
+		}
+		keys.lock.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+func (keys *WorkerKeys) markUsed(ctx context.Context, uses map[workerKeyID]time.Time) error {
+	var body []atc.WorkerKeyUse
+	for id, usedAt := range uses {
+		body = append(body, atc.WorkerKeyUse{
+			TeamName: id.teamName,
+			Name:     id.name,
+			UsedAt:   usedAt.Unix(),
+		})
+	}
+
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	request, err := keys.atcEndpointPicker.Pick().CreateRequest(atc.MarkWorkerKeysUsed, nil, bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := keys.httpClient.Do(request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("bad-response (%d)", response.StatusCode)
+	}
+
+	return nil
+}
+
+func parseWorkerKey(key atc.WorkerKey) (workerKey, error) {
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(key.PublicKey))
+	if err != nil {
+		return workerKey{}, err
+	}
+
+	return workerKey{
+		WorkerKey: key,
+		marshaled: publicKey.Marshal(),
+	}, nil
+}
@@ -0,0 +1,202 @@
+package tsa_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/tsa"
+	"github.com/concourse/concourse/tsa/tsafakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/oauth2"
+)
+
+var _ = Describe("WorkerKeys", func() {
+	const (
+		somePublicKey      = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIFdKVCFpynKib6/W5IGS6qXrd/yxmYbgJKdH/zOv51fo"
+		someOtherPublicKey = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIKpWKvUBVBFBliOp07H0SeNoCg6V2FMAmJ15lNJRhm3U"
+	)
+
+	var (
+		ctx       context.Context
+		fakeClock *fakeclock.FakeClock
+		fakeATC   *ghttp.Server
+
+		workerKeys *tsa.WorkerKeys
+
+		someKey      ssh.PublicKey
+		someOtherKey ssh.PublicKey
+	)
+
+	parseKey := func(publicKey string) ssh.PublicKey {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+		Expect(err).NotTo(HaveOccurred())
+		return key
+	}
+
+	listKeys := func(keys ...atc.WorkerKey) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", "/api/v1/worker-keys"),
+			ghttp.VerifyHeaderKV("Authorization", "Bearer yo"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, keys),
+		)
+	}
+
+	BeforeEach(func() {
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+		fakeATC = ghttp.NewServer()
+
+		fakeEndpointPicker := new(tsafakes.FakeEndpointPicker)
+		fakeEndpointPicker.PickReturns(rata.NewRequestGenerator(fakeATC.URL(), atc.Routes))
+
+		token := &oauth2.Token{TokenType: "Bearer", AccessToken: "yo"}
+		httpClient := oauth2.NewClient(oauth2.NoContext, oauth2.StaticTokenSource(token))
+
+		workerKeys = tsa.NewWorkerKeys(fakeClock, time.Minute, fakeEndpointPicker, httpClient)
+
+		someKey = parseKey(somePublicKey)
+		someOtherKey = parseKey(someOtherPublicKey)
+	})
+
+	AfterEach(func() {
+		fakeATC.Close()
+	})
+
+	It("authorizes nothing before syncing", func() {
+		_, found := workerKeys.Authorize(someKey)
+		Expect(found).To(BeFalse())
+	})
+
+	Context("when synced", func() {
+		BeforeEach(func() {
+			fakeATC.AppendHandlers(listKeys(
+				atc.WorkerKey{TeamName: "some-team", Name: "some-key", PublicKey: somePublicKey},
+				atc.WorkerKey{TeamName: "some-other-team", Name: "expiring-key", PublicKey: someOtherPublicKey, ExpiresAt: 1060},
+			))
+
+			Expect(workerKeys.Sync(ctx)).To(Succeed())
+		})
+
+		It("authorizes the keys for their teams", func() {
+			key, found := workerKeys.Authorize(someKey)
+			Expect(found).To(BeTrue())
+			Expect(key.TeamName).To(Equal("some-team"))
+
+			key, found = workerKeys.Authorize(someOtherKey)
+			Expect(found).To(BeTrue())
+			Expect(key.TeamName).To(Equal("some-other-team"))
+		})
+
+		It("stops authorizing keys once they expire", func() {
+			fakeClock.Increment(time.Minute)
+
+			_, found := workerKeys.Authorize(someOtherKey)
+			Expect(found).To(BeFalse())
+		})
+
+		It("stops authorizing keys which are removed", func() {
+			fakeATC.AppendHandlers(listKeys())
+
+			Expect(workerKeys.Sync(ctx)).To(Succeed())
+
+			_, found := workerKeys.Authorize(someKey)
+			Expect(found).To(BeFalse())
+		})
+
+		It("reports when the keys were used on the next sync", func() {
+			workerKeys.Authorize(someKey)
+			fakeClock.Increment(time.Second)
+			workerKeys.Authorize(someKey)
+
+			var uses []atc.WorkerKeyUse
+			fakeATC.AppendHandlers(
+				listKeys(atc.WorkerKey{TeamName: "some-team", Name: "some-key", PublicKey: somePublicKey}),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/worker-keys/used"),
+					func(w http.ResponseWriter, r *http.Request) {
+						Expect(json.NewDecoder(r.Body).Decode(&uses)).To(Succeed())
+					},
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			Expect(workerKeys.Sync(ctx)).To(Succeed())
+			Expect(uses).To(Equal([]atc.WorkerKeyUse{
+				{TeamName: "some-team", Name: "some-key", UsedAt: 1001},
+			}))
+
+			By("not reporting them again")
+			fakeATC.AppendHandlers(listKeys())
+			Expect(workerKeys.Sync(ctx)).To(Succeed())
+			Expect(fakeATC.ReceivedRequests()).To(HaveLen(4))
+		})
+
+		It("reports the uses again when reporting fails", func() {
+			workerKeys.Authorize(someKey)
+
+			fakeATC.AppendHandlers(
+				listKeys(atc.WorkerKey{TeamName: "some-team", Name: "some-key", PublicKey: somePublicKey}),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/worker-keys/used"),
+					ghttp.RespondWith(http.StatusInternalServerError, nil),
+				),
+				listKeys(atc.WorkerKey{TeamName: "some-team", Name: "some-key", PublicKey: somePublicKey}),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/worker-keys/used"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+
+			Expect(workerKeys.Sync(ctx)).To(MatchError(ContainSubstring("500")))
+			Expect(workerKeys.Sync(ctx)).To(Succeed())
+			Expect(fakeATC.ReceivedRequests()).To(HaveLen(5))
+		})
+
+		Context("when the ATC cannot be reached", func() {
+			BeforeEach(func() {
+				fakeATC.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/worker-keys"),
+					ghttp.RespondWith(http.StatusInternalServerError, nil),
+				))
+			})
+
+			It("keeps authorizing the last synced keys", func() {
+				Expect(workerKeys.Sync(ctx)).To(HaveOccurred())
+
+				key, found := workerKeys.Authorize(someKey)
+				Expect(found).To(BeTrue())
+				Expect(key.TeamName).To(Equal("some-team"))
+			})
+		})
+	})
+
+	Context("when a worker registers its key", func() {
+		BeforeEach(func() {
+			err := workerKeys.Add(atc.WorkerKey{
+				TeamName:   "some-team",
+				Name:       "some-worker",
+				PublicKey:  somePublicKey,
+				WorkerName: "some-worker",
+				Tags:       []string{"some-tag"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("authorizes the key for the worker before the next sync", func() {
+			key, found := workerKeys.Authorize(someKey)
+			Expect(found).To(BeTrue())
+			Expect(key.WorkerName).To(Equal("some-worker"))
+			Expect(key.Tags).To(Equal([]string{"some-tag"}))
+		})
+	})
+})
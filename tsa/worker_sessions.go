@@ -0,0 +1,267 @@
+package tsa
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+)
+
+func (status HeartbeatStatus) String() string {
+	switch status {
+	case HeartbeatStatusHealthy:
+		return "healthy"
+	case HeartbeatStatusLanded:
+		return "landed"
+	case HeartbeatStatusGoneAway:
+		return "gone-away"
+	default:
+		return "unhealthy"
+	}
+}
+
+// WorkerSessions are the connections currently served by this TSA. They are
+// reported to the ATC on an interval so that the sessions of every web node
+// can be listed together.
+type WorkerSessions struct {
+	clock    clock.Clock
+	interval time.Duration
+	tsa      string
+
+	atcEndpointPicker EndpointPicker
+	httpClient        *http.Client
+
+	lock     sync.Mutex
+	sessions map[string]*WorkerSession
+}
+
+func NewWorkerSessions(
+	clock clock.Clock,
+	interval time.Duration,
+	tsa string,
+	atcEndpointPicker EndpointPicker,
+	httpClient *http.Client,
+) *WorkerSessions {
+	return &WorkerSessions{
+		clock:    clock,
+		interval: interval,
+		tsa:      tsa,
+
+		atcEndpointPicker: atcEndpointPicker,
+		httpClient:        httpClient,
+
+		sessions: map[string]*WorkerSession{},
+	}
+}
+
+// Open starts tracking a connection, until it is closed.
+func (sessions *WorkerSessions) Open(id string, remoteAddr string, transport string) *WorkerSession {
+	session := &WorkerSession{
+		clock: sessions.clock,
+		session: atc.WorkerSession{
+			ID:          id,
+			TSA:         sessions.tsa,
+			RemoteAddr:  remoteAddr,
+			Transport:   transport,
+			ConnectedAt: sessions.clock.Now().Unix(),
+		},
+		lastKeepalive: sessions.clock.Now(),
+	}
+
+	sessions.lock.Lock()
+	sessions.sessions[id] = session
+	sessions.lock.Unlock()
+
+	return session
+}
+
+func (sessions *WorkerSessions) Close(id string) {
+	sessions.lock.Lock()
+	delete(sessions.sessions, id)
+	sessions.lock.Unlock()
+}
+
+// Snapshot returns the sessions as they are now, oldest first.
+func (sessions *WorkerSessions) Snapshot() []atc.WorkerSession {
+	sessions.lock.Lock()
+	snapshot := make([]atc.WorkerSession, 0, len(sessions.sessions))
+	for _, session := range sessions.sessions {
+		snapshot = append(snapshot, session.snapshot())
+	}
+	sessions.lock.Unlock()
+
+	sort.Slice(snapshot, func(i, j int) bool {
+		if snapshot[i].ConnectedAt == snapshot[j].ConnectedAt {
+			return snapshot[i].ID < snapshot[j].ID
+		}
+
+		return snapshot[i].ConnectedAt < snapshot[j].ConnectedAt
+	})
+
+	return snapshot
+}
+
+// Run reports the sessions until the context is done.
+func (sessions *WorkerSessions) Run(ctx context.Context) {
+	logger := lagerctx.FromContext(ctx)
+
+	ticker := sessions.clock.NewTicker(sessions.interval)
+	defer ticker.Stop()
+
+	for {
+		err := sessions.Report(ctx)
+		if err != nil {
+			logger.Error("failed-to-report-worker-sessions", err)
+		}
+
+		select {
+		case <-ticker.C():
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Report sends the current sessions to the ATC, replacing the ones reported
+// before. They expire if the TSA stops reporting, e.g. because it went away.
+func (sessions *WorkerSessions) Report(ctx context.Context) error {
+	payload, err := json.Marshal(atc.WorkerSessionsReport{
+		TSA:      sessions.tsa,
+		Sessions: sessions.Snapshot(),
+	})
+	if err != nil {
+		return err
+	}
+
+	request, err := sessions.atcEndpointPicker.Pick().CreateRequest(atc.ReportWorkerSessions, nil, bytes.NewBuffer(payload))
+	if err != nil {
+		return err
+	}
+
+	request.URL.RawQuery = url.Values{
+		"ttl": []string{(sessions.interval * 2).String()},
+	}.Encode()
+
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := sessions.httpClient.Do(request.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("bad-response (%d)", response.StatusCode)
+	}
+
+	return nil
+}
+
+// WorkerSession records what happens on a connection. Its methods can be
+// called from any of the connection's goroutines.
+type WorkerSession struct {
+	// accessed atomically; first for alignment on 32-bit platforms
+	bytesProxied int64
+
+	clock clock.Clock
+
+	lock    sync.Mutex
+	session atc.WorkerSession
+
+	// lastKeepalive is when the last keepalive arrived, or when the session
+	// was opened before the first one.
+	lastKeepalive time.Time
+}
+
+func (session *WorkerSession) Registering(workerName string, teamName string) {
+	session.lock.Lock()
+	session.session.WorkerName = workerName
+	session.session.TeamName = teamName
+	session.lock.Unlock()
+}
+
+func (session *WorkerSession) Forwarded(bindAddr string, boundPort uint32) {
+	session.lock.Lock()
+	session.session.ForwardedPorts = append(session.session.ForwardedPorts, atc.WorkerSessionForward{
+		BindAddr:  bindAddr,
+		BoundPort: boundPort,
+	})
+	session.lock.Unlock()
+}
+
+func (session *WorkerSession) Heartbeated(status HeartbeatStatus) {
+	session.lock.Lock()
+	session.session.LastHeartbeatAt = session.clock.Now().Unix()
+	session.session.LastHeartbeatStatus = status.String()
+	session.lock.Unlock()
+}
+
+// KeptAlive records a keepalive request from the client, counting the ones
+// it missed since the previous keepalive.
+func (session *WorkerSession) KeptAlive() {
+	now := session.clock.Now()
+
+	session.lock.Lock()
+	session.session.MissedKeepalives += missedKeepalives(now.Sub(session.lastKeepalive))
+	session.session.LastKeepaliveAt = now.Unix()
+	session.lastKeepalive = now
+	session.lock.Unlock()
+}
+
+// Proxied counts the bytes copied through the forwarded ports.
+func (session *WorkerSession) Proxied(n int64) {
+	atomic.AddInt64(&session.bytesProxied, n)
+}
+
+// CountingReader counts the bytes read from the reader as proxied.
+func (session *WorkerSession) CountingReader(reader io.Reader) io.Reader {
+	return proxiedReader{reader: reader, session: session}
+}
+
+func (session *WorkerSession) snapshot() atc.WorkerSession {
+	now := session.clock.Now()
+
+	session.lock.Lock()
+	snapshot := session.session
+	snapshot.ForwardedPorts = append([]atc.WorkerSessionForward(nil), session.session.ForwardedPorts...)
+	snapshot.MissedKeepalives += missedKeepalives(now.Sub(session.lastKeepalive))
+	session.lock.Unlock()
+
+	snapshot.BytesProxied = atomic.LoadInt64(&session.bytesProxied)
+
+	return snapshot
+}
+
+// missedKeepalives is how many keepalives should have arrived in the time
+// since the last one, beyond the one which is due.
+func missedKeepalives(since time.Duration) int {
+	missed := int(since/KeepAliveInterval) - 1
+	if missed < 0 {
+		return 0
+	}
+
+	return missed
+}
+
+type proxiedReader struct {
+	reader  io.Reader
+	session *WorkerSession
+}
+
+func (reader proxiedReader) Read(p []byte) (int, error) {
+	n, err := reader.reader.Read(p)
+	reader.session.Proxied(int64(n))
+	return n, err
+}
@@ -0,0 +1,175 @@
+package tsa_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/tsa"
+	"github.com/concourse/concourse/tsa/tsafakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+	"golang.org/x/oauth2"
+)
+
+var _ = Describe("WorkerSessions", func() {
+	var (
+		ctx       context.Context
+		fakeClock *fakeclock.FakeClock
+		fakeATC   *ghttp.Server
+
+		workerSessions *tsa.WorkerSessions
+	)
+
+	BeforeEach(func() {
+		ctx = lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+		fakeATC = ghttp.NewServer()
+
+		fakeEndpointPicker := new(tsafakes.FakeEndpointPicker)
+		fakeEndpointPicker.PickReturns(rata.NewRequestGenerator(fakeATC.URL(), atc.Routes))
+
+		token := &oauth2.Token{TokenType: "Bearer", AccessToken: "yo"}
+		httpClient := oauth2.NewClient(oauth2.NoContext, oauth2.StaticTokenSource(token))
+
+		workerSessions = tsa.NewWorkerSessions(fakeClock, 10*time.Second, "some-tsa:2222", fakeEndpointPicker, httpClient)
+	})
+
+	AfterEach(func() {
+		fakeATC.Close()
+	})
+
+	It("records what happens on each session", func() {
+		session := workerSessions.Open("some-session", "1.2.3.4:5678", tsa.TransportWebSocket)
+
+		fakeClock.Increment(time.Second)
+		session.Registering("some-worker", "some-team")
+		session.Forwarded("0.0.0.0:7777", 40000)
+		session.Heartbeated(tsa.HeartbeatStatusHealthy)
+		session.KeptAlive()
+
+		_, err := ioutil.ReadAll(session.CountingReader(strings.NewReader("some-bytes")))
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(workerSessions.Snapshot()).To(Equal([]atc.WorkerSession{
+			{
+				ID:          "some-session",
+				TSA:         "some-tsa:2222",
+				WorkerName:  "some-worker",
+				TeamName:    "some-team",
+				RemoteAddr:  "1.2.3.4:5678",
+				Transport:   "websocket",
+				ConnectedAt: 1000,
+				ForwardedPorts: []atc.WorkerSessionForward{
+					{BindAddr: "0.0.0.0:7777", BoundPort: 40000},
+				},
+				LastHeartbeatAt:     1001,
+				LastHeartbeatStatus: "healthy",
+				BytesProxied:        10,
+				LastKeepaliveAt:     1001,
+			},
+		}))
+	})
+
+	Describe("missed keepalives", func() {
+		var session *tsa.WorkerSession
+
+		BeforeEach(func() {
+			session = workerSessions.Open("some-session", "1.2.3.4:5678", tsa.TransportSSH)
+			session.KeptAlive()
+		})
+
+		It("counts none while the client keeps alive on time", func() {
+			for i := 0; i < 5; i++ {
+				fakeClock.Increment(tsa.KeepAliveInterval + time.Second)
+				session.KeptAlive()
+			}
+
+			Expect(workerSessions.Snapshot()[0].MissedKeepalives).To(Equal(0))
+		})
+
+		Context("when the client stops sending keepalives", func() {
+			BeforeEach(func() {
+				fakeClock.Increment(3 * tsa.KeepAliveInterval)
+			})
+
+			It("counts the keepalives which are overdue", func() {
+				Expect(workerSessions.Snapshot()[0].MissedKeepalives).To(Equal(2))
+
+				fakeClock.Increment(2 * tsa.KeepAliveInterval)
+				Expect(workerSessions.Snapshot()[0].MissedKeepalives).To(Equal(4))
+			})
+
+			It("keeps counting them once the client resumes", func() {
+				session.KeptAlive()
+
+				fakeClock.Increment(tsa.KeepAliveInterval)
+				session.KeptAlive()
+
+				snapshot := workerSessions.Snapshot()[0]
+				Expect(snapshot.MissedKeepalives).To(Equal(2))
+				Expect(snapshot.LastKeepaliveAt).To(Equal(int64(1020)))
+			})
+		})
+	})
+
+	It("lists the sessions oldest first until they are closed", func() {
+		workerSessions.Open("some-session", "1.2.3.4:5678", tsa.TransportSSH)
+		fakeClock.Increment(time.Second)
+		workerSessions.Open("some-other-session", "1.2.3.5:5678", tsa.TransportSSH)
+
+		snapshot := workerSessions.Snapshot()
+		Expect(snapshot).To(HaveLen(2))
+		Expect(snapshot[0].ID).To(Equal("some-session"))
+		Expect(snapshot[1].ID).To(Equal("some-other-session"))
+
+		workerSessions.Close("some-session")
+
+		snapshot = workerSessions.Snapshot()
+		Expect(snapshot).To(HaveLen(1))
+		Expect(snapshot[0].ID).To(Equal("some-other-session"))
+	})
+
+	Describe("Report", func() {
+		BeforeEach(func() {
+			workerSessions.Open("some-session", "1.2.3.4:5678", tsa.TransportSSH)
+		})
+
+		It("sends the sessions to the ATC with a ttl of two intervals", func() {
+			fakeATC.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/worker-sessions", "ttl=20s"),
+				ghttp.VerifyHeaderKV("Authorization", "Bearer yo"),
+				ghttp.VerifyJSONRepresenting(atc.WorkerSessionsReport{
+					TSA: "some-tsa:2222",
+					Sessions: []atc.WorkerSession{
+						{
+							ID:          "some-session",
+							TSA:         "some-tsa:2222",
+							RemoteAddr:  "1.2.3.4:5678",
+							Transport:   "ssh",
+							ConnectedAt: 1000,
+						},
+					},
+				}),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			))
+
+			Expect(workerSessions.Report(ctx)).To(Succeed())
+			Expect(fakeATC.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("errors when the ATC rejects the report", func() {
+			fakeATC.AppendHandlers(ghttp.RespondWith(http.StatusForbidden, nil))
+
+			Expect(workerSessions.Report(ctx)).To(MatchError("bad-response (403)"))
+		})
+	})
+})
@@ -9,18 +9,28 @@ type TrackedVarsIterator interface {
 	YieldCred(string, string)
 }
 
+type AccessedVarsIterator interface {
+	YieldAccessedVar(Reference)
+}
+
 type Tracker struct {
 	Enabled bool
 
+	// OnAccess, if set, is called with each var the first time it is
+	// accessed.
+	OnAccess func(Reference)
+
 	// Considering in-parallel steps, a lock is need.
 	lock              sync.RWMutex
 	interpolatedCreds map[string]string
+	accessedVars      map[string]Reference
 }
 
 func NewTracker(on bool) *Tracker {
 	return &Tracker{
 		Enabled:           on,
 		interpolatedCreds: map[string]string{},
+		accessedVars:      map[string]Reference{},
 	}
 }
 
@@ -68,6 +78,29 @@ func (t *Tracker) IterateInterpolatedCreds(iter TrackedVarsIterator) {
 	t.lock.RUnlock()
 }
 
+// TrackAccess records that the var was resolved. Unlike Track, it is done
+// even when redaction is disabled, and the value is never kept.
+func (t *Tracker) TrackAccess(varRef Reference) {
+	key := varRef.String()
+
+	t.lock.Lock()
+	_, accessed := t.accessedVars[key]
+	t.accessedVars[key] = varRef
+	t.lock.Unlock()
+
+	if !accessed && t.OnAccess != nil {
+		t.OnAccess(varRef)
+	}
+}
+
+func (t *Tracker) IterateAccessedVars(iter AccessedVarsIterator) {
+	t.lock.RLock()
+	for _, ref := range t.accessedVars {
+		iter.YieldAccessedVar(ref)
+	}
+	t.lock.RUnlock()
+}
+
 type CredVarsTracker struct {
 	*Tracker
 	CredVars Variables
@@ -77,6 +110,7 @@ func (t *CredVarsTracker) Get(ref Reference) (interface{}, bool, error) {
 	val, found, err := t.CredVars.Get(ref)
 	if found {
 		t.Tracker.Track(ref, val)
+		t.Tracker.TrackAccess(ref)
 	}
 	return val, found, err
 }
@@ -95,3 +129,10 @@ func (it TrackedVarsMap) YieldCred(k, v string) {
 		it[k] = v
 	}
 }
+
+// AccessedVarsList is an AccessedVarsIterator which collects the accessed vars.
+type AccessedVarsList []Reference
+
+func (it *AccessedVarsList) YieldAccessedVar(ref Reference) {
+	*it = append(*it, ref)
+}
@@ -9,6 +9,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 )
 
@@ -28,6 +29,9 @@ type Beacon struct {
 	LocalBaggageclaimNetwork string
 	LocalBaggageclaimAddr    string
 
+	// Resources, if set, returns the resources to report with each heartbeat.
+	Resources func() (atc.WorkerResources, bool)
+
 	drained int32
 }
 
@@ -203,6 +207,8 @@ func (beacon *Beacon) registerWorker(
 			HeartbeatedFunc: func() {
 				logger.Debug("heartbeated")
 			},
+
+			ResourcesFunc: beacon.Resources,
 		})
 
 		once.Do(func() { close(registeredOrFailed) })
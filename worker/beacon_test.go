@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 	"github.com/concourse/concourse/worker"
 	"github.com/concourse/concourse/worker/workerfakes"
@@ -47,6 +48,10 @@ var _ = Describe("Beacon", func() {
 
 			LocalBaggageclaimNetwork: "some-baggageclaim-network",
 			LocalBaggageclaimAddr:    "some-baggageclaim-addr",
+
+			Resources: func() (atc.WorkerResources, bool) {
+				return atc.WorkerResources{MemoryFree: 1024}, true
+			},
 		}
 	})
 
@@ -63,6 +68,16 @@ var _ = Describe("Beacon", func() {
 		Expect(opts.LocalBaggageclaimAddr).To(Equal(beacon.LocalBaggageclaimAddr))
 	})
 
+	It("reports its resources with each heartbeat", func() {
+		Eventually(fakeClient.RegisterCallCount).Should(Equal(1))
+		_, opts := fakeClient.RegisterArgsForCall(0)
+		Expect(opts.ResourcesFunc).ToNot(BeNil())
+
+		resources, ok := opts.ResourcesFunc()
+		Expect(ok).To(BeTrue())
+		Expect(resources).To(Equal(atc.WorkerResources{MemoryFree: 1024}))
+	})
+
 	Context("during registration", func() {
 		BeforeEach(func() {
 			fakeClient.RegisterStub = func(ctx context.Context, opts tsa.RegisterOptions) error {
@@ -0,0 +1,19 @@
+package worker
+
+import "sync/atomic"
+
+// EgressViolations counts the packets denied by the egress policies of the
+// worker's containers since the worker started.
+type EgressViolations struct {
+	packets uint64
+}
+
+// Add records packets denied by the egress policy of a container.
+func (violations *EgressViolations) Add(packets uint64) {
+	atomic.AddUint64(&violations.packets, packets)
+}
+
+// Packets returns the number of packets denied so far.
+func (violations *EgressViolations) Packets() uint64 {
+	return atomic.LoadUint64(&violations.packets)
+}
@@ -2,26 +2,40 @@ package worker
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"time"
 
 	"code.cloudfoundry.org/lager"
 )
 
+// PressureSource returns the resources the worker is currently under
+// pressure on.
+type PressureSource func() []string
+
 type healthChecker struct {
 	client           *http.Client
 	baggageclaimAddr string
 	gardenAddr       string
 	timeout          time.Duration
+	pressure         PressureSource
 	logger           lager.Logger
 }
 
-func NewHealthChecker(logger lager.Logger, baggageclaimAddr, gardenAddr string, checkTimeout time.Duration) healthChecker {
+// HealthStatus is the body of a successful health check. A degraded worker
+// is still healthy, as it only needs time for its pressure to clear.
+type HealthStatus struct {
+	State    string   `json:"state"`
+	Pressure []string `json:"pressure,omitempty"`
+}
+
+func NewHealthChecker(logger lager.Logger, baggageclaimAddr, gardenAddr string, checkTimeout time.Duration, pressure PressureSource) healthChecker {
 	return healthChecker{
 		logger:           logger,
 		baggageclaimAddr: baggageclaimAddr,
 		gardenAddr:       gardenAddr,
 		timeout:          checkTimeout,
+		pressure:         pressure,
 	}
 }
 
@@ -62,5 +76,19 @@ func (h *healthChecker) CheckHealth(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
-	return
+	status := HealthStatus{State: "running"}
+	if h.pressure != nil {
+		status.Pressure = h.pressure()
+	}
+
+	if len(status.Pressure) > 0 {
+		status.State = "degraded"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(status)
+	if err != nil {
+		h.logger.Error("failed-to-encode-health-status", err)
+	}
 }
@@ -1,6 +1,7 @@
 package worker_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"time"
@@ -19,15 +20,19 @@ var _ = Describe("CheckHealth", func() {
 		garden        *ghttp.Server
 		baggageclaim  *ghttp.Server
 
+		pressure []string
+
 		testLogger = lagertest.NewTestLogger("healthchecker")
 	)
 
 	BeforeEach(func() {
 		garden = ghttp.NewServer()
 		baggageclaim = ghttp.NewServer()
+		pressure = nil
 
 		hc := NewHealthChecker(testLogger,
-			"http://"+baggageclaim.Addr(), "http://"+garden.Addr(), 100*time.Millisecond)
+			"http://"+baggageclaim.Addr(), "http://"+garden.Addr(), 100*time.Millisecond,
+			func() []string { return pressure })
 
 		healthchecker = httptest.NewServer(
 			http.HandlerFunc(hc.CheckHealth))
@@ -111,6 +116,31 @@ var _ = Describe("CheckHealth", func() {
 			It("returns 200", func() {
 				Expect(resp.StatusCode).To(Equal(200))
 			})
+
+			It("reports the worker as running", func() {
+				var status HealthStatus
+				Expect(json.NewDecoder(resp.Body).Decode(&status)).To(Succeed())
+				Expect(status).To(Equal(HealthStatus{State: "running"}))
+			})
+
+			Context("when the worker is under pressure", func() {
+				BeforeEach(func() {
+					pressure = []string{"disk", "inodes"}
+				})
+
+				It("still returns 200", func() {
+					Expect(resp.StatusCode).To(Equal(200))
+				})
+
+				It("reports the worker as degraded", func() {
+					var status HealthStatus
+					Expect(json.NewDecoder(resp.Body).Decode(&status)).To(Succeed())
+					Expect(status).To(Equal(HealthStatus{
+						State:    "degraded",
+						Pressure: []string{"disk", "inodes"},
+					}))
+				})
+			})
 		})
 	})
 })
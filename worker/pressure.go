@@ -0,0 +1,47 @@
+package worker
+
+import (
+	"github.com/concourse/concourse/atc"
+)
+
+const (
+	PressureMemory = "memory"
+	PressureDisk   = "disk"
+	PressureInodes = "inodes"
+)
+
+// PressureThresholds are the minimum percentages of free memory, disk space
+// and inodes below which the worker considers itself under pressure. A
+// threshold of 0 disables the check.
+type PressureThresholds struct {
+	Memory float64
+	Disk   float64
+	Inodes float64
+}
+
+// Pressure returns the resources which are below their threshold.
+func (thresholds PressureThresholds) Pressure(resources atc.WorkerResources) []string {
+	var pressure []string
+
+	if below(thresholds.Memory, resources.MemoryFree, resources.MemoryTotal) {
+		pressure = append(pressure, PressureMemory)
+	}
+
+	if below(thresholds.Disk, resources.DiskFree, resources.DiskTotal) {
+		pressure = append(pressure, PressureDisk)
+	}
+
+	if below(thresholds.Inodes, resources.InodesFree, resources.InodesTotal) {
+		pressure = append(pressure, PressureInodes)
+	}
+
+	return pressure
+}
+
+func below(threshold float64, free uint64, total uint64) bool {
+	if threshold <= 0 || total == 0 {
+		return false
+	}
+
+	return float64(free)/float64(total)*100 < threshold
+}
@@ -0,0 +1,36 @@
+package worker_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PressureThresholds", func() {
+	resources := atc.WorkerResources{
+		MemoryFree:  100,
+		MemoryTotal: 1000,
+		DiskFree:    50,
+		DiskTotal:   1000,
+		InodesFree:  200,
+		InodesTotal: 1000,
+	}
+
+	DescribeTable("Pressure",
+		func(thresholds worker.PressureThresholds, expected []string) {
+			Expect(thresholds.Pressure(resources)).To(Equal(expected))
+		},
+		Entry("with no thresholds", worker.PressureThresholds{}, nil),
+		Entry("with thresholds below the free percentages", worker.PressureThresholds{Memory: 5, Disk: 5, Inodes: 5}, nil),
+		Entry("with a memory threshold above free memory", worker.PressureThresholds{Memory: 20}, []string{"memory"}),
+		Entry("with every threshold exceeded", worker.PressureThresholds{Memory: 20, Disk: 10, Inodes: 30}, []string{"memory", "disk", "inodes"}),
+	)
+
+	It("ignores resources whose total is unknown", func() {
+		thresholds := worker.PressureThresholds{Inodes: 30}
+		Expect(thresholds.Pressure(atc.WorkerResources{})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,120 @@
+package worker
+
+import (
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+)
+
+// ResourceCollector measures the headroom of the worker.
+type ResourceCollector func() (atc.WorkerResources, error)
+
+// ResourceReporter is an ifrit.Runner that periodically collects the
+// worker's available memory, CPU load and disk space. The beacon reports the
+// latest collection with each heartbeat so that they can be taken into
+// account during container placement.
+//
+// Any resources below the configured thresholds are reported as pressure,
+// which causes the ATC to degrade the worker until the pressure clears.
+type ResourceReporter struct {
+	logger     lager.Logger
+	interval   time.Duration
+	collect    ResourceCollector
+	thresholds PressureThresholds
+
+	resourcesL sync.Mutex
+	resources  *atc.WorkerResources
+
+	pressureL sync.Mutex
+	pressure  []string
+}
+
+func NewResourceReporter(
+	logger lager.Logger,
+	reportInterval time.Duration,
+	collect ResourceCollector,
+	thresholds PressureThresholds,
+) *ResourceReporter {
+	return &ResourceReporter{
+		logger:     logger,
+		interval:   reportInterval,
+		collect:    collect,
+		thresholds: thresholds,
+	}
+}
+
+// Resources returns the resources as of the last collection. It returns false
+// if they have not been collected yet.
+func (reporter *ResourceReporter) Resources() (atc.WorkerResources, bool) {
+	reporter.resourcesL.Lock()
+	defer reporter.resourcesL.Unlock()
+
+	if reporter.resources == nil {
+		return atc.WorkerResources{}, false
+	}
+
+	return *reporter.resources, true
+}
+
+// Pressure returns the resources which were under pressure as of the last
+// collection.
+func (reporter *ResourceReporter) Pressure() []string {
+	reporter.pressureL.Lock()
+	defer reporter.pressureL.Unlock()
+
+	return reporter.pressure
+}
+
+func (reporter *ResourceReporter) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	timer := time.NewTicker(reporter.interval)
+	defer timer.Stop()
+
+	close(ready)
+
+	for {
+		select {
+		case <-timer.C:
+			reporter.report(reporter.logger.Session("tick"))
+
+		case sig := <-signals:
+			reporter.logger.Info("report-cancelled-by-signal", lager.Data{"signal": sig})
+			return nil
+		}
+	}
+}
+
+func (reporter *ResourceReporter) report(logger lager.Logger) {
+	resources, err := reporter.collect()
+	if err != nil {
+		logger.Error("failed-to-collect-resources", err)
+		return
+	}
+
+	resources.Pressure = reporter.thresholds.Pressure(resources)
+	reporter.setPressure(logger, resources.Pressure)
+
+	reporter.resourcesL.Lock()
+	reporter.resources = &resources
+	reporter.resourcesL.Unlock()
+}
+
+func (reporter *ResourceReporter) setPressure(logger lager.Logger, pressure []string) {
+	reporter.pressureL.Lock()
+	defer reporter.pressureL.Unlock()
+
+	if reflect.DeepEqual(reporter.pressure, pressure) {
+		return
+	}
+
+	if len(pressure) > 0 {
+		logger.Info("under-pressure", lager.Data{"pressure": pressure})
+	} else {
+		logger.Info("pressure-cleared")
+	}
+
+	reporter.pressure = pressure
+}
@@ -0,0 +1,105 @@
+package worker_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resource Reporter", func() {
+	const reportInterval = 10 * time.Millisecond
+
+	var (
+		testLogger = lagertest.NewTestLogger("resource-reporter")
+
+		collectErr error
+		resources  atc.WorkerResources
+		thresholds worker.PressureThresholds
+		reporter   *worker.ResourceReporter
+
+		osSignal chan os.Signal
+		exited   chan struct{}
+	)
+
+	BeforeEach(func() {
+		resources = atc.WorkerResources{
+			MemoryFree:  1024,
+			MemoryTotal: 4096,
+			CPULoad:     0.5,
+			DiskFree:    2048,
+			DiskTotal:   8192,
+		}
+		collectErr = nil
+		thresholds = worker.PressureThresholds{}
+
+		osSignal = make(chan os.Signal)
+		exited = make(chan struct{})
+	})
+
+	JustBeforeEach(func() {
+		reporter = worker.NewResourceReporter(testLogger, reportInterval, func() (atc.WorkerResources, error) {
+			return resources, collectErr
+		}, thresholds)
+
+		go func() {
+			_ = reporter.Run(osSignal, make(chan struct{}))
+			close(exited)
+		}()
+	})
+
+	AfterEach(func() {
+		close(osSignal)
+		<-exited
+	})
+
+	It("periodically collects the resources", func() {
+		Eventually(func() bool {
+			_, collected := reporter.Resources()
+			return collected
+		}).Should(BeTrue())
+
+		collected, _ := reporter.Resources()
+		Expect(collected).To(Equal(resources))
+		Expect(reporter.Pressure()).To(BeEmpty())
+	})
+
+	Context("when resources are below the pressure thresholds", func() {
+		BeforeEach(func() {
+			resources.InodesFree = 10
+			resources.InodesTotal = 1000
+
+			thresholds = worker.PressureThresholds{
+				Memory: 10,
+				Disk:   30,
+				Inodes: 5,
+			}
+		})
+
+		It("includes the pressure in the resources", func() {
+			Eventually(reporter.Pressure).Should(Equal([]string{"disk", "inodes"}))
+
+			collected, _ := reporter.Resources()
+			Expect(collected.Pressure).To(Equal([]string{"disk", "inodes"}))
+		})
+	})
+
+	Context("when collecting the resources fails", func() {
+		BeforeEach(func() {
+			collectErr = errors.New("nope")
+		})
+
+		It("has no resources to report", func() {
+			Consistently(func() bool {
+				_, collected := reporter.Resources()
+				return collected
+			}, 5*reportInterval).Should(BeFalse())
+		})
+	})
+})
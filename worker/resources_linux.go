@@ -0,0 +1,117 @@
+package worker
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// NewResourceCollector returns a collector which reads the memory and load
+// of the host from /proc and the disk space and inodes of the given work
+// dir, along with the packets denied by egress policies so far.
+func NewResourceCollector(workDir string, violations *EgressViolations) ResourceCollector {
+	return func() (atc.WorkerResources, error) {
+		var resources atc.WorkerResources
+
+		memFree, memTotal, err := readMeminfo("/proc/meminfo")
+		if err != nil {
+			return atc.WorkerResources{}, fmt.Errorf("read meminfo: %w", err)
+		}
+
+		resources.MemoryFree = memFree
+		resources.MemoryTotal = memTotal
+
+		load, err := readLoadavg("/proc/loadavg")
+		if err != nil {
+			return atc.WorkerResources{}, fmt.Errorf("read loadavg: %w", err)
+		}
+
+		resources.CPULoad = load / float64(runtime.NumCPU())
+
+		var stat syscall.Statfs_t
+		err = syscall.Statfs(workDir, &stat)
+		if err != nil {
+			return atc.WorkerResources{}, fmt.Errorf("statfs work dir: %w", err)
+		}
+
+		resources.DiskFree = stat.Bavail * uint64(stat.Bsize)
+		resources.DiskTotal = stat.Blocks * uint64(stat.Bsize)
+		resources.InodesFree = stat.Ffree
+		resources.InodesTotal = stat.Files
+
+		resources.EgressPacketsDenied = violations.Packets()
+
+		return resources, nil
+	}
+}
+
+func readMeminfo(path string) (uint64, uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer file.Close()
+
+	var free, total uint64
+	var foundFree, foundTotal bool
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		var dest *uint64
+		switch fields[0] {
+		case "MemAvailable:":
+			dest = &free
+			foundFree = true
+		case "MemTotal:":
+			dest = &total
+			foundTotal = true
+		default:
+			continue
+		}
+
+		kb, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		*dest = kb * 1024
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !foundFree || !foundTotal {
+		return 0, 0, fmt.Errorf("MemAvailable or MemTotal missing from %s", path)
+	}
+
+	return free, total, nil
+}
+
+func readLoadavg(path string) (float64, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	fields := strings.Fields(string(contents))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty %s", path)
+	}
+
+	return strconv.ParseFloat(fields[0], 64)
+}
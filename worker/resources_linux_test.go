@@ -0,0 +1,41 @@
+package worker_test
+
+import (
+	"io/ioutil"
+
+	"github.com/concourse/concourse/worker"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewResourceCollector", func() {
+	It("collects the resources of the host", func() {
+		workDir, err := ioutil.TempDir("", "work-dir")
+		Expect(err).ToNot(HaveOccurred())
+
+		resources, err := worker.NewResourceCollector(workDir, new(worker.EgressViolations))()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(resources.MemoryTotal).To(BeNumerically(">", 0))
+		Expect(resources.MemoryFree).To(BeNumerically("<=", resources.MemoryTotal))
+		Expect(resources.CPULoad).To(BeNumerically(">=", 0))
+		Expect(resources.DiskTotal).To(BeNumerically(">", 0))
+		Expect(resources.DiskFree).To(BeNumerically("<=", resources.DiskTotal))
+		Expect(resources.InodesFree).To(BeNumerically("<=", resources.InodesTotal))
+	})
+
+	It("includes the packets denied by egress policies", func() {
+		workDir, err := ioutil.TempDir("", "work-dir")
+		Expect(err).ToNot(HaveOccurred())
+
+		violations := new(worker.EgressViolations)
+		violations.Add(3)
+		violations.Add(4)
+
+		resources, err := worker.NewResourceCollector(workDir, violations)()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(resources.EgressPacketsDenied).To(Equal(uint64(7)))
+	})
+})
@@ -0,0 +1,17 @@
+// +build !linux
+
+package worker
+
+import (
+	"errors"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var ErrResourcesNotSupported = errors.New("reporting worker resources is only supported on linux")
+
+func NewResourceCollector(workDir string, violations *EgressViolations) ResourceCollector {
+	return func() (atc.WorkerResources, error) {
+		return atc.WorkerResources{}, ErrResourcesNotSupported
+	}
+}
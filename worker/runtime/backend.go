@@ -7,10 +7,12 @@ package runtime
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd"
 	bespec "github.com/concourse/concourse/worker/runtime/spec"
 	"github.com/containerd/containerd"
@@ -30,6 +32,8 @@ type GardenBackend struct {
 	userNamespace UserNamespace
 	initBinPath   string
 
+	runtimeClasses map[string]string
+
 	maxContainers  int
 	requestTimeout time.Duration
 	createLock     TimeoutWithByPassLock
@@ -101,6 +105,16 @@ func WithInitBinPath(initBinPath string) GardenBackendOpt {
 	}
 }
 
+// WithRuntimeClasses configures the runtime classes containers can request
+// through the RuntimeClassProperty, mapping each class to the containerd
+// runtime which runs its containers, e.g. `gvisor` to `io.containerd.runsc.v1`.
+//
+func WithRuntimeClasses(classes map[string]string) GardenBackendOpt {
+	return func(b *GardenBackend) {
+		b.runtimeClasses = classes
+	}
+}
+
 // NewGardenBackend instantiates a GardenBackend with tweakable configurations passed as Config.
 //
 func NewGardenBackend(client libcontainerd.Client, opts ...GardenBackendOpt) (b GardenBackend, err error) {
@@ -189,12 +203,17 @@ func (b *GardenBackend) Ping() (err error) {
 func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container, error) {
 	ctx := context.Background()
 
+	policy, err := egressPolicyFor(gdnSpec.Properties)
+	if err != nil {
+		return nil, err
+	}
+
 	cont, err := b.createContainer(ctx, gdnSpec)
 	if err != nil {
 		return nil, fmt.Errorf("new container: %w", err)
 	}
 
-	err = b.startTask(ctx, cont)
+	err = b.startTask(ctx, cont, policy)
 	if err != nil {
 		return nil, fmt.Errorf("starting task: %w", err)
 	}
@@ -219,6 +238,11 @@ func (b *GardenBackend) createContainer(ctx context.Context, gdnSpec garden.Cont
 		return nil, fmt.Errorf("checking container capacity: %w", err)
 	}
 
+	runtime, err := b.runtimeFor(gdnSpec.Properties)
+	if err != nil {
+		return nil, err
+	}
+
 	maxUid, maxGid, err := b.userNamespace.MaxValidIds()
 	if err != nil {
 		return nil, fmt.Errorf("getting uid and gid maps: %w", err)
@@ -236,16 +260,51 @@ func (b *GardenBackend) createContainer(ctx context.Context, gdnSpec garden.Cont
 
 	oci.Mounts = append(oci.Mounts, netMounts...)
 
-	return b.client.NewContainer(ctx, gdnSpec.Handle, gdnSpec.Properties, oci)
+	return b.client.NewContainer(ctx, gdnSpec.Handle, gdnSpec.Properties, oci, runtime)
+}
+
+// runtimeFor determines the containerd runtime for the runtime class requested
+// in the container's properties, if any.
+//
+func (b *GardenBackend) runtimeFor(properties garden.Properties) (string, error) {
+	class := properties[RuntimeClassProperty]
+	if class == "" {
+		return "", nil
+	}
+
+	runtime, found := b.runtimeClasses[class]
+	if !found {
+		return "", ErrInvalidInput("unknown runtime class: " + class)
+	}
+
+	return runtime, nil
+}
+
+// egressPolicyFor parses the egress policy given in the container's
+// properties, if any.
+//
+func egressPolicyFor(properties garden.Properties) (*atc.EgressPolicy, error) {
+	payload := properties[EgressPolicyProperty]
+	if payload == "" {
+		return nil, nil
+	}
+
+	var policy atc.EgressPolicy
+	err := json.Unmarshal([]byte(payload), &policy)
+	if err != nil {
+		return nil, ErrInvalidInput("invalid egress policy: " + err.Error())
+	}
+
+	return &policy, nil
 }
 
-func (b *GardenBackend) startTask(ctx context.Context, cont containerd.Container) error {
+func (b *GardenBackend) startTask(ctx context.Context, cont containerd.Container, policy *atc.EgressPolicy) error {
 	task, err := cont.NewTask(ctx, cio.NullIO, containerd.WithNoNewKeyring)
 	if err != nil {
 		return fmt.Errorf("new task: %w", err)
 	}
 
-	err = b.network.Add(ctx, task)
+	err = b.network.Add(ctx, task, policy)
 	if err != nil {
 		return fmt.Errorf("network add: %w", err)
 	}
@@ -9,6 +9,7 @@ import (
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/worker/runtime"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/concourse/concourse/worker/runtime/runtimefakes"
@@ -146,6 +147,106 @@ func (s *BackendSuite) TestCreateContainerSetsHandle() {
 	s.Equal("handle", cont.Handle())
 }
 
+func (s *BackendSuite) TestCreateWithEgressPolicy() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	spec := minimumValidGdnSpec
+	spec.Properties = garden.Properties{
+		runtime.EgressPolicyProperty: `{"deny":[{"network":"10.0.0.0/8"}],"block_metadata":true}`,
+	}
+
+	_, err := s.backend.Create(spec)
+	s.NoError(err)
+
+	s.Equal(1, s.network.AddCallCount())
+	_, task, policy := s.network.AddArgsForCall(0)
+	s.Equal(fakeTask, task)
+	s.Equal(&atc.EgressPolicy{
+		Deny:          []atc.EgressRule{{Network: "10.0.0.0/8"}},
+		BlockMetadata: true,
+	}, policy)
+}
+
+func (s *BackendSuite) TestCreateWithInvalidEgressPolicy() {
+	spec := minimumValidGdnSpec
+	spec.Properties = garden.Properties{
+		runtime.EgressPolicyProperty: `{"deny":`,
+	}
+
+	_, err := s.backend.Create(spec)
+	s.EqualError(err, "invalid egress policy: unexpected end of JSON input")
+
+	s.Equal(0, s.client.NewContainerCallCount())
+}
+
+func (s *BackendSuite) TestCreateWithRuntimeClass() {
+	backend, err := runtime.NewGardenBackend(s.client,
+		runtime.WithKiller(s.killer),
+		runtime.WithNetwork(s.network),
+		runtime.WithUserNamespace(s.userns),
+		runtime.WithRuntimeClasses(map[string]string{
+			"gvisor": "io.containerd.runsc.v1",
+		}),
+	)
+	s.NoError(err)
+
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	for _, tc := range []struct {
+		desc            string
+		class           string
+		expectedRuntime string
+		succeeds        bool
+	}{
+		{
+			desc:            "no runtime class uses the default runtime",
+			class:           "",
+			expectedRuntime: "",
+			succeeds:        true,
+		},
+		{
+			desc:            "a known runtime class uses its runtime",
+			class:           "gvisor",
+			expectedRuntime: "io.containerd.runsc.v1",
+			succeeds:        true,
+		},
+		{
+			desc:     "an unknown runtime class fails",
+			class:    "kata",
+			succeeds: false,
+		},
+	} {
+		s.T().Run(tc.desc, func(t *testing.T) {
+			calls := s.client.NewContainerCallCount()
+
+			spec := minimumValidGdnSpec
+			spec.Properties = garden.Properties{}
+			if tc.class != "" {
+				spec.Properties[runtime.RuntimeClassProperty] = tc.class
+			}
+
+			_, err := backend.Create(spec)
+			if !tc.succeeds {
+				s.EqualError(errors.Unwrap(err), "unknown runtime class: "+tc.class)
+				s.Equal(calls, s.client.NewContainerCallCount())
+				return
+			}
+
+			s.NoError(err)
+			_, _, _, _, actualRuntime := s.client.NewContainerArgsForCall(calls)
+			s.Equal(tc.expectedRuntime, actualRuntime)
+		})
+	}
+}
+
 func (s *BackendSuite) TestCreateMaxContainersReached() {
 	backend, err := runtime.NewGardenBackend(s.client,
 		runtime.WithKiller(s.killer),
@@ -174,7 +275,7 @@ func (s *BackendSuite) TestCreateMaxContainersReachedConcurrent() {
 
 	fakeContainer.NewTaskReturns(fakeTask, nil)
 
-	s.client.NewContainerStub = func(context context.Context, str string, strings map[string]string, spec *specs.Spec) (container containerd.Container, e error) {
+	s.client.NewContainerStub = func(context context.Context, str string, strings map[string]string, spec *specs.Spec, runtime string) (container containerd.Container, e error) {
 		s.client.ContainersReturns([]containerd.Container{fakeContainer}, nil)
 		return fakeContainer, nil
 	}
@@ -219,7 +320,7 @@ func (s *BackendSuite) TestCreateContainerLockTimeout() {
 	fakeContainer.IDReturns("handle")
 	fakeContainer.NewTaskReturns(fakeTask, nil)
 
-	s.client.NewContainerStub = func(context context.Context, str string, strings map[string]string, spec *specs.Spec) (container containerd.Container, e error) {
+	s.client.NewContainerStub = func(context context.Context, str string, strings map[string]string, spec *specs.Spec, runtime string) (container containerd.Container, e error) {
 		s.client.ContainersReturns([]containerd.Container{fakeContainer}, nil)
 		time.Sleep(500 * time.Millisecond)
 		return fakeContainer, nil
@@ -2,10 +2,15 @@ package runtime
 
 import (
 	"context"
+	"crypto/sha1"
 	"fmt"
+	"net"
 	"path/filepath"
+	"strconv"
 	"strings"
 
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/worker/runtime/iptables"
 	"github.com/containerd/containerd"
 	"github.com/containerd/go-cni"
@@ -45,6 +50,22 @@ const (
 	binariesDir = "/usr/local/concourse/bin"
 
 	ipTablesAdminChainName = "CONCOURSE-OPERATOR"
+
+	// egressDenyChainName is the chain which logs and rejects the traffic
+	// denied by the egress policies of containers.
+	//
+	egressDenyChainName = "CONCOURSE-EGRESS-DENY"
+
+	// egressChainPrefix prefixes the names of the chains enforcing the egress
+	// policy of each container.
+	//
+	egressChainPrefix = "CONCOURSE-EGRESS-"
+
+	// egressLogPrefix prefixes the kernel log entries of denied traffic.
+	//
+	egressLogPrefix = "concourse-egress-deny: "
+
+	filterTable = "filter"
 )
 
 var (
@@ -158,6 +179,24 @@ func WithIptables(ipt iptables.Iptables) CNINetworkOpt {
 	}
 }
 
+// WithCNILogger configures the logger to which the egress policy violations
+// of containers are reported when they are removed from the network.
+//
+func WithCNILogger(logger lager.Logger) CNINetworkOpt {
+	return func(n *cniNetwork) {
+		n.logger = logger
+	}
+}
+
+// WithEgressViolations configures a func which is given the number of packets
+// denied by the egress policy of each container removed from the network.
+//
+func WithEgressViolations(report func(packets uint64)) CNINetworkOpt {
+	return func(n *cniNetwork) {
+		n.reportViolations = report
+	}
+}
+
 type cniNetwork struct {
 	client             cni.CNI
 	store              FileStore
@@ -166,6 +205,8 @@ type cniNetwork struct {
 	binariesDir        string
 	restrictedNetworks []string
 	ipt                iptables.Iptables
+	logger             lager.Logger
+	reportViolations   func(packets uint64)
 }
 
 var _ Network = (*cniNetwork)(nil)
@@ -204,6 +245,14 @@ func NewCNINetwork(opts ...CNINetworkOpt) (*cniNetwork, error) {
 		}
 	}
 
+	if n.logger == nil {
+		n.logger = lager.NewLogger("cni-network")
+	}
+
+	if n.reportViolations == nil {
+		n.reportViolations = func(uint64) {}
+	}
+
 	if n.ipt == nil {
 		n.ipt, err = iptables.New()
 
@@ -258,7 +307,15 @@ func (n cniNetwork) SetupMounts(handle string) ([]specs.Mount, error) {
 
 func (n cniNetwork) SetupRestrictedNetworks() error {
 	const tableName = "filter"
-	err := n.ipt.CreateChainOrFlushIfExists(tableName, ipTablesAdminChainName)
+
+	// Containers which are still running when the worker restarts keep their
+	// egress chains, so the jumps to them are restored after the flush
+	rules, err := n.ipt.ListRules(tableName, ipTablesAdminChainName)
+	if err != nil {
+		return fmt.Errorf("list rules of admin chain failed: %w", err)
+	}
+
+	err = n.ipt.CreateChainOrFlushIfExists(tableName, ipTablesAdminChainName)
 	if err != nil {
 		return fmt.Errorf("create chain or flush if exists failed: %w", err)
 	}
@@ -276,6 +333,29 @@ func (n cniNetwork) SetupRestrictedNetworks() error {
 			return fmt.Errorf("appending reject rule for restricted network %s failed: %w", restrictedNetwork, err)
 		}
 	}
+
+	for chain, jump := range egressJumps(rules) {
+		err = n.ipt.AppendRule(tableName, ipTablesAdminChainName, jump...)
+		if err != nil {
+			return fmt.Errorf("restoring jump rule to %s failed: %w", chain, err)
+		}
+	}
+
+	err = n.ipt.CreateChainOrFlushIfExists(tableName, egressDenyChainName)
+	if err != nil {
+		return fmt.Errorf("create egress deny chain failed: %w", err)
+	}
+
+	err = n.ipt.AppendRule(tableName, egressDenyChainName, "-m", "limit", "--limit", "10/min", "-j", "LOG", "--log-prefix", egressLogPrefix)
+	if err != nil {
+		return fmt.Errorf("appending log rule for denied egress failed: %w", err)
+	}
+
+	err = n.ipt.AppendRule(tableName, egressDenyChainName, "-j", "REJECT")
+	if err != nil {
+		return fmt.Errorf("appending reject rule for denied egress failed: %w", err)
+	}
+
 	return nil
 }
 
@@ -293,18 +373,32 @@ func (n cniNetwork) generateResolvConfContents() ([]byte, error) {
 	return []byte(contents), err
 }
 
-func (n cniNetwork) Add(ctx context.Context, task containerd.Task) error {
+func (n cniNetwork) Add(ctx context.Context, task containerd.Task, policy *atc.EgressPolicy) error {
 	if task == nil {
 		return ErrInvalidInput("nil task")
 	}
 
 	id, netns := netId(task), netNsPath(task)
 
-	_, err := n.client.Setup(ctx, id, netns)
+	result, err := n.client.Setup(ctx, id, netns)
 	if err != nil {
 		return fmt.Errorf("cni net setup: %w", err)
 	}
 
+	if policy == nil {
+		return nil
+	}
+
+	ip, err := containerIP(result)
+	if err != nil {
+		return err
+	}
+
+	err = n.enforceEgressPolicy(id, ip, *policy)
+	if err != nil {
+		return fmt.Errorf("enforcing egress policy: %w", err)
+	}
+
 	return nil
 }
 
@@ -315,7 +409,12 @@ func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 
 	id, netns := netId(task), netNsPath(task)
 
-	err := n.client.Remove(ctx, id, netns)
+	err := n.removeEgressPolicy(id)
+	if err != nil {
+		return fmt.Errorf("removing egress policy: %w", err)
+	}
+
+	err = n.client.Remove(ctx, id, netns)
 	if err != nil {
 		return fmt.Errorf("cni net teardown: %w", err)
 	}
@@ -323,6 +422,158 @@ func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 	return nil
 }
 
+// enforceEgressPolicy creates a chain enforcing the policy on the traffic from
+// the container's ip. Denied traffic is handed to the egress deny chain, and
+// allowed traffic returns to the admin chain so that restricted networks still
+// apply.
+//
+func (n cniNetwork) enforceEgressPolicy(id string, ip net.IP, policy atc.EgressPolicy) error {
+	chain := egressChainName(id)
+
+	err := n.ipt.CreateChainOrFlushIfExists(filterTable, chain)
+	if err != nil {
+		return fmt.Errorf("create chain %s: %w", chain, err)
+	}
+
+	for _, rule := range policy.DeniedRules() {
+		err = n.ipt.AppendRule(filterTable, chain, append(egressRuleSpec(rule), "-j", egressDenyChainName)...)
+		if err != nil {
+			return fmt.Errorf("appending deny rule for %s: %w", rule.Network, err)
+		}
+	}
+
+	if len(policy.Allow) > 0 {
+		for _, rule := range policy.Allow {
+			err = n.ipt.AppendRule(filterTable, chain, append(egressRuleSpec(rule), "-j", "RETURN")...)
+			if err != nil {
+				return fmt.Errorf("appending allow rule for %s: %w", rule.Network, err)
+			}
+		}
+
+		err = n.ipt.AppendRule(filterTable, chain, "-j", egressDenyChainName)
+		if err != nil {
+			return fmt.Errorf("appending default deny rule: %w", err)
+		}
+	}
+
+	err = n.ipt.AppendRule(filterTable, ipTablesAdminChainName, "-s", ip.String()+"/32", "-j", chain)
+	if err != nil {
+		return fmt.Errorf("appending jump rule to %s: %w", chain, err)
+	}
+
+	return nil
+}
+
+// removeEgressPolicy removes the chain enforcing the container's egress
+// policy, if any, reporting the packets it denied.
+//
+func (n cniNetwork) removeEgressPolicy(id string) error {
+	chain := egressChainName(id)
+
+	rules, err := n.ipt.ListRules(filterTable, ipTablesAdminChainName)
+	if err != nil {
+		return fmt.Errorf("list rules: %w", err)
+	}
+
+	jump, found := egressJumps(rules)[chain]
+	if !found {
+		return nil
+	}
+
+	denied, err := n.ipt.CountPackets(filterTable, chain, egressDenyChainName)
+	if err != nil {
+		return fmt.Errorf("count denied packets: %w", err)
+	}
+
+	if denied > 0 {
+		n.logger.Info("egress-policy-violations", lager.Data{
+			"handle":  id,
+			"packets": denied,
+		})
+
+		n.reportViolations(denied)
+	}
+
+	err = n.ipt.DeleteRule(filterTable, ipTablesAdminChainName, jump...)
+	if err != nil {
+		return fmt.Errorf("delete jump rule to %s: %w", chain, err)
+	}
+
+	err = n.ipt.DeleteChain(filterTable, chain)
+	if err != nil {
+		return fmt.Errorf("delete chain %s: %w", chain, err)
+	}
+
+	return nil
+}
+
+// egressJumps finds the rules among the listed rules of a chain which jump to
+// the egress chain of a container, returning their rulespecs by egress chain.
+//
+func egressJumps(rules []string) map[string][]string {
+	jumps := map[string][]string{}
+	for _, rule := range rules {
+		fields := strings.Fields(rule)
+		if len(fields) < 3 || fields[0] != "-A" {
+			continue
+		}
+
+		target := fields[len(fields)-1]
+		if !strings.HasPrefix(target, egressChainPrefix) || target == egressDenyChainName {
+			continue
+		}
+
+		jumps[target] = fields[2:]
+	}
+
+	return jumps
+}
+
+// egressChainName derives a name for the chain enforcing the egress policy of
+// a container which fits in iptables' 28 character limit.
+//
+func egressChainName(id string) string {
+	return fmt.Sprintf("%s%x", egressChainPrefix, sha1.Sum([]byte(id)))[:28]
+}
+
+func egressRuleSpec(rule atc.EgressRule) []string {
+	spec := []string{"-d", rule.Network}
+
+	if rule.Protocol != "" {
+		spec = append(spec, "-p", rule.Protocol)
+	}
+
+	if len(rule.Ports) > 0 {
+		ports := make([]string, len(rule.Ports))
+		for i, port := range rule.Ports {
+			ports[i] = strconv.Itoa(port)
+		}
+
+		spec = append(spec, "-m", "multiport", "--dports", strings.Join(ports, ","))
+	}
+
+	return spec
+}
+
+// containerIP finds the IPv4 address assigned to the container's end of the
+// network.
+//
+func containerIP(result *cni.CNIResult) (net.IP, error) {
+	for _, iface := range result.Interfaces {
+		if iface.Sandbox == "" {
+			continue
+		}
+
+		for _, config := range iface.IPConfigs {
+			if ip := config.IP.To4(); ip != nil {
+				return ip, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no ipv4 address assigned to container")
+}
+
 func netId(task containerd.Task) string {
 	return task.ID()
 }
@@ -3,12 +3,15 @@ package runtime_test
 import (
 	"context"
 	"errors"
+	"net"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/worker/runtime"
 	"github.com/concourse/concourse/worker/runtime/iptables/iptablesfakes"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/concourse/concourse/worker/runtime/runtimefakes"
+	"github.com/containerd/go-cni"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -166,10 +169,47 @@ func (s *CNINetworkSuite) TestSetupRestrictedNetworksCreatesEmptyAdminChain() {
 	s.Equal(tablename, "filter")
 	s.Equal(chainName, "CONCOURSE-OPERATOR")
 	s.Equal(rulespec, []string{"-d", "8.8.8.8", "-j", "REJECT"})
+
+	tablename, chainName = s.iptables.CreateChainOrFlushIfExistsArgsForCall(1)
+	s.Equal(tablename, "filter")
+	s.Equal(chainName, "CONCOURSE-EGRESS-DENY")
+
+	tablename, chainName, rulespec = s.iptables.AppendRuleArgsForCall(3)
+	s.Equal(tablename, "filter")
+	s.Equal(chainName, "CONCOURSE-EGRESS-DENY")
+	s.Equal(rulespec, []string{"-m", "limit", "--limit", "10/min", "-j", "LOG", "--log-prefix", "concourse-egress-deny: "})
+
+	tablename, chainName, rulespec = s.iptables.AppendRuleArgsForCall(4)
+	s.Equal(tablename, "filter")
+	s.Equal(chainName, "CONCOURSE-EGRESS-DENY")
+	s.Equal(rulespec, []string{"-j", "REJECT"})
+}
+
+func (s *CNINetworkSuite) TestSetupRestrictedNetworksRestoresEgressJumps() {
+	s.iptables.ListRulesReturns([]string{
+		"-N CONCOURSE-OPERATOR",
+		"-A CONCOURSE-OPERATOR -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT",
+		"-A CONCOURSE-OPERATOR -s 10.80.0.5/32 -j CONCOURSE-EGRESS-0123456789ab",
+	}, nil)
+
+	err := s.network.SetupRestrictedNetworks()
+	s.NoError(err)
+
+	tablename, chainName := s.iptables.ListRulesArgsForCall(0)
+	s.Equal("filter", tablename)
+	s.Equal("CONCOURSE-OPERATOR", chainName)
+
+	_, chainName = s.iptables.CreateChainOrFlushIfExistsArgsForCall(0)
+	s.Equal("CONCOURSE-OPERATOR", chainName)
+
+	tablename, chainName, rulespec := s.iptables.AppendRuleArgsForCall(1)
+	s.Equal("filter", tablename)
+	s.Equal("CONCOURSE-OPERATOR", chainName)
+	s.Equal([]string{"-s", "10.80.0.5/32", "-j", "CONCOURSE-EGRESS-0123456789ab"}, rulespec)
 }
 
 func (s *CNINetworkSuite) TestAddNilTask() {
-	err := s.network.Add(context.Background(), nil)
+	err := s.network.Add(context.Background(), nil, nil)
 	s.EqualError(err, "nil task")
 }
 
@@ -177,7 +217,7 @@ func (s *CNINetworkSuite) TestAddSetupErrors() {
 	s.cni.SetupReturns(nil, errors.New("setup-err"))
 	task := new(libcontainerdfakes.FakeTask)
 
-	err := s.network.Add(context.Background(), task)
+	err := s.network.Add(context.Background(), task, nil)
 	s.EqualError(errors.Unwrap(err), "setup-err")
 }
 
@@ -186,7 +226,7 @@ func (s *CNINetworkSuite) TestAdd() {
 	task.PidReturns(123)
 	task.IDReturns("id")
 
-	err := s.network.Add(context.Background(), task)
+	err := s.network.Add(context.Background(), task, nil)
 	s.NoError(err)
 
 	s.Equal(1, s.cni.SetupCallCount())
@@ -195,6 +235,62 @@ func (s *CNINetworkSuite) TestAdd() {
 	s.Equal("/proc/123/ns/net", netns)
 }
 
+func (s *CNINetworkSuite) TestAddWithEgressPolicy() {
+	task := new(libcontainerdfakes.FakeTask)
+	task.PidReturns(123)
+	task.IDReturns("id")
+
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"concourse0": {
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("10.80.0.1")}},
+			},
+			"eth0": {
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("10.80.0.5")}},
+				Sandbox:   "/proc/123/ns/net",
+			},
+		},
+	}, nil)
+
+	err := s.network.Add(context.Background(), task, &atc.EgressPolicy{
+		Allow: []atc.EgressRule{
+			{Network: "10.0.0.0/8", Protocol: "tcp", Ports: []int{443, 8443}},
+		},
+		BlockMetadata: true,
+	})
+	s.NoError(err)
+
+	s.Equal(1, s.iptables.CreateChainOrFlushIfExistsCallCount())
+	tablename, chain := s.iptables.CreateChainOrFlushIfExistsArgsForCall(0)
+	s.Equal("filter", tablename)
+	s.True(strings.HasPrefix(chain, "CONCOURSE-EGRESS-"))
+	s.Len(chain, 28)
+
+	var rules [][]string
+	for i := 0; i < s.iptables.AppendRuleCallCount(); i++ {
+		_, chainName, rulespec := s.iptables.AppendRuleArgsForCall(i)
+		rules = append(rules, append([]string{chainName}, rulespec...))
+	}
+
+	s.Equal([][]string{
+		{chain, "-d", "169.254.169.254/32", "-j", "CONCOURSE-EGRESS-DENY"},
+		{chain, "-d", "10.0.0.0/8", "-p", "tcp", "-m", "multiport", "--dports", "443,8443", "-j", "RETURN"},
+		{chain, "-j", "CONCOURSE-EGRESS-DENY"},
+		{"CONCOURSE-OPERATOR", "-s", "10.80.0.5/32", "-j", chain},
+	}, rules)
+}
+
+func (s *CNINetworkSuite) TestAddWithEgressPolicyWithoutIP() {
+	task := new(libcontainerdfakes.FakeTask)
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{"eth0": {Sandbox: "/proc/123/ns/net"}},
+	}, nil)
+
+	err := s.network.Add(context.Background(), task, &atc.EgressPolicy{BlockMetadata: true})
+	s.EqualError(err, "no ipv4 address assigned to container")
+	s.Equal(0, s.iptables.AppendRuleCallCount())
+}
+
 func (s *CNINetworkSuite) TestRemoveNilTask() {
 	err := s.network.Remove(context.Background(), nil)
 	s.EqualError(err, "nil task")
@@ -220,4 +316,80 @@ func (s *CNINetworkSuite) TestRemove() {
 	_, id, netns, _ := s.cni.RemoveArgsForCall(0)
 	s.Equal("id", id)
 	s.Equal("/proc/123/ns/net", netns)
+
+	s.Equal(0, s.iptables.DeleteRuleCallCount())
+	s.Equal(0, s.iptables.DeleteChainCallCount())
+}
+
+func (s *CNINetworkSuite) TestRemoveWithEgressPolicy() {
+	task := new(libcontainerdfakes.FakeTask)
+	task.PidReturns(123)
+	task.IDReturns("id")
+
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"eth0": {
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("10.80.0.5")}},
+				Sandbox:   "/proc/123/ns/net",
+			},
+		},
+	}, nil)
+
+	err := s.network.Add(context.Background(), task, &atc.EgressPolicy{BlockMetadata: true})
+	s.NoError(err)
+
+	_, chain := s.iptables.CreateChainOrFlushIfExistsArgsForCall(0)
+	s.iptables.ListRulesReturns([]string{
+		"-N CONCOURSE-OPERATOR",
+		"-A CONCOURSE-OPERATOR -m conntrack --ctstate RELATED,ESTABLISHED -j ACCEPT",
+		"-A CONCOURSE-OPERATOR -s 10.80.0.5/32 -j " + chain,
+	}, nil)
+	s.iptables.CountPacketsReturns(3, nil)
+
+	err = s.network.Remove(context.Background(), task)
+	s.NoError(err)
+
+	tablename, chainName, target := s.iptables.CountPacketsArgsForCall(0)
+	s.Equal("filter", tablename)
+	s.Equal(chain, chainName)
+	s.Equal("CONCOURSE-EGRESS-DENY", target)
+
+	s.Equal(1, s.iptables.DeleteRuleCallCount())
+	tablename, chainName, rulespec := s.iptables.DeleteRuleArgsForCall(0)
+	s.Equal("filter", tablename)
+	s.Equal("CONCOURSE-OPERATOR", chainName)
+	s.Equal([]string{"-s", "10.80.0.5/32", "-j", chain}, rulespec)
+
+	s.Equal(1, s.iptables.DeleteChainCallCount())
+	_, chainName = s.iptables.DeleteChainArgsForCall(0)
+	s.Equal(chain, chainName)
+
+	s.Equal(1, s.cni.RemoveCallCount())
+}
+
+func (s *CNINetworkSuite) TestRemoveReportsEgressViolations() {
+	var reported uint64
+
+	network, err := runtime.NewCNINetwork(
+		runtime.WithCNIFileStore(s.store),
+		runtime.WithCNIClient(s.cni),
+		runtime.WithIptables(s.iptables),
+		runtime.WithEgressViolations(func(packets uint64) {
+			reported += packets
+		}),
+	)
+	s.NoError(err)
+
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	s.iptables.ListRulesReturns([]string{
+		"-A CONCOURSE-OPERATOR -s 10.80.0.5/32 -j CONCOURSE-EGRESS-87ea5dfc8b8",
+	}, nil)
+	s.iptables.CountPacketsReturns(3, nil)
+
+	err = network.Remove(context.Background(), task)
+	s.NoError(err)
+
+	s.Equal(uint64(3), reported)
 }
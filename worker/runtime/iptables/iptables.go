@@ -8,7 +8,17 @@ import (
 
 type Iptables interface {
 	CreateChainOrFlushIfExists(table string, chain string) error
+	DeleteChain(table string, chain string) error
 	AppendRule(table string, chain string, rulespec ...string) error
+	DeleteRule(table string, chain string, rulespec ...string) error
+
+	// ListRules lists the rules of the chain, or none if the chain does not
+	// exist.
+	ListRules(table string, chain string) ([]string, error)
+
+	// CountPackets returns the number of packets matched by the rules of
+	// the chain which jump to the target.
+	CountPackets(table string, chain string, target string) (uint64, error)
 }
 
 type iptables struct {
@@ -38,4 +48,49 @@ func (ipt *iptables) CreateChainOrFlushIfExists(table string, chain string) erro
 func (ipt *iptables) AppendRule(table string, chain string, rulespec ...string) error {
 	err := ipt.goipt.Append(table, chain, rulespec...)
 	return err
-}
\ No newline at end of file
+}
+
+func (ipt *iptables) DeleteChain(table string, chain string) error {
+	err := ipt.goipt.ClearChain(table, chain)
+	if err != nil {
+		return err
+	}
+
+	return ipt.goipt.DeleteChain(table, chain)
+}
+
+func (ipt *iptables) DeleteRule(table string, chain string, rulespec ...string) error {
+	err := ipt.goipt.Delete(table, chain, rulespec...)
+	return err
+}
+
+func (ipt *iptables) ListRules(table string, chain string) ([]string, error) {
+	chains, err := ipt.goipt.ListChains(table)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range chains {
+		if c == chain {
+			return ipt.goipt.List(table, chain)
+		}
+	}
+
+	return nil, nil
+}
+
+func (ipt *iptables) CountPackets(table string, chain string, target string) (uint64, error) {
+	stats, err := ipt.goipt.StructuredStats(table, chain)
+	if err != nil {
+		return 0, err
+	}
+
+	var packets uint64
+	for _, stat := range stats {
+		if stat.Target == target {
+			packets += stat.Packets
+		}
+	}
+
+	return packets, nil
+}
@@ -21,6 +21,21 @@ type FakeIptables struct {
 	appendRuleReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CountPacketsStub        func(string, string, string) (uint64, error)
+	countPacketsMutex       sync.RWMutex
+	countPacketsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	countPacketsReturns struct {
+		result1 uint64
+		result2 error
+	}
+	countPacketsReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
 	CreateChainOrFlushIfExistsStub        func(string, string) error
 	createChainOrFlushIfExistsMutex       sync.RWMutex
 	createChainOrFlushIfExistsArgsForCall []struct {
@@ -33,6 +48,45 @@ type FakeIptables struct {
 	createChainOrFlushIfExistsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteChainStub        func(string, string) error
+	deleteChainMutex       sync.RWMutex
+	deleteChainArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteChainReturns struct {
+		result1 error
+	}
+	deleteChainReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRuleStub        func(string, string, ...string) error
+	deleteRuleMutex       sync.RWMutex
+	deleteRuleArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}
+	deleteRuleReturns struct {
+		result1 error
+	}
+	deleteRuleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ListRulesStub        func(string, string) ([]string, error)
+	listRulesMutex       sync.RWMutex
+	listRulesArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	listRulesReturns struct {
+		result1 []string
+		result2 error
+	}
+	listRulesReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -99,6 +153,71 @@ func (fake *FakeIptables) AppendRuleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeIptables) CountPackets(arg1 string, arg2 string, arg3 string) (uint64, error) {
+	fake.countPacketsMutex.Lock()
+	ret, specificReturn := fake.countPacketsReturnsOnCall[len(fake.countPacketsArgsForCall)]
+	fake.countPacketsArgsForCall = append(fake.countPacketsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CountPackets", []interface{}{arg1, arg2, arg3})
+	fake.countPacketsMutex.Unlock()
+	if fake.CountPacketsStub != nil {
+		return fake.CountPacketsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.countPacketsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIptables) CountPacketsCallCount() int {
+	fake.countPacketsMutex.RLock()
+	defer fake.countPacketsMutex.RUnlock()
+	return len(fake.countPacketsArgsForCall)
+}
+
+func (fake *FakeIptables) CountPacketsCalls(stub func(string, string, string) (uint64, error)) {
+	fake.countPacketsMutex.Lock()
+	defer fake.countPacketsMutex.Unlock()
+	fake.CountPacketsStub = stub
+}
+
+func (fake *FakeIptables) CountPacketsArgsForCall(i int) (string, string, string) {
+	fake.countPacketsMutex.RLock()
+	defer fake.countPacketsMutex.RUnlock()
+	argsForCall := fake.countPacketsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIptables) CountPacketsReturns(result1 uint64, result2 error) {
+	fake.countPacketsMutex.Lock()
+	defer fake.countPacketsMutex.Unlock()
+	fake.CountPacketsStub = nil
+	fake.countPacketsReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIptables) CountPacketsReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.countPacketsMutex.Lock()
+	defer fake.countPacketsMutex.Unlock()
+	fake.CountPacketsStub = nil
+	if fake.countPacketsReturnsOnCall == nil {
+		fake.countPacketsReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.countPacketsReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeIptables) CreateChainOrFlushIfExists(arg1 string, arg2 string) error {
 	fake.createChainOrFlushIfExistsMutex.Lock()
 	ret, specificReturn := fake.createChainOrFlushIfExistsReturnsOnCall[len(fake.createChainOrFlushIfExistsArgsForCall)]
@@ -160,13 +279,208 @@ func (fake *FakeIptables) CreateChainOrFlushIfExistsReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeIptables) DeleteChain(arg1 string, arg2 string) error {
+	fake.deleteChainMutex.Lock()
+	ret, specificReturn := fake.deleteChainReturnsOnCall[len(fake.deleteChainArgsForCall)]
+	fake.deleteChainArgsForCall = append(fake.deleteChainArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteChain", []interface{}{arg1, arg2})
+	fake.deleteChainMutex.Unlock()
+	if fake.DeleteChainStub != nil {
+		return fake.DeleteChainStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteChainReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) DeleteChainCallCount() int {
+	fake.deleteChainMutex.RLock()
+	defer fake.deleteChainMutex.RUnlock()
+	return len(fake.deleteChainArgsForCall)
+}
+
+func (fake *FakeIptables) DeleteChainCalls(stub func(string, string) error) {
+	fake.deleteChainMutex.Lock()
+	defer fake.deleteChainMutex.Unlock()
+	fake.DeleteChainStub = stub
+}
+
+func (fake *FakeIptables) DeleteChainArgsForCall(i int) (string, string) {
+	fake.deleteChainMutex.RLock()
+	defer fake.deleteChainMutex.RUnlock()
+	argsForCall := fake.deleteChainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) DeleteChainReturns(result1 error) {
+	fake.deleteChainMutex.Lock()
+	defer fake.deleteChainMutex.Unlock()
+	fake.DeleteChainStub = nil
+	fake.deleteChainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteChainReturnsOnCall(i int, result1 error) {
+	fake.deleteChainMutex.Lock()
+	defer fake.deleteChainMutex.Unlock()
+	fake.DeleteChainStub = nil
+	if fake.deleteChainReturnsOnCall == nil {
+		fake.deleteChainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteChainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteRule(arg1 string, arg2 string, arg3 ...string) error {
+	fake.deleteRuleMutex.Lock()
+	ret, specificReturn := fake.deleteRuleReturnsOnCall[len(fake.deleteRuleArgsForCall)]
+	fake.deleteRuleArgsForCall = append(fake.deleteRuleArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteRule", []interface{}{arg1, arg2, arg3})
+	fake.deleteRuleMutex.Unlock()
+	if fake.DeleteRuleStub != nil {
+		return fake.DeleteRuleStub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteRuleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) DeleteRuleCallCount() int {
+	fake.deleteRuleMutex.RLock()
+	defer fake.deleteRuleMutex.RUnlock()
+	return len(fake.deleteRuleArgsForCall)
+}
+
+func (fake *FakeIptables) DeleteRuleCalls(stub func(string, string, ...string) error) {
+	fake.deleteRuleMutex.Lock()
+	defer fake.deleteRuleMutex.Unlock()
+	fake.DeleteRuleStub = stub
+}
+
+func (fake *FakeIptables) DeleteRuleArgsForCall(i int) (string, string, []string) {
+	fake.deleteRuleMutex.RLock()
+	defer fake.deleteRuleMutex.RUnlock()
+	argsForCall := fake.deleteRuleArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIptables) DeleteRuleReturns(result1 error) {
+	fake.deleteRuleMutex.Lock()
+	defer fake.deleteRuleMutex.Unlock()
+	fake.DeleteRuleStub = nil
+	fake.deleteRuleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteRuleReturnsOnCall(i int, result1 error) {
+	fake.deleteRuleMutex.Lock()
+	defer fake.deleteRuleMutex.Unlock()
+	fake.DeleteRuleStub = nil
+	if fake.deleteRuleReturnsOnCall == nil {
+		fake.deleteRuleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRuleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) ListRules(arg1 string, arg2 string) ([]string, error) {
+	fake.listRulesMutex.Lock()
+	ret, specificReturn := fake.listRulesReturnsOnCall[len(fake.listRulesArgsForCall)]
+	fake.listRulesArgsForCall = append(fake.listRulesArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("ListRules", []interface{}{arg1, arg2})
+	fake.listRulesMutex.Unlock()
+	if fake.ListRulesStub != nil {
+		return fake.ListRulesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listRulesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIptables) ListRulesCallCount() int {
+	fake.listRulesMutex.RLock()
+	defer fake.listRulesMutex.RUnlock()
+	return len(fake.listRulesArgsForCall)
+}
+
+func (fake *FakeIptables) ListRulesCalls(stub func(string, string) ([]string, error)) {
+	fake.listRulesMutex.Lock()
+	defer fake.listRulesMutex.Unlock()
+	fake.ListRulesStub = stub
+}
+
+func (fake *FakeIptables) ListRulesArgsForCall(i int) (string, string) {
+	fake.listRulesMutex.RLock()
+	defer fake.listRulesMutex.RUnlock()
+	argsForCall := fake.listRulesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) ListRulesReturns(result1 []string, result2 error) {
+	fake.listRulesMutex.Lock()
+	defer fake.listRulesMutex.Unlock()
+	fake.ListRulesStub = nil
+	fake.listRulesReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIptables) ListRulesReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.listRulesMutex.Lock()
+	defer fake.listRulesMutex.Unlock()
+	fake.ListRulesStub = nil
+	if fake.listRulesReturnsOnCall == nil {
+		fake.listRulesReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.listRulesReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeIptables) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.appendRuleMutex.RLock()
 	defer fake.appendRuleMutex.RUnlock()
+	fake.countPacketsMutex.RLock()
+	defer fake.countPacketsMutex.RUnlock()
 	fake.createChainOrFlushIfExistsMutex.RLock()
 	defer fake.createChainOrFlushIfExistsMutex.RUnlock()
+	fake.deleteChainMutex.RLock()
+	defer fake.deleteChainMutex.RUnlock()
+	fake.deleteRuleMutex.RLock()
+	defer fake.deleteRuleMutex.RUnlock()
+	fake.listRulesMutex.RLock()
+	defer fake.listRulesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -61,13 +61,14 @@ type FakeClient struct {
 	initReturnsOnCall map[int]struct {
 		result1 error
 	}
-	NewContainerStub        func(context.Context, string, map[string]string, *specs.Spec) (containerd.Container, error)
+	NewContainerStub        func(context.Context, string, map[string]string, *specs.Spec, string) (containerd.Container, error)
 	newContainerMutex       sync.RWMutex
 	newContainerArgsForCall []struct {
 		arg1 context.Context
 		arg2 string
 		arg3 map[string]string
 		arg4 *specs.Spec
+		arg5 string
 	}
 	newContainerReturns struct {
 		result1 containerd.Container
@@ -343,7 +344,7 @@ func (fake *FakeClient) InitReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) NewContainer(arg1 context.Context, arg2 string, arg3 map[string]string, arg4 *specs.Spec) (containerd.Container, error) {
+func (fake *FakeClient) NewContainer(arg1 context.Context, arg2 string, arg3 map[string]string, arg4 *specs.Spec, arg5 string) (containerd.Container, error) {
 	fake.newContainerMutex.Lock()
 	ret, specificReturn := fake.newContainerReturnsOnCall[len(fake.newContainerArgsForCall)]
 	fake.newContainerArgsForCall = append(fake.newContainerArgsForCall, struct {
@@ -351,11 +352,12 @@ func (fake *FakeClient) NewContainer(arg1 context.Context, arg2 string, arg3 map
 		arg2 string
 		arg3 map[string]string
 		arg4 *specs.Spec
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("NewContainer", []interface{}{arg1, arg2, arg3, arg4})
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("NewContainer", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.newContainerMutex.Unlock()
 	if fake.NewContainerStub != nil {
-		return fake.NewContainerStub(arg1, arg2, arg3, arg4)
+		return fake.NewContainerStub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -370,17 +372,17 @@ func (fake *FakeClient) NewContainerCallCount() int {
 	return len(fake.newContainerArgsForCall)
 }
 
-func (fake *FakeClient) NewContainerCalls(stub func(context.Context, string, map[string]string, *specs.Spec) (containerd.Container, error)) {
+func (fake *FakeClient) NewContainerCalls(stub func(context.Context, string, map[string]string, *specs.Spec, string) (containerd.Container, error)) {
 	fake.newContainerMutex.Lock()
 	defer fake.newContainerMutex.Unlock()
 	fake.NewContainerStub = stub
 }
 
-func (fake *FakeClient) NewContainerArgsForCall(i int) (context.Context, string, map[string]string, *specs.Spec) {
+func (fake *FakeClient) NewContainerArgsForCall(i int) (context.Context, string, map[string]string, *specs.Spec, string) {
 	fake.newContainerMutex.RLock()
 	defer fake.newContainerMutex.RUnlock()
 	argsForCall := fake.newContainerArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeClient) NewContainerReturns(result1 containerd.Container, result2 error) {
@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/containerd/containerd"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -20,9 +21,10 @@ type Network interface {
 	//
 	SetupRestrictedNetworks() (err error)
 
-	// Add adds a task to the network.
+	// Add adds a task to the network, enforcing the egress policy on its
+	// traffic if one is given.
 	//
-	Add(ctx context.Context, task containerd.Task) (err error)
+	Add(ctx context.Context, task containerd.Task, policy *atc.EgressPolicy) (err error)
 
 	// Removes a task from the network.
 	//
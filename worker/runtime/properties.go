@@ -6,6 +6,16 @@ import (
 	"code.cloudfoundry.org/garden"
 )
 
+// RuntimeClassProperty is the property through which a container requests the
+// runtime class it is run with.
+//
+const RuntimeClassProperty = "concourse:runtime-class"
+
+// EgressPolicyProperty is the property through which a container is given the
+// egress policy enforced on its traffic.
+//
+const EgressPolicyProperty = "concourse:egress-policy"
+
 // propertiesToFilterList converts a set of garden properties to a list of
 // filters as expected by containerd.
 //
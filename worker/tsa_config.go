@@ -1,22 +1,142 @@
 package worker
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 	"github.com/concourse/flag"
 )
 
 type TSAConfig struct {
-	Hosts            []string            `long:"host" default:"127.0.0.1:2222" description:"TSA host to forward the worker through. Can be specified multiple times."`
-	PublicKey        flag.AuthorizedKeys `long:"public-key" description:"File containing a public key to expect from the TSA."`
-	WorkerPrivateKey *flag.PrivateKey    `long:"worker-private-key" required:"true" description:"File containing the private key to use when authenticating to the TSA."`
+	Hosts             []string            `long:"host" default:"127.0.0.1:2222" description:"TSA host to forward the worker through. Can be specified multiple times."`
+	PublicKey         flag.AuthorizedKeys `long:"public-key" description:"File containing a public key to expect from the TSA."`
+	Transport         string              `long:"transport" default:"ssh" choice:"ssh" choice:"websocket" description:"How to connect to the TSA. With 'websocket', the SSH connection is tunneled through --tsa-websocket-url instead of connecting to --tsa-host."`
+	WebSocketURLs     []flag.URL          `long:"websocket-url" description:"WebSocket endpoint of the TSA, e.g. wss://ci.example.com:8443. Can be specified multiple times."`
+	WebSocketCACert   flag.File           `long:"websocket-ca-cert" description:"File containing a CA certificate to trust for the WebSocket endpoint, in addition to the system's."`
+	WorkerPrivateKey  *flag.PrivateKey    `long:"worker-private-key" description:"File containing the private key to use when authenticating to the TSA. Not needed when a registration token is given."`
+	RegistrationToken string              `long:"registration-token" description:"Token with which to register the worker's key through the TSA when it is not yet authorized."`
+	WorkerKeyPath     string              `long:"worker-key-path" description:"File in which to keep the key generated for the registration token. Defaults to a file in the work dir."`
+}
+
+func (config TSAConfig) Client(worker atc.Worker) (*tsa.Client, error) {
+	client := &tsa.Client{
+		Hosts:             config.Hosts,
+		HostKeys:          config.PublicKey.Keys,
+		Transport:         config.Transport,
+		Worker:            worker,
+		RegistrationToken: config.RegistrationToken,
+	}
+
+	if config.Transport == tsa.TransportWebSocket {
+		if len(config.WebSocketURLs) == 0 {
+			return nil, errors.New("--tsa-websocket-url must be specified to use the websocket transport")
+		}
+
+		for _, url := range config.WebSocketURLs {
+			client.WebSocketURLs = append(client.WebSocketURLs, url.String())
+		}
+
+		tlsConfig, err := config.webSocketTLSConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		client.WebSocketTLSConfig = tlsConfig
+	}
+
+	if config.WorkerPrivateKey != nil {
+		client.PrivateKey = config.WorkerPrivateKey.PrivateKey
+		return client, nil
+	}
+
+	if config.RegistrationToken == "" {
+		return nil, errors.New("either --tsa-worker-private-key or --tsa-registration-token must be specified")
+	}
+
+	if config.WorkerKeyPath == "" {
+		return nil, errors.New("--tsa-worker-key-path must be specified along with --tsa-registration-token")
+	}
+
+	privateKey, err := loadOrGenerateWorkerKey(config.WorkerKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	client.PrivateKey = privateKey
+
+	return client, nil
+}
+
+func (config TSAConfig) webSocketTLSConfig() (*tls.Config, error) {
+	if config.WebSocketCACert == "" {
+		return nil, nil
+	}
+
+	certPool, err := x509.SystemCertPool()
+	if err != nil {
+		certPool = x509.NewCertPool()
+	}
+
+	caCert, err := ioutil.ReadFile(config.WebSocketCACert.Path())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read websocket ca cert: %s", err)
+	}
+
+	if !certPool.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("no certificates found in websocket ca cert")
+	}
+
+	return &tls.Config{RootCAs: certPool}, nil
 }
 
-func (config TSAConfig) Client(worker atc.Worker) *tsa.Client {
-	return &tsa.Client{
-		Hosts:      config.Hosts,
-		HostKeys:   config.PublicKey.Keys,
-		PrivateKey: config.WorkerPrivateKey.PrivateKey,
-		Worker:     worker,
+// loadOrGenerateWorkerKey keeps the key registered with a registration token
+// across restarts, so that the worker does not need to redeem the token
+// again.
+func loadOrGenerateWorkerKey(path string) (*rsa.PrivateKey, error) {
+	_, err := os.Stat(path)
+	if err == nil {
+		var key flag.PrivateKey
+		err = key.UnmarshalFlag(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return key.PrivateKey, nil
 	}
+
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate worker key: %s", err)
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "RSA PRIVATE KEY",
+		Bytes: x509.MarshalPKCS1PrivateKey(privateKey),
+	})
+
+	err = ioutil.WriteFile(path, keyPEM, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save worker key: %s", err)
+	}
+
+	return privateKey, nil
 }
@@ -8,6 +8,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"syscall"
 
 	"code.cloudfoundry.org/garden/server"
@@ -51,6 +52,7 @@ func (cmd *WorkerCommand) containerdGardenServerRunner(
 	logger lager.Logger,
 	containerdAddr string,
 	dnsServers []string,
+	reportEgressViolations func(packets uint64),
 ) (ifrit.Runner, error) {
 	const (
 		graceTime = 0
@@ -58,7 +60,11 @@ func (cmd *WorkerCommand) containerdGardenServerRunner(
 	)
 
 	backendOpts := []runtime.GardenBackendOpt{}
-	networkOpts := []runtime.CNINetworkOpt{runtime.WithCNIBinariesDir(cmd.Containerd.CNIPluginsDir)}
+	networkOpts := []runtime.CNINetworkOpt{
+		runtime.WithCNIBinariesDir(cmd.Containerd.CNIPluginsDir),
+		runtime.WithCNILogger(logger.Session("cni-network")),
+		runtime.WithEgressViolations(reportEgressViolations),
+	}
 
 	if len(dnsServers) > 0 {
 		networkOpts = append(networkOpts, runtime.WithNameServers(dnsServers))
@@ -89,6 +95,15 @@ func (cmd *WorkerCommand) containerdGardenServerRunner(
 		runtime.WithInitBinPath(cmd.Containerd.InitBin),
 	)
 
+	if len(cmd.Containerd.RuntimeClasses) > 0 {
+		classes := map[string]string{}
+		for _, class := range cmd.Containerd.RuntimeClasses {
+			classes[class.Name] = class.Runtime
+		}
+
+		backendOpts = append(backendOpts, runtime.WithRuntimeClasses(classes))
+	}
+
 	gardenBackend, err := runtime.NewGardenBackend(
 		libcontainerd.New(containerdAddr, namespace, cmd.Containerd.RequestTimeout),
 		backendOpts...,
@@ -108,7 +123,7 @@ func (cmd *WorkerCommand) containerdGardenServerRunner(
 
 // containerdRunner spawns a containerd and a Garden server process for use as the container
 // runtime of Concourse.
-func (cmd *WorkerCommand) containerdRunner(logger lager.Logger) (ifrit.Runner, error) {
+func (cmd *WorkerCommand) containerdRunner(logger lager.Logger, reportEgressViolations func(packets uint64)) (ifrit.Runner, error) {
 	const sock = "/run/containerd/containerd.sock"
 
 	var (
@@ -176,6 +191,7 @@ func (cmd *WorkerCommand) containerdRunner(logger lager.Logger) (ifrit.Runner, e
 		logger,
 		sock,
 		dnsServers,
+		reportEgressViolations,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("containerd garden server runner: %w", err)
@@ -195,3 +211,20 @@ func (cmd *WorkerCommand) containerdRunner(logger lager.Logger) (ifrit.Runner, e
 	// Using the Ordered strategy to ensure containerd is up before the garden server is started
 	return grouper.NewOrdered(os.Interrupt, members), nil
 }
+
+type RuntimeClass struct {
+	Name    string
+	Runtime string
+}
+
+func (class *RuntimeClass) UnmarshalFlag(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return fmt.Errorf("invalid runtime class '%s' (must be of the form name=runtime)", value)
+	}
+
+	class.Name = parts[0]
+	class.Runtime = parts[1]
+
+	return nil
+}
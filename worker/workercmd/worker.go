@@ -44,6 +44,12 @@ type WorkerCommand struct {
 	VolumeSweeperMaxInFlight    uint16        `long:"volume-sweeper-max-in-flight" default:"3" description:"Maximum number of volumes which can be swept in parallel."`
 	ContainerSweeperMaxInFlight uint16        `long:"container-sweeper-max-in-flight" default:"5" description:"Maximum number of containers which can be swept in parallel."`
 
+	ResourceReportInterval time.Duration `long:"resource-report-interval" default:"30s" description:"Interval on which the worker's available memory, CPU load and work dir disk space are collected. The latest collection is reported with each heartbeat for container placement. 0 disables reporting."`
+
+	MemoryPressureThreshold float64 `long:"memory-pressure-threshold" default:"0"  description:"Percentage of free memory below which the worker is degraded and receives no new containers. 0 disables the check."`
+	DiskPressureThreshold   float64 `long:"disk-pressure-threshold"   default:"5"  description:"Percentage of free work dir disk space below which the worker is degraded and receives no new containers. 0 disables the check."`
+	InodePressureThreshold  float64 `long:"inode-pressure-threshold"  default:"5"  description:"Percentage of free work dir inodes below which the worker is degraded and receives no new containers. 0 disables the check."`
+
 	RebalanceInterval time.Duration `long:"rebalance-interval" default:"4h" description:"Duration after which the registration should be swapped to another random SSH gateway."`
 
 	ConnectionDrainTimeout time.Duration `long:"connection-drain-timeout" default:"1h" description:"Duration after which a worker should give up draining forwarded connections on shutdown."`
@@ -81,7 +87,9 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 
 	logger, _ := cmd.Logger.Logger("worker")
 
-	atcWorker, gardenServerRunner, err := cmd.gardenServerRunner(logger.Session("garden"))
+	egressViolations := new(worker.EgressViolations)
+
+	atcWorker, gardenServerRunner, err := cmd.gardenServerRunner(logger.Session("garden"), egressViolations.Add)
 	if err != nil {
 		return nil, err
 	}
@@ -93,15 +101,34 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		return nil, err
 	}
 
+	if cmd.TSA.WorkerKeyPath == "" {
+		cmd.TSA.WorkerKeyPath = filepath.Join(cmd.WorkDir.Path(), "tsa-worker-key")
+	}
+
+	tsaClient, err := cmd.TSA.Client(atcWorker)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceReporter := worker.NewResourceReporter(
+		logger.Session("resource-reporter"),
+		cmd.ResourceReportInterval,
+		worker.NewResourceCollector(cmd.WorkDir.Path(), egressViolations),
+		worker.PressureThresholds{
+			Memory: cmd.MemoryPressureThreshold,
+			Disk:   cmd.DiskPressureThreshold,
+			Inodes: cmd.InodePressureThreshold,
+		},
+	)
+
 	healthChecker := worker.NewHealthChecker(
 		logger.Session("healthchecker"),
 		cmd.baggageclaimURL(),
 		cmd.gardenURL(),
 		cmd.HealthCheckTimeout,
+		resourceReporter.Pressure,
 	)
 
-	tsaClient := cmd.TSA.Client(atcWorker)
-
 	beaconRunner := worker.NewBeaconRunner(
 		logger.Session("beacon-runner"),
 		tsaClient,
@@ -109,6 +136,7 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		cmd.ConnectionDrainTimeout,
 		cmd.gardenAddr(),
 		cmd.baggageclaimAddr(),
+		resourceReporter.Resources,
 	)
 
 	gardenClient := gclient.BasicGardenClientWithRequestTimeout(
@@ -155,6 +183,16 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 
 	var members grouper.Members
 
+	if cmd.ResourceReportInterval != 0 {
+		members = append(members, grouper.Member{
+			Name: "resource-reporter",
+			Runner: concourseCmd.NewLoggingRunner(
+				logger.Session("resource-reporter"),
+				resourceReporter,
+			),
+		})
+	}
+
 	if !cmd.gardenServerIsExternal() {
 		members = append(members, grouper.Member{
 			Name:   "garden",
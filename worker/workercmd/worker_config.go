@@ -1,6 +1,8 @@
 package workercmd
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -11,6 +13,8 @@ type WorkerConfig struct {
 	Tags     []string `long:"tag"   description:"A tag to set during registration. Can be specified multiple times."`
 	TeamName string   `long:"team"  description:"The name of the team that this worker will be assigned to."`
 
+	Labels []WorkerLabel `long:"label" value-name:"KEY=VALUE" description:"A label to set during registration, which steps can select workers by. Can be specified multiple times."`
+
 	HTTPProxy  string `long:"http-proxy"  env:"http_proxy"                  description:"HTTP proxy endpoint to use for containers."`
 	HTTPSProxy string `long:"https-proxy" env:"https_proxy"                 description:"HTTPS proxy endpoint to use for containers."`
 	NoProxy    string `long:"no-proxy"    env:"no_proxy"                    description:"Blacklist of addresses to skip the proxy when reaching."`
@@ -21,8 +25,17 @@ type WorkerConfig struct {
 }
 
 func (c WorkerConfig) Worker() atc.Worker {
+	var labels map[string]string
+	if len(c.Labels) > 0 {
+		labels = map[string]string{}
+		for _, label := range c.Labels {
+			labels[label.Key] = label.Value
+		}
+	}
+
 	return atc.Worker{
 		Tags:          c.Tags,
+		Labels:        labels,
 		Team:          c.TeamName,
 		Name:          c.Name,
 		StartTime:     time.Now().Unix(),
@@ -33,3 +46,20 @@ func (c WorkerConfig) Worker() atc.Worker {
 		Ephemeral:     c.Ephemeral,
 	}
 }
+
+type WorkerLabel struct {
+	Key   string
+	Value string
+}
+
+func (label *WorkerLabel) UnmarshalFlag(value string) error {
+	parts := strings.SplitN(value, "=", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return fmt.Errorf("invalid label '%s' (must be of the form key=value)", value)
+	}
+
+	label.Key = parts[0]
+	label.Value = parts[1]
+
+	return nil
+}
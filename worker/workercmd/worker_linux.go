@@ -49,6 +49,8 @@ type ContainerdRuntime struct {
 	RestrictedNetworks []string  `long:"restricted-network" description:"Network ranges to which traffic from containers will be restricted. Can be specified multiple times."`
 	MaxContainers      int       `long:"max-containers" default:"250" description:"Max container capacity. 0 means no limit."`
 	NetworkPool        string    `long:"network-pool" default:"10.80.0.0/16" description:"Network range to use for dynamically allocated container subnets."`
+
+	RuntimeClasses []RuntimeClass `long:"runtime-class" value-name:"NAME=RUNTIME" description:"A runtime class which task steps can request to be run with, and the containerd runtime which runs them, e.g. gvisor=io.containerd.runsc.v1. Can be specified multiple times."`
 }
 
 const containerdRuntime = "containerd"
@@ -64,7 +66,8 @@ func (cmd WorkerCommand) LessenRequirements(prefix string, command *flags.Comman
 // The runtime is represented as a Ifrit runner that must include a Garden Server process. The Garden server exposes API
 // endpoints that allow the ATC to make container related requests to the worker.
 // The runner may also include additional processes such as the runtime's daemon or a DNS proxy server.
-func (cmd *WorkerCommand) gardenServerRunner(logger lager.Logger) (atc.Worker, ifrit.Runner, error) {
+// Runtimes enforcing egress policies report the packets they deny to reportEgressViolations.
+func (cmd *WorkerCommand) gardenServerRunner(logger lager.Logger, reportEgressViolations func(packets uint64)) (atc.Worker, ifrit.Runner, error) {
 	err := cmd.checkRoot()
 	if err != nil {
 		return atc.Worker{}, nil, err
@@ -100,7 +103,7 @@ func (cmd *WorkerCommand) gardenServerRunner(logger lager.Logger) (atc.Worker, i
 	case cmd.Runtime == houdiniRuntime:
 		runner, err = cmd.houdiniRunner(logger)
 	case cmd.Runtime == containerdRuntime:
-		runner, err = cmd.containerdRunner(logger)
+		runner, err = cmd.containerdRunner(logger, reportEgressViolations)
 	case cmd.Runtime == guardianRuntime:
 		runner, err = cmd.guardianRunner(logger)
 	default:
@@ -111,6 +114,14 @@ func (cmd *WorkerCommand) gardenServerRunner(logger lager.Logger) (atc.Worker, i
 		return atc.Worker{}, nil, err
 	}
 
+	if cmd.Runtime == containerdRuntime {
+		for _, class := range cmd.Containerd.RuntimeClasses {
+			worker.RuntimeClasses = append(worker.RuntimeClasses, class.Name)
+		}
+
+		worker.EnforcesEgressPolicies = true
+	}
+
 	return worker, runner, nil
 }
 